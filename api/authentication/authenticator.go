@@ -0,0 +1,103 @@
+package authentication
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nephio-experimental/tko/backend"
+	"gopkg.in/yaml.v2"
+)
+
+const BearerPrefix = "Bearer "
+
+//
+// Authenticator
+//
+
+// Authenticates callers by their verified TLS client certificate (mTLS) or by a bearer token.
+type Authenticator struct {
+	Tokens         map[string]*backend.Identity // key is token
+	AllowAnonymous bool
+}
+
+func NewAuthenticator(allowAnonymous bool) *Authenticator {
+	return &Authenticator{
+		Tokens:         make(map[string]*backend.Identity),
+		AllowAnonymous: allowAnonymous,
+	}
+}
+
+// Loads a YAML list of tokens. Each entry has "token" and "identity" keys and an optional
+// "groups" list.
+func (self *Authenticator) LoadTokens(path string) error {
+	if content, err := os.ReadFile(path); err == nil {
+		var tokens []Token
+		if err := yaml.UnmarshalStrict(content, &tokens); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		for _, token := range tokens {
+			if token.Token == "" {
+				return fmt.Errorf("%s: token is empty", path)
+			}
+			if token.Identity == "" {
+				return fmt.Errorf("%s: identity is empty", path)
+			}
+			self.Tokens[token.Token] = backend.NewIdentity(token.Identity, token.Groups...)
+		}
+
+		return nil
+	} else {
+		return err
+	}
+}
+
+// The authorization argument is the value of the "Authorization" header (or gRPC metadata),
+// and may be empty. A verified client certificate takes precedence over the bearer token.
+//
+// Returns an anonymous identity if there are no credentials and AllowAnonymous is true.
+// Returns false if the credentials are invalid or missing.
+func (self *Authenticator) Authenticate(tlsState *tls.ConnectionState, authorization string) (*backend.Identity, bool) {
+	if identity, ok := IdentityFromTLS(tlsState); ok {
+		return identity, true
+	}
+
+	if authorization != "" {
+		if token, ok := strings.CutPrefix(authorization, BearerPrefix); ok {
+			if identity, ok := self.Tokens[token]; ok {
+				return identity, true
+			}
+		}
+		return nil, false
+	}
+
+	if self.AllowAnonymous {
+		return backend.NewAnonymousIdentity(), true
+	}
+
+	return nil, false
+}
+
+// The identity is the certificate's subject common name and the groups are its organizations,
+// as in Kubernetes.
+func IdentityFromTLS(tlsState *tls.ConnectionState) (*backend.Identity, bool) {
+	if (tlsState != nil) && (len(tlsState.VerifiedChains) > 0) && (len(tlsState.VerifiedChains[0]) > 0) {
+		subject := tlsState.VerifiedChains[0][0].Subject
+		if subject.CommonName != "" {
+			return backend.NewIdentity(subject.CommonName, subject.Organization...), true
+		}
+	}
+	return nil, false
+}
+
+//
+// Token
+//
+
+type Token struct {
+	Token    string   `yaml:"token"`
+	Identity string   `yaml:"identity"`
+	Groups   []string `yaml:"groups"`
+}
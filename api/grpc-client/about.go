@@ -21,7 +21,7 @@ type AboutGRPC struct {
 
 func (self *Client) About() (About, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Infof("about")
@@ -0,0 +1,256 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/tliron/go-ard"
+	"github.com/tliron/kutil/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// Tar of YAML files, one per entity. The first YAML document in each file is the entity's
+	// info and the rest are its package's resources.
+	ArchiveFormatTar = "tar"
+
+	// CBOR sequence of archive entries.
+	ArchiveFormatCBOR = "cbor"
+
+	ArchiveFormatsDescription = "\"tar\" or \"cbor\""
+)
+
+var archiveCborEncode cbor.EncMode
+var archiveCborDecode cbor.DecMode
+
+func init() {
+	var err error
+	if archiveCborEncode, err = (cbor.EncOptions{Time: cbor.TimeRFC3339Nano}).EncMode(); err != nil {
+		panic(err)
+	}
+	if archiveCborDecode, err = (cbor.DecOptions{DefaultMapType: reflect.TypeFor[ard.Map]()}).DecMode(); err != nil {
+		panic(err)
+	}
+}
+
+func IsValidArchiveFormat(format string) bool {
+	switch format {
+	case ArchiveFormatTar, ArchiveFormatCBOR:
+		return true
+	default:
+		return false
+	}
+}
+
+// Writes the entries in order.
+func WriteArchive(writer io.Writer, format string, archiveEntries util.Results[ArchiveEntry]) error {
+	switch format {
+	case ArchiveFormatTar:
+		tarWriter := tar.NewWriter(writer)
+		if err := util.IterateResults(archiveEntries, func(archiveEntry ArchiveEntry) error {
+			return writeTarArchiveEntry(tarWriter, archiveEntry)
+		}); err != nil {
+			return err
+		}
+		return tarWriter.Close()
+
+	case ArchiveFormatCBOR:
+		encoder := archiveCborEncode.NewEncoder(writer)
+		return util.IterateResults(archiveEntries, func(archiveEntry ArchiveEntry) error {
+			return encoder.Encode(archiveEntry)
+		})
+
+	default:
+		archiveEntries.Release()
+		return fmt.Errorf("archive format must be %s: %s", ArchiveFormatsDescription, format)
+	}
+}
+
+// Reads the entries in order.
+func ReadArchive(reader io.Reader, format string) (util.Results[ArchiveEntry], error) {
+	var next func() (ArchiveEntry, error)
+
+	switch format {
+	case ArchiveFormatTar:
+		tarReader := tar.NewReader(reader)
+		next = func() (ArchiveEntry, error) {
+			return readTarArchiveEntry(tarReader)
+		}
+
+	case ArchiveFormatCBOR:
+		decoder := archiveCborDecode.NewDecoder(reader)
+		next = func() (ArchiveEntry, error) {
+			var archiveEntry ArchiveEntry
+			err := decoder.Decode(&archiveEntry)
+			return archiveEntry, err // special handling for io.EOF
+		}
+
+	default:
+		return nil, fmt.Errorf("archive format must be %s: %s", ArchiveFormatsDescription, format)
+	}
+
+	stream := util.NewResultsStream[ArchiveEntry](nil)
+
+	go func() {
+		for {
+			if archiveEntry, err := next(); err == nil {
+				stream.Send(archiveEntry)
+			} else {
+				stream.Close(err) // special handling for io.EOF
+				return
+			}
+		}
+	}()
+
+	return stream, nil
+}
+
+func writeTarArchiveEntry(tarWriter *tar.Writer, archiveEntry ArchiveEntry) error {
+	var path string
+	var modTime time.Time
+	var documents []any
+
+	switch {
+	case archiveEntry.Template != nil:
+		template := archiveEntry.Template
+		path = "templates/" + template.Namespace + "/" + url.PathEscape(template.TemplateID) + ".yaml"
+		modTime = template.Updated
+		documents = append(documents, template.TemplateInfo)
+		for _, resource := range template.Package {
+			documents = append(documents, resource)
+		}
+
+	case archiveEntry.Site != nil:
+		site := archiveEntry.Site
+		path = "sites/" + site.Namespace + "/" + url.PathEscape(site.SiteID) + ".yaml"
+		modTime = site.Updated
+		documents = append(documents, site.SiteInfo)
+		for _, resource := range site.Package {
+			documents = append(documents, resource)
+		}
+
+	case archiveEntry.Plugin != nil:
+		plugin := archiveEntry.Plugin
+		path = "plugins/" + plugin.Type + "/" + url.PathEscape(plugin.Name) + ".yaml"
+		modTime = time.Now()
+		documents = append(documents, plugin)
+
+	case archiveEntry.Deployment != nil:
+		deployment := archiveEntry.Deployment
+		path = "deployments/" + deployment.Namespace + "/" + url.PathEscape(deployment.DeploymentID) + ".yaml"
+		modTime = deployment.Updated
+		documents = append(documents, deployment.DeploymentInfo)
+		for _, resource := range deployment.Package {
+			documents = append(documents, resource)
+		}
+
+	default:
+		return nil
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	for _, document := range documents {
+		if err := encoder.Encode(document); err != nil {
+			return err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path,
+		Mode:     0644,
+		Size:     int64(buffer.Len()),
+		ModTime:  modTime,
+	}); err != nil {
+		return err
+	}
+
+	_, err := tarWriter.Write(buffer.Bytes())
+	return err
+}
+
+func readTarArchiveEntry(tarReader *tar.Reader) (ArchiveEntry, error) {
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			return ArchiveEntry{}, err // special handling for io.EOF
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		decoder := yaml.NewDecoder(tarReader)
+
+		type_, _, _ := strings.Cut(header.Name, "/")
+		switch type_ {
+		case "templates":
+			var template Template
+			if err := decoder.Decode(&template.TemplateInfo); err != nil {
+				return ArchiveEntry{}, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			if template.Package, err = readTarArchivePackage(decoder); err != nil {
+				return ArchiveEntry{}, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			return ArchiveEntry{Template: &template}, nil
+
+		case "sites":
+			var site Site
+			if err := decoder.Decode(&site.SiteInfo); err != nil {
+				return ArchiveEntry{}, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			if site.Package, err = readTarArchivePackage(decoder); err != nil {
+				return ArchiveEntry{}, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			return ArchiveEntry{Site: &site}, nil
+
+		case "plugins":
+			var plugin Plugin
+			if err := decoder.Decode(&plugin); err != nil {
+				return ArchiveEntry{}, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			return ArchiveEntry{Plugin: &plugin}, nil
+
+		case "deployments":
+			var deployment Deployment
+			if err := decoder.Decode(&deployment.DeploymentInfo); err != nil {
+				return ArchiveEntry{}, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			if deployment.Package, err = readTarArchivePackage(decoder); err != nil {
+				return ArchiveEntry{}, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			return ArchiveEntry{Deployment: &deployment}, nil
+
+		default:
+			return ArchiveEntry{}, fmt.Errorf("unsupported archive path: %s", header.Name)
+		}
+	}
+}
+
+func readTarArchivePackage(decoder *yaml.Decoder) (tkoutil.Package, error) {
+	var package_ tkoutil.Package
+	for {
+		var resource tkoutil.Resource
+		if err := decoder.Decode(&resource); err == nil {
+			if resource != nil {
+				package_ = append(package_, resource)
+			}
+		} else if err == io.EOF {
+			return package_, nil
+		} else {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package client
+
+import (
+	contextpkg "context"
+	"fmt"
+	"io"
+	"time"
+
+	api "github.com/nephio-experimental/tko/api/grpc"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/tliron/kutil/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Exactly one of the fields is set.
+type ArchiveEntry struct {
+	Template   *Template   `json:"template,omitempty" yaml:"template,omitempty"`
+	Site       *Site       `json:"site,omitempty" yaml:"site,omitempty"`
+	Plugin     *Plugin     `json:"plugin,omitempty" yaml:"plugin,omitempty"`
+	Deployment *Deployment `json:"deployment,omitempty" yaml:"deployment,omitempty"`
+}
+
+type ImportArchiveResult struct {
+	Imported          bool   `json:"imported" yaml:"imported"`
+	NotImportedReason string `json:"notImportedReason,omitempty" yaml:"notImportedReason,omitempty"`
+	Templates         uint64 `json:"templates" yaml:"templates"`
+	Sites             uint64 `json:"sites" yaml:"sites"`
+	Plugins           uint64 `json:"plugins" yaml:"plugins"`
+	Deployments       uint64 `json:"deployments" yaml:"deployments"`
+}
+
+// Namespace can be "*" for all namespaces, which also includes plugins.
+//
+// Entries are streamed in an order that allows them to be imported.
+func (self *Client) ExportArchive(namespace string) (util.Results[ArchiveEntry], error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		// Archives can be large, so we do not time out
+		context, cancel := contextpkg.WithCancel(self.parentContext())
+
+		self.log.Info("exportArchive",
+			"namespace", namespace)
+		if client, err := apiClient.ExportArchive(context, &api.ExportArchive{Namespace: namespace, PreferredPackageFormat: self.PackageFormat}); err == nil {
+			stream := util.NewResultsStream[ArchiveEntry](cancel)
+
+			go func() {
+				for {
+					if archiveEntry, err := client.Recv(); err == nil {
+						if archiveEntry_, err := self.archiveEntryFromAPI(archiveEntry); err == nil {
+							stream.Send(archiveEntry_)
+						} else {
+							stream.Close(err)
+							return
+						}
+					} else {
+						stream.Close(err) // special handling for io.EOF
+						return
+					}
+				}
+			}()
+
+			return stream, nil
+		} else {
+			cancel()
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
+// Mode can be "merge" (or empty) or "replace".
+//
+// Entries should be in the order in which they were exported. Importing stops at the first
+// failure, in which case the result has the counts of entries that were imported.
+func (self *Client) ImportArchive(mode string, archiveEntries util.Results[ArchiveEntry]) (ImportArchiveResult, error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		// Archives can be large, so we do not time out
+		context, cancel := contextpkg.WithCancel(self.parentContext())
+		defer cancel()
+
+		self.log.Info("importArchive",
+			"mode", mode)
+		if client, err := apiClient.ImportArchive(context); err == nil {
+			// The mode is sent even if there are no entries
+			if err := client.Send(&api.ImportArchive{Mode: mode}); err != nil {
+				return ImportArchiveResult{}, self.closeImportArchive(client, err)
+			}
+
+			if err := util.IterateResults(archiveEntries, func(archiveEntry ArchiveEntry) error {
+				if archiveEntry_, err := self.archiveEntryToAPI(archiveEntry); err == nil {
+					return client.Send(&api.ImportArchive{Entry: archiveEntry_})
+				} else {
+					return err
+				}
+			}); err != nil {
+				return ImportArchiveResult{}, self.closeImportArchive(client, err)
+			}
+
+			if response, err := client.CloseAndRecv(); err == nil {
+				return ImportArchiveResult{
+					Imported:          response.Imported,
+					NotImportedReason: response.NotImportedReason,
+					Templates:         response.Templates,
+					Sites:             response.Sites,
+					Plugins:           response.Plugins,
+					Deployments:       response.Deployments,
+				}, nil
+			} else {
+				return ImportArchiveResult{}, err
+			}
+		} else {
+			return ImportArchiveResult{}, err
+		}
+	} else {
+		return ImportArchiveResult{}, err
+	}
+}
+
+// If the server closed the stream then its error is more informative than ours.
+func (self *Client) closeImportArchive(client api.Data_ImportArchiveClient, err error) error {
+	if err == io.EOF {
+		if _, err_ := client.CloseAndRecv(); err_ != nil {
+			return err_
+		}
+	}
+	return err
+}
+
+func (self *Client) archiveEntryFromAPI(archiveEntry *api.ArchiveEntry) (ArchiveEntry, error) {
+	switch entity := archiveEntry.Entity.(type) {
+	case *api.ArchiveEntry_Template:
+		template := entity.Template
+		if package_, err := tkoutil.DecodePackage(template.PackageFormat, template.Package); err == nil {
+			return ArchiveEntry{Template: &Template{
+				TemplateInfo: TemplateInfo{
+					Namespace:  template.Namespace,
+					TemplateID: template.TemplateId,
+					Metadata:   template.Metadata,
+					Updated:    self.toTime(template.Updated),
+					Version:    template.Version,
+				},
+				Package: package_,
+			}}, nil
+		} else {
+			return ArchiveEntry{}, err
+		}
+
+	case *api.ArchiveEntry_Site:
+		site := entity.Site
+		if package_, err := tkoutil.DecodePackage(site.PackageFormat, site.Package); err == nil {
+			return ArchiveEntry{Site: &Site{
+				SiteInfo: SiteInfo{
+					Namespace:  site.Namespace,
+					SiteID:     site.SiteId,
+					TemplateID: site.TemplateId,
+					Metadata:   site.Metadata,
+					Updated:    self.toTime(site.Updated),
+					Version:    site.Version,
+				},
+				Package: package_,
+			}}, nil
+		} else {
+			return ArchiveEntry{}, err
+		}
+
+	case *api.ArchiveEntry_Plugin:
+		plugin := entity.Plugin
+		return ArchiveEntry{Plugin: &Plugin{
+			PluginID:   NewPluginID(plugin.Type, plugin.Name),
+			Executor:   plugin.Executor,
+			Arguments:  plugin.Arguments,
+			Properties: plugin.Properties,
+			Triggers:   tkoutil.TriggersFromAPI(plugin.Triggers),
+			Version:    plugin.Version,
+		}}, nil
+
+	case *api.ArchiveEntry_Deployment:
+		deployment := entity.Deployment
+		if package_, err := tkoutil.DecodePackage(deployment.PackageFormat, deployment.Package); err == nil {
+			return ArchiveEntry{Deployment: &Deployment{
+				DeploymentInfo: DeploymentInfo{
+					Namespace:          deployment.Namespace,
+					DeploymentID:       deployment.DeploymentId,
+					ParentDeploymentID: deployment.ParentDeploymentId,
+					TemplateID:         deployment.TemplateId,
+					SiteID:             deployment.SiteId,
+					Metadata:           deployment.Metadata,
+					Created:            self.toTime(deployment.Created),
+					Updated:            self.toTime(deployment.Updated),
+					Prepared:           deployment.Prepared,
+					Approved:           deployment.Approved,
+					Version:            deployment.Version,
+				},
+				Package: package_,
+			}}, nil
+		} else {
+			return ArchiveEntry{}, err
+		}
+	}
+
+	return ArchiveEntry{}, nil
+}
+
+func (self *Client) archiveEntryToAPI(archiveEntry ArchiveEntry) (*api.ArchiveEntry, error) {
+	switch {
+	case archiveEntry.Template != nil:
+		template := archiveEntry.Template
+		if package_, err := self.encodePackage(template.Package); err == nil {
+			return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Template{Template: &api.Template{
+				Namespace:     template.Namespace,
+				TemplateId:    template.TemplateID,
+				Metadata:      template.Metadata,
+				Updated:       toTimestamp(template.Updated),
+				PackageFormat: self.PackageFormat,
+				Package:       package_,
+			}}}, nil
+		} else {
+			return nil, err
+		}
+
+	case archiveEntry.Site != nil:
+		site := archiveEntry.Site
+		if package_, err := self.encodePackage(site.Package); err == nil {
+			return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Site{Site: &api.Site{
+				Namespace:     site.Namespace,
+				SiteId:        site.SiteID,
+				TemplateId:    site.TemplateID,
+				Metadata:      site.Metadata,
+				Updated:       toTimestamp(site.Updated),
+				PackageFormat: self.PackageFormat,
+				Package:       package_,
+			}}}, nil
+		} else {
+			return nil, err
+		}
+
+	case archiveEntry.Plugin != nil:
+		plugin := archiveEntry.Plugin
+		return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Plugin{Plugin: &api.Plugin{
+			Type:       plugin.Type,
+			Name:       plugin.Name,
+			Executor:   plugin.Executor,
+			Arguments:  plugin.Arguments,
+			Properties: plugin.Properties,
+			Triggers:   tkoutil.TriggersToAPI(plugin.Triggers),
+		}}}, nil
+
+	case archiveEntry.Deployment != nil:
+		deployment := archiveEntry.Deployment
+		if package_, err := self.encodePackage(deployment.Package); err == nil {
+			return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Deployment{Deployment: &api.Deployment{
+				Namespace:          deployment.Namespace,
+				DeploymentId:       deployment.DeploymentID,
+				ParentDeploymentId: deployment.ParentDeploymentID,
+				TemplateId:         deployment.TemplateID,
+				SiteId:             deployment.SiteID,
+				Metadata:           deployment.Metadata,
+				Created:            toTimestamp(deployment.Created),
+				Updated:            toTimestamp(deployment.Updated),
+				Prepared:           deployment.Prepared,
+				Approved:           deployment.Approved,
+				PackageFormat:      self.PackageFormat,
+				Package:            package_,
+			}}}, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("archive entry is empty")
+}
+
+// Zero is nil.
+func toTimestamp(time_ time.Time) *timestamppb.Timestamp {
+	if time_.IsZero() {
+		return nil
+	}
+	return timestamppb.New(time_)
+}
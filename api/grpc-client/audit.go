@@ -0,0 +1,101 @@
+package client
+
+import (
+	contextpkg "context"
+	"time"
+
+	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/tliron/kutil/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditEvent struct {
+	ID        uint64    `json:"id" yaml:"id"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+	Actor     string    `json:"actor" yaml:"actor"`
+	Operation string    `json:"operation" yaml:"operation"`
+	Type      string    `json:"type" yaml:"type"`
+	Namespace string    `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	IDs       []string  `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Selector  string    `json:"selector,omitempty" yaml:"selector,omitempty"`
+	Outcome   string    `json:"outcome" yaml:"outcome"`
+	Reason    string    `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Diff      string    `json:"diff,omitempty" yaml:"diff,omitempty"`
+
+	ClaimedAuthor string `json:"claimedAuthor,omitempty" yaml:"claimedAuthor,omitempty"` // not verified
+}
+
+type SelectAuditEvents struct {
+	Namespace string    `json:"namespace,omitempty" yaml:"namespace,omitempty"` // can be "*"
+	Since     time.Time `json:"since,omitempty" yaml:"since,omitempty"`         // zero for no lower bound
+	Until     time.Time `json:"until,omitempty" yaml:"until,omitempty"`         // zero for no upper bound
+	Actors    []string  `json:"actors,omitempty" yaml:"actors,omitempty"`
+}
+
+func (self *Client) ListAllAuditEvents(selectAuditEvents SelectAuditEvents) util.Results[AuditEvent] {
+	return util.CombineResults(func(offset uint) (util.Results[AuditEvent], error) {
+		return self.ListAuditEvents(selectAuditEvents, offset, ChunkSize)
+	})
+}
+
+func (self *Client) ListAuditEvents(selectAuditEvents SelectAuditEvents, offset uint, maxCount int) (util.Results[AuditEvent], error) {
+	var window *api.Window
+	var err error
+	if window, err = newWindow(offset, maxCount); err != nil {
+		return nil, err
+	}
+
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
+
+		listAuditEvents := api.ListAuditEvents{
+			Window:    window,
+			Namespace: selectAuditEvents.Namespace,
+			Actors:    selectAuditEvents.Actors,
+		}
+		if !selectAuditEvents.Since.IsZero() {
+			listAuditEvents.Since = timestamppb.New(selectAuditEvents.Since)
+		}
+		if !selectAuditEvents.Until.IsZero() {
+			listAuditEvents.Until = timestamppb.New(selectAuditEvents.Until)
+		}
+
+		self.log.Info("listAuditEvents",
+			"selectAuditEvents", selectAuditEvents)
+		if client, err := apiClient.ListAuditEvents(context, &listAuditEvents); err == nil {
+			stream := util.NewResultsStream[AuditEvent](cancel)
+
+			go func() {
+				for {
+					if auditEvent, err := client.Recv(); err == nil {
+						stream.Send(AuditEvent{
+							ID:        auditEvent.Id,
+							Timestamp: self.toTime(auditEvent.Timestamp),
+							Actor:     auditEvent.Actor,
+							Operation: auditEvent.Operation,
+							Type:      auditEvent.Type,
+							Namespace: auditEvent.Namespace,
+							IDs:       auditEvent.Ids,
+							Selector:  auditEvent.Selector,
+							Outcome:   auditEvent.Outcome,
+							Reason:    auditEvent.Reason,
+							Diff:      auditEvent.Diff,
+
+							ClaimedAuthor: auditEvent.ClaimedAuthor,
+						})
+					} else {
+						stream.Close(err) // special handling for io.EOF
+						return
+					}
+				}
+			}()
+
+			return stream, nil
+		} else {
+			cancel()
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
@@ -1,16 +1,24 @@
 package client
 
 import (
+	contextpkg "context"
+	"crypto/tls"
 	"sync"
 	"time"
 
 	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Packages larger than this (in bytes) are sent in chunks via the streaming RPCs.
+const DefaultStreamThreshold = 1024 * 1024
+
 //
 // Client
 //
@@ -22,10 +30,14 @@ type Client struct {
 	PackageFormat      string
 	Timeout            time.Duration
 	Timezone           *time.Location
+	Author             string      // recorded in revisions
+	Token              string      // bearer token
+	TLS                *tls.Config // if nil will not use TLS
+	StreamThreshold    int         // in bytes; if <= 0 will not stream packages
 
-	dataClient     api.DataClient
-	dataClientLock sync.Mutex
-	log            commonlog.Logger
+	context    contextpkg.Context
+	connection *connection
+	log        commonlog.Logger
 }
 
 func NewClient(grpcIpStack util.IPStack, grpcAddress string, grpcPort int, packageFormat string, timeout time.Duration, log commonlog.Logger) *Client {
@@ -42,23 +54,33 @@ func NewClient(grpcIpStack util.IPStack, grpcAddress string, grpcPort int, packa
 		PackageFormat:      packageFormat,
 		Timeout:            timeout,
 		Timezone:           time.Local,
+		StreamThreshold:    DefaultStreamThreshold,
+		connection:         new(connection),
 		log:                log,
 	}
 }
 
+// Returns a copy of the client that uses the context as the parent for all requests, e.g. in
+// order to propagate its trace. The copy shares the connection.
+func (self *Client) WithContext(context contextpkg.Context) *Client {
+	client := *self
+	client.context = context
+	return &client
+}
+
 func (self *Client) DataClient() (api.DataClient, error) {
-	self.dataClientLock.Lock()
-	defer self.dataClientLock.Unlock()
+	self.connection.lock.Lock()
+	defer self.connection.lock.Unlock()
 
-	if self.dataClient == nil {
-		if clientConn, err := tkoutil.DialGRPCInsecure(self.GRPCAddress, self.GRPCPort); err == nil {
-			self.dataClient = api.NewDataClient(clientConn)
+	if self.connection.dataClient == nil {
+		if clientConn, err := tkoutil.DialGRPC(self.GRPCAddress, self.GRPCPort, self.TLS, grpc.WithUnaryInterceptor(self.unaryInterceptor), grpc.WithStreamInterceptor(self.streamInterceptor), telemetry.GRPCDialOption()); err == nil {
+			self.connection.dataClient = api.NewDataClient(clientConn)
 		} else {
 			return nil, err
 		}
 	}
 
-	return self.dataClient, nil
+	return self.connection.dataClient, nil
 }
 
 // Utils
@@ -67,6 +89,48 @@ func (self *Client) encodePackage(package_ tkoutil.Package) ([]byte, error) {
 	return tkoutil.EncodePackage(self.PackageFormat, package_)
 }
 
+func (self *Client) streamPackage(package_ []byte) bool {
+	return (self.StreamThreshold > 0) && (len(package_) > self.StreamThreshold)
+}
+
+func (self *Client) parentContext() contextpkg.Context {
+	if self.context != nil {
+		return self.context
+	} else {
+		return contextpkg.Background()
+	}
+}
+
 func (self *Client) toTime(timestamp *timestamppb.Timestamp) time.Time {
 	return timestamp.AsTime().In(self.Timezone)
 }
+
+// ([grpc.UnaryClientInterceptor] signature)
+func (self *Client) unaryInterceptor(context contextpkg.Context, method string, request any, reply any, clientConn *grpc.ClientConn, invoker grpc.UnaryInvoker, options ...grpc.CallOption) error {
+	if self.Author != "" {
+		context = metadata.AppendToOutgoingContext(context, tkoutil.GRPCAuthorMetadataKey, self.Author)
+	}
+
+	return invoker(self.withToken(context), method, request, reply, clientConn, options...)
+}
+
+// ([grpc.StreamClientInterceptor] signature)
+func (self *Client) streamInterceptor(context contextpkg.Context, streamDesc *grpc.StreamDesc, clientConn *grpc.ClientConn, method string, streamer grpc.Streamer, options ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(self.withToken(context), streamDesc, clientConn, method, options...)
+}
+
+func (self *Client) withToken(context contextpkg.Context) contextpkg.Context {
+	if self.Token != "" {
+		context = metadata.AppendToOutgoingContext(context, tkoutil.GRPCAuthorizationMetadataKey, "Bearer "+self.Token)
+	}
+	return context
+}
+
+//
+// connection
+//
+
+type connection struct {
+	dataClient api.DataClient
+	lock       sync.Mutex
+}
@@ -12,6 +12,9 @@ import (
 
 const ChunkSize = 100
 
+// For listing and watching.
+const AllNamespaces = "*"
+
 func IsNotFoundError(err error) bool {
 	if status_, ok := status.FromError(err); ok {
 		if status_.Code() == codes.NotFound {
@@ -21,6 +24,33 @@ func IsNotFoundError(err error) bool {
 	return false
 }
 
+func IsNotImplementedError(err error) bool {
+	if status_, ok := status.FromError(err); ok {
+		if status_.Code() == codes.Unimplemented {
+			return true
+		}
+	}
+	return false
+}
+
+func IsResourceExhaustedError(err error) bool {
+	if status_, ok := status.FromError(err); ok {
+		if status_.Code() == codes.ResourceExhausted {
+			return true
+		}
+	}
+	return false
+}
+
+func IsConflictError(err error) bool {
+	if status_, ok := status.FromError(err); ok {
+		if status_.Code() == codes.FailedPrecondition {
+			return true
+		}
+	}
+	return false
+}
+
 func stringifyStringList(list []string) string {
 	return strings.Join(list, ",")
 }
@@ -4,6 +4,7 @@ import (
 	contextpkg "context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 )
 
 type DeploymentInfo struct {
+	Namespace          string            `json:"namespace" yaml:"namespace"`
 	DeploymentID       string            `json:"deploymentId" yaml:"deploymentId"`
 	ParentDeploymentID string            `json:"parentDeploymentId,omitempty" yaml:"parentDeploymentId,omitempty"`
 	TemplateID         string            `json:"templateId" yaml:"templateId"`
@@ -23,27 +25,29 @@ type DeploymentInfo struct {
 	Updated            time.Time         `json:"updated" yaml:"updated"`
 	Prepared           bool              `json:"prepared" yaml:"prepared"`
 	Approved           bool              `json:"approved" yaml:"approved"`
+	Version            uint64            `json:"version" yaml:"version"`
 }
 
 type Deployment struct {
-	DeploymentInfo
-	Package tkoutil.Package `json:"package" yaml:"package"`
+	DeploymentInfo `json:",inline" yaml:",inline"`
+	Package        tkoutil.Package `json:"package" yaml:"package"`
 }
 
-func (self *Client) CreateDeployment(parentDeploymentId string, templateId string, siteId string, mergeMetadata map[string]string, prepared bool, approved bool, mergePackage tkoutil.Package) (bool, string, string, error) {
+func (self *Client) CreateDeployment(namespace string, parentDeploymentId string, templateId string, siteId string, mergeMetadata map[string]string, prepared bool, approved bool, mergePackage tkoutil.Package) (bool, string, string, error) {
 	if mergePackage_, err := self.encodePackage(mergePackage); err == nil {
-		return self.CreateDeploymentRaw(parentDeploymentId, templateId, siteId, mergeMetadata, prepared, approved, self.PackageFormat, mergePackage_)
+		return self.CreateDeploymentRaw(namespace, parentDeploymentId, templateId, siteId, mergeMetadata, prepared, approved, self.PackageFormat, mergePackage_)
 	} else {
 		return false, "", "", err
 	}
 }
 
-func (self *Client) CreateDeploymentRaw(parentDeploymentId string, templateId string, siteId string, mergeMetadata map[string]string, prepared bool, approved bool, mergePackageFormat string, mergePackage []byte) (bool, string, string, error) {
+func (self *Client) CreateDeploymentRaw(namespace string, parentDeploymentId string, templateId string, siteId string, mergeMetadata map[string]string, prepared bool, approved bool, mergePackageFormat string, mergePackage []byte) (bool, string, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("createDeployment",
+			"namespace", namespace,
 			"parentDeploymentId", parentDeploymentId,
 			"templateId", templateId,
 			"siteId", siteId,
@@ -52,6 +56,7 @@ func (self *Client) CreateDeploymentRaw(parentDeploymentId string, templateId st
 			"approved", approved,
 			"mergePackageFormat", mergePackageFormat)
 		if response, err := apiClient.CreateDeployment(context, &api.CreateDeployment{
+			Namespace:          namespace,
 			ParentDeploymentId: parentDeploymentId,
 			TemplateId:         templateId,
 			SiteId:             siteId,
@@ -70,31 +75,55 @@ func (self *Client) CreateDeploymentRaw(parentDeploymentId string, templateId st
 	}
 }
 
-func (self *Client) GetDeployment(deploymentId string) (Deployment, bool, error) {
+func (self *Client) GetDeployment(namespace string, deploymentId string) (Deployment, bool, error) {
+	return self.getDeployment(namespace, deploymentId, false)
+}
+
+// The package will contain only those resources that differ from the template.
+func (self *Client) GetDeploymentChanges(namespace string, deploymentId string) (Deployment, bool, error) {
+	return self.getDeployment(namespace, deploymentId, true)
+}
+
+func (self *Client) getDeployment(namespace string, deploymentId string, changesOnly bool) (Deployment, bool, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("getDeployment",
-			"deploymentId", deploymentId)
-		if deployment, err := apiClient.GetDeployment(context, &api.GetDeployment{DeploymentId: deploymentId, PreferredPackageFormat: self.PackageFormat}); err == nil {
-			if package_, err := tkoutil.DecodePackage(deployment.PackageFormat, deployment.Package); err == nil {
-				return Deployment{
-					DeploymentInfo: DeploymentInfo{
-						DeploymentID: deployment.DeploymentId,
-						TemplateID:   deployment.TemplateId,
-						SiteID:       deployment.SiteId,
-						Metadata:     deployment.Metadata,
-						Created:      self.toTime(deployment.Created),
-						Updated:      self.toTime(deployment.Updated),
-						Prepared:     deployment.Prepared,
-						Approved:     deployment.Approved,
-					},
-					Package: package_,
-				}, true, nil
-			} else {
-				return Deployment{}, false, err
-			}
+			"namespace", namespace,
+			"deploymentId", deploymentId,
+			"changesOnly", changesOnly)
+		getDeployment := api.GetDeployment{Namespace: namespace, DeploymentId: deploymentId, PreferredPackageFormat: self.PackageFormat, ChangesOnly: changesOnly}
+
+		deployment, err := apiClient.GetDeployment(context, &getDeployment)
+		var package_ tkoutil.Package
+		if err == nil {
+			package_, err = tkoutil.DecodePackage(deployment.PackageFormat, deployment.Package)
+		} else if IsResourceExhaustedError(err) && (self.StreamThreshold > 0) {
+			// Too big for a single message
+			self.log.Info("getDeploymentChunked",
+				"namespace", namespace,
+				"deploymentId", deploymentId,
+				"changesOnly", changesOnly)
+			deployment, package_, err = getDeploymentChunked(context, apiClient, &getDeployment)
+		}
+
+		if err == nil {
+			return Deployment{
+				DeploymentInfo: DeploymentInfo{
+					Namespace:    deployment.Namespace,
+					DeploymentID: deployment.DeploymentId,
+					TemplateID:   deployment.TemplateId,
+					SiteID:       deployment.SiteId,
+					Metadata:     deployment.Metadata,
+					Created:      self.toTime(deployment.Created),
+					Updated:      self.toTime(deployment.Updated),
+					Prepared:     deployment.Prepared,
+					Approved:     deployment.Approved,
+					Version:      deployment.Version,
+				},
+				Package: package_,
+			}, true, nil
 		} else if IsNotFoundError(err) {
 			return Deployment{}, false, nil
 		} else {
@@ -105,14 +134,17 @@ func (self *Client) GetDeployment(deploymentId string) (Deployment, bool, error)
 	}
 }
 
-func (self *Client) DeleteDeployment(deploymentId string) (bool, string, error) {
+// Propagation can be "orphan" (or empty), "background", or "foreground".
+func (self *Client) DeleteDeployment(namespace string, deploymentId string, propagation string) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("deleteDeployment",
-			"deploymentId", deploymentId)
-		if response, err := apiClient.DeleteDeployment(context, &api.DeploymentID{DeploymentId: deploymentId}); err == nil {
+			"namespace", namespace,
+			"deploymentId", deploymentId,
+			"propagation", propagation)
+		if response, err := apiClient.DeleteDeployment(context, &api.DeploymentID{Namespace: namespace, DeploymentId: deploymentId, Propagation: propagation}); err == nil {
 			return response.Deleted, response.NotDeletedReason, nil
 		} else {
 			return false, "", err
@@ -123,12 +155,15 @@ func (self *Client) DeleteDeployment(deploymentId string) (bool, string, error)
 }
 
 type SelectDeployments struct {
+	Namespace                string // can be "*" for listing
 	ParentDeploymentID       *string
 	TemplateIDPatterns       []string
 	TemplateMetadataPatterns map[string]string
 	SiteIDPatterns           []string
 	SiteMetadataPatterns     map[string]string
 	MetadataPatterns         map[string]string
+	MetadataSelector         string
+	PackageSelector          string
 	Prepared                 *bool
 	Approved                 *bool
 }
@@ -136,6 +171,9 @@ type SelectDeployments struct {
 // ([fmt.Stringer] interface)
 func (self SelectDeployments) String() string {
 	var s []string
+	if self.Namespace != "" {
+		s = append(s, "namespace="+self.Namespace)
+	}
 	if self.ParentDeploymentID != nil {
 		s = append(s, "parentDeploymentID="+*self.ParentDeploymentID)
 	}
@@ -154,6 +192,12 @@ func (self SelectDeployments) String() string {
 	if (self.MetadataPatterns != nil) && (len(self.MetadataPatterns) > 0) {
 		s = append(s, "metadataPatterns="+stringifyStringMap(self.MetadataPatterns))
 	}
+	if self.MetadataSelector != "" {
+		s = append(s, "metadataSelector="+self.MetadataSelector)
+	}
+	if self.PackageSelector != "" {
+		s = append(s, "packageSelector="+self.PackageSelector)
+	}
 	if self.Prepared != nil {
 		s = append(s, "prepared="+strconv.FormatBool(*self.Prepared))
 	}
@@ -177,19 +221,22 @@ func (self *Client) ListDeployments(selectDeployments SelectDeployments, offset
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 
 		self.log.Info("listDeployments",
 			"selectDeployments", selectDeployments)
 		if client, err := apiClient.ListDeployments(context, &api.ListDeployments{
 			Window: window,
 			Select: &api.SelectDeployments{
+				Namespace:                selectDeployments.Namespace,
 				ParentDeploymentId:       selectDeployments.ParentDeploymentID,
 				TemplateIdPatterns:       selectDeployments.TemplateIDPatterns,
 				TemplateMetadataPatterns: selectDeployments.TemplateMetadataPatterns,
 				SiteIdPatterns:           selectDeployments.SiteIDPatterns,
 				SiteMetadataPatterns:     selectDeployments.SiteMetadataPatterns,
 				MetadataPatterns:         selectDeployments.MetadataPatterns,
+				MetadataSelector:         selectDeployments.MetadataSelector,
+				PackageSelector:          selectDeployments.PackageSelector,
 				Prepared:                 selectDeployments.Prepared,
 				Approved:                 selectDeployments.Approved,
 			},
@@ -200,6 +247,7 @@ func (self *Client) ListDeployments(selectDeployments SelectDeployments, offset
 				for {
 					if listedDeployment, err := client.Recv(); err == nil {
 						stream.Send(DeploymentInfo{
+							Namespace:          listedDeployment.Namespace,
 							DeploymentID:       listedDeployment.DeploymentId,
 							ParentDeploymentID: listedDeployment.ParentDeploymentId,
 							TemplateID:         listedDeployment.TemplateId,
@@ -209,6 +257,7 @@ func (self *Client) ListDeployments(selectDeployments SelectDeployments, offset
 							Updated:            self.toTime(listedDeployment.Updated),
 							Prepared:           listedDeployment.Prepared,
 							Approved:           listedDeployment.Approved,
+							Version:            listedDeployment.Version,
 						})
 					} else {
 						stream.Close(err) // special handling for io.EOF
@@ -227,22 +276,28 @@ func (self *Client) ListDeployments(selectDeployments SelectDeployments, offset
 	}
 }
 
-func (self *Client) PurgeDeployments(selectDeployments SelectDeployments) (bool, string, error) {
+// Propagation can be "orphan" (or empty), "background", or "foreground".
+func (self *Client) PurgeDeployments(selectDeployments SelectDeployments, propagation string) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("purgeDeployments",
-			"selectDeployments", selectDeployments)
+			"selectDeployments", selectDeployments,
+			"propagation", propagation)
 		if response, err := apiClient.PurgeDeployments(context, &api.SelectDeployments{
+			Namespace:                selectDeployments.Namespace,
 			ParentDeploymentId:       selectDeployments.ParentDeploymentID,
 			TemplateIdPatterns:       selectDeployments.TemplateIDPatterns,
 			TemplateMetadataPatterns: selectDeployments.TemplateMetadataPatterns,
 			SiteIdPatterns:           selectDeployments.SiteIDPatterns,
 			SiteMetadataPatterns:     selectDeployments.SiteMetadataPatterns,
 			MetadataPatterns:         selectDeployments.MetadataPatterns,
+			MetadataSelector:         selectDeployments.MetadataSelector,
+			PackageSelector:          selectDeployments.PackageSelector,
 			Prepared:                 selectDeployments.Prepared,
 			Approved:                 selectDeployments.Approved,
+			Propagation:              propagation,
 		}); err == nil {
 			return response.Deleted, response.NotDeletedReason, nil
 		} else {
@@ -253,14 +308,17 @@ func (self *Client) PurgeDeployments(selectDeployments SelectDeployments) (bool,
 	}
 }
 
-func (self *Client) StartDeploymentModification(deploymentId string) (bool, string, string, tkoutil.Package, error) {
+// If expectedVersion is not 0 then it must match the current version.
+func (self *Client) StartDeploymentModification(namespace string, deploymentId string, expectedVersion uint64) (bool, string, string, tkoutil.Package, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("startDeploymentModification",
-			"deploymentId", deploymentId)
-		if response, err := apiClient.StartDeploymentModification(context, &api.StartDeploymentModification{DeploymentId: deploymentId}); err == nil {
+			"namespace", namespace,
+			"deploymentId", deploymentId,
+			"expectedVersion", expectedVersion)
+		if response, err := apiClient.StartDeploymentModification(context, &api.StartDeploymentModification{Namespace: namespace, DeploymentId: deploymentId, Version: expectedVersion}); err == nil {
 			if package_, err := tkoutil.DecodePackage(response.PackageFormat, response.Package); err == nil {
 				return response.Started, response.NotStartedReason, response.ModificationToken, package_, nil
 			} else {
@@ -284,17 +342,26 @@ func (self *Client) EndDeploymentModification(modificationToken string, package_
 
 func (self *Client) EndDeploymentModificationRaw(modificationToken string, packageFormat string, package_ []byte) (bool, string, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("endDeploymentModification",
 			"modificationToken", modificationToken,
 			"packageFormat", packageFormat)
-		if response, err := apiClient.EndDeploymentModification(context, &api.EndDeploymentModification{
+		endDeploymentModification := api.EndDeploymentModification{
 			ModificationToken: modificationToken,
 			PackageFormat:     packageFormat,
-			Package:           package_,
-		}); err == nil {
+		}
+
+		var response *api.EndDeploymentModificationResponse
+		if self.streamPackage(package_) {
+			response, err = endDeploymentModificationChunked(context, apiClient, &endDeploymentModification, package_)
+		} else {
+			endDeploymentModification.Package = package_
+			response, err = apiClient.EndDeploymentModification(context, &endDeploymentModification)
+		}
+
+		if err == nil {
 			return response.Modified, response.NotModifiedReason, response.DeploymentId, nil
 		} else {
 			return false, "", "", err
@@ -306,7 +373,7 @@ func (self *Client) EndDeploymentModificationRaw(modificationToken string, packa
 
 func (self *Client) CancelDeploymentModification(modificationToken string) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("cancelDeploymentModification",
@@ -321,10 +388,65 @@ func (self *Client) CancelDeploymentModification(modificationToken string) (bool
 	}
 }
 
+type DeploymentModificationResult struct {
+	DeploymentID      string `json:"deploymentId" yaml:"deploymentId"`
+	Modified          bool   `json:"modified" yaml:"modified"`
+	Version           uint64 `json:"version" yaml:"version"`
+	NotModifiedReason string `json:"notModifiedReason,omitempty" yaml:"notModifiedReason,omitempty"`
+}
+
+// Applies to all selected deployments on the server in a single call.
+// If approved is not nil sets or clears the approved annotation.
+func (self *Client) ModifyDeployments(selectDeployments SelectDeployments, approved *bool, setMetadata map[string]string, deleteMetadata []string) ([]DeploymentModificationResult, error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
+		defer cancel()
+
+		self.log.Info("modifyDeployments",
+			"selectDeployments", selectDeployments,
+			"approved", approved,
+			"setMetadata", setMetadata,
+			"deleteMetadata", deleteMetadata)
+		if response, err := apiClient.ModifyDeployments(context, &api.ModifyDeployments{
+			Select: &api.SelectDeployments{
+				Namespace:                selectDeployments.Namespace,
+				ParentDeploymentId:       selectDeployments.ParentDeploymentID,
+				TemplateIdPatterns:       selectDeployments.TemplateIDPatterns,
+				TemplateMetadataPatterns: selectDeployments.TemplateMetadataPatterns,
+				SiteIdPatterns:           selectDeployments.SiteIDPatterns,
+				SiteMetadataPatterns:     selectDeployments.SiteMetadataPatterns,
+				MetadataPatterns:         selectDeployments.MetadataPatterns,
+				MetadataSelector:         selectDeployments.MetadataSelector,
+				PackageSelector:          selectDeployments.PackageSelector,
+				Prepared:                 selectDeployments.Prepared,
+				Approved:                 selectDeployments.Approved,
+			},
+			Approved:       approved,
+			SetMetadata:    setMetadata,
+			DeleteMetadata: deleteMetadata,
+		}); err == nil {
+			results := make([]DeploymentModificationResult, len(response.Deployments))
+			for index, modifiedDeployment := range response.Deployments {
+				results[index] = DeploymentModificationResult{
+					DeploymentID:      modifiedDeployment.DeploymentId,
+					Modified:          modifiedDeployment.Modified,
+					Version:           modifiedDeployment.Version,
+					NotModifiedReason: modifiedDeployment.NotModifiedReason,
+				}
+			}
+			return results, nil
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
 type ModifyDeploymentFunc func(package_ tkoutil.Package) (bool, tkoutil.Package, error)
 
-func (self *Client) ModifyDeployment(deploymentId string, modify ModifyDeploymentFunc) (bool, error) {
-	if started, reason, modificationToken, package_, err := self.StartDeploymentModification(deploymentId); err == nil {
+func (self *Client) ModifyDeployment(namespace string, deploymentId string, modify ModifyDeploymentFunc) (bool, error) {
+	if started, reason, modificationToken, package_, err := self.StartDeploymentModification(namespace, deploymentId, 0); err == nil {
 		if started {
 			if modified, package__, err := modify(package_); err == nil {
 				if modified {
@@ -371,3 +493,57 @@ func (self *Client) ModifyDeployment(deploymentId string, modify ModifyDeploymen
 		return false, err
 	}
 }
+
+// Utils
+
+func getDeploymentChunked(context contextpkg.Context, apiClient api.DataClient, getDeployment *api.GetDeployment) (*api.Deployment, tkoutil.Package, error) {
+	if client, err := apiClient.GetDeploymentChunked(context, getDeployment); err == nil {
+		var deployment *api.Deployment
+		if chunk, err := client.Recv(); err == nil {
+			if deployment = chunk.GetDeployment(); deployment == nil {
+				return nil, nil, errors.New("first chunk must be the deployment")
+			}
+		} else {
+			return nil, nil, err
+		}
+
+		reader := tkoutil.NewChunkReader(func() ([]byte, error) {
+			if chunk, err := client.Recv(); err == nil {
+				if package_ := chunk.GetPackage(); package_ != nil {
+					return package_, nil
+				} else {
+					return nil, errors.New("expected package chunk")
+				}
+			} else {
+				return nil, err
+			}
+		})
+
+		if package_, err := tkoutil.ReadPackage(deployment.PackageFormat, reader); err == nil {
+			return deployment, package_, nil
+		} else {
+			return nil, nil, err
+		}
+	} else {
+		return nil, nil, err
+	}
+}
+
+func endDeploymentModificationChunked(context contextpkg.Context, apiClient api.DataClient, endDeploymentModification *api.EndDeploymentModification, package_ []byte) (*api.EndDeploymentModificationResponse, error) {
+	if client, err := apiClient.EndDeploymentModificationChunked(context); err == nil {
+		if err := client.Send(&api.EndDeploymentModificationChunk{Chunk: &api.EndDeploymentModificationChunk_Modification{Modification: endDeploymentModification}}); err == nil {
+			if err := tkoutil.SendChunks(package_, func(chunk []byte) error {
+				return client.Send(&api.EndDeploymentModificationChunk{Chunk: &api.EndDeploymentModificationChunk_Package{Package: chunk}})
+			}); (err != nil) && (err != io.EOF) {
+				return nil, err
+			}
+		} else if err != io.EOF {
+			return nil, err
+		}
+
+		// On io.EOF the server has ended the stream, and the actual error will be returned here
+		return client.CloseAndRecv()
+	} else {
+		return nil, err
+	}
+}
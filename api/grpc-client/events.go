@@ -0,0 +1,66 @@
+package client
+
+import (
+	contextpkg "context"
+	"time"
+
+	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/tliron/kutil/util"
+)
+
+type Event struct {
+	Revision  uint64    `json:"revision" yaml:"revision"`
+	Type      string    `json:"type" yaml:"type"`
+	Kind      string    `json:"kind" yaml:"kind"`
+	Namespace string    `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	ID        string    `json:"id" yaml:"id"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+}
+
+type SelectEvents struct {
+	Kinds         []string `json:"kinds,omitempty" yaml:"kinds,omitempty"`
+	Namespace     string   `json:"namespace,omitempty" yaml:"namespace,omitempty"` // can be "*"
+	SinceRevision uint64   `json:"sinceRevision,omitempty" yaml:"sinceRevision,omitempty"`
+}
+
+// Results will continue until the context is done or the stream is broken.
+func (self *Client) Watch(context contextpkg.Context, selectEvents SelectEvents) (util.Results[Event], error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithCancel(context)
+
+		self.log.Info("watch",
+			"selectEvents", selectEvents)
+		if client, err := apiClient.Watch(context, &api.Watch{
+			Kinds:         selectEvents.Kinds,
+			Namespace:     selectEvents.Namespace,
+			SinceRevision: selectEvents.SinceRevision,
+		}); err == nil {
+			stream := util.NewResultsStream[Event](cancel)
+
+			go func() {
+				for {
+					if event, err := client.Recv(); err == nil {
+						stream.Send(Event{
+							Revision:  event.Revision,
+							Type:      event.Type,
+							Kind:      event.Kind,
+							Namespace: event.Namespace,
+							ID:        event.Id,
+							Timestamp: self.toTime(event.Timestamp),
+						})
+					} else {
+						stream.Close(err) // special handling for io.EOF
+						return
+					}
+				}
+			}()
+
+			return stream, nil
+		} else {
+			cancel()
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
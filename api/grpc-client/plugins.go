@@ -17,6 +17,7 @@ type Plugin struct {
 	Arguments  []string          `json:"arguments" yaml:"arguments"`
 	Properties map[string]string `json:"properties" yaml:"properties"`
 	Triggers   []tkoutil.GVK     `json:"triggers" yaml:"triggers"`
+	Version    uint64            `json:"version" yaml:"version"`
 }
 
 type PluginID struct {
@@ -36,13 +37,14 @@ func NewPluginID(type_ string, name string) PluginID {
 	}
 }
 
-func (self *Client) RegisterPlugin(pluginId PluginID, executor string, arguments []string, properties map[string]string, triggers []tkoutil.GVK) (bool, string, error) {
+// If expectedVersion is not 0 then it must match the current version.
+func (self *Client) RegisterPlugin(pluginId PluginID, executor string, arguments []string, properties map[string]string, triggers []tkoutil.GVK, expectedVersion uint64) (bool, string, error) {
 	if !plugins.IsValidPluginType(pluginId.Type, false) {
 		return false, "", fmt.Errorf("plugin type must be %s: %s", plugins.PluginTypesDescription, pluginId.Type)
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("registerPlugin",
@@ -50,7 +52,8 @@ func (self *Client) RegisterPlugin(pluginId PluginID, executor string, arguments
 			"executor", executor,
 			"arguments", arguments,
 			"properties", properties,
-			"triggers", triggers)
+			"triggers", triggers,
+			"expectedVersion", expectedVersion)
 		if response, err := apiClient.RegisterPlugin(context, &api.Plugin{
 			Type:       pluginId.Type,
 			Name:       pluginId.Name,
@@ -58,6 +61,7 @@ func (self *Client) RegisterPlugin(pluginId PluginID, executor string, arguments
 			Arguments:  arguments,
 			Properties: properties,
 			Triggers:   tkoutil.TriggersToAPI(triggers),
+			Version:    expectedVersion,
 		}); err == nil {
 			return response.Registered, response.NotRegisteredReason, nil
 		} else {
@@ -74,7 +78,7 @@ func (self *Client) GetPlugin(pluginId PluginID) (Plugin, bool, error) {
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("getPlugin",
@@ -89,6 +93,7 @@ func (self *Client) GetPlugin(pluginId PluginID) (Plugin, bool, error) {
 				Arguments:  plugin.Arguments,
 				Properties: plugin.Properties,
 				Triggers:   tkoutil.TriggersFromAPI(plugin.Triggers),
+				Version:    plugin.Version,
 			}, true, nil
 		} else if IsNotFoundError(err) {
 			return Plugin{}, false, nil
@@ -106,7 +111,7 @@ func (self *Client) DeletePlugin(pluginId PluginID) (bool, string, error) {
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("deletePlugin",
@@ -169,7 +174,7 @@ func (self *Client) ListPlugins(selectPlugins SelectPlugins, offset uint, maxCou
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 
 		self.log.Info("listPlugins",
 			"selectPlugins", selectPlugins)
@@ -193,6 +198,7 @@ func (self *Client) ListPlugins(selectPlugins SelectPlugins, offset uint, maxCou
 							Arguments:  plugin.Arguments,
 							Properties: plugin.Properties,
 							Triggers:   tkoutil.TriggersFromAPI(plugin.Triggers),
+							Version:    plugin.Version,
 						})
 					} else {
 						stream.Close(err) // special handling for io.EOF
@@ -219,7 +225,7 @@ func (self *Client) PurgePlugins(selectPlugins SelectPlugins) (bool, string, err
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("purgePlugins",
@@ -0,0 +1,163 @@
+package client
+
+import (
+	contextpkg "context"
+	"strconv"
+	"time"
+
+	api "github.com/nephio-experimental/tko/api/grpc"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/tliron/kutil/util"
+)
+
+type RevisionID struct {
+	Type      string `json:"type" yaml:"type"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	ObjectID  string `json:"objectId" yaml:"objectId"`
+	Revision  uint64 `json:"revision" yaml:"revision"`
+}
+
+// ([fmt.Stringer] interface)
+func (self RevisionID) String() string {
+	return "type=" + self.Type + " namespace=" + self.Namespace + " objectId=" + self.ObjectID + " revision=" + strconv.FormatUint(self.Revision, 10)
+}
+
+func NewRevisionID(type_ string, namespace string, objectId string, revision uint64) RevisionID {
+	return RevisionID{
+		Type:      type_,
+		Namespace: namespace,
+		ObjectID:  objectId,
+		Revision:  revision,
+	}
+}
+
+type RevisionInfo struct {
+	RevisionID `json:",inline" yaml:",inline"`
+	Author     string            `json:"author,omitempty" yaml:"author,omitempty"`
+	Created    time.Time         `json:"created" yaml:"created"`
+	Hash       string            `json:"hash" yaml:"hash"`
+	TemplateID string            `json:"templateId,omitempty" yaml:"templateId,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+type Revision struct {
+	RevisionInfo
+	Package tkoutil.Package `json:"package" yaml:"package"`
+}
+
+func (self *Client) GetRevision(revisionId RevisionID) (Revision, bool, error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
+		defer cancel()
+
+		self.log.Info("getRevision",
+			"revisionId", revisionId)
+		if revision, err := apiClient.GetRevision(context, &api.GetRevision{
+			Type:                   revisionId.Type,
+			Namespace:              revisionId.Namespace,
+			ObjectId:               revisionId.ObjectID,
+			Revision:               revisionId.Revision,
+			PreferredPackageFormat: self.PackageFormat,
+		}); err == nil {
+			if package_, err := tkoutil.DecodePackage(revision.PackageFormat, revision.Package); err == nil {
+				return Revision{
+					RevisionInfo: RevisionInfo{
+						RevisionID: NewRevisionID(revision.Type, revision.Namespace, revision.ObjectId, revision.Revision),
+						Author:     revision.Author,
+						Created:    self.toTime(revision.Created),
+						Hash:       revision.Hash,
+						TemplateID: revision.TemplateId,
+						Metadata:   revision.Metadata,
+					},
+					Package: package_,
+				}, true, nil
+			} else {
+				return Revision{}, false, err
+			}
+		} else if IsNotFoundError(err) {
+			return Revision{}, false, nil
+		} else {
+			return Revision{}, false, err
+		}
+	} else {
+		return Revision{}, false, err
+	}
+}
+
+func (self *Client) RevertTo(revisionId RevisionID) (bool, string, error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
+		defer cancel()
+
+		self.log.Info("revertTo",
+			"revisionId", revisionId)
+		if response, err := apiClient.RevertTo(context, &api.RevisionID{
+			Type:      revisionId.Type,
+			Namespace: revisionId.Namespace,
+			ObjectId:  revisionId.ObjectID,
+			Revision:  revisionId.Revision,
+		}); err == nil {
+			return response.Reverted, response.NotRevertedReason, nil
+		} else {
+			return false, "", err
+		}
+	} else {
+		return false, "", err
+	}
+}
+
+func (self *Client) ListAllRevisions(type_ string, namespace string, objectId string) util.Results[RevisionInfo] {
+	return util.CombineResults(func(offset uint) (util.Results[RevisionInfo], error) {
+		return self.ListRevisions(type_, namespace, objectId, offset, ChunkSize)
+	})
+}
+
+func (self *Client) ListRevisions(type_ string, namespace string, objectId string, offset uint, maxCount int) (util.Results[RevisionInfo], error) {
+	var window *api.Window
+	var err error
+	if window, err = newWindow(offset, maxCount); err != nil {
+		return nil, err
+	}
+
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
+
+		self.log.Info("listRevisions",
+			"type", type_,
+			"namespace", namespace,
+			"objectId", objectId)
+		if client, err := apiClient.ListRevisions(context, &api.ListRevisions{
+			Window:    window,
+			Type:      type_,
+			Namespace: namespace,
+			ObjectId:  objectId,
+		}); err == nil {
+			stream := util.NewResultsStream[RevisionInfo](cancel)
+
+			go func() {
+				for {
+					if listedRevision, err := client.Recv(); err == nil {
+						stream.Send(RevisionInfo{
+							RevisionID: NewRevisionID(listedRevision.Type, listedRevision.Namespace, listedRevision.ObjectId, listedRevision.Revision),
+							Author:     listedRevision.Author,
+							Created:    self.toTime(listedRevision.Created),
+							Hash:       listedRevision.Hash,
+							TemplateID: listedRevision.TemplateId,
+							Metadata:   listedRevision.Metadata,
+						})
+					} else {
+						stream.Close(err) // special handling for io.EOF
+						return
+					}
+				}
+			}()
+
+			return stream, nil
+		} else {
+			cancel()
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	contextpkg "context"
+	"io"
 	"strings"
 	"time"
 
@@ -11,43 +12,60 @@ import (
 )
 
 type SiteInfo struct {
+	Namespace     string            `json:"namespace" yaml:"namespace"`
 	SiteID        string            `json:"siteId" yaml:"siteId"`
 	TemplateID    string            `json:"templateId,omitempty" yaml:"templateId,omitempty"`
 	Metadata      map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 	Updated       time.Time         `json:"updated" yaml:"updated"`
 	DeploymentIDs []string          `json:"deploymentIds,omitempty" yaml:"deploymentIds,omitempty"`
+	Version       uint64            `json:"version" yaml:"version"`
 }
 
 type Site struct {
-	SiteInfo
-	Package tkoutil.Package `json:"package" yaml:"package"`
+	SiteInfo `json:",inline" yaml:",inline"`
+	Package  tkoutil.Package `json:"package" yaml:"package"`
 }
 
-func (self *Client) RegisterSite(siteId string, templateId string, metadata map[string]string, package_ tkoutil.Package) (bool, string, error) {
+// If expectedVersion is not 0 then it must match the current version.
+func (self *Client) RegisterSite(namespace string, siteId string, templateId string, metadata map[string]string, package_ tkoutil.Package, expectedVersion uint64) (bool, string, error) {
 	if package__, err := self.encodePackage(package_); err == nil {
-		return self.RegisterSiteRaw(siteId, templateId, metadata, self.PackageFormat, package__)
+		return self.RegisterSiteRaw(namespace, siteId, templateId, metadata, self.PackageFormat, package__, expectedVersion)
 	} else {
 		return false, "", err
 	}
 }
 
-func (self *Client) RegisterSiteRaw(siteId string, templateId string, metadata map[string]string, packageFormat string, package_ []byte) (bool, string, error) {
+// If expectedVersion is not 0 then it must match the current version.
+func (self *Client) RegisterSiteRaw(namespace string, siteId string, templateId string, metadata map[string]string, packageFormat string, package_ []byte, expectedVersion uint64) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("registerSite",
+			"namespace", namespace,
 			"siteId", siteId,
 			"templateId", templateId,
 			"metadata", metadata,
-			"packageFormat", packageFormat)
-		if response, err := apiClient.RegisterSite(context, &api.Site{
+			"packageFormat", packageFormat,
+			"expectedVersion", expectedVersion)
+		site := api.Site{
+			Namespace:     namespace,
 			SiteId:        siteId,
 			TemplateId:    templateId,
 			Metadata:      metadata,
 			PackageFormat: packageFormat,
-			Package:       package_,
-		}); err == nil {
+			Version:       expectedVersion,
+		}
+
+		var response *api.RegisterResponse
+		if self.streamPackage(package_) {
+			response, err = registerSiteChunked(context, apiClient, &site, package_)
+		} else {
+			site.Package = package_
+			response, err = apiClient.RegisterSite(context, &site)
+		}
+
+		if err == nil {
 			return response.Registered, response.NotRegisteredReason, nil
 		} else {
 			return false, "", err
@@ -57,22 +75,25 @@ func (self *Client) RegisterSiteRaw(siteId string, templateId string, metadata m
 	}
 }
 
-func (self *Client) GetSite(siteId string) (Site, bool, error) {
+func (self *Client) GetSite(namespace string, siteId string) (Site, bool, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("getSite",
+			"namespace", namespace,
 			"siteId", siteId)
-		if site, err := apiClient.GetSite(context, &api.GetSite{SiteId: siteId, PreferredPackageFormat: self.PackageFormat}); err == nil {
+		if site, err := apiClient.GetSite(context, &api.GetSite{Namespace: namespace, SiteId: siteId, PreferredPackageFormat: self.PackageFormat}); err == nil {
 			if package_, err := tkoutil.DecodePackage(site.PackageFormat, site.Package); err == nil {
 				return Site{
 					SiteInfo: SiteInfo{
+						Namespace:     site.Namespace,
 						SiteID:        site.SiteId,
 						TemplateID:    site.TemplateId,
 						Metadata:      site.Metadata,
 						Updated:       self.toTime(site.Updated),
 						DeploymentIDs: site.DeploymentIds,
+						Version:       site.Version,
 					},
 					Package: package_,
 				}, true, nil
@@ -89,14 +110,15 @@ func (self *Client) GetSite(siteId string) (Site, bool, error) {
 	}
 }
 
-func (self *Client) DeleteSite(siteId string) (bool, string, error) {
+func (self *Client) DeleteSite(namespace string, siteId string) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
-		self.log.Infof("deleteSite",
+		self.log.Info("deleteSite",
+			"namespace", namespace,
 			"siteId", siteId)
-		if response, err := apiClient.DeleteSite(context, &api.SiteID{SiteId: siteId}); err == nil {
+		if response, err := apiClient.DeleteSite(context, &api.SiteID{Namespace: namespace, SiteId: siteId}); err == nil {
 			return response.Deleted, response.NotDeletedReason, nil
 		} else {
 			return false, "", err
@@ -107,14 +129,20 @@ func (self *Client) DeleteSite(siteId string) (bool, string, error) {
 }
 
 type SelectSites struct {
+	Namespace          string // can be "*" for listing
 	SiteIDPatterns     []string
 	TemplateIDPatterns []string
 	MetadataPatterns   map[string]string
+	MetadataSelector   string
+	PackageSelector    string
 }
 
 // ([fmt.Stringer] interface)
 func (self SelectSites) String() string {
 	var s []string
+	if self.Namespace != "" {
+		s = append(s, "namespace="+self.Namespace)
+	}
 	if len(self.SiteIDPatterns) > 0 {
 		s = append(s, "siteIdPatterns="+stringifyStringList(self.SiteIDPatterns))
 	}
@@ -124,6 +152,12 @@ func (self SelectSites) String() string {
 	if (self.MetadataPatterns != nil) && (len(self.MetadataPatterns) > 0) {
 		s = append(s, "metadataPatterns="+stringifyStringMap(self.MetadataPatterns))
 	}
+	if self.MetadataSelector != "" {
+		s = append(s, "metadataSelector="+self.MetadataSelector)
+	}
+	if self.PackageSelector != "" {
+		s = append(s, "packageSelector="+self.PackageSelector)
+	}
 	return strings.Join(s, " ")
 }
 
@@ -141,16 +175,19 @@ func (self *Client) ListSites(selectSites SelectSites, offset uint, maxCount int
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 
 		self.log.Info("listSites",
 			"selectSites", selectSites)
 		if client, err := apiClient.ListSites(context, &api.ListSites{
 			Window: window,
 			Select: &api.SelectSites{
+				Namespace:          selectSites.Namespace,
 				SiteIdPatterns:     selectSites.SiteIDPatterns,
 				TemplateIdPatterns: selectSites.TemplateIDPatterns,
 				MetadataPatterns:   selectSites.MetadataPatterns,
+				MetadataSelector:   selectSites.MetadataSelector,
+				PackageSelector:    selectSites.PackageSelector,
 			},
 		}); err == nil {
 			stream := util.NewResultsStream[SiteInfo](cancel)
@@ -159,11 +196,13 @@ func (self *Client) ListSites(selectSites SelectSites, offset uint, maxCount int
 				for {
 					if listedSite, err := client.Recv(); err == nil {
 						stream.Send(SiteInfo{
+							Namespace:     listedSite.Namespace,
 							SiteID:        listedSite.SiteId,
 							TemplateID:    listedSite.TemplateId,
 							Metadata:      listedSite.Metadata,
 							Updated:       self.toTime(listedSite.Updated),
 							DeploymentIDs: listedSite.DeploymentIds,
+							Version:       listedSite.Version,
 						})
 					} else {
 						stream.Close(err) // special handling for io.EOF
@@ -184,15 +223,18 @@ func (self *Client) ListSites(selectSites SelectSites, offset uint, maxCount int
 
 func (self *Client) PurgeSites(selectSites SelectSites) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("purgeSites",
 			"selectSites", selectSites)
 		if response, err := apiClient.PurgeSites(context, &api.SelectSites{
+			Namespace:          selectSites.Namespace,
 			SiteIdPatterns:     selectSites.SiteIDPatterns,
 			TemplateIdPatterns: selectSites.TemplateIDPatterns,
 			MetadataPatterns:   selectSites.MetadataPatterns,
+			MetadataSelector:   selectSites.MetadataSelector,
+			PackageSelector:    selectSites.PackageSelector,
 		}); err == nil {
 			return response.Deleted, response.NotDeletedReason, nil
 		} else {
@@ -202,3 +244,97 @@ func (self *Client) PurgeSites(selectSites SelectSites) (bool, string, error) {
 		return false, "", err
 	}
 }
+
+type DeletedDeployment struct {
+	Namespace    string          `json:"namespace" yaml:"namespace"`
+	DeploymentID string          `json:"deploymentId" yaml:"deploymentId"`
+	SiteID       string          `json:"siteId" yaml:"siteId"`
+	Deleted      time.Time       `json:"deleted" yaml:"deleted"`
+	Package      tkoutil.Package `json:"package" yaml:"package"`
+}
+
+func (self *Client) ListDeletedDeployments(namespace string, siteId string) (util.Results[DeletedDeployment], error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
+
+		self.log.Info("listDeletedDeployments",
+			"namespace", namespace,
+			"siteId", siteId)
+		if client, err := apiClient.ListDeletedDeployments(context, &api.GetSite{Namespace: namespace, SiteId: siteId, PreferredPackageFormat: self.PackageFormat}); err == nil {
+			stream := util.NewResultsStream[DeletedDeployment](cancel)
+
+			go func() {
+				for {
+					if deletedDeployment, err := client.Recv(); err == nil {
+						if package_, err := tkoutil.DecodePackage(deletedDeployment.PackageFormat, deletedDeployment.Package); err == nil {
+							stream.Send(DeletedDeployment{
+								Namespace:    deletedDeployment.Namespace,
+								DeploymentID: deletedDeployment.DeploymentId,
+								SiteID:       deletedDeployment.SiteId,
+								Deleted:      self.toTime(deletedDeployment.Deleted),
+								Package:      package_,
+							})
+						} else {
+							stream.Close(err)
+							return
+						}
+					} else {
+						stream.Close(err) // special handling for io.EOF
+						return
+					}
+				}
+			}()
+
+			return stream, nil
+		} else {
+			cancel()
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
+func (self *Client) AcknowledgeDeletedDeployments(namespace string, siteId string, deploymentIds []string) (bool, string, error) {
+	if apiClient, err := self.DataClient(); err == nil {
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
+		defer cancel()
+
+		self.log.Info("acknowledgeDeletedDeployments",
+			"namespace", namespace,
+			"siteId", siteId,
+			"deploymentIds", deploymentIds)
+		if response, err := apiClient.AcknowledgeDeletedDeployments(context, &api.AcknowledgeDeletedDeployments{
+			Namespace:     namespace,
+			SiteId:        siteId,
+			DeploymentIds: deploymentIds,
+		}); err == nil {
+			return response.Deleted, response.NotDeletedReason, nil
+		} else {
+			return false, "", err
+		}
+	} else {
+		return false, "", err
+	}
+}
+
+// Utils
+
+func registerSiteChunked(context contextpkg.Context, apiClient api.DataClient, site *api.Site, package_ []byte) (*api.RegisterResponse, error) {
+	if client, err := apiClient.RegisterSiteChunked(context); err == nil {
+		if err := client.Send(&api.SiteChunk{Chunk: &api.SiteChunk_Site{Site: site}}); err == nil {
+			if err := tkoutil.SendChunks(package_, func(chunk []byte) error {
+				return client.Send(&api.SiteChunk{Chunk: &api.SiteChunk_Package{Package: chunk}})
+			}); (err != nil) && (err != io.EOF) {
+				return nil, err
+			}
+		} else if err != io.EOF {
+			return nil, err
+		}
+
+		// On io.EOF the server has ended the stream, and the actual error will be returned here
+		return client.CloseAndRecv()
+	} else {
+		return nil, err
+	}
+}
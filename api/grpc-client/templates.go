@@ -2,6 +2,7 @@ package client
 
 import (
 	contextpkg "context"
+	"io"
 	"strings"
 	"time"
 
@@ -11,40 +12,57 @@ import (
 )
 
 type TemplateInfo struct {
+	Namespace     string            `json:"namespace" yaml:"namespace"`
 	TemplateID    string            `json:"templateId" yaml:"templateId"`
 	Metadata      map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 	Updated       time.Time         `json:"updated" yaml:"updated"`
 	DeploymentIDs []string          `json:"deploymentIds,omitempty" yaml:"deploymentIds,omitempty"`
+	Version       uint64            `json:"version" yaml:"version"`
 }
 
 type Template struct {
-	TemplateInfo
-	Package tkoutil.Package `json:"package" yaml:"package"`
+	TemplateInfo `json:",inline" yaml:",inline"`
+	Package      tkoutil.Package `json:"package" yaml:"package"`
 }
 
-func (self *Client) RegisterTemplate(templateId string, metadata map[string]string, package_ tkoutil.Package) (bool, string, error) {
+// If expectedVersion is not 0 then it must match the current version.
+func (self *Client) RegisterTemplate(namespace string, templateId string, metadata map[string]string, package_ tkoutil.Package, expectedVersion uint64) (bool, string, error) {
 	if package__, err := self.encodePackage(package_); err == nil {
-		return self.RegisterTemplateRaw(templateId, metadata, self.PackageFormat, package__)
+		return self.RegisterTemplateRaw(namespace, templateId, metadata, self.PackageFormat, package__, expectedVersion)
 	} else {
 		return false, "", err
 	}
 }
 
-func (self *Client) RegisterTemplateRaw(templateId string, metadata map[string]string, packageFormat string, package_ []byte) (bool, string, error) {
+// If expectedVersion is not 0 then it must match the current version.
+func (self *Client) RegisterTemplateRaw(namespace string, templateId string, metadata map[string]string, packageFormat string, package_ []byte, expectedVersion uint64) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("registerTemplate",
+			"namespace", namespace,
 			"templateId", templateId,
 			"metadata", metadata,
-			"packageFormat", packageFormat)
-		if response, err := apiClient.RegisterTemplate(context, &api.Template{
+			"packageFormat", packageFormat,
+			"expectedVersion", expectedVersion)
+		template := api.Template{
+			Namespace:     namespace,
 			TemplateId:    templateId,
 			Metadata:      metadata,
 			PackageFormat: packageFormat,
-			Package:       package_,
-		}); err == nil {
+			Version:       expectedVersion,
+		}
+
+		var response *api.RegisterResponse
+		if self.streamPackage(package_) {
+			response, err = registerTemplateChunked(context, apiClient, &template, package_)
+		} else {
+			template.Package = package_
+			response, err = apiClient.RegisterTemplate(context, &template)
+		}
+
+		if err == nil {
 			return response.Registered, response.NotRegisteredReason, nil
 		} else {
 			return false, "", err
@@ -54,21 +72,24 @@ func (self *Client) RegisterTemplateRaw(templateId string, metadata map[string]s
 	}
 }
 
-func (self *Client) GetTemplate(templateId string) (Template, bool, error) {
+func (self *Client) GetTemplate(namespace string, templateId string) (Template, bool, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("getTemplate",
+			"namespace", namespace,
 			"templateId", templateId)
-		if template, err := apiClient.GetTemplate(context, &api.GetTemplate{TemplateId: templateId, PreferredPackageFormat: self.PackageFormat}); err == nil {
+		if template, err := apiClient.GetTemplate(context, &api.GetTemplate{Namespace: namespace, TemplateId: templateId, PreferredPackageFormat: self.PackageFormat}); err == nil {
 			if package_, err := tkoutil.DecodePackage(template.PackageFormat, template.Package); err == nil {
 				return Template{
 					TemplateInfo: TemplateInfo{
+						Namespace:     template.Namespace,
 						TemplateID:    template.TemplateId,
 						Metadata:      template.Metadata,
 						Updated:       self.toTime(template.Updated),
 						DeploymentIDs: template.DeploymentIds,
+						Version:       template.Version,
 					},
 					Package: package_,
 				}, true, nil
@@ -85,14 +106,15 @@ func (self *Client) GetTemplate(templateId string) (Template, bool, error) {
 	}
 }
 
-func (self *Client) DeleteTemplate(templateId string) (bool, string, error) {
+func (self *Client) DeleteTemplate(namespace string, templateId string) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("deleteTemplate",
+			"namespace", namespace,
 			"templateId", templateId)
-		if response, err := apiClient.DeleteTemplate(context, &api.TemplateID{TemplateId: templateId}); err == nil {
+		if response, err := apiClient.DeleteTemplate(context, &api.TemplateID{Namespace: namespace, TemplateId: templateId}); err == nil {
 			return response.Deleted, response.NotDeletedReason, nil
 		} else {
 			return false, "", err
@@ -103,19 +125,31 @@ func (self *Client) DeleteTemplate(templateId string) (bool, string, error) {
 }
 
 type SelectTemplates struct {
+	Namespace          string // can be "*" for listing
 	TemplateIDPatterns []string
 	MetadataPatterns   map[string]string
+	MetadataSelector   string
+	PackageSelector    string
 }
 
 // ([fmt.Stringer] interface)
 func (self SelectTemplates) String() string {
 	var s []string
+	if self.Namespace != "" {
+		s = append(s, "namespace="+self.Namespace)
+	}
 	if len(self.TemplateIDPatterns) > 0 {
 		s = append(s, "templateIdPatterns="+strings.Join(self.TemplateIDPatterns, ","))
 	}
 	if (self.MetadataPatterns != nil) && (len(self.MetadataPatterns) > 0) {
 		s = append(s, "metadataPatterns="+stringifyStringMap(self.MetadataPatterns))
 	}
+	if self.MetadataSelector != "" {
+		s = append(s, "metadataSelector="+self.MetadataSelector)
+	}
+	if self.PackageSelector != "" {
+		s = append(s, "packageSelector="+self.PackageSelector)
+	}
 	return strings.Join(s, " ")
 }
 
@@ -133,15 +167,18 @@ func (self *Client) ListTemplates(selectTemplates SelectTemplates, offset uint,
 	}
 
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 
 		self.log.Info("listTemplates",
 			"selectTemplates", selectTemplates)
 		if client, err := apiClient.ListTemplates(context, &api.ListTemplates{
 			Window: window,
 			Select: &api.SelectTemplates{
+				Namespace:          selectTemplates.Namespace,
 				TemplateIdPatterns: selectTemplates.TemplateIDPatterns,
 				MetadataPatterns:   selectTemplates.MetadataPatterns,
+				MetadataSelector:   selectTemplates.MetadataSelector,
+				PackageSelector:    selectTemplates.PackageSelector,
 			},
 		}); err == nil {
 			stream := util.NewResultsStream[TemplateInfo](cancel)
@@ -150,10 +187,12 @@ func (self *Client) ListTemplates(selectTemplates SelectTemplates, offset uint,
 				for {
 					if listedTemplate, err := client.Recv(); err == nil {
 						stream.Send(TemplateInfo{
+							Namespace:     listedTemplate.Namespace,
 							TemplateID:    listedTemplate.TemplateId,
 							Metadata:      listedTemplate.Metadata,
 							Updated:       self.toTime(listedTemplate.Updated),
 							DeploymentIDs: listedTemplate.DeploymentIds,
+							Version:       listedTemplate.Version,
 						})
 					} else {
 						stream.Close(err) // special handling for io.EOF
@@ -174,14 +213,17 @@ func (self *Client) ListTemplates(selectTemplates SelectTemplates, offset uint,
 
 func (self *Client) PurgeTemplates(selectTemplates SelectTemplates) (bool, string, error) {
 	if apiClient, err := self.DataClient(); err == nil {
-		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+		context, cancel := contextpkg.WithTimeout(self.parentContext(), self.Timeout)
 		defer cancel()
 
 		self.log.Info("purgeTemplates",
 			"selectTemplates", selectTemplates)
 		if response, err := apiClient.PurgeTemplates(context, &api.SelectTemplates{
+			Namespace:          selectTemplates.Namespace,
 			TemplateIdPatterns: selectTemplates.TemplateIDPatterns,
 			MetadataPatterns:   selectTemplates.MetadataPatterns,
+			MetadataSelector:   selectTemplates.MetadataSelector,
+			PackageSelector:    selectTemplates.PackageSelector,
 		}); err == nil {
 			return response.Deleted, response.NotDeletedReason, nil
 		} else {
@@ -191,3 +233,24 @@ func (self *Client) PurgeTemplates(selectTemplates SelectTemplates) (bool, strin
 		return false, "", err
 	}
 }
+
+// Utils
+
+func registerTemplateChunked(context contextpkg.Context, apiClient api.DataClient, template *api.Template, package_ []byte) (*api.RegisterResponse, error) {
+	if client, err := apiClient.RegisterTemplateChunked(context); err == nil {
+		if err := client.Send(&api.TemplateChunk{Chunk: &api.TemplateChunk_Template{Template: template}}); err == nil {
+			if err := tkoutil.SendChunks(package_, func(chunk []byte) error {
+				return client.Send(&api.TemplateChunk{Chunk: &api.TemplateChunk_Package{Package: chunk}})
+			}); (err != nil) && (err != io.EOF) {
+				return nil, err
+			}
+		} else if err != io.EOF {
+			return nil, err
+		}
+
+		// On io.EOF the server has ended the stream, and the actual error will be returned here
+		return client.CloseAndRecv()
+	} else {
+		return nil, err
+	}
+}
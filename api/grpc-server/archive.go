@@ -0,0 +1,221 @@
+package server
+
+import (
+	"io"
+	"time"
+
+	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/backend/archive"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ([api.DataServer] interface)
+func (self *Server) ExportArchive(exportArchive *api.ExportArchive, server api.Data_ExportArchiveServer) error {
+	self.Log.Infof("exportArchive: %+v", exportArchive)
+
+	packageFormat := exportArchive.PreferredPackageFormat
+	if packageFormat == "" {
+		packageFormat = self.DefaultPackageFormat
+	}
+
+	if err := archive.Export(server.Context(), self.Backend, exportArchive.Namespace, func(entry archive.Entry) error {
+		if entry_, err := archiveEntryToAPI(entry, packageFormat); err == nil {
+			return server.Send(entry_)
+		} else {
+			return err
+		}
+	}); err != nil {
+		return ToGRPCError(err)
+	}
+
+	return nil
+}
+
+// ([api.DataServer] interface)
+func (self *Server) ImportArchive(server api.Data_ImportArchiveServer) error {
+	var importer *archive.Importer
+	response := new(api.ImportArchiveResponse)
+
+	for {
+		importArchive, err := server.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if importer == nil {
+			self.Log.Infof("importArchive: mode=%s", importArchive.Mode)
+			if importer, err = archive.NewImporter(self.Backend, importArchive.Mode); err != nil {
+				return ToGRPCError(err)
+			}
+			importer.MaxStagedEntries = self.MaxReplaceEntries
+		}
+
+		if importArchive.Entry == nil {
+			continue
+		}
+
+		entry, err := archiveEntryFromAPI(importArchive.Entry)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if err := importer.Import(server.Context(), entry); err != nil {
+			return self.closeImportArchive(server, importer, response, err)
+		}
+	}
+
+	if importer != nil {
+		if err := importer.Finish(server.Context()); err != nil {
+			return self.closeImportArchive(server, importer, response, err)
+		}
+	}
+
+	return self.closeImportArchive(server, importer, response, nil)
+}
+
+func (self *Server) closeImportArchive(server api.Data_ImportArchiveServer, importer *archive.Importer, response *api.ImportArchiveResponse, err error) error {
+	if importer != nil {
+		response.Templates = uint64(importer.Templates)
+		response.Sites = uint64(importer.Sites)
+		response.Plugins = uint64(importer.Plugins)
+		response.Deployments = uint64(importer.Deployments)
+	}
+
+	if err == nil {
+		response.Imported = true
+		return server.SendAndClose(response)
+	} else if backend.IsNotDoneError(err) {
+		response.NotImportedReason = err.Error()
+		return server.SendAndClose(response)
+	} else {
+		return ToGRPCError(err)
+	}
+}
+
+func archiveEntryToAPI(entry archive.Entry, packageFormat string) (*api.ArchiveEntry, error) {
+	switch {
+	case entry.Template != nil:
+		template := entry.Template
+		if package_, err := template.EncodePackage(packageFormat); err == nil {
+			return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Template{Template: &api.Template{
+				Namespace:     template.Namespace,
+				TemplateId:    template.TemplateID,
+				Metadata:      template.Metadata,
+				Updated:       timestamppb.New(template.Updated),
+				PackageFormat: packageFormat,
+				Package:       package_,
+				Version:       template.Version,
+			}}}, nil
+		} else {
+			return nil, err
+		}
+
+	case entry.Site != nil:
+		site := entry.Site
+		if package_, err := site.EncodePackage(packageFormat); err == nil {
+			return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Site{Site: &api.Site{
+				Namespace:     site.Namespace,
+				SiteId:        site.SiteID,
+				TemplateId:    site.TemplateID,
+				Metadata:      site.Metadata,
+				Updated:       timestamppb.New(site.Updated),
+				PackageFormat: packageFormat,
+				Package:       package_,
+				Version:       site.Version,
+			}}}, nil
+		} else {
+			return nil, err
+		}
+
+	case entry.Plugin != nil:
+		plugin := entry.Plugin
+		return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Plugin{Plugin: &api.Plugin{
+			Type:       plugin.Type,
+			Name:       plugin.Name,
+			Executor:   plugin.Executor,
+			Arguments:  plugin.Arguments,
+			Properties: plugin.Properties,
+			Triggers:   tkoutil.TriggersToAPI(plugin.Triggers),
+			Version:    plugin.Version,
+		}}}, nil
+
+	case entry.Deployment != nil:
+		deployment := entry.Deployment
+		if package_, err := deployment.EncodePackage(packageFormat); err == nil {
+			return &api.ArchiveEntry{Entity: &api.ArchiveEntry_Deployment{Deployment: &api.Deployment{
+				Namespace:          deployment.Namespace,
+				DeploymentId:       deployment.DeploymentID,
+				ParentDeploymentId: deployment.ParentDeploymentID,
+				TemplateId:         deployment.TemplateID,
+				SiteId:             deployment.SiteID,
+				Metadata:           deployment.Metadata,
+				Created:            timestamppb.New(deployment.Created),
+				Updated:            timestamppb.New(deployment.Updated),
+				Prepared:           deployment.Prepared,
+				Approved:           deployment.Approved,
+				PackageFormat:      packageFormat,
+				Package:            package_,
+				Version:            deployment.Version,
+			}}}, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	return new(api.ArchiveEntry), nil
+}
+
+func archiveEntryFromAPI(entry *api.ArchiveEntry) (archive.Entry, error) {
+	switch entity := entry.Entity.(type) {
+	case *api.ArchiveEntry_Template:
+		template := entity.Template
+		if template_, err := backend.NewTemplateFromBytes(template.Namespace, template.TemplateId, template.Metadata, template.PackageFormat, template.Package); err == nil {
+			template_.UpdateFromPackage()
+			template_.Updated = fromTimestamp(template.Updated)
+			return archive.Entry{Template: template_}, nil
+		} else {
+			return archive.Entry{}, err
+		}
+
+	case *api.ArchiveEntry_Site:
+		site := entity.Site
+		if site_, err := backend.NewSiteFromBytes(site.Namespace, site.SiteId, site.TemplateId, site.Metadata, site.PackageFormat, site.Package); err == nil {
+			site_.UpdateFromPackage()
+			site_.Updated = fromTimestamp(site.Updated)
+			return archive.Entry{Site: site_}, nil
+		} else {
+			return archive.Entry{}, err
+		}
+
+	case *api.ArchiveEntry_Plugin:
+		plugin := entity.Plugin
+		return archive.Entry{Plugin: backend.NewPlugin(plugin.Type, plugin.Name, plugin.Executor, plugin.Arguments, plugin.Properties, tkoutil.TriggersFromAPI(plugin.Triggers))}, nil
+
+	case *api.ArchiveEntry_Deployment:
+		deployment := entity.Deployment
+		if deployment_, err := backend.NewDeploymentFromBytes(deployment.Namespace, deployment.ParentDeploymentId, deployment.TemplateId, deployment.SiteId, deployment.Metadata, deployment.Prepared, deployment.Approved, deployment.PackageFormat, deployment.Package); err == nil {
+			deployment_.DeploymentID = deployment.DeploymentId
+			deployment_.Created = fromTimestamp(deployment.Created)
+			deployment_.Updated = fromTimestamp(deployment.Updated)
+			return archive.Entry{Deployment: deployment_}, nil
+		} else {
+			return archive.Entry{}, err
+		}
+	}
+
+	return archive.Entry{}, nil
+}
+
+// Nil is the zero time.
+func fromTimestamp(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
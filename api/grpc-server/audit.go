@@ -0,0 +1,57 @@
+package server
+
+import (
+	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ([api.DataServer] interface)
+func (self *Server) ListAuditEvents(listAuditEvents *api.ListAuditEvents, server api.Data_ListAuditEventsServer) error {
+	self.Log.Infof("listAuditEvents: %+v", listAuditEvents)
+
+	if listAuditEvents.Window == nil {
+		listAuditEvents.Window = new(api.Window)
+	}
+
+	selectAuditEvents := backend.SelectAuditEvents{
+		Namespace: listAuditEvents.Namespace,
+		Actors:    listAuditEvents.Actors,
+	}
+	if listAuditEvents.Since != nil {
+		selectAuditEvents.Since = listAuditEvents.Since.AsTime()
+	}
+	if listAuditEvents.Until != nil {
+		selectAuditEvents.Until = listAuditEvents.Until.AsTime()
+	}
+
+	if auditEventResults, err := self.Backend.ListAuditEvents(server.Context(), selectAuditEvents, backend.Window{
+		Offset:   uint(listAuditEvents.Window.Offset),
+		MaxCount: int(listAuditEvents.Window.MaxCount),
+	}); err == nil {
+		if err := util.IterateResults(auditEventResults, func(auditEvent backend.AuditEvent) error {
+			return server.Send(&api.AuditEvent{
+				Id:        auditEvent.ID,
+				Timestamp: timestamppb.New(auditEvent.Timestamp),
+				Actor:     auditEvent.Actor,
+				Operation: auditEvent.Operation,
+				Type:      auditEvent.Type,
+				Namespace: auditEvent.Namespace,
+				Ids:       auditEvent.IDs,
+				Selector:  auditEvent.Selector,
+				Outcome:   auditEvent.Outcome,
+				Reason:    auditEvent.Reason,
+				Diff:      auditEvent.Diff,
+
+				ClaimedAuthor: auditEvent.ClaimedAuthor,
+			})
+		}); err != nil {
+			return ToGRPCError(err)
+		}
+	} else {
+		return ToGRPCError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	contextpkg "context"
+	"crypto/tls"
+	"strings"
+
+	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// ([grpc.UnaryServerInterceptor] signature)
+func (self *Server) authenticationUnaryInterceptor(context contextpkg.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if isHealthMethod(info.FullMethod) {
+		return handler(context, request)
+	}
+
+	if context, err := self.authenticate(context); err == nil {
+		return handler(context, request)
+	} else {
+		return nil, err
+	}
+}
+
+// ([grpc.StreamServerInterceptor] signature)
+func (self *Server) authenticationStreamInterceptor(server any, serverStream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isHealthMethod(info.FullMethod) {
+		return handler(server, serverStream)
+	}
+
+	if context, err := self.authenticate(serverStream.Context()); err == nil {
+		return handler(server, &contextualServerStream{serverStream, context})
+	} else {
+		return err
+	}
+}
+
+// Adds the authenticated identity to the context. The identity also overrides the author
+// provided by the caller, which is kept as the claimed author for auditing.
+func (self *Server) authenticate(context contextpkg.Context) (contextpkg.Context, error) {
+	if self.Authenticator == nil {
+		return context, nil
+	}
+
+	var authorization string
+	if metadata_, ok := metadata.FromIncomingContext(context); ok {
+		if authorizations := metadata_.Get(tkoutil.GRPCAuthorizationMetadataKey); len(authorizations) > 0 {
+			authorization = authorizations[0]
+		}
+	}
+
+	if identity, ok := self.Authenticator.Authenticate(getTLSState(context), authorization); ok {
+		context = backend.ContextWithIdentity(context, identity)
+		if !identity.IsAnonymous() {
+			if author := backend.GetAuthor(context); author != "" {
+				context = backend.ContextWithClaimedAuthor(context, author)
+			}
+			context = backend.ContextWithAuthor(context, identity.Name)
+		}
+		return context, nil
+	} else {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated")
+	}
+}
+
+func getTLSState(context contextpkg.Context) *tls.ConnectionState {
+	if peer_, ok := peer.FromContext(context); ok {
+		if tlsInfo, ok := peer_.AuthInfo.(credentials.TLSInfo); ok {
+			return &tlsInfo.State
+		}
+	}
+	return nil
+}
+
+// Health checks are not authenticated, because probes (e.g. Kubernetes gRPC probes) cannot
+// provide credentials.
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/")
+}
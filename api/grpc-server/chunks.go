@@ -0,0 +1,26 @@
+package server
+
+import (
+	"errors"
+
+	tkoutil "github.com/nephio-experimental/tko/util"
+)
+
+type packageChunk interface {
+	GetPackage() []byte
+}
+
+// Reads the package chunks that follow the header chunk of a client stream.
+func newPackageReader[C packageChunk](receive func() (C, error)) *tkoutil.ChunkReader {
+	return tkoutil.NewChunkReader(func() ([]byte, error) {
+		if chunk, err := receive(); err == nil {
+			if package_ := chunk.GetPackage(); package_ != nil {
+				return package_, nil
+			} else {
+				return nil, errors.New("expected package chunk")
+			}
+		} else {
+			return nil, err
+		}
+	})
+}
@@ -1,14 +1,21 @@
 package server
 
 import (
+	contextpkg "context"
+
 	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 func ToGRPCError(err error) error {
 	// Backend errors
-	if backend.IsBadArgumentError(err) {
+	if backend.IsNotImplementedError(err) {
+		return status.Error(codes.Unimplemented, err.Error())
+	} else if backend.IsBadArgumentError(err) {
 		return status.Error(codes.InvalidArgument, err.Error())
 	} else if backend.IsNotFoundError(err) {
 		return status.Error(codes.NotFound, err.Error())
@@ -16,7 +23,49 @@ func ToGRPCError(err error) error {
 		return status.Error(codes.Aborted, err.Error())
 	} else if backend.IsTimeoutError(err) {
 		return status.Error(codes.Aborted, err.Error())
+	} else if backend.IsConflictError(err) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	} else if backend.IsNotAuthorizedError(err) {
+		return status.Error(codes.PermissionDenied, err.Error())
 	} else {
 		return status.Error(codes.Internal, err.Error())
 	}
 }
+
+// Adds the author from the request metadata (if provided) to the context.
+// ([grpc.UnaryServerInterceptor] signature)
+func AuthorUnaryInterceptor(context contextpkg.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if metadata_, ok := metadata.FromIncomingContext(context); ok {
+		if authors := metadata_.Get(tkoutil.GRPCAuthorMetadataKey); len(authors) > 0 {
+			context = backend.ContextWithAuthor(context, authors[0])
+		}
+	}
+
+	return handler(context, request)
+}
+
+// Adds the author from the request metadata (if provided) to the stream context.
+// ([grpc.StreamServerInterceptor] signature)
+func AuthorStreamInterceptor(server any, serverStream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if metadata_, ok := metadata.FromIncomingContext(serverStream.Context()); ok {
+		if authors := metadata_.Get(tkoutil.GRPCAuthorMetadataKey); len(authors) > 0 {
+			serverStream = &contextualServerStream{serverStream, backend.ContextWithAuthor(serverStream.Context(), authors[0])}
+		}
+	}
+
+	return handler(server, serverStream)
+}
+
+//
+// contextualServerStream
+//
+
+type contextualServerStream struct {
+	grpc.ServerStream
+	context contextpkg.Context
+}
+
+// ([grpc.ServerStream] interface)
+func (self *contextualServerStream) Context() contextpkg.Context {
+	return self.context
+}
@@ -16,7 +16,7 @@ import (
 func (self *Server) CreateDeployment(context contextpkg.Context, createDeployment *api.CreateDeployment) (*api.CreateDeploymentResponse, error) {
 	self.Log.Infof("createDeployment: %+v", createDeployment)
 
-	deployment, err := backend.NewDeploymentFromBytes(createDeployment.ParentDeploymentId, createDeployment.TemplateId, createDeployment.SiteId, createDeployment.MergeMetadata, createDeployment.Prepared, createDeployment.Approved, createDeployment.MergePackageFormat, createDeployment.MergePackage)
+	deployment, err := backend.NewDeploymentFromBytes(createDeployment.Namespace, createDeployment.ParentDeploymentId, createDeployment.TemplateId, createDeployment.SiteId, createDeployment.MergeMetadata, createDeployment.Prepared, createDeployment.Approved, createDeployment.MergePackageFormat, createDeployment.MergePackage)
 	if err != nil {
 		return new(api.CreateDeploymentResponse), status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -34,7 +34,7 @@ func (self *Server) CreateDeployment(context contextpkg.Context, createDeploymen
 func (self *Server) DeleteDeployment(context contextpkg.Context, deploymentId *api.DeploymentID) (*api.DeleteResponse, error) {
 	self.Log.Infof("deleteDeployment: %+v", deploymentId)
 
-	if err := self.Backend.DeleteDeployment(context, deploymentId.DeploymentId); err == nil {
+	if err := self.Backend.DeleteDeployment(context, deploymentId.Namespace, deploymentId.DeploymentId, deploymentId.Propagation); err == nil {
 		return &api.DeleteResponse{Deleted: true}, nil
 	} else if backend.IsNotDoneError(err) {
 		return &api.DeleteResponse{Deleted: false, NotDeletedReason: err.Error()}, nil
@@ -47,13 +47,20 @@ func (self *Server) DeleteDeployment(context contextpkg.Context, deploymentId *a
 func (self *Server) GetDeployment(context contextpkg.Context, getDeployment *api.GetDeployment) (*api.Deployment, error) {
 	self.Log.Infof("getDeployment: %+v", getDeployment)
 
-	if deployment, err := self.Backend.GetDeployment(context, getDeployment.DeploymentId); err == nil {
+	if deployment, err := self.Backend.GetDeployment(context, getDeployment.Namespace, getDeployment.DeploymentId); err == nil {
+		if getDeployment.ChangesOnly {
+			if err := self.removeTemplateResources(context, deployment); err != nil {
+				return new(api.Deployment), ToGRPCError(err)
+			}
+		}
+
 		packageFormat := getDeployment.PreferredPackageFormat
 		if packageFormat == "" {
 			packageFormat = self.DefaultPackageFormat
 		}
 		if pakcage_, err := deployment.EncodePackage(packageFormat); err == nil {
 			return &api.Deployment{
+				Namespace:          deployment.Namespace,
 				DeploymentId:       deployment.DeploymentID,
 				ParentDeploymentId: deployment.ParentDeploymentID,
 				TemplateId:         deployment.TemplateID,
@@ -64,6 +71,7 @@ func (self *Server) GetDeployment(context contextpkg.Context, getDeployment *api
 				Approved:           deployment.Approved,
 				PackageFormat:      packageFormat,
 				Package:            pakcage_,
+				Version:            deployment.Version,
 			}, nil
 		} else {
 			return new(api.Deployment), ToGRPCError(err)
@@ -73,6 +81,50 @@ func (self *Server) GetDeployment(context contextpkg.Context, getDeployment *api
 	}
 }
 
+// ([api.DataServer] interface)
+func (self *Server) GetDeploymentChunked(getDeployment *api.GetDeployment, server api.Data_GetDeploymentChunkedServer) error {
+	self.Log.Infof("getDeploymentChunked: %+v", getDeployment)
+
+	if deployment, err := self.Backend.GetDeployment(server.Context(), getDeployment.Namespace, getDeployment.DeploymentId); err == nil {
+		if getDeployment.ChangesOnly {
+			if err := self.removeTemplateResources(server.Context(), deployment); err != nil {
+				return ToGRPCError(err)
+			}
+		}
+
+		packageFormat := getDeployment.PreferredPackageFormat
+		if packageFormat == "" {
+			packageFormat = self.DefaultPackageFormat
+		}
+
+		if err := server.Send(&api.DeploymentChunk{Chunk: &api.DeploymentChunk_Deployment{Deployment: &api.Deployment{
+			Namespace:          deployment.Namespace,
+			DeploymentId:       deployment.DeploymentID,
+			ParentDeploymentId: deployment.ParentDeploymentID,
+			TemplateId:         deployment.TemplateID,
+			SiteId:             deployment.SiteID,
+			Created:            timestamppb.New(deployment.Created),
+			Updated:            timestamppb.New(deployment.Updated),
+			Prepared:           deployment.Prepared,
+			Approved:           deployment.Approved,
+			PackageFormat:      packageFormat,
+			Version:            deployment.Version,
+		}}}); err != nil {
+			return err
+		}
+
+		writer := tkoutil.NewChunkWriter(func(chunk []byte) error {
+			return server.Send(&api.DeploymentChunk{Chunk: &api.DeploymentChunk_Package{Package: chunk}})
+		})
+		if err := tkoutil.WritePackage(packageFormat, writer, deployment.Package); err != nil {
+			return ToGRPCError(err)
+		}
+		return writer.Flush()
+	} else {
+		return ToGRPCError(err)
+	}
+}
+
 // ([api.DataServer] interface)
 func (self *Server) ListDeployments(listDeployments *api.ListDeployments, server api.Data_ListDeploymentsServer) error {
 	self.Log.Infof("listDeployments: %+v", listDeployments)
@@ -86,8 +138,11 @@ func (self *Server) ListDeployments(listDeployments *api.ListDeployments, server
 	}
 
 	if deploymentInfoResults, err := self.Backend.ListDeployments(server.Context(), backend.SelectDeployments{
+		Namespace:                listDeployments.Select.Namespace,
 		ParentDeploymentID:       listDeployments.Select.ParentDeploymentId,
 		MetadataPatterns:         listDeployments.Select.MetadataPatterns,
+		MetadataSelector:         listDeployments.Select.MetadataSelector,
+		PackageSelector:          listDeployments.Select.PackageSelector,
 		TemplateIDPatterns:       listDeployments.Select.TemplateIdPatterns,
 		TemplateMetadataPatterns: listDeployments.Select.TemplateMetadataPatterns,
 		SiteIDPatterns:           listDeployments.Select.SiteIdPatterns,
@@ -100,6 +155,7 @@ func (self *Server) ListDeployments(listDeployments *api.ListDeployments, server
 	}); err == nil {
 		if err := util.IterateResults(deploymentInfoResults, func(deploymentInfo backend.DeploymentInfo) error {
 			return server.Send(&api.ListedDeployment{
+				Namespace:          deploymentInfo.Namespace,
 				DeploymentId:       deploymentInfo.DeploymentID,
 				ParentDeploymentId: deploymentInfo.ParentDeploymentID,
 				TemplateId:         deploymentInfo.TemplateID,
@@ -109,6 +165,7 @@ func (self *Server) ListDeployments(listDeployments *api.ListDeployments, server
 				Updated:            timestamppb.New(deploymentInfo.Updated),
 				Prepared:           deploymentInfo.Prepared,
 				Approved:           deploymentInfo.Approved,
+				Version:            deploymentInfo.Version,
 			})
 		}); err != nil {
 			return ToGRPCError(err)
@@ -125,15 +182,18 @@ func (self *Server) PurgeDeployments(context contextpkg.Context, selectDeploymen
 	self.Log.Infof("purgeDeployments: %+v", selectDeployments)
 
 	if err := self.Backend.PurgeDeployments(context, backend.SelectDeployments{
+		Namespace:                selectDeployments.Namespace,
 		ParentDeploymentID:       selectDeployments.ParentDeploymentId,
 		MetadataPatterns:         selectDeployments.MetadataPatterns,
+		MetadataSelector:         selectDeployments.MetadataSelector,
+		PackageSelector:          selectDeployments.PackageSelector,
 		TemplateIDPatterns:       selectDeployments.TemplateIdPatterns,
 		TemplateMetadataPatterns: selectDeployments.TemplateMetadataPatterns,
 		SiteIDPatterns:           selectDeployments.SiteIdPatterns,
 		SiteMetadataPatterns:     selectDeployments.SiteMetadataPatterns,
 		Prepared:                 selectDeployments.Prepared,
 		Approved:                 selectDeployments.Approved,
-	}); err == nil {
+	}, selectDeployments.Propagation); err == nil {
 		return &api.DeleteResponse{Deleted: true}, nil
 	} else if backend.IsNotDoneError(err) {
 		return &api.DeleteResponse{Deleted: false, NotDeletedReason: err.Error()}, nil
@@ -146,7 +206,7 @@ func (self *Server) PurgeDeployments(context contextpkg.Context, selectDeploymen
 func (self *Server) StartDeploymentModification(context contextpkg.Context, startDeploymentModification *api.StartDeploymentModification) (*api.StartDeploymentModificationResponse, error) {
 	self.Log.Infof("startDeploymentModification: %+v", startDeploymentModification)
 
-	if modificationToken, deployment, err := self.Backend.StartDeploymentModification(context, startDeploymentModification.DeploymentId); err == nil {
+	if modificationToken, deployment, err := backend.StartDeploymentModificationForVersion(context, self.Backend, startDeploymentModification.Namespace, startDeploymentModification.DeploymentId, startDeploymentModification.Version); err == nil {
 		packageFormat := startDeploymentModification.PreferredPackageFormat
 		if packageFormat == "" {
 			packageFormat = self.DefaultPackageFormat
@@ -186,6 +246,33 @@ func (self *Server) EndDeploymentModification(context contextpkg.Context, endDep
 	}
 }
 
+// ([api.DataServer] interface)
+func (self *Server) EndDeploymentModificationChunked(server api.Data_EndDeploymentModificationChunkedServer) error {
+	var endDeploymentModification *api.EndDeploymentModification
+	if chunk, err := server.Recv(); err == nil {
+		if endDeploymentModification = chunk.GetModification(); endDeploymentModification == nil {
+			return status.Error(codes.InvalidArgument, "first chunk must be the modification")
+		}
+	} else {
+		return err
+	}
+
+	self.Log.Infof("endDeploymentModificationChunked: %+v", endDeploymentModification)
+
+	package_, err := tkoutil.ReadPackage(endDeploymentModification.PackageFormat, newPackageReader(server.Recv))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if deploymentId, err := self.Backend.EndDeploymentModification(server.Context(), endDeploymentModification.ModificationToken, package_, nil); err == nil {
+		return server.SendAndClose(&api.EndDeploymentModificationResponse{Modified: true, DeploymentId: deploymentId})
+	} else if backend.IsNotDoneError(err) {
+		return server.SendAndClose(&api.EndDeploymentModificationResponse{Modified: false, NotModifiedReason: err.Error()})
+	} else {
+		return ToGRPCError(err)
+	}
+}
+
 // ([api.DataServer] interface)
 func (self *Server) CancelDeploymentModification(context contextpkg.Context, cancelDeploymentModification *api.CancelDeploymentModification) (*api.CancelDeploymentModificationResponse, error) {
 	self.Log.Infof("cancelDeploymentModification: %+v", cancelDeploymentModification)
@@ -198,3 +285,60 @@ func (self *Server) CancelDeploymentModification(context contextpkg.Context, can
 		return new(api.CancelDeploymentModificationResponse), ToGRPCError(err)
 	}
 }
+
+// ([api.DataServer] interface)
+func (self *Server) ModifyDeployments(context contextpkg.Context, modifyDeployments *api.ModifyDeployments) (*api.ModifyDeploymentsResponse, error) {
+	self.Log.Infof("modifyDeployments: %+v", modifyDeployments)
+
+	if modifyDeployments.Select == nil {
+		modifyDeployments.Select = new(api.SelectDeployments)
+	}
+
+	if results, err := self.Backend.ModifyDeployments(context, backend.SelectDeployments{
+		Namespace:                modifyDeployments.Select.Namespace,
+		ParentDeploymentID:       modifyDeployments.Select.ParentDeploymentId,
+		MetadataPatterns:         modifyDeployments.Select.MetadataPatterns,
+		MetadataSelector:         modifyDeployments.Select.MetadataSelector,
+		PackageSelector:          modifyDeployments.Select.PackageSelector,
+		TemplateIDPatterns:       modifyDeployments.Select.TemplateIdPatterns,
+		TemplateMetadataPatterns: modifyDeployments.Select.TemplateMetadataPatterns,
+		SiteIDPatterns:           modifyDeployments.Select.SiteIdPatterns,
+		SiteMetadataPatterns:     modifyDeployments.Select.SiteMetadataPatterns,
+		Prepared:                 modifyDeployments.Select.Prepared,
+		Approved:                 modifyDeployments.Select.Approved,
+	}, backend.ModifyDeployments{
+		Approved:       modifyDeployments.Approved,
+		SetMetadata:    modifyDeployments.SetMetadata,
+		DeleteMetadata: modifyDeployments.DeleteMetadata,
+	}); err == nil {
+		response := api.ModifyDeploymentsResponse{Deployments: make([]*api.ModifiedDeployment, len(results))}
+		for index, result := range results {
+			response.Deployments[index] = &api.ModifiedDeployment{
+				DeploymentId:      result.DeploymentID,
+				Modified:          result.Modified,
+				Version:           result.Version,
+				NotModifiedReason: result.NotModifiedReason,
+			}
+		}
+		return &response, nil
+	} else {
+		return new(api.ModifyDeploymentsResponse), ToGRPCError(err)
+	}
+}
+
+// Utils
+
+// If the template does not exist then all resources are considered changed.
+func (self *Server) removeTemplateResources(context contextpkg.Context, deployment *backend.Deployment) error {
+	if deployment.TemplateID == "" {
+		return nil
+	}
+
+	if template, err := self.Backend.GetTemplate(context, deployment.Namespace, deployment.TemplateID); err == nil {
+		return deployment.RemoveTemplateResources(template)
+	} else if backend.IsNotFoundError(err) {
+		return nil
+	} else {
+		return err
+	}
+}
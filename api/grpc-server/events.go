@@ -0,0 +1,36 @@
+package server
+
+import (
+	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ([api.DataServer] interface)
+func (self *Server) Watch(watch *api.Watch, server api.Data_WatchServer) error {
+	self.Log.Infof("watch: %+v", watch)
+
+	if eventResults, err := self.Backend.Watch(server.Context(), backend.SelectEvents{
+		Kinds:         watch.Kinds,
+		Namespace:     watch.Namespace,
+		SinceRevision: watch.SinceRevision,
+	}); err == nil {
+		if err := util.IterateResults(eventResults, func(event backend.Event) error {
+			return server.Send(&api.Event{
+				Revision:  event.Revision,
+				Type:      event.Type,
+				Kind:      event.Kind,
+				Namespace: event.Namespace,
+				Id:        event.ID,
+				Timestamp: timestamppb.New(event.Timestamp),
+			})
+		}); err != nil {
+			return ToGRPCError(err)
+		}
+	} else {
+		return ToGRPCError(err)
+	}
+
+	return nil
+}
@@ -21,7 +21,10 @@ func (self *Server) RegisterPlugin(context contextpkg.Context, plugin *api.Plugi
 		return new(api.RegisterResponse), status.Error(codes.InvalidArgument, fmt.Sprintf("plugin type must be %s: %s", plugins.PluginTypesDescription, plugin.Type))
 	}
 
-	if err := self.Backend.SetPlugin(context, backend.NewPlugin(plugin.Type, plugin.Name, plugin.Executor, plugin.Arguments, plugin.Properties, tkoutil.TriggersFromAPI(plugin.Triggers))); err == nil {
+	plugin_ := backend.NewPlugin(plugin.Type, plugin.Name, plugin.Executor, plugin.Arguments, plugin.Properties, tkoutil.TriggersFromAPI(plugin.Triggers))
+	plugin_.Version = plugin.Version
+
+	if err := self.Backend.SetPlugin(context, plugin_); err == nil {
 		return &api.RegisterResponse{Registered: true}, nil
 	} else if backend.IsNotDoneError(err) {
 		return &api.RegisterResponse{Registered: false, NotRegisteredReason: err.Error()}, nil
@@ -63,6 +66,7 @@ func (self *Server) GetPlugin(context contextpkg.Context, pluginId *api.PluginID
 			Arguments:  plugin.Arguments,
 			Properties: plugin.Properties,
 			Triggers:   tkoutil.TriggersToAPI(plugin.Triggers),
+			Version:    plugin.Version,
 		}, nil
 	} else {
 		return new(api.Plugin), ToGRPCError(err)
@@ -104,6 +108,7 @@ func (self *Server) ListPlugins(listPlugins *api.ListPlugins, server api.Data_Li
 				Arguments:  plugin.Arguments,
 				Properties: plugin.Properties,
 				Triggers:   tkoutil.TriggersToAPI(plugin.Triggers),
+				Version:    plugin.Version,
 			})
 		}); err != nil {
 			return ToGRPCError(err)
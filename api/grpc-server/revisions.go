@@ -0,0 +1,88 @@
+package server
+
+import (
+	contextpkg "context"
+
+	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ([api.DataServer] interface)
+func (self *Server) ListRevisions(listRevisions *api.ListRevisions, server api.Data_ListRevisionsServer) error {
+	self.Log.Infof("listRevisions: %+v", listRevisions)
+
+	if listRevisions.Window == nil {
+		listRevisions.Window = new(api.Window)
+	}
+
+	if revisionInfoResults, err := self.Backend.ListRevisions(server.Context(), listRevisions.Type, listRevisions.Namespace, listRevisions.ObjectId, backend.Window{
+		Offset:   uint(listRevisions.Window.Offset),
+		MaxCount: int(listRevisions.Window.MaxCount),
+	}); err == nil {
+		if err := util.IterateResults(revisionInfoResults, func(revisionInfo backend.RevisionInfo) error {
+			return server.Send(&api.ListedRevision{
+				Type:       revisionInfo.Type,
+				Namespace:  revisionInfo.Namespace,
+				ObjectId:   revisionInfo.ObjectID,
+				Revision:   revisionInfo.Revision,
+				Author:     revisionInfo.Author,
+				Created:    timestamppb.New(revisionInfo.Created),
+				Hash:       revisionInfo.Hash,
+				TemplateId: revisionInfo.TemplateID,
+				Metadata:   revisionInfo.Metadata,
+			})
+		}); err != nil {
+			return ToGRPCError(err)
+		}
+	} else {
+		return ToGRPCError(err)
+	}
+
+	return nil
+}
+
+// ([api.DataServer] interface)
+func (self *Server) GetRevision(context contextpkg.Context, getRevision *api.GetRevision) (*api.Revision, error) {
+	self.Log.Infof("getRevision: %+v", getRevision)
+
+	if revision, err := self.Backend.GetRevision(context, backend.NewRevisionID(getRevision.Type, getRevision.Namespace, getRevision.ObjectId, getRevision.Revision)); err == nil {
+		packageFormat := getRevision.PreferredPackageFormat
+		if packageFormat == "" {
+			packageFormat = self.DefaultPackageFormat
+		}
+		if package_, err := revision.EncodePackage(packageFormat); err == nil {
+			return &api.Revision{
+				Type:          revision.Type,
+				Namespace:     revision.Namespace,
+				ObjectId:      revision.ObjectID,
+				Revision:      revision.Revision,
+				Author:        revision.Author,
+				Created:       timestamppb.New(revision.Created),
+				Hash:          revision.Hash,
+				TemplateId:    revision.TemplateID,
+				Metadata:      revision.Metadata,
+				PackageFormat: packageFormat,
+				Package:       package_,
+			}, nil
+		} else {
+			return new(api.Revision), ToGRPCError(err)
+		}
+	} else {
+		return new(api.Revision), ToGRPCError(err)
+	}
+}
+
+// ([api.DataServer] interface)
+func (self *Server) RevertTo(context contextpkg.Context, revisionId *api.RevisionID) (*api.RevertResponse, error) {
+	self.Log.Infof("revertTo: %+v", revisionId)
+
+	if err := self.Backend.RevertTo(context, backend.NewRevisionID(revisionId.Type, revisionId.Namespace, revisionId.ObjectId, revisionId.Revision)); err == nil {
+		return &api.RevertResponse{Reverted: true}, nil
+	} else if backend.IsNotDoneError(err) {
+		return &api.RevertResponse{Reverted: false, NotRevertedReason: err.Error()}, nil
+	} else {
+		return new(api.RevertResponse), ToGRPCError(err)
+	}
+}
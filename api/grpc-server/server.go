@@ -1,13 +1,21 @@
 package server
 
 import (
+	"crypto/tls"
 	"net"
 
+	"github.com/nephio-experimental/tko/api/authentication"
 	api "github.com/nephio-experimental/tko/api/grpc"
 	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/health"
+	"github.com/nephio-experimental/tko/metrics"
+	"github.com/nephio-experimental/tko/telemetry"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 //
@@ -24,6 +32,11 @@ type Server struct {
 	Address              string
 	Port                 int
 	DefaultPackageFormat string
+	TLS                  *tls.Config                   // if nil will not use TLS
+	Authenticator        *authentication.Authenticator // if nil will not authenticate
+	Health               *health.Health                // if nil will not serve the gRPC health service
+	Reflection           bool
+	MaxReplaceEntries    uint // archive import in replace mode; 0 for no limit
 	Log                  commonlog.Logger
 
 	grpcServers        []*grpc.Server
@@ -65,8 +78,23 @@ func (self *Server) start(level2protocol string, address string) error {
 				"level2protocol", level2protocol,
 				"addressPort", listener.Addr().String())
 
-			grpcServer := grpc.NewServer()
+			options := []grpc.ServerOption{
+				grpc.ChainUnaryInterceptor(metrics.GRPCUnaryInterceptor, AuthorUnaryInterceptor, self.authenticationUnaryInterceptor),
+				grpc.ChainStreamInterceptor(metrics.GRPCStreamInterceptor, AuthorStreamInterceptor, self.authenticationStreamInterceptor),
+				telemetry.GRPCServerOption(),
+			}
+			if self.TLS != nil {
+				options = append(options, grpc.Creds(credentials.NewTLS(self.TLS)))
+			}
+
+			grpcServer := grpc.NewServer(options...)
 			api.RegisterDataServer(grpcServer, self)
+			if self.Health != nil {
+				grpc_health_v1.RegisterHealthServer(grpcServer, self.Health.GRPCServer())
+			}
+			if self.Reflection {
+				reflection.Register(grpcServer)
+			}
 			self.grpcServers = append(self.grpcServers, grpcServer)
 			self.clientAddressPorts = append(self.clientAddressPorts, util.IPAddressPortWithoutZone(addressPort))
 
@@ -17,12 +17,13 @@ var _ api.DataServer = new(Server)
 func (self *Server) RegisterSite(context contextpkg.Context, site *api.Site) (*api.RegisterResponse, error) {
 	self.Log.Infof("registerSite: %+v", site)
 
-	site_, err := backend.NewSiteFromBytes(site.SiteId, site.TemplateId, site.Metadata, site.PackageFormat, site.Package)
+	site_, err := backend.NewSiteFromBytes(site.Namespace, site.SiteId, site.TemplateId, site.Metadata, site.PackageFormat, site.Package)
 	if err != nil {
 		return new(api.RegisterResponse), status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	site_.UpdateFromPackage()
+	site_.Version = site.Version
 
 	if err := self.Backend.SetSite(context, site_); err == nil {
 		return &api.RegisterResponse{Registered: true}, nil
@@ -33,11 +34,41 @@ func (self *Server) RegisterSite(context contextpkg.Context, site *api.Site) (*a
 	}
 }
 
+// ([api.DataServer] interface)
+func (self *Server) RegisterSiteChunked(server api.Data_RegisterSiteChunkedServer) error {
+	var site *api.Site
+	if chunk, err := server.Recv(); err == nil {
+		if site = chunk.GetSite(); site == nil {
+			return status.Error(codes.InvalidArgument, "first chunk must be the site")
+		}
+	} else {
+		return err
+	}
+
+	self.Log.Infof("registerSiteChunked: %+v", site)
+
+	site_, err := backend.NewSiteFromReader(site.Namespace, site.SiteId, site.TemplateId, site.Metadata, site.PackageFormat, newPackageReader(server.Recv))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	site_.UpdateFromPackage()
+	site_.Version = site.Version
+
+	if err := self.Backend.SetSite(server.Context(), site_); err == nil {
+		return server.SendAndClose(&api.RegisterResponse{Registered: true})
+	} else if backend.IsNotDoneError(err) {
+		return server.SendAndClose(&api.RegisterResponse{Registered: false, NotRegisteredReason: err.Error()})
+	} else {
+		return ToGRPCError(err)
+	}
+}
+
 // ([api.DataServer] interface)
 func (self *Server) DeleteSite(context contextpkg.Context, siteId *api.SiteID) (*api.DeleteResponse, error) {
 	self.Log.Infof("deleteSite: %+v", siteId)
 
-	if err := self.Backend.DeleteSite(context, siteId.SiteId); err == nil {
+	if err := self.Backend.DeleteSite(context, siteId.Namespace, siteId.SiteId); err == nil {
 		return &api.DeleteResponse{Deleted: true}, nil
 	} else if backend.IsNotDoneError(err) {
 		return &api.DeleteResponse{Deleted: false, NotDeletedReason: err.Error()}, nil
@@ -50,13 +81,14 @@ func (self *Server) DeleteSite(context contextpkg.Context, siteId *api.SiteID) (
 func (self *Server) GetSite(context contextpkg.Context, getSite *api.GetSite) (*api.Site, error) {
 	self.Log.Infof("getSite: %+v", getSite)
 
-	if site, err := self.Backend.GetSite(context, getSite.SiteId); err == nil {
+	if site, err := self.Backend.GetSite(context, getSite.Namespace, getSite.SiteId); err == nil {
 		packageFormat := getSite.PreferredPackageFormat
 		if packageFormat == "" {
 			packageFormat = self.DefaultPackageFormat
 		}
 		if package_, err := site.EncodePackage(packageFormat); err == nil {
 			return &api.Site{
+				Namespace:     site.Namespace,
 				SiteId:        site.SiteID,
 				TemplateId:    site.TemplateID,
 				Metadata:      site.Metadata,
@@ -64,6 +96,7 @@ func (self *Server) GetSite(context contextpkg.Context, getSite *api.GetSite) (*
 				PackageFormat: packageFormat,
 				Package:       package_,
 				DeploymentIds: site.DeploymentIDs,
+				Version:       site.Version,
 			}, nil
 		} else {
 			return new(api.Site), ToGRPCError(err)
@@ -86,20 +119,25 @@ func (self *Server) ListSites(listSites *api.ListSites, server api.Data_ListSite
 	}
 
 	if siteInfoResults, err := self.Backend.ListSites(server.Context(), backend.SelectSites{
+		Namespace:          listSites.Select.Namespace,
 		SiteIDPatterns:     listSites.Select.SiteIdPatterns,
 		TemplateIDPatterns: listSites.Select.TemplateIdPatterns,
 		MetadataPatterns:   listSites.Select.MetadataPatterns,
+		MetadataSelector:   listSites.Select.MetadataSelector,
+		PackageSelector:    listSites.Select.PackageSelector,
 	}, backend.Window{
 		Offset:   uint(listSites.Window.Offset),
 		MaxCount: int(listSites.Window.MaxCount),
 	}); err == nil {
 		if err := util.IterateResults(siteInfoResults, func(siteInfo backend.SiteInfo) error {
 			return server.Send(&api.ListedSite{
+				Namespace:     siteInfo.Namespace,
 				SiteId:        siteInfo.SiteID,
 				TemplateId:    siteInfo.TemplateID,
 				Metadata:      siteInfo.Metadata,
 				Updated:       timestamppb.New(siteInfo.Updated),
 				DeploymentIds: siteInfo.DeploymentIDs,
+				Version:       siteInfo.Version,
 			})
 		}); err != nil {
 			return ToGRPCError(err)
@@ -116,9 +154,12 @@ func (self *Server) PurgeSites(context contextpkg.Context, selectSites *api.Sele
 	self.Log.Infof("purgeSites: %+v", selectSites)
 
 	if err := self.Backend.PurgeSites(context, backend.SelectSites{
+		Namespace:          selectSites.Namespace,
 		SiteIDPatterns:     selectSites.SiteIdPatterns,
 		TemplateIDPatterns: selectSites.TemplateIdPatterns,
 		MetadataPatterns:   selectSites.MetadataPatterns,
+		MetadataSelector:   selectSites.MetadataSelector,
+		PackageSelector:    selectSites.PackageSelector,
 	}); err == nil {
 		return &api.DeleteResponse{Deleted: true}, nil
 	} else if backend.IsNotDoneError(err) {
@@ -127,3 +168,49 @@ func (self *Server) PurgeSites(context contextpkg.Context, selectSites *api.Sele
 		return new(api.DeleteResponse), ToGRPCError(err)
 	}
 }
+
+// ([api.DataServer] interface)
+func (self *Server) ListDeletedDeployments(getSite *api.GetSite, server api.Data_ListDeletedDeploymentsServer) error {
+	self.Log.Infof("listDeletedDeployments: %+v", getSite)
+
+	packageFormat := getSite.PreferredPackageFormat
+	if packageFormat == "" {
+		packageFormat = self.DefaultPackageFormat
+	}
+
+	if deletedDeploymentResults, err := self.Backend.ListDeletedDeployments(server.Context(), getSite.Namespace, getSite.SiteId); err == nil {
+		if err := util.IterateResults(deletedDeploymentResults, func(deletedDeployment backend.DeletedDeployment) error {
+			if package_, err := deletedDeployment.EncodePackage(packageFormat); err == nil {
+				return server.Send(&api.DeletedDeployment{
+					Namespace:     deletedDeployment.Namespace,
+					DeploymentId:  deletedDeployment.DeploymentID,
+					SiteId:        deletedDeployment.SiteID,
+					Deleted:       timestamppb.New(deletedDeployment.Deleted),
+					PackageFormat: packageFormat,
+					Package:       package_,
+				})
+			} else {
+				return err
+			}
+		}); err != nil {
+			return ToGRPCError(err)
+		}
+	} else {
+		return ToGRPCError(err)
+	}
+
+	return nil
+}
+
+// ([api.DataServer] interface)
+func (self *Server) AcknowledgeDeletedDeployments(context contextpkg.Context, acknowledgeDeletedDeployments *api.AcknowledgeDeletedDeployments) (*api.DeleteResponse, error) {
+	self.Log.Infof("acknowledgeDeletedDeployments: %+v", acknowledgeDeletedDeployments)
+
+	if err := self.Backend.AcknowledgeDeletedDeployments(context, acknowledgeDeletedDeployments.Namespace, acknowledgeDeletedDeployments.SiteId, acknowledgeDeletedDeployments.DeploymentIds); err == nil {
+		return &api.DeleteResponse{Deleted: true}, nil
+	} else if backend.IsNotDoneError(err) {
+		return &api.DeleteResponse{Deleted: false, NotDeletedReason: err.Error()}, nil
+	} else {
+		return new(api.DeleteResponse), ToGRPCError(err)
+	}
+}
@@ -15,12 +15,13 @@ import (
 func (self *Server) RegisterTemplate(context contextpkg.Context, template *api.Template) (*api.RegisterResponse, error) {
 	self.Log.Infof("registerTemplate: %+v", template)
 
-	template_, err := backend.NewTemplateFromBytes(template.TemplateId, template.Metadata, template.PackageFormat, template.Package)
+	template_, err := backend.NewTemplateFromBytes(template.Namespace, template.TemplateId, template.Metadata, template.PackageFormat, template.Package)
 	if err != nil {
 		return new(api.RegisterResponse), status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	template_.UpdateFromPackage()
+	template_.Version = template.Version
 
 	if err := self.Backend.SetTemplate(context, template_); err == nil {
 		return &api.RegisterResponse{Registered: true}, nil
@@ -31,11 +32,41 @@ func (self *Server) RegisterTemplate(context contextpkg.Context, template *api.T
 	}
 }
 
+// ([api.DataServer] interface)
+func (self *Server) RegisterTemplateChunked(server api.Data_RegisterTemplateChunkedServer) error {
+	var template *api.Template
+	if chunk, err := server.Recv(); err == nil {
+		if template = chunk.GetTemplate(); template == nil {
+			return status.Error(codes.InvalidArgument, "first chunk must be the template")
+		}
+	} else {
+		return err
+	}
+
+	self.Log.Infof("registerTemplateChunked: %+v", template)
+
+	template_, err := backend.NewTemplateFromReader(template.Namespace, template.TemplateId, template.Metadata, template.PackageFormat, newPackageReader(server.Recv))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	template_.UpdateFromPackage()
+	template_.Version = template.Version
+
+	if err := self.Backend.SetTemplate(server.Context(), template_); err == nil {
+		return server.SendAndClose(&api.RegisterResponse{Registered: true})
+	} else if backend.IsNotDoneError(err) {
+		return server.SendAndClose(&api.RegisterResponse{Registered: false, NotRegisteredReason: err.Error()})
+	} else {
+		return ToGRPCError(err)
+	}
+}
+
 // ([api.DataServer] interface)
 func (self *Server) DeleteTemplate(context contextpkg.Context, templateId *api.TemplateID) (*api.DeleteResponse, error) {
 	self.Log.Infof("deleteTemplate: %+v", templateId)
 
-	if err := self.Backend.DeleteTemplate(context, templateId.TemplateId); err == nil {
+	if err := self.Backend.DeleteTemplate(context, templateId.Namespace, templateId.TemplateId); err == nil {
 		return &api.DeleteResponse{Deleted: true}, nil
 	} else if backend.IsNotDoneError(err) {
 		return &api.DeleteResponse{Deleted: false, NotDeletedReason: err.Error()}, nil
@@ -48,19 +79,21 @@ func (self *Server) DeleteTemplate(context contextpkg.Context, templateId *api.T
 func (self *Server) GetTemplate(context contextpkg.Context, getTemplate *api.GetTemplate) (*api.Template, error) {
 	self.Log.Infof("getTemplate: %+v", getTemplate)
 
-	if template, err := self.Backend.GetTemplate(context, getTemplate.TemplateId); err == nil {
+	if template, err := self.Backend.GetTemplate(context, getTemplate.Namespace, getTemplate.TemplateId); err == nil {
 		packageFormat := getTemplate.PreferredPackageFormat
 		if packageFormat == "" {
 			packageFormat = self.DefaultPackageFormat
 		}
 		if package_, err := template.EncodePackage(packageFormat); err == nil {
 			return &api.Template{
+				Namespace:     template.Namespace,
 				TemplateId:    template.TemplateID,
 				Metadata:      template.Metadata,
 				Updated:       timestamppb.New(template.Updated),
 				PackageFormat: packageFormat,
 				Package:       package_,
 				DeploymentIds: template.DeploymentIDs,
+				Version:       template.Version,
 			}, nil
 		} else {
 			return new(api.Template), ToGRPCError(err)
@@ -83,18 +116,23 @@ func (self *Server) ListTemplates(listTemplates *api.ListTemplates, server api.D
 	}
 
 	if templateInfoResults, err := self.Backend.ListTemplates(server.Context(), backend.SelectTemplates{
+		Namespace:          listTemplates.Select.Namespace,
 		TemplateIDPatterns: listTemplates.Select.TemplateIdPatterns,
 		MetadataPatterns:   listTemplates.Select.MetadataPatterns,
+		MetadataSelector:   listTemplates.Select.MetadataSelector,
+		PackageSelector:    listTemplates.Select.PackageSelector,
 	}, backend.Window{
 		Offset:   uint(listTemplates.Window.Offset),
 		MaxCount: int(listTemplates.Window.MaxCount),
 	}); err == nil {
 		if err := util.IterateResults(templateInfoResults, func(templateInfo backend.TemplateInfo) error {
 			return server.Send(&api.ListedTemplate{
+				Namespace:     templateInfo.Namespace,
 				TemplateId:    templateInfo.TemplateID,
 				Metadata:      templateInfo.Metadata,
 				Updated:       timestamppb.New(templateInfo.Updated),
 				DeploymentIds: templateInfo.DeploymentIDs,
+				Version:       templateInfo.Version,
 			})
 		}); err != nil {
 			return ToGRPCError(err)
@@ -111,8 +149,11 @@ func (self *Server) PurgeTemplates(context contextpkg.Context, selectTemplates *
 	self.Log.Infof("purgeTemplates: %+v", selectTemplates)
 
 	if err := self.Backend.PurgeTemplates(context, backend.SelectTemplates{
+		Namespace:          selectTemplates.Namespace,
 		TemplateIDPatterns: selectTemplates.TemplateIdPatterns,
 		MetadataPatterns:   selectTemplates.MetadataPatterns,
+		MetadataSelector:   selectTemplates.MetadataSelector,
+		PackageSelector:    selectTemplates.PackageSelector,
 	}); err == nil {
 		return &api.DeleteResponse{Deleted: true}, nil
 	} else if backend.IsNotDoneError(err) {
@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.19.6
+// source: plugin.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Plugin_Capabilities_FullMethodName = "/tko.plugin.Plugin/capabilities"
+	Plugin_Validate_FullMethodName     = "/tko.plugin.Plugin/validate"
+	Plugin_Prepare_FullMethodName      = "/tko.plugin.Plugin/prepare"
+	Plugin_Schedule_FullMethodName     = "/tko.plugin.Plugin/schedule"
+)
+
+// PluginClient is the client API for Plugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Implemented by long-running plugin servers, which are used via the "grpc" executor
+type PluginClient interface {
+	Capabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PluginCapabilities, error)
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ValidateResponse], error)
+	Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PrepareResponse], error)
+	Schedule(ctx context.Context, in *ScheduleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScheduleResponse], error)
+}
+
+type pluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPluginClient(cc grpc.ClientConnInterface) PluginClient {
+	return &pluginClient{cc}
+}
+
+func (c *pluginClient) Capabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PluginCapabilities, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PluginCapabilities)
+	err := c.cc.Invoke(ctx, Plugin_Capabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ValidateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Plugin_ServiceDesc.Streams[0], Plugin_Validate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ValidateRequest, ValidateResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_ValidateClient = grpc.ServerStreamingClient[ValidateResponse]
+
+func (c *pluginClient) Prepare(ctx context.Context, in *PrepareRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PrepareResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Plugin_ServiceDesc.Streams[1], Plugin_Prepare_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PrepareRequest, PrepareResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_PrepareClient = grpc.ServerStreamingClient[PrepareResponse]
+
+func (c *pluginClient) Schedule(ctx context.Context, in *ScheduleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScheduleResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Plugin_ServiceDesc.Streams[2], Plugin_Schedule_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ScheduleRequest, ScheduleResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_ScheduleClient = grpc.ServerStreamingClient[ScheduleResponse]
+
+// PluginServer is the server API for Plugin service.
+// All implementations must embed UnimplementedPluginServer
+// for forward compatibility.
+//
+// Implemented by long-running plugin servers, which are used via the "grpc" executor
+type PluginServer interface {
+	Capabilities(context.Context, *emptypb.Empty) (*PluginCapabilities, error)
+	Validate(*ValidateRequest, grpc.ServerStreamingServer[ValidateResponse]) error
+	Prepare(*PrepareRequest, grpc.ServerStreamingServer[PrepareResponse]) error
+	Schedule(*ScheduleRequest, grpc.ServerStreamingServer[ScheduleResponse]) error
+	mustEmbedUnimplementedPluginServer()
+}
+
+// UnimplementedPluginServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPluginServer struct{}
+
+func (UnimplementedPluginServer) Capabilities(context.Context, *emptypb.Empty) (*PluginCapabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capabilities not implemented")
+}
+func (UnimplementedPluginServer) Validate(*ValidateRequest, grpc.ServerStreamingServer[ValidateResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedPluginServer) Prepare(*PrepareRequest, grpc.ServerStreamingServer[PrepareResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Prepare not implemented")
+}
+func (UnimplementedPluginServer) Schedule(*ScheduleRequest, grpc.ServerStreamingServer[ScheduleResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
+func (UnimplementedPluginServer) mustEmbedUnimplementedPluginServer() {}
+func (UnimplementedPluginServer) testEmbeddedByValue()                {}
+
+// UnsafePluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PluginServer will
+// result in compilation errors.
+type UnsafePluginServer interface {
+	mustEmbedUnimplementedPluginServer()
+}
+
+func RegisterPluginServer(s grpc.ServiceRegistrar, srv PluginServer) {
+	// If the following call pancis, it indicates UnimplementedPluginServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Plugin_ServiceDesc, srv)
+}
+
+func _Plugin_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Plugin_Capabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).Capabilities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_Validate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginServer).Validate(m, &grpc.GenericServerStream[ValidateRequest, ValidateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_ValidateServer = grpc.ServerStreamingServer[ValidateResponse]
+
+func _Plugin_Prepare_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrepareRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginServer).Prepare(m, &grpc.GenericServerStream[PrepareRequest, PrepareResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_PrepareServer = grpc.ServerStreamingServer[PrepareResponse]
+
+func _Plugin_Schedule_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScheduleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginServer).Schedule(m, &grpc.GenericServerStream[ScheduleRequest, ScheduleResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Plugin_ScheduleServer = grpc.ServerStreamingServer[ScheduleResponse]
+
+// Plugin_ServiceDesc is the grpc.ServiceDesc for Plugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Plugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tko.plugin.Plugin",
+	HandlerType: (*PluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "capabilities",
+			Handler:    _Plugin_Capabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "validate",
+			Handler:       _Plugin_Validate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "prepare",
+			Handler:       _Plugin_Prepare_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "schedule",
+			Handler:       _Plugin_Schedule_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "plugin.proto",
+}
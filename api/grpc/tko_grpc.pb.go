@@ -20,30 +20,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Data_About_FullMethodName                        = "/tko.Data/about"
-	Data_RegisterTemplate_FullMethodName             = "/tko.Data/registerTemplate"
-	Data_DeleteTemplate_FullMethodName               = "/tko.Data/deleteTemplate"
-	Data_GetTemplate_FullMethodName                  = "/tko.Data/getTemplate"
-	Data_ListTemplates_FullMethodName                = "/tko.Data/listTemplates"
-	Data_PurgeTemplates_FullMethodName               = "/tko.Data/purgeTemplates"
-	Data_RegisterSite_FullMethodName                 = "/tko.Data/registerSite"
-	Data_DeleteSite_FullMethodName                   = "/tko.Data/deleteSite"
-	Data_GetSite_FullMethodName                      = "/tko.Data/getSite"
-	Data_ListSites_FullMethodName                    = "/tko.Data/listSites"
-	Data_PurgeSites_FullMethodName                   = "/tko.Data/purgeSites"
-	Data_CreateDeployment_FullMethodName             = "/tko.Data/createDeployment"
-	Data_DeleteDeployment_FullMethodName             = "/tko.Data/deleteDeployment"
-	Data_GetDeployment_FullMethodName                = "/tko.Data/getDeployment"
-	Data_ListDeployments_FullMethodName              = "/tko.Data/listDeployments"
-	Data_PurgeDeployments_FullMethodName             = "/tko.Data/purgeDeployments"
-	Data_StartDeploymentModification_FullMethodName  = "/tko.Data/startDeploymentModification"
-	Data_EndDeploymentModification_FullMethodName    = "/tko.Data/endDeploymentModification"
-	Data_CancelDeploymentModification_FullMethodName = "/tko.Data/cancelDeploymentModification"
-	Data_RegisterPlugin_FullMethodName               = "/tko.Data/registerPlugin"
-	Data_DeletePlugin_FullMethodName                 = "/tko.Data/deletePlugin"
-	Data_GetPlugin_FullMethodName                    = "/tko.Data/getPlugin"
-	Data_ListPlugins_FullMethodName                  = "/tko.Data/listPlugins"
-	Data_PurgePlugins_FullMethodName                 = "/tko.Data/purgePlugins"
+	Data_About_FullMethodName                            = "/tko.Data/about"
+	Data_RegisterTemplate_FullMethodName                 = "/tko.Data/registerTemplate"
+	Data_RegisterTemplateChunked_FullMethodName          = "/tko.Data/registerTemplateChunked"
+	Data_DeleteTemplate_FullMethodName                   = "/tko.Data/deleteTemplate"
+	Data_GetTemplate_FullMethodName                      = "/tko.Data/getTemplate"
+	Data_ListTemplates_FullMethodName                    = "/tko.Data/listTemplates"
+	Data_PurgeTemplates_FullMethodName                   = "/tko.Data/purgeTemplates"
+	Data_RegisterSite_FullMethodName                     = "/tko.Data/registerSite"
+	Data_RegisterSiteChunked_FullMethodName              = "/tko.Data/registerSiteChunked"
+	Data_DeleteSite_FullMethodName                       = "/tko.Data/deleteSite"
+	Data_GetSite_FullMethodName                          = "/tko.Data/getSite"
+	Data_ListSites_FullMethodName                        = "/tko.Data/listSites"
+	Data_PurgeSites_FullMethodName                       = "/tko.Data/purgeSites"
+	Data_ListDeletedDeployments_FullMethodName           = "/tko.Data/listDeletedDeployments"
+	Data_AcknowledgeDeletedDeployments_FullMethodName    = "/tko.Data/acknowledgeDeletedDeployments"
+	Data_CreateDeployment_FullMethodName                 = "/tko.Data/createDeployment"
+	Data_DeleteDeployment_FullMethodName                 = "/tko.Data/deleteDeployment"
+	Data_GetDeployment_FullMethodName                    = "/tko.Data/getDeployment"
+	Data_GetDeploymentChunked_FullMethodName             = "/tko.Data/getDeploymentChunked"
+	Data_ListDeployments_FullMethodName                  = "/tko.Data/listDeployments"
+	Data_PurgeDeployments_FullMethodName                 = "/tko.Data/purgeDeployments"
+	Data_StartDeploymentModification_FullMethodName      = "/tko.Data/startDeploymentModification"
+	Data_EndDeploymentModification_FullMethodName        = "/tko.Data/endDeploymentModification"
+	Data_EndDeploymentModificationChunked_FullMethodName = "/tko.Data/endDeploymentModificationChunked"
+	Data_CancelDeploymentModification_FullMethodName     = "/tko.Data/cancelDeploymentModification"
+	Data_ModifyDeployments_FullMethodName                = "/tko.Data/modifyDeployments"
+	Data_RegisterPlugin_FullMethodName                   = "/tko.Data/registerPlugin"
+	Data_DeletePlugin_FullMethodName                     = "/tko.Data/deletePlugin"
+	Data_GetPlugin_FullMethodName                        = "/tko.Data/getPlugin"
+	Data_ListPlugins_FullMethodName                      = "/tko.Data/listPlugins"
+	Data_PurgePlugins_FullMethodName                     = "/tko.Data/purgePlugins"
+	Data_ListRevisions_FullMethodName                    = "/tko.Data/listRevisions"
+	Data_GetRevision_FullMethodName                      = "/tko.Data/getRevision"
+	Data_RevertTo_FullMethodName                         = "/tko.Data/revertTo"
+	Data_ListAuditEvents_FullMethodName                  = "/tko.Data/listAuditEvents"
+	Data_ExportArchive_FullMethodName                    = "/tko.Data/exportArchive"
+	Data_ImportArchive_FullMethodName                    = "/tko.Data/importArchive"
+	Data_Watch_FullMethodName                            = "/tko.Data/watch"
 )
 
 // DataClient is the client API for Data service.
@@ -52,28 +66,42 @@ const (
 type DataClient interface {
 	About(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AboutResponse, error)
 	RegisterTemplate(ctx context.Context, in *Template, opts ...grpc.CallOption) (*RegisterResponse, error)
+	RegisterTemplateChunked(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TemplateChunk, RegisterResponse], error)
 	DeleteTemplate(ctx context.Context, in *TemplateID, opts ...grpc.CallOption) (*DeleteResponse, error)
 	GetTemplate(ctx context.Context, in *GetTemplate, opts ...grpc.CallOption) (*Template, error)
 	ListTemplates(ctx context.Context, in *ListTemplates, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedTemplate], error)
 	PurgeTemplates(ctx context.Context, in *SelectTemplates, opts ...grpc.CallOption) (*DeleteResponse, error)
 	RegisterSite(ctx context.Context, in *Site, opts ...grpc.CallOption) (*RegisterResponse, error)
+	RegisterSiteChunked(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SiteChunk, RegisterResponse], error)
 	DeleteSite(ctx context.Context, in *SiteID, opts ...grpc.CallOption) (*DeleteResponse, error)
 	GetSite(ctx context.Context, in *GetSite, opts ...grpc.CallOption) (*Site, error)
 	ListSites(ctx context.Context, in *ListSites, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedSite], error)
 	PurgeSites(ctx context.Context, in *SelectSites, opts ...grpc.CallOption) (*DeleteResponse, error)
+	ListDeletedDeployments(ctx context.Context, in *GetSite, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeletedDeployment], error)
+	AcknowledgeDeletedDeployments(ctx context.Context, in *AcknowledgeDeletedDeployments, opts ...grpc.CallOption) (*DeleteResponse, error)
 	CreateDeployment(ctx context.Context, in *CreateDeployment, opts ...grpc.CallOption) (*CreateDeploymentResponse, error)
 	DeleteDeployment(ctx context.Context, in *DeploymentID, opts ...grpc.CallOption) (*DeleteResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeployment, opts ...grpc.CallOption) (*Deployment, error)
+	GetDeploymentChunked(ctx context.Context, in *GetDeployment, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeploymentChunk], error)
 	ListDeployments(ctx context.Context, in *ListDeployments, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedDeployment], error)
 	PurgeDeployments(ctx context.Context, in *SelectDeployments, opts ...grpc.CallOption) (*DeleteResponse, error)
 	StartDeploymentModification(ctx context.Context, in *StartDeploymentModification, opts ...grpc.CallOption) (*StartDeploymentModificationResponse, error)
 	EndDeploymentModification(ctx context.Context, in *EndDeploymentModification, opts ...grpc.CallOption) (*EndDeploymentModificationResponse, error)
+	EndDeploymentModificationChunked(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[EndDeploymentModificationChunk, EndDeploymentModificationResponse], error)
 	CancelDeploymentModification(ctx context.Context, in *CancelDeploymentModification, opts ...grpc.CallOption) (*CancelDeploymentModificationResponse, error)
+	ModifyDeployments(ctx context.Context, in *ModifyDeployments, opts ...grpc.CallOption) (*ModifyDeploymentsResponse, error)
 	RegisterPlugin(ctx context.Context, in *Plugin, opts ...grpc.CallOption) (*RegisterResponse, error)
 	DeletePlugin(ctx context.Context, in *PluginID, opts ...grpc.CallOption) (*DeleteResponse, error)
 	GetPlugin(ctx context.Context, in *PluginID, opts ...grpc.CallOption) (*Plugin, error)
 	ListPlugins(ctx context.Context, in *ListPlugins, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Plugin], error)
 	PurgePlugins(ctx context.Context, in *SelectPlugins, opts ...grpc.CallOption) (*DeleteResponse, error)
+	ListRevisions(ctx context.Context, in *ListRevisions, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedRevision], error)
+	GetRevision(ctx context.Context, in *GetRevision, opts ...grpc.CallOption) (*Revision, error)
+	RevertTo(ctx context.Context, in *RevisionID, opts ...grpc.CallOption) (*RevertResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEvents, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditEvent], error)
+	ExportArchive(ctx context.Context, in *ExportArchive, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveEntry], error)
+	ImportArchive(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArchive, ImportArchiveResponse], error)
+	Watch(ctx context.Context, in *Watch, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
 }
 
 type dataClient struct {
@@ -104,6 +132,19 @@ func (c *dataClient) RegisterTemplate(ctx context.Context, in *Template, opts ..
 	return out, nil
 }
 
+func (c *dataClient) RegisterTemplateChunked(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TemplateChunk, RegisterResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[0], Data_RegisterTemplateChunked_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TemplateChunk, RegisterResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_RegisterTemplateChunkedClient = grpc.ClientStreamingClient[TemplateChunk, RegisterResponse]
+
 func (c *dataClient) DeleteTemplate(ctx context.Context, in *TemplateID, opts ...grpc.CallOption) (*DeleteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteResponse)
@@ -126,7 +167,7 @@ func (c *dataClient) GetTemplate(ctx context.Context, in *GetTemplate, opts ...g
 
 func (c *dataClient) ListTemplates(ctx context.Context, in *ListTemplates, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedTemplate], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[0], Data_ListTemplates_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[1], Data_ListTemplates_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +204,19 @@ func (c *dataClient) RegisterSite(ctx context.Context, in *Site, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *dataClient) RegisterSiteChunked(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SiteChunk, RegisterResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[2], Data_RegisterSiteChunked_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SiteChunk, RegisterResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_RegisterSiteChunkedClient = grpc.ClientStreamingClient[SiteChunk, RegisterResponse]
+
 func (c *dataClient) DeleteSite(ctx context.Context, in *SiteID, opts ...grpc.CallOption) (*DeleteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteResponse)
@@ -185,7 +239,7 @@ func (c *dataClient) GetSite(ctx context.Context, in *GetSite, opts ...grpc.Call
 
 func (c *dataClient) ListSites(ctx context.Context, in *ListSites, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedSite], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[1], Data_ListSites_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[3], Data_ListSites_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +266,35 @@ func (c *dataClient) PurgeSites(ctx context.Context, in *SelectSites, opts ...gr
 	return out, nil
 }
 
+func (c *dataClient) ListDeletedDeployments(ctx context.Context, in *GetSite, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeletedDeployment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[4], Data_ListDeletedDeployments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetSite, DeletedDeployment]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ListDeletedDeploymentsClient = grpc.ServerStreamingClient[DeletedDeployment]
+
+func (c *dataClient) AcknowledgeDeletedDeployments(ctx context.Context, in *AcknowledgeDeletedDeployments, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, Data_AcknowledgeDeletedDeployments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) CreateDeployment(ctx context.Context, in *CreateDeployment, opts ...grpc.CallOption) (*CreateDeploymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateDeploymentResponse)
@@ -242,9 +325,28 @@ func (c *dataClient) GetDeployment(ctx context.Context, in *GetDeployment, opts
 	return out, nil
 }
 
+func (c *dataClient) GetDeploymentChunked(ctx context.Context, in *GetDeployment, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DeploymentChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[5], Data_GetDeploymentChunked_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetDeployment, DeploymentChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_GetDeploymentChunkedClient = grpc.ServerStreamingClient[DeploymentChunk]
+
 func (c *dataClient) ListDeployments(ctx context.Context, in *ListDeployments, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedDeployment], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[2], Data_ListDeployments_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[6], Data_ListDeployments_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +393,19 @@ func (c *dataClient) EndDeploymentModification(ctx context.Context, in *EndDeplo
 	return out, nil
 }
 
+func (c *dataClient) EndDeploymentModificationChunked(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[EndDeploymentModificationChunk, EndDeploymentModificationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[7], Data_EndDeploymentModificationChunked_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EndDeploymentModificationChunk, EndDeploymentModificationResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_EndDeploymentModificationChunkedClient = grpc.ClientStreamingClient[EndDeploymentModificationChunk, EndDeploymentModificationResponse]
+
 func (c *dataClient) CancelDeploymentModification(ctx context.Context, in *CancelDeploymentModification, opts ...grpc.CallOption) (*CancelDeploymentModificationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelDeploymentModificationResponse)
@@ -301,6 +416,16 @@ func (c *dataClient) CancelDeploymentModification(ctx context.Context, in *Cance
 	return out, nil
 }
 
+func (c *dataClient) ModifyDeployments(ctx context.Context, in *ModifyDeployments, opts ...grpc.CallOption) (*ModifyDeploymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModifyDeploymentsResponse)
+	err := c.cc.Invoke(ctx, Data_ModifyDeployments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) RegisterPlugin(ctx context.Context, in *Plugin, opts ...grpc.CallOption) (*RegisterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterResponse)
@@ -333,7 +458,7 @@ func (c *dataClient) GetPlugin(ctx context.Context, in *PluginID, opts ...grpc.C
 
 func (c *dataClient) ListPlugins(ctx context.Context, in *ListPlugins, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Plugin], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[3], Data_ListPlugins_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[8], Data_ListPlugins_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -360,34 +485,157 @@ func (c *dataClient) PurgePlugins(ctx context.Context, in *SelectPlugins, opts .
 	return out, nil
 }
 
+func (c *dataClient) ListRevisions(ctx context.Context, in *ListRevisions, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListedRevision], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[9], Data_ListRevisions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListRevisions, ListedRevision]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ListRevisionsClient = grpc.ServerStreamingClient[ListedRevision]
+
+func (c *dataClient) GetRevision(ctx context.Context, in *GetRevision, opts ...grpc.CallOption) (*Revision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Revision)
+	err := c.cc.Invoke(ctx, Data_GetRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) RevertTo(ctx context.Context, in *RevisionID, opts ...grpc.CallOption) (*RevertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertResponse)
+	err := c.cc.Invoke(ctx, Data_RevertTo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListAuditEvents(ctx context.Context, in *ListAuditEvents, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[10], Data_ListAuditEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListAuditEvents, AuditEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ListAuditEventsClient = grpc.ServerStreamingClient[AuditEvent]
+
+func (c *dataClient) ExportArchive(ctx context.Context, in *ExportArchive, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[11], Data_ExportArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportArchive, ArchiveEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ExportArchiveClient = grpc.ServerStreamingClient[ArchiveEntry]
+
+func (c *dataClient) ImportArchive(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArchive, ImportArchiveResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[12], Data_ImportArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportArchive, ImportArchiveResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ImportArchiveClient = grpc.ClientStreamingClient[ImportArchive, ImportArchiveResponse]
+
+func (c *dataClient) Watch(ctx context.Context, in *Watch, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[13], Data_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Watch, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_WatchClient = grpc.ServerStreamingClient[Event]
+
 // DataServer is the server API for Data service.
 // All implementations must embed UnimplementedDataServer
 // for forward compatibility.
 type DataServer interface {
 	About(context.Context, *emptypb.Empty) (*AboutResponse, error)
 	RegisterTemplate(context.Context, *Template) (*RegisterResponse, error)
+	RegisterTemplateChunked(grpc.ClientStreamingServer[TemplateChunk, RegisterResponse]) error
 	DeleteTemplate(context.Context, *TemplateID) (*DeleteResponse, error)
 	GetTemplate(context.Context, *GetTemplate) (*Template, error)
 	ListTemplates(*ListTemplates, grpc.ServerStreamingServer[ListedTemplate]) error
 	PurgeTemplates(context.Context, *SelectTemplates) (*DeleteResponse, error)
 	RegisterSite(context.Context, *Site) (*RegisterResponse, error)
+	RegisterSiteChunked(grpc.ClientStreamingServer[SiteChunk, RegisterResponse]) error
 	DeleteSite(context.Context, *SiteID) (*DeleteResponse, error)
 	GetSite(context.Context, *GetSite) (*Site, error)
 	ListSites(*ListSites, grpc.ServerStreamingServer[ListedSite]) error
 	PurgeSites(context.Context, *SelectSites) (*DeleteResponse, error)
+	ListDeletedDeployments(*GetSite, grpc.ServerStreamingServer[DeletedDeployment]) error
+	AcknowledgeDeletedDeployments(context.Context, *AcknowledgeDeletedDeployments) (*DeleteResponse, error)
 	CreateDeployment(context.Context, *CreateDeployment) (*CreateDeploymentResponse, error)
 	DeleteDeployment(context.Context, *DeploymentID) (*DeleteResponse, error)
 	GetDeployment(context.Context, *GetDeployment) (*Deployment, error)
+	GetDeploymentChunked(*GetDeployment, grpc.ServerStreamingServer[DeploymentChunk]) error
 	ListDeployments(*ListDeployments, grpc.ServerStreamingServer[ListedDeployment]) error
 	PurgeDeployments(context.Context, *SelectDeployments) (*DeleteResponse, error)
 	StartDeploymentModification(context.Context, *StartDeploymentModification) (*StartDeploymentModificationResponse, error)
 	EndDeploymentModification(context.Context, *EndDeploymentModification) (*EndDeploymentModificationResponse, error)
+	EndDeploymentModificationChunked(grpc.ClientStreamingServer[EndDeploymentModificationChunk, EndDeploymentModificationResponse]) error
 	CancelDeploymentModification(context.Context, *CancelDeploymentModification) (*CancelDeploymentModificationResponse, error)
+	ModifyDeployments(context.Context, *ModifyDeployments) (*ModifyDeploymentsResponse, error)
 	RegisterPlugin(context.Context, *Plugin) (*RegisterResponse, error)
 	DeletePlugin(context.Context, *PluginID) (*DeleteResponse, error)
 	GetPlugin(context.Context, *PluginID) (*Plugin, error)
 	ListPlugins(*ListPlugins, grpc.ServerStreamingServer[Plugin]) error
 	PurgePlugins(context.Context, *SelectPlugins) (*DeleteResponse, error)
+	ListRevisions(*ListRevisions, grpc.ServerStreamingServer[ListedRevision]) error
+	GetRevision(context.Context, *GetRevision) (*Revision, error)
+	RevertTo(context.Context, *RevisionID) (*RevertResponse, error)
+	ListAuditEvents(*ListAuditEvents, grpc.ServerStreamingServer[AuditEvent]) error
+	ExportArchive(*ExportArchive, grpc.ServerStreamingServer[ArchiveEntry]) error
+	ImportArchive(grpc.ClientStreamingServer[ImportArchive, ImportArchiveResponse]) error
+	Watch(*Watch, grpc.ServerStreamingServer[Event]) error
 	mustEmbedUnimplementedDataServer()
 }
 
@@ -404,6 +652,9 @@ func (UnimplementedDataServer) About(context.Context, *emptypb.Empty) (*AboutRes
 func (UnimplementedDataServer) RegisterTemplate(context.Context, *Template) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterTemplate not implemented")
 }
+func (UnimplementedDataServer) RegisterTemplateChunked(grpc.ClientStreamingServer[TemplateChunk, RegisterResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterTemplateChunked not implemented")
+}
 func (UnimplementedDataServer) DeleteTemplate(context.Context, *TemplateID) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
 }
@@ -419,6 +670,9 @@ func (UnimplementedDataServer) PurgeTemplates(context.Context, *SelectTemplates)
 func (UnimplementedDataServer) RegisterSite(context.Context, *Site) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterSite not implemented")
 }
+func (UnimplementedDataServer) RegisterSiteChunked(grpc.ClientStreamingServer[SiteChunk, RegisterResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterSiteChunked not implemented")
+}
 func (UnimplementedDataServer) DeleteSite(context.Context, *SiteID) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSite not implemented")
 }
@@ -431,6 +685,12 @@ func (UnimplementedDataServer) ListSites(*ListSites, grpc.ServerStreamingServer[
 func (UnimplementedDataServer) PurgeSites(context.Context, *SelectSites) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeSites not implemented")
 }
+func (UnimplementedDataServer) ListDeletedDeployments(*GetSite, grpc.ServerStreamingServer[DeletedDeployment]) error {
+	return status.Errorf(codes.Unimplemented, "method ListDeletedDeployments not implemented")
+}
+func (UnimplementedDataServer) AcknowledgeDeletedDeployments(context.Context, *AcknowledgeDeletedDeployments) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeDeletedDeployments not implemented")
+}
 func (UnimplementedDataServer) CreateDeployment(context.Context, *CreateDeployment) (*CreateDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeployment not implemented")
 }
@@ -440,6 +700,9 @@ func (UnimplementedDataServer) DeleteDeployment(context.Context, *DeploymentID)
 func (UnimplementedDataServer) GetDeployment(context.Context, *GetDeployment) (*Deployment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeployment not implemented")
 }
+func (UnimplementedDataServer) GetDeploymentChunked(*GetDeployment, grpc.ServerStreamingServer[DeploymentChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method GetDeploymentChunked not implemented")
+}
 func (UnimplementedDataServer) ListDeployments(*ListDeployments, grpc.ServerStreamingServer[ListedDeployment]) error {
 	return status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
 }
@@ -452,9 +715,15 @@ func (UnimplementedDataServer) StartDeploymentModification(context.Context, *Sta
 func (UnimplementedDataServer) EndDeploymentModification(context.Context, *EndDeploymentModification) (*EndDeploymentModificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndDeploymentModification not implemented")
 }
+func (UnimplementedDataServer) EndDeploymentModificationChunked(grpc.ClientStreamingServer[EndDeploymentModificationChunk, EndDeploymentModificationResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method EndDeploymentModificationChunked not implemented")
+}
 func (UnimplementedDataServer) CancelDeploymentModification(context.Context, *CancelDeploymentModification) (*CancelDeploymentModificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelDeploymentModification not implemented")
 }
+func (UnimplementedDataServer) ModifyDeployments(context.Context, *ModifyDeployments) (*ModifyDeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyDeployments not implemented")
+}
 func (UnimplementedDataServer) RegisterPlugin(context.Context, *Plugin) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPlugin not implemented")
 }
@@ -470,6 +739,27 @@ func (UnimplementedDataServer) ListPlugins(*ListPlugins, grpc.ServerStreamingSer
 func (UnimplementedDataServer) PurgePlugins(context.Context, *SelectPlugins) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgePlugins not implemented")
 }
+func (UnimplementedDataServer) ListRevisions(*ListRevisions, grpc.ServerStreamingServer[ListedRevision]) error {
+	return status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedDataServer) GetRevision(context.Context, *GetRevision) (*Revision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedDataServer) RevertTo(context.Context, *RevisionID) (*RevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTo not implemented")
+}
+func (UnimplementedDataServer) ListAuditEvents(*ListAuditEvents, grpc.ServerStreamingServer[AuditEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedDataServer) ExportArchive(*ExportArchive, grpc.ServerStreamingServer[ArchiveEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportArchive not implemented")
+}
+func (UnimplementedDataServer) ImportArchive(grpc.ClientStreamingServer[ImportArchive, ImportArchiveResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportArchive not implemented")
+}
+func (UnimplementedDataServer) Watch(*Watch, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedDataServer) mustEmbedUnimplementedDataServer() {}
 func (UnimplementedDataServer) testEmbeddedByValue()              {}
 
@@ -527,6 +817,13 @@ func _Data_RegisterTemplate_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_RegisterTemplateChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServer).RegisterTemplateChunked(&grpc.GenericServerStream[TemplateChunk, RegisterResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_RegisterTemplateChunkedServer = grpc.ClientStreamingServer[TemplateChunk, RegisterResponse]
+
 func _Data_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TemplateID)
 	if err := dec(in); err != nil {
@@ -610,6 +907,13 @@ func _Data_RegisterSite_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_RegisterSiteChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServer).RegisterSiteChunked(&grpc.GenericServerStream[SiteChunk, RegisterResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_RegisterSiteChunkedServer = grpc.ClientStreamingServer[SiteChunk, RegisterResponse]
+
 func _Data_DeleteSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SiteID)
 	if err := dec(in); err != nil {
@@ -675,6 +979,35 @@ func _Data_PurgeSites_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ListDeletedDeployments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSite)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).ListDeletedDeployments(m, &grpc.GenericServerStream[GetSite, DeletedDeployment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ListDeletedDeploymentsServer = grpc.ServerStreamingServer[DeletedDeployment]
+
+func _Data_AcknowledgeDeletedDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeDeletedDeployments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).AcknowledgeDeletedDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_AcknowledgeDeletedDeployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).AcknowledgeDeletedDeployments(ctx, req.(*AcknowledgeDeletedDeployments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_CreateDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateDeployment)
 	if err := dec(in); err != nil {
@@ -729,6 +1062,17 @@ func _Data_GetDeployment_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_GetDeploymentChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDeployment)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).GetDeploymentChunked(m, &grpc.GenericServerStream[GetDeployment, DeploymentChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_GetDeploymentChunkedServer = grpc.ServerStreamingServer[DeploymentChunk]
+
 func _Data_ListDeployments_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ListDeployments)
 	if err := stream.RecvMsg(m); err != nil {
@@ -794,6 +1138,13 @@ func _Data_EndDeploymentModification_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_EndDeploymentModificationChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServer).EndDeploymentModificationChunked(&grpc.GenericServerStream[EndDeploymentModificationChunk, EndDeploymentModificationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_EndDeploymentModificationChunkedServer = grpc.ClientStreamingServer[EndDeploymentModificationChunk, EndDeploymentModificationResponse]
+
 func _Data_CancelDeploymentModification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelDeploymentModification)
 	if err := dec(in); err != nil {
@@ -812,6 +1163,24 @@ func _Data_CancelDeploymentModification_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ModifyDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyDeployments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ModifyDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ModifyDeployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ModifyDeployments(ctx, req.(*ModifyDeployments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_RegisterPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Plugin)
 	if err := dec(in); err != nil {
@@ -895,6 +1264,93 @@ func _Data_PurgePlugins_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ListRevisions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRevisions)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).ListRevisions(m, &grpc.GenericServerStream[ListRevisions, ListedRevision]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ListRevisionsServer = grpc.ServerStreamingServer[ListedRevision]
+
+func _Data_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevision)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetRevision(ctx, req.(*GetRevision))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_RevertTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RevertTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_RevertTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RevertTo(ctx, req.(*RevisionID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListAuditEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuditEvents)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).ListAuditEvents(m, &grpc.GenericServerStream[ListAuditEvents, AuditEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ListAuditEventsServer = grpc.ServerStreamingServer[AuditEvent]
+
+func _Data_ExportArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportArchive)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).ExportArchive(m, &grpc.GenericServerStream[ExportArchive, ArchiveEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ExportArchiveServer = grpc.ServerStreamingServer[ArchiveEntry]
+
+func _Data_ImportArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServer).ImportArchive(&grpc.GenericServerStream[ImportArchive, ImportArchiveResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ImportArchiveServer = grpc.ClientStreamingServer[ImportArchive, ImportArchiveResponse]
+
+func _Data_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Watch)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).Watch(m, &grpc.GenericServerStream[Watch, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_WatchServer = grpc.ServerStreamingServer[Event]
+
 // Data_ServiceDesc is the grpc.ServiceDesc for Data service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -938,6 +1394,10 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "purgeSites",
 			Handler:    _Data_PurgeSites_Handler,
 		},
+		{
+			MethodName: "acknowledgeDeletedDeployments",
+			Handler:    _Data_AcknowledgeDeletedDeployments_Handler,
+		},
 		{
 			MethodName: "createDeployment",
 			Handler:    _Data_CreateDeployment_Handler,
@@ -966,6 +1426,10 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "cancelDeploymentModification",
 			Handler:    _Data_CancelDeploymentModification_Handler,
 		},
+		{
+			MethodName: "modifyDeployments",
+			Handler:    _Data_ModifyDeployments_Handler,
+		},
 		{
 			MethodName: "registerPlugin",
 			Handler:    _Data_RegisterPlugin_Handler,
@@ -982,28 +1446,86 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "purgePlugins",
 			Handler:    _Data_PurgePlugins_Handler,
 		},
+		{
+			MethodName: "getRevision",
+			Handler:    _Data_GetRevision_Handler,
+		},
+		{
+			MethodName: "revertTo",
+			Handler:    _Data_RevertTo_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "registerTemplateChunked",
+			Handler:       _Data_RegisterTemplateChunked_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "listTemplates",
 			Handler:       _Data_ListTemplates_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "registerSiteChunked",
+			Handler:       _Data_RegisterSiteChunked_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "listSites",
 			Handler:       _Data_ListSites_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "listDeletedDeployments",
+			Handler:       _Data_ListDeletedDeployments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "getDeploymentChunked",
+			Handler:       _Data_GetDeploymentChunked_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "listDeployments",
 			Handler:       _Data_ListDeployments_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "endDeploymentModificationChunked",
+			Handler:       _Data_EndDeploymentModificationChunked_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "listPlugins",
 			Handler:       _Data_ListPlugins_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "listRevisions",
+			Handler:       _Data_ListRevisions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "listAuditEvents",
+			Handler:       _Data_ListAuditEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "exportArchive",
+			Handler:       _Data_ExportArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "importArchive",
+			Handler:       _Data_ImportArchive_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "watch",
+			Handler:       _Data_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "tko.proto",
 }
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// Adds the authenticated identity to the request context.
+func (self *Server) authenticate(handler http.Handler) http.Handler {
+	if self.Authenticator == nil {
+		return handler
+	}
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if identity, ok := self.Authenticator.Authenticate(request.TLS, request.Header.Get("Authorization")); ok {
+			handler.ServeHTTP(writer, request.WithContext(backend.ContextWithIdentity(request.Context(), identity)))
+		} else {
+			writer.Header().Set("WWW-Authenticate", "Bearer")
+			writer.WriteHeader(401)
+		}
+	})
+}
@@ -16,7 +16,11 @@ func (self *Server) timestamp(timestamp time.Time) int64 {
 }
 
 func (self *Server) error(writer http.ResponseWriter, err error) {
-	writer.WriteHeader(500)
+	if backend.IsNotAuthorizedError(err) {
+		writer.WriteHeader(403)
+	} else {
+		writer.WriteHeader(500)
+	}
 	if self.Debug {
 		writer.Write(util.StringToBytes(err.Error()))
 	}
@@ -10,10 +10,11 @@ import (
 
 func (self *Server) ListDeployments(writer http.ResponseWriter, request *http.Request) {
 	// TODO: paging
-	if deploymentInfoResults, err := self.Backend.ListDeployments(request.Context(), backend.SelectDeployments{}, getWindow(request)); err == nil {
+	if deploymentInfoResults, err := self.Backend.ListDeployments(request.Context(), backend.SelectDeployments{Namespace: backend.AllNamespaces}, getWindow(request)); err == nil {
 		var deployments []ard.StringMap
 		if err := util.IterateResults(deploymentInfoResults, func(deploymentInfo backend.DeploymentInfo) error {
 			deployments = append(deployments, ard.StringMap{
+				"namespace":        deploymentInfo.Namespace,
 				"id":               deploymentInfo.DeploymentID,
 				"template":         deploymentInfo.TemplateID,
 				"parent":           deploymentInfo.ParentDeploymentID,
@@ -37,8 +38,10 @@ func (self *Server) ListDeployments(writer http.ResponseWriter, request *http.Re
 }
 
 func (self *Server) GetDeployment(writer http.ResponseWriter, request *http.Request) {
-	id := request.URL.Query().Get("id")
-	if deploymentInfo, err := self.Backend.GetDeployment(request.Context(), id); err == nil {
+	query := request.URL.Query()
+	namespace := query.Get("namespace")
+	id := query.Get("id")
+	if deploymentInfo, err := self.Backend.GetDeployment(request.Context(), namespace, id); err == nil {
 		self.writePackage(writer, deploymentInfo.Package)
 	} else {
 		self.error(writer, err)
@@ -2,12 +2,16 @@ package server
 
 import (
 	contextpkg "context"
+	"crypto/tls"
 	"net"
 	"net/http"
 	"time"
 
+	"github.com/nephio-experimental/tko/api/authentication"
 	"github.com/nephio-experimental/tko/assets/web"
 	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/health"
+	"github.com/nephio-experimental/tko/metrics"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
 )
@@ -24,6 +28,9 @@ type Server struct {
 	IPStack             util.IPStack
 	Address             string
 	Port                int
+	TLS                 *tls.Config                   // if nil will not use TLS
+	Authenticator       *authentication.Authenticator // if nil will not authenticate
+	Health              *health.Health                // if nil will not serve "/healthz" and "/readyz"
 	Log                 commonlog.Logger
 	Debug               bool
 
@@ -44,16 +51,18 @@ func NewServer(backend backend.Backend, timeout time.Duration, ipStack util.IPSt
 		mux:     http.NewServeMux(),
 	}
 
-	self.mux.Handle("/", http.FileServer(http.FS(web.FS)))
+	self.handle("/", http.FileServer(http.FS(web.FS)))
 
-	self.mux.HandleFunc("/api/about", self.About)
-	self.mux.HandleFunc("/api/deployment/list", self.ListDeployments)
-	self.mux.HandleFunc("/api/deployment", self.GetDeployment)
-	self.mux.HandleFunc("/api/site/list", self.ListSites)
-	self.mux.HandleFunc("/api/site", self.GetSite)
-	self.mux.HandleFunc("/api/template/list", self.ListTemplates)
-	self.mux.HandleFunc("/api/template", self.GetTemplate)
-	self.mux.HandleFunc("/api/plugin/list", self.ListPlugins)
+	self.handleFunc("/api/about", self.About)
+	self.handleFunc("/api/deployment/list", self.ListDeployments)
+	self.handleFunc("/api/deployment", self.GetDeployment)
+	self.handleFunc("/api/site/list", self.ListSites)
+	self.handleFunc("/api/site", self.GetSite)
+	self.handleFunc("/api/template/list", self.ListTemplates)
+	self.handleFunc("/api/template", self.GetTemplate)
+	self.handleFunc("/api/plugin/list", self.ListPlugins)
+
+	self.mux.Handle("/metrics", metrics.Handler())
 
 	return &self, nil
 }
@@ -75,6 +84,27 @@ func (self *Server) Stop() {
 	}
 }
 
+func (self *Server) handle(pattern string, handler http.Handler) {
+	self.mux.Handle(pattern, metrics.HTTPHandler(pattern, handler))
+}
+
+func (self *Server) handleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	self.handle(pattern, http.HandlerFunc(handler))
+}
+
+// Health checks are not authenticated, because probes cannot provide credentials.
+func (self *Server) handler() http.Handler {
+	handler := self.authenticate(self.mux)
+	if self.Health == nil {
+		return handler
+	}
+
+	mux := http.NewServeMux()
+	self.Health.Handle(mux)
+	mux.Handle("/", handler)
+	return mux
+}
+
 // ([util.IPStackStartServerFunc] signature)
 func (self *Server) start(level2protocol string, address string) error {
 	addressPort := util.JoinIPAddressPort(address, self.Port)
@@ -86,13 +116,22 @@ func (self *Server) start(level2protocol string, address string) error {
 			"addressPort", listener.Addr().String())
 
 		httpServer := http.Server{
-			Handler: http.TimeoutHandler(self.mux, self.Timeout, ""),
+			Handler:   http.TimeoutHandler(self.handler(), self.Timeout, ""),
+			TLSConfig: self.TLS,
 		}
 		self.httpServers = append(self.httpServers, &httpServer)
 		self.clientAddressPorts = append(self.clientAddressPorts, util.IPAddressPortWithoutZone(addressPort))
 
 		go func() {
-			if err := httpServer.Serve(listener); err != nil {
+			var err error
+			if self.TLS != nil {
+				// Certificates are already in TLSConfig
+				err = httpServer.ServeTLS(listener, "", "")
+			} else {
+				err = httpServer.Serve(listener)
+			}
+
+			if err != nil {
 				if err == http.ErrServerClosed {
 					self.Log.Notice("stopped HTTP server",
 						"index", index)
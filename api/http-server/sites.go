@@ -11,11 +11,12 @@ import (
 
 func (self *Server) ListSites(writer http.ResponseWriter, request *http.Request) {
 	// TODO: paging
-	if siteInfoResults, err := self.Backend.ListSites(request.Context(), backend.SelectSites{}, getWindow(request)); err == nil {
+	if siteInfoResults, err := self.Backend.ListSites(request.Context(), backend.SelectSites{Namespace: backend.AllNamespaces}, getWindow(request)); err == nil {
 		var sites []ard.StringMap
 		if err := util.IterateResults(siteInfoResults, func(siteInfo backend.SiteInfo) error {
 			slices.Sort(siteInfo.DeploymentIDs)
 			sites = append(sites, ard.StringMap{
+				"namespace":        siteInfo.Namespace,
 				"id":               siteInfo.SiteID,
 				"template":         siteInfo.TemplateID,
 				"deployments":      siteInfo.DeploymentIDs,
@@ -35,8 +36,10 @@ func (self *Server) ListSites(writer http.ResponseWriter, request *http.Request)
 }
 
 func (self *Server) GetSite(writer http.ResponseWriter, request *http.Request) {
-	id := request.URL.Query().Get("id")
-	if site, err := self.Backend.GetSite(request.Context(), id); err == nil {
+	query := request.URL.Query()
+	namespace := query.Get("namespace")
+	id := query.Get("id")
+	if site, err := self.Backend.GetSite(request.Context(), namespace, id); err == nil {
 		self.writePackage(writer, site.Package)
 	} else {
 		self.error(writer, err)
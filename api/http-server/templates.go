@@ -11,11 +11,12 @@ import (
 
 func (self *Server) ListTemplates(writer http.ResponseWriter, request *http.Request) {
 	// TODO: paging
-	if templateInfoResults, err := self.Backend.ListTemplates(request.Context(), backend.SelectTemplates{}, getWindow(request)); err == nil {
+	if templateInfoResults, err := self.Backend.ListTemplates(request.Context(), backend.SelectTemplates{Namespace: backend.AllNamespaces}, getWindow(request)); err == nil {
 		var templates []ard.StringMap
 		if err := util.IterateResults(templateInfoResults, func(templateInfo backend.TemplateInfo) error {
 			slices.Sort(templateInfo.DeploymentIDs)
 			templates = append(templates, ard.StringMap{
+				"namespace":        templateInfo.Namespace,
 				"id":               templateInfo.TemplateID,
 				"deployments":      templateInfo.DeploymentIDs,
 				"metadata":         templateInfo.Metadata,
@@ -29,13 +30,15 @@ func (self *Server) ListTemplates(writer http.ResponseWriter, request *http.Requ
 
 		self.writeJson(writer, templates)
 	} else {
-		writer.WriteHeader(500)
+		self.error(writer, err)
 	}
 }
 
 func (self *Server) GetTemplate(writer http.ResponseWriter, request *http.Request) {
-	id := request.URL.Query().Get("id")
-	if template, err := self.Backend.GetTemplate(request.Context(), id); err == nil {
+	query := request.URL.Query()
+	namespace := query.Get("namespace")
+	id := query.Get("id")
+	if template, err := self.Backend.GetTemplate(request.Context(), namespace, id); err == nil {
 		self.writePackage(writer, template.Package)
 	} else {
 		self.error(writer, err)
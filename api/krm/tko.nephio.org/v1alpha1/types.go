@@ -20,7 +20,6 @@ import (
 // Note that the template metadata is represented as ObjectMeta labels.
 //
 // +genclient
-// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type Template struct {
 	meta.TypeMeta   `json:",inline"`
@@ -66,7 +65,6 @@ type TemplateList struct {
 // Note that the site metadata is represented as ObjectMeta labels.
 //
 // +genclient
-// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type Site struct {
 	meta.TypeMeta   `json:",inline"`
@@ -176,6 +174,7 @@ type DeploymentList struct {
 // TKO plugin.
 //
 // +genclient
+// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type Plugin struct {
 	meta.TypeMeta   `json:",inline"`
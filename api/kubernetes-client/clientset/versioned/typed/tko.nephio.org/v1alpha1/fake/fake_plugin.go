@@ -16,7 +16,6 @@ import (
 // FakePlugins implements PluginInterface
 type FakePlugins struct {
 	Fake *FakeTkoV1alpha1
-	ns   string
 }
 
 var pluginsResource = v1alpha1.SchemeGroupVersion.WithResource("plugins")
@@ -27,8 +26,7 @@ var pluginsKind = v1alpha1.SchemeGroupVersion.WithKind("Plugin")
 func (c *FakePlugins) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Plugin, err error) {
 	emptyResult := &v1alpha1.Plugin{}
 	obj, err := c.Fake.
-		Invokes(testing.NewGetActionWithOptions(pluginsResource, c.ns, name, options), emptyResult)
-
+		Invokes(testing.NewRootGetActionWithOptions(pluginsResource, name, options), emptyResult)
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -39,8 +37,7 @@ func (c *FakePlugins) Get(ctx context.Context, name string, options v1.GetOption
 func (c *FakePlugins) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PluginList, err error) {
 	emptyResult := &v1alpha1.PluginList{}
 	obj, err := c.Fake.
-		Invokes(testing.NewListActionWithOptions(pluginsResource, pluginsKind, c.ns, opts), emptyResult)
-
+		Invokes(testing.NewRootListActionWithOptions(pluginsResource, pluginsKind, opts), emptyResult)
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -61,16 +58,14 @@ func (c *FakePlugins) List(ctx context.Context, opts v1.ListOptions) (result *v1
 // Watch returns a watch.Interface that watches the requested plugins.
 func (c *FakePlugins) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return c.Fake.
-		InvokesWatch(testing.NewWatchActionWithOptions(pluginsResource, c.ns, opts))
-
+		InvokesWatch(testing.NewRootWatchActionWithOptions(pluginsResource, opts))
 }
 
 // Create takes the representation of a plugin and creates it.  Returns the server's representation of the plugin, and an error, if there is any.
 func (c *FakePlugins) Create(ctx context.Context, plugin *v1alpha1.Plugin, opts v1.CreateOptions) (result *v1alpha1.Plugin, err error) {
 	emptyResult := &v1alpha1.Plugin{}
 	obj, err := c.Fake.
-		Invokes(testing.NewCreateActionWithOptions(pluginsResource, c.ns, plugin, opts), emptyResult)
-
+		Invokes(testing.NewRootCreateActionWithOptions(pluginsResource, plugin, opts), emptyResult)
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -81,8 +76,7 @@ func (c *FakePlugins) Create(ctx context.Context, plugin *v1alpha1.Plugin, opts
 func (c *FakePlugins) Update(ctx context.Context, plugin *v1alpha1.Plugin, opts v1.UpdateOptions) (result *v1alpha1.Plugin, err error) {
 	emptyResult := &v1alpha1.Plugin{}
 	obj, err := c.Fake.
-		Invokes(testing.NewUpdateActionWithOptions(pluginsResource, c.ns, plugin, opts), emptyResult)
-
+		Invokes(testing.NewRootUpdateActionWithOptions(pluginsResource, plugin, opts), emptyResult)
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -94,8 +88,7 @@ func (c *FakePlugins) Update(ctx context.Context, plugin *v1alpha1.Plugin, opts
 func (c *FakePlugins) UpdateStatus(ctx context.Context, plugin *v1alpha1.Plugin, opts v1.UpdateOptions) (result *v1alpha1.Plugin, err error) {
 	emptyResult := &v1alpha1.Plugin{}
 	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceActionWithOptions(pluginsResource, "status", c.ns, plugin, opts), emptyResult)
-
+		Invokes(testing.NewRootUpdateSubresourceActionWithOptions(pluginsResource, "status", plugin, opts), emptyResult)
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -105,14 +98,13 @@ func (c *FakePlugins) UpdateStatus(ctx context.Context, plugin *v1alpha1.Plugin,
 // Delete takes name of the plugin and deletes it. Returns an error if one occurs.
 func (c *FakePlugins) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteActionWithOptions(pluginsResource, c.ns, name, opts), &v1alpha1.Plugin{})
-
+		Invokes(testing.NewRootDeleteActionWithOptions(pluginsResource, name, opts), &v1alpha1.Plugin{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
 func (c *FakePlugins) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	action := testing.NewDeleteCollectionActionWithOptions(pluginsResource, c.ns, opts, listOpts)
+	action := testing.NewRootDeleteCollectionActionWithOptions(pluginsResource, opts, listOpts)
 
 	_, err := c.Fake.Invokes(action, &v1alpha1.PluginList{})
 	return err
@@ -122,8 +114,7 @@ func (c *FakePlugins) DeleteCollection(ctx context.Context, opts v1.DeleteOption
 func (c *FakePlugins) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Plugin, err error) {
 	emptyResult := &v1alpha1.Plugin{}
 	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceActionWithOptions(pluginsResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
-
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(pluginsResource, name, pt, data, opts, subresources...), emptyResult)
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -16,6 +16,7 @@ import (
 // FakeSites implements SiteInterface
 type FakeSites struct {
 	Fake *FakeTkoV1alpha1
+	ns   string
 }
 
 var sitesResource = v1alpha1.SchemeGroupVersion.WithResource("sites")
@@ -26,7 +27,8 @@ var sitesKind = v1alpha1.SchemeGroupVersion.WithKind("Site")
 func (c *FakeSites) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Site, err error) {
 	emptyResult := &v1alpha1.Site{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootGetActionWithOptions(sitesResource, name, options), emptyResult)
+		Invokes(testing.NewGetActionWithOptions(sitesResource, c.ns, name, options), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -37,7 +39,8 @@ func (c *FakeSites) Get(ctx context.Context, name string, options v1.GetOptions)
 func (c *FakeSites) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.SiteList, err error) {
 	emptyResult := &v1alpha1.SiteList{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootListActionWithOptions(sitesResource, sitesKind, opts), emptyResult)
+		Invokes(testing.NewListActionWithOptions(sitesResource, sitesKind, c.ns, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -58,14 +61,16 @@ func (c *FakeSites) List(ctx context.Context, opts v1.ListOptions) (result *v1al
 // Watch returns a watch.Interface that watches the requested sites.
 func (c *FakeSites) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return c.Fake.
-		InvokesWatch(testing.NewRootWatchActionWithOptions(sitesResource, opts))
+		InvokesWatch(testing.NewWatchActionWithOptions(sitesResource, c.ns, opts))
+
 }
 
 // Create takes the representation of a site and creates it.  Returns the server's representation of the site, and an error, if there is any.
 func (c *FakeSites) Create(ctx context.Context, site *v1alpha1.Site, opts v1.CreateOptions) (result *v1alpha1.Site, err error) {
 	emptyResult := &v1alpha1.Site{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateActionWithOptions(sitesResource, site, opts), emptyResult)
+		Invokes(testing.NewCreateActionWithOptions(sitesResource, c.ns, site, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -76,7 +81,8 @@ func (c *FakeSites) Create(ctx context.Context, site *v1alpha1.Site, opts v1.Cre
 func (c *FakeSites) Update(ctx context.Context, site *v1alpha1.Site, opts v1.UpdateOptions) (result *v1alpha1.Site, err error) {
 	emptyResult := &v1alpha1.Site{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateActionWithOptions(sitesResource, site, opts), emptyResult)
+		Invokes(testing.NewUpdateActionWithOptions(sitesResource, c.ns, site, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -88,7 +94,8 @@ func (c *FakeSites) Update(ctx context.Context, site *v1alpha1.Site, opts v1.Upd
 func (c *FakeSites) UpdateStatus(ctx context.Context, site *v1alpha1.Site, opts v1.UpdateOptions) (result *v1alpha1.Site, err error) {
 	emptyResult := &v1alpha1.Site{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateSubresourceActionWithOptions(sitesResource, "status", site, opts), emptyResult)
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(sitesResource, "status", c.ns, site, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -98,13 +105,14 @@ func (c *FakeSites) UpdateStatus(ctx context.Context, site *v1alpha1.Site, opts
 // Delete takes name of the site and deletes it. Returns an error if one occurs.
 func (c *FakeSites) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteActionWithOptions(sitesResource, name, opts), &v1alpha1.Site{})
+		Invokes(testing.NewDeleteActionWithOptions(sitesResource, c.ns, name, opts), &v1alpha1.Site{})
+
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
 func (c *FakeSites) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	action := testing.NewRootDeleteCollectionActionWithOptions(sitesResource, opts, listOpts)
+	action := testing.NewDeleteCollectionActionWithOptions(sitesResource, c.ns, opts, listOpts)
 
 	_, err := c.Fake.Invokes(action, &v1alpha1.SiteList{})
 	return err
@@ -114,7 +122,8 @@ func (c *FakeSites) DeleteCollection(ctx context.Context, opts v1.DeleteOptions,
 func (c *FakeSites) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Site, err error) {
 	emptyResult := &v1alpha1.Site{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceActionWithOptions(sitesResource, name, pt, data, opts, subresources...), emptyResult)
+		Invokes(testing.NewPatchSubresourceActionWithOptions(sitesResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
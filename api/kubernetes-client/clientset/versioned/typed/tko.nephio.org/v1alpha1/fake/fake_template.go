@@ -16,6 +16,7 @@ import (
 // FakeTemplates implements TemplateInterface
 type FakeTemplates struct {
 	Fake *FakeTkoV1alpha1
+	ns   string
 }
 
 var templatesResource = v1alpha1.SchemeGroupVersion.WithResource("templates")
@@ -26,7 +27,8 @@ var templatesKind = v1alpha1.SchemeGroupVersion.WithKind("Template")
 func (c *FakeTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Template, err error) {
 	emptyResult := &v1alpha1.Template{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootGetActionWithOptions(templatesResource, name, options), emptyResult)
+		Invokes(testing.NewGetActionWithOptions(templatesResource, c.ns, name, options), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -37,7 +39,8 @@ func (c *FakeTemplates) Get(ctx context.Context, name string, options v1.GetOpti
 func (c *FakeTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TemplateList, err error) {
 	emptyResult := &v1alpha1.TemplateList{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootListActionWithOptions(templatesResource, templatesKind, opts), emptyResult)
+		Invokes(testing.NewListActionWithOptions(templatesResource, templatesKind, c.ns, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -58,14 +61,16 @@ func (c *FakeTemplates) List(ctx context.Context, opts v1.ListOptions) (result *
 // Watch returns a watch.Interface that watches the requested templates.
 func (c *FakeTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return c.Fake.
-		InvokesWatch(testing.NewRootWatchActionWithOptions(templatesResource, opts))
+		InvokesWatch(testing.NewWatchActionWithOptions(templatesResource, c.ns, opts))
+
 }
 
 // Create takes the representation of a template and creates it.  Returns the server's representation of the template, and an error, if there is any.
 func (c *FakeTemplates) Create(ctx context.Context, template *v1alpha1.Template, opts v1.CreateOptions) (result *v1alpha1.Template, err error) {
 	emptyResult := &v1alpha1.Template{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootCreateActionWithOptions(templatesResource, template, opts), emptyResult)
+		Invokes(testing.NewCreateActionWithOptions(templatesResource, c.ns, template, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -76,7 +81,8 @@ func (c *FakeTemplates) Create(ctx context.Context, template *v1alpha1.Template,
 func (c *FakeTemplates) Update(ctx context.Context, template *v1alpha1.Template, opts v1.UpdateOptions) (result *v1alpha1.Template, err error) {
 	emptyResult := &v1alpha1.Template{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateActionWithOptions(templatesResource, template, opts), emptyResult)
+		Invokes(testing.NewUpdateActionWithOptions(templatesResource, c.ns, template, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -88,7 +94,8 @@ func (c *FakeTemplates) Update(ctx context.Context, template *v1alpha1.Template,
 func (c *FakeTemplates) UpdateStatus(ctx context.Context, template *v1alpha1.Template, opts v1.UpdateOptions) (result *v1alpha1.Template, err error) {
 	emptyResult := &v1alpha1.Template{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootUpdateSubresourceActionWithOptions(templatesResource, "status", template, opts), emptyResult)
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(templatesResource, "status", c.ns, template, opts), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -98,13 +105,14 @@ func (c *FakeTemplates) UpdateStatus(ctx context.Context, template *v1alpha1.Tem
 // Delete takes name of the template and deletes it. Returns an error if one occurs.
 func (c *FakeTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewRootDeleteActionWithOptions(templatesResource, name, opts), &v1alpha1.Template{})
+		Invokes(testing.NewDeleteActionWithOptions(templatesResource, c.ns, name, opts), &v1alpha1.Template{})
+
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
 func (c *FakeTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	action := testing.NewRootDeleteCollectionActionWithOptions(templatesResource, opts, listOpts)
+	action := testing.NewDeleteCollectionActionWithOptions(templatesResource, c.ns, opts, listOpts)
 
 	_, err := c.Fake.Invokes(action, &v1alpha1.TemplateList{})
 	return err
@@ -114,7 +122,8 @@ func (c *FakeTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOpti
 func (c *FakeTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Template, err error) {
 	emptyResult := &v1alpha1.Template{}
 	obj, err := c.Fake.
-		Invokes(testing.NewRootPatchSubresourceActionWithOptions(templatesResource, name, pt, data, opts, subresources...), emptyResult)
+		Invokes(testing.NewPatchSubresourceActionWithOptions(templatesResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
 	if obj == nil {
 		return emptyResult, err
 	}
@@ -16,16 +16,16 @@ func (c *FakeTkoV1alpha1) Deployments(namespace string) v1alpha1.DeploymentInter
 	return &FakeDeployments{c, namespace}
 }
 
-func (c *FakeTkoV1alpha1) Plugins(namespace string) v1alpha1.PluginInterface {
-	return &FakePlugins{c, namespace}
+func (c *FakeTkoV1alpha1) Plugins() v1alpha1.PluginInterface {
+	return &FakePlugins{c}
 }
 
-func (c *FakeTkoV1alpha1) Sites() v1alpha1.SiteInterface {
-	return &FakeSites{c}
+func (c *FakeTkoV1alpha1) Sites(namespace string) v1alpha1.SiteInterface {
+	return &FakeSites{c, namespace}
 }
 
-func (c *FakeTkoV1alpha1) Templates() v1alpha1.TemplateInterface {
-	return &FakeTemplates{c}
+func (c *FakeTkoV1alpha1) Templates(namespace string) v1alpha1.TemplateInterface {
+	return &FakeTemplates{c, namespace}
 }
 
 // RESTClient returns a RESTClient that is used to communicate
@@ -16,7 +16,7 @@ import (
 // PluginsGetter has a method to return a PluginInterface.
 // A group's client should implement this interface.
 type PluginsGetter interface {
-	Plugins(namespace string) PluginInterface
+	Plugins() PluginInterface
 }
 
 // PluginInterface has methods to work with Plugin resources.
@@ -40,13 +40,13 @@ type plugins struct {
 }
 
 // newPlugins returns a Plugins
-func newPlugins(c *TkoV1alpha1Client, namespace string) *plugins {
+func newPlugins(c *TkoV1alpha1Client) *plugins {
 	return &plugins{
 		gentype.NewClientWithList[*v1alpha1.Plugin, *v1alpha1.PluginList](
 			"plugins",
 			c.RESTClient(),
 			scheme.ParameterCodec,
-			namespace,
+			"",
 			func() *v1alpha1.Plugin { return &v1alpha1.Plugin{} },
 			func() *v1alpha1.PluginList { return &v1alpha1.PluginList{} }),
 	}
@@ -16,7 +16,7 @@ import (
 // SitesGetter has a method to return a SiteInterface.
 // A group's client should implement this interface.
 type SitesGetter interface {
-	Sites() SiteInterface
+	Sites(namespace string) SiteInterface
 }
 
 // SiteInterface has methods to work with Site resources.
@@ -40,13 +40,13 @@ type sites struct {
 }
 
 // newSites returns a Sites
-func newSites(c *TkoV1alpha1Client) *sites {
+func newSites(c *TkoV1alpha1Client, namespace string) *sites {
 	return &sites{
 		gentype.NewClientWithList[*v1alpha1.Site, *v1alpha1.SiteList](
 			"sites",
 			c.RESTClient(),
 			scheme.ParameterCodec,
-			"",
+			namespace,
 			func() *v1alpha1.Site { return &v1alpha1.Site{} },
 			func() *v1alpha1.SiteList { return &v1alpha1.SiteList{} }),
 	}
@@ -16,7 +16,7 @@ import (
 // TemplatesGetter has a method to return a TemplateInterface.
 // A group's client should implement this interface.
 type TemplatesGetter interface {
-	Templates() TemplateInterface
+	Templates(namespace string) TemplateInterface
 }
 
 // TemplateInterface has methods to work with Template resources.
@@ -40,13 +40,13 @@ type templates struct {
 }
 
 // newTemplates returns a Templates
-func newTemplates(c *TkoV1alpha1Client) *templates {
+func newTemplates(c *TkoV1alpha1Client, namespace string) *templates {
 	return &templates{
 		gentype.NewClientWithList[*v1alpha1.Template, *v1alpha1.TemplateList](
 			"templates",
 			c.RESTClient(),
 			scheme.ParameterCodec,
-			"",
+			namespace,
 			func() *v1alpha1.Template { return &v1alpha1.Template{} },
 			func() *v1alpha1.TemplateList { return &v1alpha1.TemplateList{} }),
 	}
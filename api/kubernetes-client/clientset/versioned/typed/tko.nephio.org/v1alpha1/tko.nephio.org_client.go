@@ -27,16 +27,16 @@ func (c *TkoV1alpha1Client) Deployments(namespace string) DeploymentInterface {
 	return newDeployments(c, namespace)
 }
 
-func (c *TkoV1alpha1Client) Plugins(namespace string) PluginInterface {
-	return newPlugins(c, namespace)
+func (c *TkoV1alpha1Client) Plugins() PluginInterface {
+	return newPlugins(c)
 }
 
-func (c *TkoV1alpha1Client) Sites() SiteInterface {
-	return newSites(c)
+func (c *TkoV1alpha1Client) Sites(namespace string) SiteInterface {
+	return newSites(c, namespace)
 }
 
-func (c *TkoV1alpha1Client) Templates() TemplateInterface {
-	return newTemplates(c)
+func (c *TkoV1alpha1Client) Templates(namespace string) TemplateInterface {
+	return newTemplates(c, namespace)
 }
 
 // NewForConfig creates a new TkoV1alpha1Client for the given config.
@@ -36,15 +36,15 @@ func (v *version) Deployments() DeploymentInformer {
 
 // Plugins returns a PluginInformer.
 func (v *version) Plugins() PluginInformer {
-	return &pluginInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+	return &pluginInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
 // Sites returns a SiteInformer.
 func (v *version) Sites() SiteInformer {
-	return &siteInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+	return &siteInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
 // Templates returns a TemplateInformer.
 func (v *version) Templates() TemplateInformer {
-	return &templateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+	return &templateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
@@ -14,14 +14,18 @@ type DeploymentNamespaceListerExpansion interface{}
 // PluginLister.
 type PluginListerExpansion interface{}
 
-// PluginNamespaceListerExpansion allows custom methods to be added to
-// PluginNamespaceLister.
-type PluginNamespaceListerExpansion interface{}
-
 // SiteListerExpansion allows custom methods to be added to
 // SiteLister.
 type SiteListerExpansion interface{}
 
+// SiteNamespaceListerExpansion allows custom methods to be added to
+// SiteNamespaceLister.
+type SiteNamespaceListerExpansion interface{}
+
 // TemplateListerExpansion allows custom methods to be added to
 // TemplateLister.
 type TemplateListerExpansion interface{}
+
+// TemplateNamespaceListerExpansion allows custom methods to be added to
+// TemplateNamespaceLister.
+type TemplateNamespaceListerExpansion interface{}
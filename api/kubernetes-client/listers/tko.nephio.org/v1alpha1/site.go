@@ -15,9 +15,8 @@ type SiteLister interface {
 	// List lists all Sites in the indexer.
 	// Objects returned here must be treated as read-only.
 	List(selector labels.Selector) (ret []*v1alpha1.Site, err error)
-	// Get retrieves the Site from the index for a given name.
-	// Objects returned here must be treated as read-only.
-	Get(name string) (*v1alpha1.Site, error)
+	// Sites returns an object that can list and get Sites.
+	Sites(namespace string) SiteNamespaceLister
 	SiteListerExpansion
 }
 
@@ -30,3 +29,26 @@ type siteLister struct {
 func NewSiteLister(indexer cache.Indexer) SiteLister {
 	return &siteLister{listers.New[*v1alpha1.Site](indexer, v1alpha1.Resource("site"))}
 }
+
+// Sites returns an object that can list and get Sites.
+func (s *siteLister) Sites(namespace string) SiteNamespaceLister {
+	return siteNamespaceLister{listers.NewNamespaced[*v1alpha1.Site](s.ResourceIndexer, namespace)}
+}
+
+// SiteNamespaceLister helps list and get Sites.
+// All objects returned here must be treated as read-only.
+type SiteNamespaceLister interface {
+	// List lists all Sites in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Site, err error)
+	// Get retrieves the Site from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.Site, error)
+	SiteNamespaceListerExpansion
+}
+
+// siteNamespaceLister implements the SiteNamespaceLister
+// interface.
+type siteNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.Site]
+}
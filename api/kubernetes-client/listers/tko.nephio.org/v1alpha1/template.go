@@ -15,9 +15,8 @@ type TemplateLister interface {
 	// List lists all Templates in the indexer.
 	// Objects returned here must be treated as read-only.
 	List(selector labels.Selector) (ret []*v1alpha1.Template, err error)
-	// Get retrieves the Template from the index for a given name.
-	// Objects returned here must be treated as read-only.
-	Get(name string) (*v1alpha1.Template, error)
+	// Templates returns an object that can list and get Templates.
+	Templates(namespace string) TemplateNamespaceLister
 	TemplateListerExpansion
 }
 
@@ -30,3 +29,26 @@ type templateLister struct {
 func NewTemplateLister(indexer cache.Indexer) TemplateLister {
 	return &templateLister{listers.New[*v1alpha1.Template](indexer, v1alpha1.Resource("template"))}
 }
+
+// Templates returns an object that can list and get Templates.
+func (s *templateLister) Templates(namespace string) TemplateNamespaceLister {
+	return templateNamespaceLister{listers.NewNamespaced[*v1alpha1.Template](s.ResourceIndexer, namespace)}
+}
+
+// TemplateNamespaceLister helps list and get Templates.
+// All objects returned here must be treated as read-only.
+type TemplateNamespaceLister interface {
+	// List lists all Templates in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Template, err error)
+	// Get retrieves the Template from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.Template, error)
+	TemplateNamespaceListerExpansion
+}
+
+// templateNamespaceLister implements the TemplateNamespaceLister
+// interface.
+type templateNamespaceLister struct {
+	listers.ResourceIndexer[*v1alpha1.Template]
+}
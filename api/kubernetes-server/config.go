@@ -41,6 +41,7 @@ func NewConfig(port int) (*server.CompletedConfig, error) {
 
 func NewRecommendedConfig(port int) (*server.RecommendedConfig, error) {
 	recommendedConfig := server.NewRecommendedConfig(Codecs)
+	recommendedConfig.BuildHandlerChainFunc = BuildHandlerChain
 
 	namer := openapi.NewDefinitionNamer(Scheme)
 
@@ -26,7 +26,9 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 		TypeListKind:      "DeploymentList",
 		TypeSingular:      "deployment",
 		TypePlural:        "deployments",
+		Namespaced:        true,
 		CanCreateOnUpdate: false,
+		EventKind:         backendpkg.EventKindDeployment,
 
 		NewObjectFunc: func() runtime.Object {
 			return new(krm.Deployment)
@@ -39,7 +41,8 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 		GetFieldsFunc: func(object runtime.Object) (fields.Set, error) {
 			if krmDeployment, ok := object.(*krm.Deployment); ok {
 				fields := fields.Set{
-					"metadata.name": krmDeployment.Name,
+					"metadata.name":      krmDeployment.Name,
+					"metadata.namespace": krmDeployment.Namespace,
 				}
 				if krmDeployment.Spec.TemplateId != nil {
 					fields["spec.templateId"] = *krmDeployment.Spec.TemplateId
@@ -53,7 +56,7 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 		CreateFunc: func(context contextpkg.Context, store *Store, object runtime.Object) (runtime.Object, error) {
 			if deployment, err := DeploymentFromKRM(object); err == nil {
 				if err := store.Backend.CreateDeployment(context, deployment); err == nil {
-					return object, nil
+					return DeploymentToKRM(deployment)
 				} else {
 					return nil, err
 				}
@@ -64,15 +67,9 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 
 		UpdateFunc: func(context contextpkg.Context, store *Store, updatedObject runtime.Object) (runtime.Object, error) {
 			if updatedDeployment, err := DeploymentFromKRM(updatedObject); err == nil {
-				if modificationToken, deployment, err := store.Backend.StartDeploymentModification(context, updatedDeployment.DeploymentID); err == nil {
-					if ResourceVersionsEqual(deployment.Updated, updatedDeployment.Updated) {
-						if _, err := store.Backend.EndDeploymentModification(context, modificationToken, updatedDeployment.Package, nil); err == nil {
-							return updatedObject, nil
-						} else {
-							return nil, err
-						}
-					} else if err := store.Backend.CancelDeploymentModification(context, modificationToken); err == nil {
-						return nil, backendpkg.NewNotDoneErrorf("deployment has been modified before the proposed update: %s, was %s", deployment.Updated, updatedDeployment.Updated)
+				if modificationToken, _, err := backendpkg.StartDeploymentModificationForVersion(context, store.Backend, updatedDeployment.Namespace, updatedDeployment.DeploymentID, updatedDeployment.Version); err == nil {
+					if deploymentId, err := store.Backend.EndDeploymentModification(context, modificationToken, updatedDeployment.Package, nil); err == nil {
+						return store.GetFunc(context, store, updatedDeployment.Namespace, deploymentId)
 					} else {
 						return nil, err
 					}
@@ -84,16 +81,16 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		DeleteFunc: func(context contextpkg.Context, store *Store, id string) error {
-			return store.Backend.DeleteDeployment(context, id)
+		DeleteFunc: func(context contextpkg.Context, store *Store, namespace string, id string, options *meta.DeleteOptions) error {
+			return store.Backend.DeleteDeployment(context, namespace, id, ToPropagation(options))
 		},
 
-		PurgeFunc: func(context contextpkg.Context, store *Store) error {
-			return store.Backend.PurgeDeployments(context, backendpkg.SelectDeployments{})
+		PurgeFunc: func(context contextpkg.Context, store *Store, namespace string, options *meta.DeleteOptions) error {
+			return store.Backend.PurgeDeployments(context, backendpkg.SelectDeployments{Namespace: namespace}, ToPropagation(options))
 		},
 
-		GetFunc: func(context contextpkg.Context, store *Store, id string) (runtime.Object, error) {
-			if deployment, err := store.Backend.GetDeployment(context, id); err == nil {
+		GetFunc: func(context contextpkg.Context, store *Store, namespace string, id string) (runtime.Object, error) {
+			if deployment, err := store.Backend.GetDeployment(context, namespace, id); err == nil {
 				if krmDeployment, err := DeploymentToKRM(deployment); err == nil {
 					return krmDeployment, nil
 				} else {
@@ -104,7 +101,7 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		ListFunc: func(context contextpkg.Context, store *Store, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
+		ListFunc: func(context contextpkg.Context, store *Store, namespace string, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
 			var krmDeploymentList krm.DeploymentList
 
 			metadataPatterns, err := MetadataPatternsFromListOptions(options)
@@ -119,7 +116,7 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 
 			if id != nil {
 				// Get single deployment
-				if deployment, err := store.Backend.GetDeployment(context, *id); err == nil {
+				if deployment, err := store.Backend.GetDeployment(context, namespace, *id); err == nil {
 					// Make sure it matches metadata
 					if backendpkg.MetadataMatchesPatterns(deployment.Metadata, metadataPatterns) {
 						if krmDeployment, err := DeploymentToKRM(deployment); err == nil {
@@ -131,7 +128,7 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 				} else {
 					return nil, err
 				}
-			} else if results, err := store.Backend.ListDeployments(context, backendpkg.SelectDeployments{MetadataPatterns: metadataPatterns}, backendpkg.Window{Offset: offset, MaxCount: int(maxCount)}); err == nil {
+			} else if results, err := store.Backend.ListDeployments(context, backendpkg.SelectDeployments{Namespace: namespace, MetadataPatterns: metadataPatterns}, backendpkg.Window{Offset: offset, MaxCount: int(maxCount)}); err == nil {
 				if err := util.IterateResults(results, func(deploymentInfo backendpkg.DeploymentInfo) error {
 					if krmDeployment, err := DeploymentInfoToKRM(&deploymentInfo); err == nil {
 						krmDeploymentList.Items = append(krmDeploymentList.Items, *krmDeployment)
@@ -176,7 +173,7 @@ func NewDeploymentStore(backend backend.Backend, log commonlog.Logger) *Store {
 			for index, krmDeployment := range krmDeployments {
 				var updated time.Time
 				var err error
-				if updated, err = FromResourceVersion(krmDeployment.ResourceVersion); err != nil {
+				if updated, err = GetUpdatedAnnotation(&krmDeployment); err != nil {
 					return nil, err
 				}
 
@@ -227,10 +224,12 @@ func DeploymentInfoToKRM(deploymentInfo *backendpkg.DeploymentInfo) (*krm.Deploy
 	var krmDeployment krm.Deployment
 	krmDeployment.APIVersion = APIVersion
 	krmDeployment.Kind = "Deployment"
+	krmDeployment.Namespace = deploymentInfo.Namespace
 	krmDeployment.Name = name
-	krmDeployment.UID = ToUID("deployment", deploymentInfo.DeploymentID)
+	krmDeployment.UID = ToUID("deployment", deploymentInfo.Namespace, deploymentInfo.DeploymentID)
 	krmDeployment.CreationTimestamp = meta.NewTime(deploymentInfo.Created)
-	krmDeployment.ResourceVersion = ToResourceVersion(deploymentInfo.Updated)
+	krmDeployment.ResourceVersion = ToResourceVersion(deploymentInfo.Version)
+	SetUpdatedAnnotation(&krmDeployment, deploymentInfo.Updated)
 	krmDeployment.Labels, _ = tkoutil.ToKubernetesNames(deploymentInfo.Metadata)
 
 	deploymentId := deploymentInfo.DeploymentID
@@ -274,16 +273,23 @@ func DeploymentFromKRM(object runtime.Object) (*backendpkg.Deployment, error) {
 		return nil, backendpkg.NewBadArgumentError(err.Error())
 	}
 
+	var version uint64
+	if version, err = FromResourceVersion(krmDeployment.ResourceVersion); err != nil {
+		return nil, err
+	}
+
 	var updated time.Time
-	if updated, err = FromResourceVersion(krmDeployment.ResourceVersion); err != nil {
+	if updated, err = GetUpdatedAnnotation(krmDeployment); err != nil {
 		return nil, err
 	}
 
 	deployment := backendpkg.Deployment{
 		DeploymentInfo: backendpkg.DeploymentInfo{
+			Namespace:    krmDeployment.Namespace,
 			DeploymentID: deploymentId,
 			Created:      krmDeployment.CreationTimestamp.Time,
 			Updated:      updated,
+			Version:      version,
 		},
 	}
 
@@ -312,3 +318,27 @@ func DeploymentFromKRM(object runtime.Object) (*backendpkg.Deployment, error) {
 
 	return &deployment, nil
 }
+
+// Defaults to orphan, unlike Kubernetes, which defaults to background.
+func ToPropagation(options *meta.DeleteOptions) string {
+	if options != nil {
+		if options.PropagationPolicy != nil {
+			switch propagationPolicy := *options.PropagationPolicy; propagationPolicy {
+			case meta.DeletePropagationOrphan:
+				return backendpkg.PropagationOrphan
+			case meta.DeletePropagationBackground:
+				return backendpkg.PropagationBackground
+			case meta.DeletePropagationForeground:
+				return backendpkg.PropagationForeground
+			default:
+				// Will be rejected by the backend
+				return string(propagationPolicy)
+			}
+		} else if (options.OrphanDependents != nil) && !*options.OrphanDependents {
+			// Deprecated field
+			return backendpkg.PropagationBackground
+		}
+	}
+
+	return backendpkg.PropagationOrphan
+}
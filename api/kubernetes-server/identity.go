@@ -0,0 +1,29 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/nephio-experimental/tko/backend"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// Adds the Kubernetes user as the backend identity, and as the author if not anonymous, so
+// that it is recorded in audit events and revisions. The user is available because the
+// default handler chain authenticates before this handler.
+func identify(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request_ *http.Request) {
+		if user_, ok := request.UserFrom(request_.Context()); ok {
+			context := request_.Context()
+			if user_.GetName() == user.Anonymous {
+				context = backend.ContextWithIdentity(context, backend.NewAnonymousIdentity())
+			} else {
+				context = backend.ContextWithIdentity(context, backend.NewIdentity(user_.GetName(), user_.GetGroups()...))
+				context = backend.ContextWithAuthor(context, user_.GetName())
+			}
+			request_ = request_.WithContext(context)
+		}
+
+		handler.ServeHTTP(writer, request_)
+	})
+}
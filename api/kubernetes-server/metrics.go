@@ -0,0 +1,29 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/nephio-experimental/tko/metrics"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/server"
+)
+
+// The default handler chain with request metrics and identities.
+// ([server.Config.BuildHandlerChainFunc] signature)
+func BuildHandlerChain(handler http.Handler, config *server.Config) http.Handler {
+	return server.DefaultBuildHandlerChain(meter(identify(handler)), config)
+}
+
+// Only resource requests are measured. The request info is available because the default
+// handler chain runs before this handler.
+func meter(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request_ *http.Request) {
+		start := time.Now()
+		statusWriter := metrics.NewStatusResponseWriter(writer)
+		handler.ServeHTTP(statusWriter, request_)
+		if requestInfo, ok := request.RequestInfoFrom(request_.Context()); ok && requestInfo.IsResourceRequest {
+			metrics.ObserveKubernetesRequest(requestInfo.Verb, requestInfo.Resource, statusWriter.Status, start)
+		}
+	})
+}
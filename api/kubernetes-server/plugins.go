@@ -26,6 +26,7 @@ func NewPluginStore(backend backend.Backend, log commonlog.Logger) *Store {
 		TypeSingular:      "plugin",
 		TypePlural:        "plugins",
 		CanCreateOnUpdate: true,
+		EventKind:         backendpkg.EventKindPlugin,
 
 		NewObjectFunc: func() runtime.Object {
 			return new(krm.Plugin)
@@ -59,7 +60,7 @@ func NewPluginStore(backend backend.Backend, log commonlog.Logger) *Store {
 			if krmPlugin, ok := object.(*krm.Plugin); ok {
 				if plugin, err := PluginFromKRM(krmPlugin); err == nil {
 					if err := store.Backend.SetPlugin(context, plugin); err == nil {
-						return krmPlugin, nil
+						return PluginToKRM(plugin)
 					} else {
 						return nil, err
 					}
@@ -71,7 +72,7 @@ func NewPluginStore(backend backend.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		DeleteFunc: func(context contextpkg.Context, store *Store, id string) error {
+		DeleteFunc: func(context contextpkg.Context, store *Store, namespace string, id string, options *meta.DeleteOptions) error {
 			pluginId, ok := backendpkg.ParsePluginID(id)
 			if !ok {
 				return backendpkg.NewBadArgumentErrorf("malformed plugin ID: %s", id)
@@ -80,11 +81,11 @@ func NewPluginStore(backend backend.Backend, log commonlog.Logger) *Store {
 			return store.Backend.DeletePlugin(context, pluginId)
 		},
 
-		PurgeFunc: func(context contextpkg.Context, store *Store) error {
+		PurgeFunc: func(context contextpkg.Context, store *Store, namespace string, options *meta.DeleteOptions) error {
 			return store.Backend.PurgePlugins(context, backendpkg.SelectPlugins{})
 		},
 
-		GetFunc: func(context contextpkg.Context, store *Store, id string) (runtime.Object, error) {
+		GetFunc: func(context contextpkg.Context, store *Store, namespace string, id string) (runtime.Object, error) {
 			pluginId, ok := backendpkg.ParsePluginID(id)
 			if !ok {
 				return nil, backendpkg.NewBadArgumentErrorf("malformed plugin ID: %s", id)
@@ -101,7 +102,7 @@ func NewPluginStore(backend backend.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		ListFunc: func(context contextpkg.Context, store *Store, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
+		ListFunc: func(context contextpkg.Context, store *Store, namespace string, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
 			var krmPluginList krm.PluginList
 
 			id, err := IDFromListOptions(options)
@@ -209,6 +210,7 @@ func PluginToKRM(plugin *backendpkg.Plugin) (*krm.Plugin, error) {
 	krmPlugin.Kind = "Plugin"
 	krmPlugin.Name = name
 	krmPlugin.UID = ToUID("plugin", pluginIdString)
+	krmPlugin.ResourceVersion = ToResourceVersion(plugin.Version)
 
 	pluginId := plugin.PluginID
 	krmPlugin.Spec.Type = &pluginId.Type
@@ -245,10 +247,16 @@ func PluginFromKRM(object runtime.Object) (*backendpkg.Plugin, error) {
 		return nil, backendpkg.NewBadArgumentError(err.Error())
 	}
 
+	version, err := FromResourceVersion(krmPlugin.ResourceVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	plugin := backendpkg.Plugin{
 		PluginID:   pluginId,
 		Arguments:  krmPlugin.Spec.Arguments,
 		Properties: krmPlugin.Spec.Properties,
+		Version:    version,
 	}
 
 	if krmPlugin.Spec.Executor != nil {
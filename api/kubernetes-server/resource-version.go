@@ -2,29 +2,84 @@ package server
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	backendpkg "github.com/nephio-experimental/tko/backend"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func ToResourceVersion(updated time.Time) string {
-	return strconv.FormatInt(updated.UnixMicro(), 10)
+// Resource versions are opaque to clients. Objects returned by get and list have the backend
+// version as their resource version, which is checked when they are updated. Objects and lists
+// returned by watch and list add the backend event revision after a separator, allowing
+// watches to be resumed.
+const ResourceVersionRevisionSeparator = "."
+
+// The resource version is no longer a timestamp, so we keep the updated timestamp here.
+const UpdatedAnnotation = "tko.nephio.org/updated"
+
+func ToResourceVersion(version uint64) string {
+	return strconv.FormatUint(version, 10)
 }
 
-func FromResourceVersion(resourceVersion string) (time.Time, error) {
+func ToWatchResourceVersion(version uint64, revision uint64) string {
+	return ToResourceVersion(version) + ResourceVersionRevisionSeparator + strconv.FormatUint(revision, 10)
+}
+
+func AddRevisionToResourceVersion(resourceVersion string, revision uint64) string {
+	resourceVersion, _, _ = strings.Cut(resourceVersion, ResourceVersionRevisionSeparator)
 	if resourceVersion == "" {
-		return time.Time{}, nil
+		resourceVersion = "0"
+	}
+	return resourceVersion + ResourceVersionRevisionSeparator + strconv.FormatUint(revision, 10)
+}
+
+// Returns 0 for an empty resource version, which will not be checked when writing.
+func FromResourceVersion(resourceVersion string) (uint64, error) {
+	resourceVersion, _, _ = strings.Cut(resourceVersion, ResourceVersionRevisionSeparator)
+
+	if resourceVersion == "" {
+		return 0, nil
 	}
 
-	if updated, err := strconv.ParseInt(resourceVersion, 10, 64); err == nil {
-		return time.UnixMicro(updated), nil
+	if version, err := strconv.ParseUint(resourceVersion, 10, 64); err == nil {
+		return version, nil
 	} else {
-		return time.Time{}, backendpkg.NewBadArgumentError(err.Error())
+		return 0, backendpkg.NewBadArgumentError(err.Error())
 	}
 }
 
-func ResourceVersionsEqual(a time.Time, b time.Time) bool {
-	a = a.Truncate(time.Millisecond)
-	b = b.Truncate(time.Millisecond)
-	return a.Equal(b)
+// Returns false if the resource version does not have a revision.
+func RevisionFromResourceVersion(resourceVersion string) (uint64, bool, error) {
+	if _, revision, ok := strings.Cut(resourceVersion, ResourceVersionRevisionSeparator); ok {
+		if revision_, err := strconv.ParseUint(revision, 10, 64); err == nil {
+			return revision_, true, nil
+		} else {
+			return 0, false, backendpkg.NewBadArgumentError(err.Error())
+		}
+	} else {
+		return 0, false, nil
+	}
+}
+
+func SetUpdatedAnnotation(object meta.Object, updated time.Time) {
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[UpdatedAnnotation] = updated.Format(time.RFC3339Nano)
+	object.SetAnnotations(annotations)
+}
+
+// Returns the zero time if the annotation is missing.
+func GetUpdatedAnnotation(object meta.Object) (time.Time, error) {
+	if updated, ok := object.GetAnnotations()[UpdatedAnnotation]; ok {
+		if updated_, err := time.Parse(time.RFC3339Nano, updated); err == nil {
+			return updated_, nil
+		} else {
+			return time.Time{}, backendpkg.NewBadArgumentError(err.Error())
+		}
+	} else {
+		return time.Time{}, nil
+	}
 }
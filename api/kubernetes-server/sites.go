@@ -26,7 +26,9 @@ func NewSiteStore(backend backend.Backend, log commonlog.Logger) *Store {
 		TypeListKind:      "SiteList",
 		TypeSingular:      "site",
 		TypePlural:        "sites",
+		Namespaced:        true,
 		CanCreateOnUpdate: true,
+		EventKind:         backendpkg.EventKindSite,
 
 		NewObjectFunc: func() runtime.Object {
 			return new(krm.Site)
@@ -39,7 +41,8 @@ func NewSiteStore(backend backend.Backend, log commonlog.Logger) *Store {
 		GetFieldsFunc: func(object runtime.Object) (fields.Set, error) {
 			if krmSite, ok := object.(*krm.Site); ok {
 				fields := fields.Set{
-					"metadata.name": krmSite.Name,
+					"metadata.name":      krmSite.Name,
+					"metadata.namespace": krmSite.Namespace,
 				}
 				if krmSite.Spec.SiteId != nil {
 					fields["spec.siteId"] = *krmSite.Spec.SiteId
@@ -56,7 +59,7 @@ func NewSiteStore(backend backend.Backend, log commonlog.Logger) *Store {
 		CreateFunc: func(context contextpkg.Context, store *Store, object runtime.Object) (runtime.Object, error) {
 			if site, err := SiteFromKRM(object); err == nil {
 				if err := store.Backend.SetSite(context, site); err == nil {
-					return object, nil
+					return SiteToKRM(site)
 				} else {
 					return nil, err
 				}
@@ -65,16 +68,16 @@ func NewSiteStore(backend backend.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		DeleteFunc: func(context contextpkg.Context, store *Store, id string) error {
-			return store.Backend.DeleteSite(context, id)
+		DeleteFunc: func(context contextpkg.Context, store *Store, namespace string, id string, options *meta.DeleteOptions) error {
+			return store.Backend.DeleteSite(context, namespace, id)
 		},
 
-		PurgeFunc: func(context contextpkg.Context, store *Store) error {
-			return store.Backend.PurgePlugins(context, backendpkg.SelectPlugins{})
+		PurgeFunc: func(context contextpkg.Context, store *Store, namespace string, options *meta.DeleteOptions) error {
+			return store.Backend.PurgeSites(context, backendpkg.SelectSites{Namespace: namespace})
 		},
 
-		GetFunc: func(context contextpkg.Context, store *Store, id string) (runtime.Object, error) {
-			if site, err := store.Backend.GetSite(context, id); err == nil {
+		GetFunc: func(context contextpkg.Context, store *Store, namespace string, id string) (runtime.Object, error) {
+			if site, err := store.Backend.GetSite(context, namespace, id); err == nil {
 				if krmSite, err := SiteToKRM(site); err == nil {
 					return krmSite, nil
 				} else {
@@ -85,7 +88,7 @@ func NewSiteStore(backend backend.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		ListFunc: func(context contextpkg.Context, store *Store, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
+		ListFunc: func(context contextpkg.Context, store *Store, namespace string, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
 			var krmSiteList krm.SiteList
 
 			idPatterns, err := IDPatternsFromListOptions(options)
@@ -97,7 +100,7 @@ func NewSiteStore(backend backend.Backend, log commonlog.Logger) *Store {
 				return nil, err
 			}
 
-			if results, err := store.Backend.ListSites(context, backendpkg.SelectSites{SiteIDPatterns: idPatterns, MetadataPatterns: metadataPatterns}, backendpkg.Window{Offset: offset, MaxCount: int(maxCount)}); err == nil {
+			if results, err := store.Backend.ListSites(context, backendpkg.SelectSites{Namespace: namespace, SiteIDPatterns: idPatterns, MetadataPatterns: metadataPatterns}, backendpkg.Window{Offset: offset, MaxCount: int(maxCount)}); err == nil {
 				if err := util.IterateResults(results, func(siteInfo backendpkg.SiteInfo) error {
 					if krmSite, err := SiteInfoToKRM(&siteInfo); err == nil {
 						krmSiteList.Items = append(krmSiteList.Items, *krmSite)
@@ -138,7 +141,7 @@ func NewSiteStore(backend backend.Backend, log commonlog.Logger) *Store {
 			for index, krmSite := range krmSites {
 				var updated time.Time
 				var err error
-				if updated, err = FromResourceVersion(krmSite.ResourceVersion); err != nil {
+				if updated, err = GetUpdatedAnnotation(&krmSite); err != nil {
 					return nil, err
 				}
 
@@ -184,9 +187,11 @@ func SiteInfoToKRM(siteInfo *backendpkg.SiteInfo) (*krm.Site, error) {
 	var krmSite krm.Site
 	krmSite.APIVersion = APIVersion
 	krmSite.Kind = "Site"
+	krmSite.Namespace = siteInfo.Namespace
 	krmSite.Name = name
-	krmSite.UID = ToUID("site", siteInfo.SiteID)
-	krmSite.ResourceVersion = ToResourceVersion(siteInfo.Updated)
+	krmSite.UID = ToUID("site", siteInfo.Namespace, siteInfo.SiteID)
+	krmSite.ResourceVersion = ToResourceVersion(siteInfo.Version)
+	SetUpdatedAnnotation(&krmSite, siteInfo.Updated)
 	krmSite.Labels, _ = tkoutil.ToKubernetesNames(siteInfo.Metadata)
 
 	siteId := siteInfo.SiteID
@@ -221,15 +226,22 @@ func SiteFromKRM(object runtime.Object) (*backendpkg.Site, error) {
 		return nil, backendpkg.NewBadArgumentError(err.Error())
 	}
 
+	var version uint64
+	if version, err = FromResourceVersion(krmSite.ResourceVersion); err != nil {
+		return nil, err
+	}
+
 	var updated time.Time
-	if updated, err = FromResourceVersion(krmSite.ResourceVersion); err != nil {
+	if updated, err = GetUpdatedAnnotation(krmSite); err != nil {
 		return nil, err
 	}
 
 	site := backendpkg.Site{
 		SiteInfo: backendpkg.SiteInfo{
-			SiteID:  siteId,
-			Updated: updated,
+			Namespace: krmSite.Namespace,
+			SiteID:    siteId,
+			Updated:   updated,
+			Version:   version,
 		},
 	}
 
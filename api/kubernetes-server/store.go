@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
 )
@@ -53,6 +54,8 @@ type Store struct {
 	TypePlural        string
 	TypeShortNames    []string
 	CanCreateOnUpdate bool
+	Namespaced        bool
+	EventKind         string
 
 	NewObjectFunc     func() runtime.Object
 	NewListObjectFunc func() runtime.Object
@@ -61,10 +64,10 @@ type Store struct {
 	// These can return backend errors
 	CreateFunc func(context contextpkg.Context, store *Store, object runtime.Object) (runtime.Object, error)
 	UpdateFunc func(context contextpkg.Context, store *Store, updatedObject runtime.Object) (runtime.Object, error) // optional
-	DeleteFunc func(context contextpkg.Context, store *Store, id string) error
-	PurgeFunc  func(context contextpkg.Context, store *Store) error
-	GetFunc    func(context contextpkg.Context, store *Store, id string) (runtime.Object, error)
-	ListFunc   func(context contextpkg.Context, store *Store, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error)
+	DeleteFunc func(context contextpkg.Context, store *Store, namespace string, id string, options *meta.DeleteOptions) error
+	PurgeFunc  func(context contextpkg.Context, store *Store, namespace string, options *meta.DeleteOptions) error
+	GetFunc    func(context contextpkg.Context, store *Store, namespace string, id string) (runtime.Object, error)
+	ListFunc   func(context contextpkg.Context, store *Store, namespace string, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error)
 	TableFunc  func(context contextpkg.Context, store *Store, object runtime.Object, withHeaders bool, withObject bool) (*meta.Table, error)
 
 	groupResource schema.GroupResource
@@ -106,7 +109,7 @@ var (
 	_ rest.Updater                        = validStore
 	_ rest.CreaterUpdater                 = validStore
 	_ rest.Patcher                        = validStore
-	// _ rest.Watcher = validStore
+	_ rest.Watcher                        = validStore
 	// _ rest.StandardStorage = validStore
 	// _ rest.Redirector = validStore
 	// _ rest.Responder = validStore
@@ -140,7 +143,7 @@ func (self *Store) Destroy() {
 // ([rest.UpdateResetFieldsStrategy] interface)
 func (self *Store) NamespaceScoped() bool {
 	self.Log.Info("NamespaceScoped")
-	return false
+	return self.Namespaced
 }
 
 // ([rest.KindProvider] interface)
@@ -199,10 +202,6 @@ func (self *Store) List(context contextpkg.Context, options *internalversion.Lis
 	maxCount := backendpkg.DefaultMaxCount
 
 	if options != nil {
-		if options.Watch {
-			return nil, apierrors.NewBadRequest("\"watch\" is not supported")
-		}
-
 		if options.Continue != "" {
 			// Note: Every call results in a new query to the backend, so there is no guarantee
 			// that we are indeed continuing the exact same result set. Thus it may be possible
@@ -236,7 +235,18 @@ func (self *Store) List(context contextpkg.Context, options *internalversion.Lis
 	//
 	//   +k8s:openapi-gen=x-kubernetes-selectable-fields:?
 
-	if list, err := self.ListFunc(context, self, options, offset, maxCount); err == nil {
+	// Get the revision before listing so that a watch resuming from it will not miss changes
+	revision, revisionErr := self.Backend.GetEventRevision(context)
+
+	if list, err := self.ListFunc(context, self, self.listNamespace(context), options, offset, maxCount); err == nil {
+		if revisionErr == nil {
+			if list_, err := metabase.ListAccessor(list); err == nil {
+				list_.SetResourceVersion(ToWatchResourceVersion(0, revision))
+			} else {
+				return nil, apierrors.NewInternalError(err)
+			}
+		}
+
 		// Check if there are potentially more results
 		if objects, err := metabase.ExtractList(list); err == nil {
 			count := uint(len(objects))
@@ -313,7 +323,7 @@ func (self *Store) Get(context contextpkg.Context, name string, options *meta.Ge
 		return nil, apierrors.NewBadRequest(err.Error())
 	}
 
-	if object, err := self.GetFunc(context, self, id); err == nil {
+	if object, err := self.GetFunc(context, self, self.namespace(context), id); err == nil {
 		return object, nil
 	} else {
 		return nil, self.toKubernetesError(err, "Get", name)
@@ -365,7 +375,7 @@ func (self *Store) Delete(context contextpkg.Context, name string, deleteValidat
 		return nil, false, nil
 	}
 
-	if err := self.DeleteFunc(context, self, id); err == nil {
+	if err := self.DeleteFunc(context, self, self.namespace(context), id, options); err == nil {
 		return nil, true, nil
 	} else {
 		return nil, false, self.toKubernetesError(err, "Delete", name)
@@ -396,7 +406,7 @@ func (self *Store) DeleteCollection(context contextpkg.Context, deleteValidation
 	}
 
 	if self.PurgeFunc != nil {
-		if err := self.PurgeFunc(context, self); err == nil {
+		if err := self.PurgeFunc(context, self, self.namespace(context), options); err == nil {
 			return nil, nil
 		} else {
 			return nil, self.toKubernetesError(err, "DeleteCollection", "")
@@ -583,14 +593,50 @@ func (self *Store) Update(context contextpkg.Context, name string, objectInfo re
 
 // ([rest.Watcher] interface)
 // ([rest.StandardStorage] interface)
-func (self *Store) _Watch(context contextpkg.Context, options *internalversion.ListOptions) (watch.Interface, error) {
+func (self *Store) Watch(context contextpkg.Context, options *internalversion.ListOptions) (watch.Interface, error) {
 	if options == nil {
 		self.Log.Infof("Watch")
+		options = new(internalversion.ListOptions)
 	} else {
 		self.Log.Infof("Watch: options=%+v", *options)
 	}
-	// TODO: supporting this would require an event model on the backend...
-	return nil, nil
+
+	// An empty or "0" resource version means that we should start with the current state,
+	// otherwise we resume from the revision in the resource version
+	var sinceRevision uint64
+	initialEvents := (options.ResourceVersion == "") || (options.ResourceVersion == "0")
+	if initialEvents {
+		var err error
+		if sinceRevision, err = self.Backend.GetEventRevision(context); err != nil {
+			return nil, self.toKubernetesError(err, "Watch", "")
+		}
+	} else {
+		if revision, ok, err := RevisionFromResourceVersion(options.ResourceVersion); err == nil {
+			if !ok {
+				return nil, apierrors.NewResourceExpired(fmt.Sprintf("resource version cannot be watched: %s", options.ResourceVersion))
+			}
+			sinceRevision = revision
+		} else {
+			return nil, apierrors.NewBadRequest(err.Error())
+		}
+	}
+
+	// The context is that of the request, so it will be done when the client disconnects
+	context, cancel := contextpkg.WithCancel(context)
+
+	// Watch all kinds and namespaces so that bookmarks can report the latest revision
+	events, err := self.Backend.Watch(context, backendpkg.SelectEvents{Namespace: backendpkg.AllNamespaces, SinceRevision: sinceRevision})
+	if err != nil {
+		cancel()
+		if err == backendpkg.EventRevisionTooOldError {
+			return nil, apierrors.NewResourceExpired(err.Error())
+		}
+		return nil, self.toKubernetesError(err, "Watch", "")
+	}
+
+	watcher := NewStoreWatcher(self, options, self.listNamespace(context), sinceRevision, events, cancel)
+	go watcher.Run(context, initialEvents)
+	return watcher, nil
 }
 
 // ([rest.Redirector] interface)
@@ -770,13 +816,37 @@ func (self *Store) WarningsOnCreate(context contextpkg.Context, object runtime.O
 
 // Utils
 
+// Returns the request namespace, or an empty string for cluster-scoped stores
+// (the backend treats an empty namespace as the default namespace).
+func (self *Store) namespace(context contextpkg.Context) string {
+	if self.Namespaced {
+		return request.NamespaceValue(context)
+	}
+	return ""
+}
+
+// An empty request namespace means "all namespaces" (e.g. "kubectl get --all-namespaces").
+func (self *Store) listNamespace(context contextpkg.Context) string {
+	if self.Namespaced {
+		if namespace := request.NamespaceValue(context); namespace != "" {
+			return namespace
+		}
+		return backendpkg.AllNamespaces
+	}
+	return ""
+}
+
 func (self *Store) toKubernetesError(err error, operation string, name string) error {
-	if backendpkg.IsBadArgumentError(err) {
+	if backendpkg.IsNotImplementedError(err) {
+		return apierrors.NewMethodNotSupported(self.groupResource, operation)
+	} else if backendpkg.IsBadArgumentError(err) {
 		return apierrors.NewBadRequest(err.Error())
 	} else if backendpkg.IsNotFoundError(err) {
 		return apierrors.NewNotFound(self.groupResource, name)
-	} else if backendpkg.IsNotDoneError(err) {
+	} else if backendpkg.IsNotDoneError(err) || backendpkg.IsConflictError(err) {
 		return apierrors.NewConflict(self.groupResource, name, err)
+	} else if backendpkg.IsNotAuthorizedError(err) {
+		return apierrors.NewForbidden(self.groupResource, name, err)
 	} else if backendpkg.IsBusyError(err) || backendpkg.IsTimeoutError(err) {
 		return apierrors.NewServerTimeout(self.groupResource, operation, RetryAfterSeconds)
 	} else {
@@ -25,7 +25,9 @@ func NewTemplateStore(backend backendpkg.Backend, log commonlog.Logger) *Store {
 		TypeListKind:      "TemplateList",
 		TypeSingular:      "template",
 		TypePlural:        "templates",
+		Namespaced:        true,
 		CanCreateOnUpdate: true,
+		EventKind:         backendpkg.EventKindTemplate,
 
 		NewObjectFunc: func() runtime.Object {
 			return new(krm.Template)
@@ -38,7 +40,8 @@ func NewTemplateStore(backend backendpkg.Backend, log commonlog.Logger) *Store {
 		GetFieldsFunc: func(object runtime.Object) (fields.Set, error) {
 			if krmTemplate, ok := object.(*krm.Template); ok {
 				fields := fields.Set{
-					"metadata.name": krmTemplate.Name,
+					"metadata.name":      krmTemplate.Name,
+					"metadata.namespace": krmTemplate.Namespace,
 				}
 				if krmTemplate.Spec.TemplateId != nil {
 					fields["spec.templateId"] = *krmTemplate.Spec.TemplateId
@@ -52,7 +55,7 @@ func NewTemplateStore(backend backendpkg.Backend, log commonlog.Logger) *Store {
 		CreateFunc: func(context contextpkg.Context, store *Store, object runtime.Object) (runtime.Object, error) {
 			if template, err := TemplateFromKRM(object); err == nil {
 				if err := store.Backend.SetTemplate(context, template); err == nil {
-					return object, nil
+					return TemplateToKRM(template)
 				} else {
 					return nil, err
 				}
@@ -61,16 +64,16 @@ func NewTemplateStore(backend backendpkg.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		DeleteFunc: func(context contextpkg.Context, store *Store, id string) error {
-			return store.Backend.DeleteTemplate(context, id)
+		DeleteFunc: func(context contextpkg.Context, store *Store, namespace string, id string, options *meta.DeleteOptions) error {
+			return store.Backend.DeleteTemplate(context, namespace, id)
 		},
 
-		PurgeFunc: func(context contextpkg.Context, store *Store) error {
-			return store.Backend.PurgeTemplates(context, backendpkg.SelectTemplates{})
+		PurgeFunc: func(context contextpkg.Context, store *Store, namespace string, options *meta.DeleteOptions) error {
+			return store.Backend.PurgeTemplates(context, backendpkg.SelectTemplates{Namespace: namespace})
 		},
 
-		GetFunc: func(context contextpkg.Context, store *Store, id string) (runtime.Object, error) {
-			if template, err := store.Backend.GetTemplate(context, id); err == nil {
+		GetFunc: func(context contextpkg.Context, store *Store, namespace string, id string) (runtime.Object, error) {
+			if template, err := store.Backend.GetTemplate(context, namespace, id); err == nil {
 				if krmTemplate, err := TemplateToKRM(template); err == nil {
 					return krmTemplate, nil
 				} else {
@@ -81,7 +84,7 @@ func NewTemplateStore(backend backendpkg.Backend, log commonlog.Logger) *Store {
 			}
 		},
 
-		ListFunc: func(context contextpkg.Context, store *Store, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
+		ListFunc: func(context contextpkg.Context, store *Store, namespace string, options *internalversion.ListOptions, offset uint, maxCount uint) (runtime.Object, error) {
 			var krmTemplateList krm.TemplateList
 
 			idPatterns, err := IDPatternsFromListOptions(options)
@@ -93,7 +96,7 @@ func NewTemplateStore(backend backendpkg.Backend, log commonlog.Logger) *Store {
 				return nil, err
 			}
 
-			if results, err := store.Backend.ListTemplates(context, backendpkg.SelectTemplates{TemplateIDPatterns: idPatterns, MetadataPatterns: metadataPatterns}, backendpkg.Window{Offset: offset, MaxCount: int(maxCount)}); err == nil {
+			if results, err := store.Backend.ListTemplates(context, backendpkg.SelectTemplates{Namespace: namespace, TemplateIDPatterns: idPatterns, MetadataPatterns: metadataPatterns}, backendpkg.Window{Offset: offset, MaxCount: int(maxCount)}); err == nil {
 				if err := util.IterateResults(results, func(templateInfo backendpkg.TemplateInfo) error {
 					if krmTemplate, err := TemplateInfoToKRM(&templateInfo); err == nil {
 						krmTemplateList.Items = append(krmTemplateList.Items, *krmTemplate)
@@ -133,7 +136,7 @@ func NewTemplateStore(backend backendpkg.Backend, log commonlog.Logger) *Store {
 			for index, krmTemplate := range krmTemplates {
 				var updated time.Time
 				var err error
-				if updated, err = FromResourceVersion(krmTemplate.ResourceVersion); err != nil {
+				if updated, err = GetUpdatedAnnotation(&krmTemplate); err != nil {
 					return nil, err
 				}
 
@@ -178,9 +181,11 @@ func TemplateInfoToKRM(templateInfo *backendpkg.TemplateInfo) (*krm.Template, er
 	var krmTemplate krm.Template
 	krmTemplate.APIVersion = APIVersion
 	krmTemplate.Kind = "Template"
+	krmTemplate.Namespace = templateInfo.Namespace
 	krmTemplate.Name = name
-	krmTemplate.UID = ToUID("template", templateInfo.TemplateID)
-	krmTemplate.ResourceVersion = ToResourceVersion(templateInfo.Updated)
+	krmTemplate.UID = ToUID("template", templateInfo.Namespace, templateInfo.TemplateID)
+	krmTemplate.ResourceVersion = ToResourceVersion(templateInfo.Version)
+	SetUpdatedAnnotation(&krmTemplate, templateInfo.Updated)
 	krmTemplate.Labels, _ = tkoutil.ToKubernetesNames(templateInfo.Metadata)
 
 	templateId := templateInfo.TemplateID
@@ -221,15 +226,22 @@ func TemplateFromKRM(object runtime.Object) (*backendpkg.Template, error) {
 		return nil, backendpkg.NewBadArgumentError(err.Error())
 	}
 
+	var version uint64
+	if version, err = FromResourceVersion(krmTemplate.ResourceVersion); err != nil {
+		return nil, err
+	}
+
 	var updated time.Time
-	if updated, err = FromResourceVersion(krmTemplate.ResourceVersion); err != nil {
+	if updated, err = GetUpdatedAnnotation(krmTemplate); err != nil {
 		return nil, err
 	}
 
 	template := backendpkg.Template{
 		TemplateInfo: backendpkg.TemplateInfo{
+			Namespace:  krmTemplate.Namespace,
 			TemplateID: templateId,
 			Updated:    updated,
+			Version:    version,
 		},
 	}
 
@@ -0,0 +1,286 @@
+package server
+
+import (
+	contextpkg "context"
+	"io"
+	"time"
+
+	krm "github.com/nephio-experimental/tko/api/krm/tko.nephio.org/v1alpha1"
+	backendpkg "github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/tliron/kutil/util"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metabase "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+var (
+	WatchBufferSize       = 100
+	WatchBookmarkInterval = time.Minute
+)
+
+//
+// StoreWatcher
+//
+
+type StoreWatcher struct {
+	*watch.ProxyWatcher
+
+	store     *Store
+	options   *internalversion.ListOptions
+	namespace string
+	revision  uint64
+	results   util.Results[backendpkg.Event]
+	events    chan watch.Event
+	cancel    contextpkg.CancelFunc
+}
+
+func NewStoreWatcher(store *Store, options *internalversion.ListOptions, namespace string, sinceRevision uint64, results util.Results[backendpkg.Event], cancel contextpkg.CancelFunc) *StoreWatcher {
+	events := make(chan watch.Event, WatchBufferSize)
+	return &StoreWatcher{
+		ProxyWatcher: watch.NewProxyWatcher(events),
+		store:        store,
+		options:      options,
+		namespace:    namespace,
+		revision:     sinceRevision,
+		results:      results,
+		events:       events,
+		cancel:       cancel,
+	}
+}
+
+// Sends events until the watcher is stopped or the context is done.
+func (self *StoreWatcher) Run(context contextpkg.Context, initialEvents bool) {
+	defer close(self.events)
+	defer self.results.Release()
+	defer self.cancel()
+
+	if initialEvents {
+		if err := self.sendInitialEvents(context); err != nil {
+			self.sendError(context, err)
+			return
+		}
+	}
+
+	backendEvents := make(chan backendpkg.Event)
+	backendErrors := make(chan error, 1)
+	go func() {
+		for {
+			if event, err := self.results.Next(); err == nil {
+				select {
+				case backendEvents <- event:
+				case <-context.Done():
+					return
+				}
+			} else {
+				backendErrors <- err
+				return
+			}
+		}
+	}()
+
+	var bookmarks <-chan time.Time
+	if self.options.AllowWatchBookmarks {
+		ticker := time.NewTicker(WatchBookmarkInterval)
+		defer ticker.Stop()
+		bookmarks = ticker.C
+	}
+	bookmarkRevision := self.revision
+
+	for {
+		select {
+		case event := <-backendEvents:
+			self.revision = event.Revision
+			if event_, ok := self.toWatchEvent(context, event); ok {
+				if !self.send(context, event_) {
+					return
+				}
+			}
+
+		case err := <-backendErrors:
+			if err != io.EOF {
+				self.sendError(context, err)
+			}
+			return
+
+		case <-bookmarks:
+			if self.revision != bookmarkRevision {
+				bookmarkRevision = self.revision
+				object := self.newObject(ToWatchResourceVersion(0, bookmarkRevision))
+				if !self.send(context, watch.Event{Type: watch.Bookmark, Object: object}) {
+					return
+				}
+			}
+
+		case <-self.StopChan():
+			return
+
+		case <-context.Done():
+			return
+		}
+	}
+}
+
+// Utils
+
+func (self *StoreWatcher) send(context contextpkg.Context, event watch.Event) bool {
+	select {
+	case self.events <- event:
+		return true
+	case <-self.StopChan():
+		return false
+	case <-context.Done():
+		return false
+	}
+}
+
+func (self *StoreWatcher) sendError(context contextpkg.Context, err error) {
+	self.store.Log.Errorf("Watch: %s", err.Error())
+
+	var statusError *apierrors.StatusError
+	if err == backendpkg.EventWatcherTooSlowError {
+		// Clients should re-list
+		statusError = apierrors.NewResourceExpired(err.Error())
+	} else if statusError_, ok := err.(*apierrors.StatusError); ok {
+		statusError = statusError_
+	} else {
+		statusError = apierrors.NewInternalError(err)
+	}
+
+	self.send(context, watch.Event{Type: watch.Error, Object: &statusError.ErrStatus})
+}
+
+func (self *StoreWatcher) sendInitialEvents(context contextpkg.Context) error {
+	options := self.options.DeepCopy()
+	options.ResourceVersion = ""
+	options.Limit = int64(backendpkg.DefaultMaxCount)
+
+	for {
+		if list, err := self.store.List(context, options); err == nil {
+			if objects, err := metabase.ExtractList(list); err == nil {
+				for _, object := range objects {
+					if accessor, err := metabase.Accessor(object); err == nil {
+						accessor.SetResourceVersion(AddRevisionToResourceVersion(accessor.GetResourceVersion(), self.revision))
+					} else {
+						return err
+					}
+
+					if !self.send(context, watch.Event{Type: watch.Added, Object: object}) {
+						return nil
+					}
+				}
+			} else {
+				return err
+			}
+
+			if list_, err := metabase.ListAccessor(list); err == nil {
+				if options.Continue = list_.GetContinue(); options.Continue == "" {
+					return nil
+				}
+			} else {
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+}
+
+func (self *StoreWatcher) toWatchEvent(context contextpkg.Context, event backendpkg.Event) (watch.Event, bool) {
+	if event.Kind != self.store.EventKind {
+		return watch.Event{}, false
+	}
+
+	if self.store.Namespaced && !backendpkg.NamespaceMatches(event.Namespace, self.namespace) {
+		return watch.Event{}, false
+	}
+
+	switch event.Type {
+	case backendpkg.EventTypeDeleted:
+		// We can't know the labels of a deleted object, so we will not filter by them
+		// (deleting an unknown object should be harmless for clients)
+		object := self.newObject(ToWatchResourceVersion(0, event.Revision))
+		if accessor, err := metabase.Accessor(object); err == nil {
+			if name, err := tkoutil.ToKubernetesName(event.ID); err == nil {
+				accessor.SetName(name)
+			} else {
+				self.store.Log.Errorf("Watch: %s", err.Error())
+				return watch.Event{}, false
+			}
+			if self.store.Namespaced {
+				accessor.SetNamespace(event.Namespace)
+				accessor.SetUID(ToUID(self.store.EventKind, event.Namespace, event.ID))
+			} else {
+				accessor.SetUID(ToUID(self.store.EventKind, event.ID))
+			}
+		}
+
+		if !self.matches(object, false) {
+			return watch.Event{}, false
+		}
+
+		return watch.Event{Type: watch.Deleted, Object: object}, true
+
+	default:
+		object, err := self.store.GetFunc(context, self.store, event.Namespace, event.ID)
+		if err != nil {
+			// The object may have been deleted in the meantime, in which case we will get its own event
+			if !backendpkg.IsNotFoundError(err) {
+				self.store.Log.Errorf("Watch: %s", err.Error())
+			}
+			return watch.Event{}, false
+		}
+
+		if accessor, err := metabase.Accessor(object); err == nil {
+			accessor.SetResourceVersion(AddRevisionToResourceVersion(accessor.GetResourceVersion(), event.Revision))
+		}
+
+		if !self.matches(object, true) {
+			return watch.Event{}, false
+		}
+
+		type_ := watch.Modified
+		if event.Type == backendpkg.EventTypeAdded {
+			type_ = watch.Added
+		}
+
+		return watch.Event{Type: type_, Object: object}, true
+	}
+}
+
+func (self *StoreWatcher) newObject(resourceVersion string) runtime.Object {
+	object := self.store.NewObjectFunc()
+	object.GetObjectKind().SetGroupVersionKind(krm.SchemeGroupVersion.WithKind(self.store.TypeKind))
+	if accessor, err := metabase.Accessor(object); err == nil {
+		accessor.SetResourceVersion(resourceVersion)
+	}
+	return object
+}
+
+func (self *StoreWatcher) matches(object runtime.Object, withLabels bool) bool {
+	if (self.options.FieldSelector != nil) && !self.options.FieldSelector.Empty() {
+		if fields, err := self.store.GetFieldsFunc(object); err == nil {
+			if !self.options.FieldSelector.Matches(fields) {
+				return false
+			}
+		} else {
+			self.store.Log.Errorf("Watch: %s", err.Error())
+			return false
+		}
+	}
+
+	if withLabels && (self.options.LabelSelector != nil) && !self.options.LabelSelector.Empty() {
+		if accessor, err := metabase.Accessor(object); err == nil {
+			if !self.options.LabelSelector.Matches(labels.Set(accessor.GetLabels())) {
+				return false
+			}
+		} else {
+			return false
+		}
+	}
+
+	return true
+}
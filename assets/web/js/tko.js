@@ -6,6 +6,7 @@ $(document).ready(function () {
   syncJson('about', 'api/about');
 
   syncTable('deployments', 'api/deployment/list', [
+    ['namespace'],
     ['id', 'api/deployment?id=', 'deployments'],
     ['template', 'api/template?id=', 'templates'],
     ['parent', 'api/deployment?id=', 'deployments'],
@@ -18,6 +19,7 @@ $(document).ready(function () {
   ]);
 
   syncTable('sites', 'api/site/list', [
+    ['namespace'],
     ['id', 'api/site?id=', 'sites'],
     ['template', 'api/template?id=', 'templates'],
     ['metadata'],
@@ -26,6 +28,7 @@ $(document).ready(function () {
   ]);
 
   syncTable('templates', 'api/template/list', [
+    ['namespace'],
     ['id', 'api/template?id=', 'templates'],
     ['metadata'],
     ['deployments', 'api/deployment?id=', 'deployments'],
@@ -155,11 +158,11 @@ function hideTab(tab) {
 }
 
 function updateTable(tbody, rows, columns) {
-  const ids = rows.map(row => row.id);
+  const keys = rows.map(row => rowKey(row));
 
   // Removed rows
   eachTableRow(tbody, function (tr) {
-    if (!ids.includes(tr.data('id')))
+    if (!keys.includes(tr.data('key')))
       tr.remove();
     return true;
   });
@@ -169,7 +172,7 @@ function updateTable(tbody, rows, columns) {
 
     // Existing row
     eachTableRow(tbody, function (tr) {
-      if (tr.data('id') == row.id) {
+      if (tr.data('key') == rowKey(row)) {
         newRow = false;
         // Replace row if necessary
         if (!deepEqual(tr.data('row'), row)) {
@@ -187,7 +190,7 @@ function updateTable(tbody, rows, columns) {
 
       eachTableRow(tbody, function (tr) {
         // Insert before higher order row
-        if (tr.data('id') > row.id) {
+        if (tr.data('key') > rowKey(row)) {
           newRow = false;
           tr.before(newTr);
           return false;
@@ -211,7 +214,7 @@ function eachTableRow(tbody, f) {
 function createTr(row, columns) {
   const tr = $('<tr></tr>');
 
-  tr.data('id', row.id);
+  tr.data('key', rowKey(row));
   tr.data('row', row);
 
   for (const column of columns) {
@@ -227,12 +230,12 @@ function createTr(row, columns) {
         value.forEach(function (e) {
           if (!first)
             td.append('<br/>');
-          td.append(newLink(e, urlPrefix, tab));
+          td.append(newLink(e, row.namespace, urlPrefix, tab));
           first = false;
         });
       } else
         // Single link
-        td.append(newLink(value, urlPrefix, tab));
+        td.append(newLink(value, row.namespace, urlPrefix, tab));
     else if (name.endsWith('Timestamp'))
       td.append(escapeContent(dateTimeFormat.format(new Date(value))));
     else
@@ -244,16 +247,23 @@ function createTr(row, columns) {
   return tr;
 }
 
-function newLink(value, urlPrefix, tab) {
+function rowKey(row) {
+  // Plugins have no namespace
+  if (row.namespace === undefined)
+    return row.id;
+  return row.namespace + '/' + row.id;
+}
+
+function newLink(value, namespace, urlPrefix, tab) {
   const link = $('<span class="entity-link">' + renderContent(value) + '</span>');
   link.click(function () {
     $.get({
-      url: urlPrefix + value,
+      url: urlPrefix + encodeURIComponent(value) + '&namespace=' + encodeURIComponent(namespace),
       dataType: 'text',
       success: function(content) {
         // Show details tab
         content = highlight(content, 'yaml');
-        $('#'+tab+'-title').html(value);
+        $('#'+tab+'-title').html(escapeContent(namespace + '/' + value));
         $('#'+tab+'-yaml').html(content);
         $('#'+tab+'-list').hide();
         $('#'+tab+'-details').show();
@@ -0,0 +1,17 @@
+package archive
+
+import (
+	"github.com/nephio-experimental/tko/backend"
+)
+
+//
+// Entry
+//
+
+// Exactly one of the fields is set.
+type Entry struct {
+	Template   *backend.Template
+	Site       *backend.Site
+	Plugin     *backend.Plugin
+	Deployment *backend.Deployment
+}
@@ -0,0 +1,123 @@
+package archive
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+type ExportFunc func(entry Entry) error
+
+// Exports all templates, sites, and deployments in the namespace. For [backend.AllNamespaces]
+// plugins are exported, too.
+//
+// Entries are exported in an order that allows them to be imported: templates, then sites,
+// then plugins, and finally deployments, with parent deployments before their children.
+func Export(context contextpkg.Context, backend_ backend.Backend, namespace string, export ExportFunc) error {
+	namespace = backend.NormalizeNamespace(namespace)
+
+	// Templates
+	templateInfos := util.CombineResults(func(offset uint) (util.Results[backend.TemplateInfo], error) {
+		return backend_.ListTemplates(context, backend.SelectTemplates{Namespace: namespace}, backend.Window{Offset: offset, MaxCount: -1})
+	})
+	if err := util.IterateResults(templateInfos, func(templateInfo backend.TemplateInfo) error {
+		if template, err := backend_.GetTemplate(context, templateInfo.Namespace, templateInfo.TemplateID); err == nil {
+			return export(Entry{Template: template})
+		} else if backend.IsNotFoundError(err) {
+			// Deleted while exporting
+			return nil
+		} else {
+			return err
+		}
+	}); err != nil {
+		return err
+	}
+
+	// Sites
+	siteInfos := util.CombineResults(func(offset uint) (util.Results[backend.SiteInfo], error) {
+		return backend_.ListSites(context, backend.SelectSites{Namespace: namespace}, backend.Window{Offset: offset, MaxCount: -1})
+	})
+	if err := util.IterateResults(siteInfos, func(siteInfo backend.SiteInfo) error {
+		if site, err := backend_.GetSite(context, siteInfo.Namespace, siteInfo.SiteID); err == nil {
+			return export(Entry{Site: site})
+		} else if backend.IsNotFoundError(err) {
+			// Deleted while exporting
+			return nil
+		} else {
+			return err
+		}
+	}); err != nil {
+		return err
+	}
+
+	// Plugins are cluster-wide
+	if namespace == backend.AllNamespaces {
+		plugins := util.CombineResults(func(offset uint) (util.Results[backend.Plugin], error) {
+			return backend_.ListPlugins(context, backend.SelectPlugins{}, backend.Window{Offset: offset, MaxCount: -1})
+		})
+		if err := util.IterateResults(plugins, func(plugin backend.Plugin) error {
+			return export(Entry{Plugin: &plugin})
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Deployments
+	deploymentInfos := util.CombineResults(func(offset uint) (util.Results[backend.DeploymentInfo], error) {
+		return backend_.ListDeployments(context, backend.SelectDeployments{Namespace: namespace}, backend.Window{Offset: offset, MaxCount: -1})
+	})
+	deploymentInfos_, err := util.GatherResults(deploymentInfos)
+	if err != nil {
+		return err
+	}
+	for _, deploymentInfo := range SortDeploymentInfosParentsFirst(deploymentInfos_) {
+		if deployment, err := backend_.GetDeployment(context, deploymentInfo.Namespace, deploymentInfo.DeploymentID); err == nil {
+			if err := export(Entry{Deployment: deployment}); err != nil {
+				return err
+			}
+		} else if !backend.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the deployment infos ordered such that parents come before their children.
+// Otherwise the original order is kept. Parents that are not in the slice are ignored.
+func SortDeploymentInfosParentsFirst(deploymentInfos []backend.DeploymentInfo) []backend.DeploymentInfo {
+	children := make(map[string][]backend.DeploymentInfo)
+	ids := make(map[string]struct{})
+	for _, deploymentInfo := range deploymentInfos {
+		ids[deploymentInfo.DeploymentID] = struct{}{}
+	}
+
+	var roots []backend.DeploymentInfo
+	for _, deploymentInfo := range deploymentInfos {
+		if _, ok := ids[deploymentInfo.ParentDeploymentID]; ok && (deploymentInfo.ParentDeploymentID != deploymentInfo.DeploymentID) {
+			children[deploymentInfo.ParentDeploymentID] = append(children[deploymentInfo.ParentDeploymentID], deploymentInfo)
+		} else {
+			roots = append(roots, deploymentInfo)
+		}
+	}
+
+	sorted := make([]backend.DeploymentInfo, 0, len(deploymentInfos))
+	added := make(map[string]struct{})
+	var add func(deploymentInfos []backend.DeploymentInfo)
+	add = func(deploymentInfos []backend.DeploymentInfo) {
+		for _, deploymentInfo := range deploymentInfos {
+			if _, ok := added[deploymentInfo.DeploymentID]; !ok {
+				added[deploymentInfo.DeploymentID] = struct{}{}
+				sorted = append(sorted, deploymentInfo)
+				add(children[deploymentInfo.DeploymentID])
+			}
+		}
+	}
+	add(roots)
+
+	// Cycles should not happen, but just in case we make sure not to lose them
+	add(deploymentInfos)
+
+	return sorted
+}
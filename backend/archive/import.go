@@ -0,0 +1,239 @@
+package archive
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+const (
+	ModeMerge   = "merge"
+	ModeReplace = "replace"
+
+	ModesDescription = "\"merge\" or \"replace\""
+)
+
+func IsValidMode(mode string) bool {
+	switch mode {
+	case ModeMerge, ModeReplace:
+		return true
+	default:
+		return false
+	}
+}
+
+//
+// Importer
+//
+
+// Imports entries in the order in which they were exported by [Export].
+//
+// In merge mode entries are imported as they arrive, and are added to the existing entities,
+// replacing those with the same IDs.
+//
+// In replace mode all the existing templates, sites, and deployments in every namespace that
+// has entries are purged, as are all existing plugins if there are plugin entries. Namespaces
+// that have no entries are not affected. In order to not purge anything for an incomplete or
+// inconsistent archive, entries are staged in memory and are only validated, purged, and
+// imported by [Importer.Finish]. Note that the backend has no transactions, so replace mode is
+// still not atomic: if importing fails after purging, the namespaces are left partially
+// imported. MaxStagedEntries bounds the memory used for staging.
+type Importer struct {
+	Backend          backend.Backend
+	Mode             string
+	MaxStagedEntries uint // replace mode; 0 for no limit
+
+	Templates   uint
+	Sites       uint
+	Plugins     uint
+	Deployments uint
+
+	staged []Entry // replace mode
+}
+
+// Empty mode defaults to merge. Can return BadArgumentError.
+func NewImporter(backend_ backend.Backend, mode string) (*Importer, error) {
+	if mode == "" {
+		mode = ModeMerge
+	}
+	if !IsValidMode(mode) {
+		return nil, backend.NewBadArgumentErrorf("mode must be %s: %s", ModesDescription, mode)
+	}
+
+	return &Importer{
+		Backend: backend_,
+		Mode:    mode,
+	}, nil
+}
+
+// In replace mode the entry is only staged, see [Importer.Finish]. Can return BadArgumentError.
+func (self *Importer) Import(context contextpkg.Context, entry Entry) error {
+	if (entry.Template == nil) && (entry.Site == nil) && (entry.Plugin == nil) && (entry.Deployment == nil) {
+		return backend.NewBadArgumentError("archive entry is empty")
+	}
+
+	if self.Mode == ModeReplace {
+		if (self.MaxStagedEntries != 0) && (uint(len(self.staged)) >= self.MaxStagedEntries) {
+			return backend.NewBadArgumentErrorf("archive has more than %d entries, which is the maximum for replace mode", self.MaxStagedEntries)
+		}
+		self.staged = append(self.staged, entry)
+		return nil
+	}
+
+	return self.import_(context, entry)
+}
+
+// Must be called after all entries have been imported. Does nothing in merge mode.
+//
+// In replace mode validates that the staged entries only refer to each other, purges, and
+// imports them. Can return BadArgumentError.
+func (self *Importer) Finish(context contextpkg.Context) error {
+	if self.Mode != ModeReplace {
+		return nil
+	}
+
+	if err := self.validateStaged(); err != nil {
+		return err
+	}
+
+	namespaces := make(map[string]struct{})
+	var plugins bool
+	for _, entry := range self.staged {
+		switch {
+		case entry.Template != nil:
+			namespaces[backend.NormalizeNamespace(entry.Template.Namespace)] = struct{}{}
+		case entry.Site != nil:
+			namespaces[backend.NormalizeNamespace(entry.Site.Namespace)] = struct{}{}
+		case entry.Plugin != nil:
+			plugins = true
+		case entry.Deployment != nil:
+			namespaces[backend.NormalizeNamespace(entry.Deployment.Namespace)] = struct{}{}
+		}
+	}
+
+	for namespace := range namespaces {
+		if err := self.purgeNamespace(context, namespace); err != nil {
+			return err
+		}
+	}
+
+	if plugins {
+		if err := self.Backend.PurgePlugins(context, backend.SelectPlugins{}); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range self.staged {
+		if err := self.import_(context, entry); err != nil {
+			return err
+		}
+	}
+
+	self.staged = nil
+	return nil
+}
+
+func (self *Importer) import_(context contextpkg.Context, entry Entry) error {
+	switch {
+	case entry.Template != nil:
+		template := entry.Template
+		template.DeploymentIDs = nil
+		if err := self.Backend.ImportTemplate(context, template); err != nil {
+			return err
+		}
+		self.Templates++
+
+	case entry.Site != nil:
+		site := entry.Site
+		site.DeploymentIDs = nil
+		if err := self.Backend.ImportSite(context, site); err != nil {
+			return err
+		}
+		self.Sites++
+
+	case entry.Plugin != nil:
+		plugin := entry.Plugin
+		plugin.Version = 0
+		if err := self.Backend.SetPlugin(context, plugin); err != nil {
+			return err
+		}
+		self.Plugins++
+
+	case entry.Deployment != nil:
+		deployment := entry.Deployment
+		if err := self.Backend.ImportDeployment(context, deployment); err != nil {
+			return err
+		}
+		self.Deployments++
+	}
+
+	return nil
+}
+
+// Because the namespaces are purged, references must be to entries in the archive, and parent
+// deployments must come before their children.
+func (self *Importer) validateStaged() error {
+	type namespacedID struct {
+		namespace string
+		id        string
+	}
+
+	templates := make(map[namespacedID]struct{})
+	sites := make(map[namespacedID]struct{})
+	deployments := make(map[namespacedID]struct{})
+
+	for _, entry := range self.staged {
+		switch {
+		case entry.Template != nil:
+			templates[namespacedID{backend.NormalizeNamespace(entry.Template.Namespace), entry.Template.TemplateID}] = struct{}{}
+
+		case entry.Site != nil:
+			sites[namespacedID{backend.NormalizeNamespace(entry.Site.Namespace), entry.Site.SiteID}] = struct{}{}
+		}
+	}
+
+	for _, entry := range self.staged {
+		switch {
+		case entry.Site != nil:
+			site := entry.Site
+			if site.TemplateID != "" {
+				if _, ok := templates[namespacedID{backend.NormalizeNamespace(site.Namespace), site.TemplateID}]; !ok {
+					return backend.NewBadArgumentErrorf("site %s/%s refers to template not in archive: %s", site.Namespace, site.SiteID, site.TemplateID)
+				}
+			}
+
+		case entry.Deployment != nil:
+			deployment := entry.Deployment
+			namespace := backend.NormalizeNamespace(deployment.Namespace)
+			if deployment.ParentDeploymentID != "" {
+				if _, ok := deployments[namespacedID{namespace, deployment.ParentDeploymentID}]; !ok {
+					return backend.NewBadArgumentErrorf("deployment %s/%s refers to parent deployment not before it in archive: %s", deployment.Namespace, deployment.DeploymentID, deployment.ParentDeploymentID)
+				}
+			}
+			if deployment.TemplateID != "" {
+				if _, ok := templates[namespacedID{namespace, deployment.TemplateID}]; !ok {
+					return backend.NewBadArgumentErrorf("deployment %s/%s refers to template not in archive: %s", deployment.Namespace, deployment.DeploymentID, deployment.TemplateID)
+				}
+			}
+			if deployment.SiteID != "" {
+				if _, ok := sites[namespacedID{namespace, deployment.SiteID}]; !ok {
+					return backend.NewBadArgumentErrorf("deployment %s/%s refers to site not in archive: %s", deployment.Namespace, deployment.DeploymentID, deployment.SiteID)
+				}
+			}
+			deployments[namespacedID{namespace, deployment.DeploymentID}] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (self *Importer) purgeNamespace(context contextpkg.Context, namespace string) error {
+	// Deployments first, because they are associated with templates and sites
+	if err := self.Backend.PurgeDeployments(context, backend.SelectDeployments{Namespace: namespace}, backend.PropagationOrphan); err != nil {
+		return err
+	}
+	if err := self.Backend.PurgeSites(context, backend.SelectSites{Namespace: namespace}); err != nil {
+		return err
+	}
+	return self.Backend.PurgeTemplates(context, backend.SelectTemplates{Namespace: namespace})
+}
@@ -0,0 +1,137 @@
+package backend
+
+import (
+	contextpkg "context"
+	"slices"
+	"time"
+)
+
+const (
+	AuditTypeTemplate   = "template"
+	AuditTypeSite       = "site"
+	AuditTypeDeployment = "deployment"
+	AuditTypePlugin     = "plugin"
+
+	AuditOperationRegister    = "register"
+	AuditOperationDelete      = "delete"
+	AuditOperationPurge       = "purge"
+	AuditOperationAcknowledge = "acknowledge"
+	AuditOperationCreate      = "create"
+	AuditOperationModify      = "modify"
+	AuditOperationApprove     = "approve"
+	AuditOperationUnapprove   = "unapprove"
+	AuditOperationRevert      = "revert"
+	AuditOperationImport      = "import"
+
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+	AuditOutcomeDenied  = "denied"
+)
+
+//
+// AuditEvent
+//
+
+type AuditEvent struct {
+	ID        uint64    // monotonically increasing; assigned by the backend
+	Timestamp time.Time // millisecond precision
+	Actor     string
+	Operation string
+	Type      string
+	Namespace string   // empty for plugins
+	IDs       []string // targets; for purges and bulk modifications these are only known on success
+	Selector  string   // for purges and bulk modifications
+	Outcome   string   // "success", "failure", or "denied"
+	Reason    string   // error message if not successful
+	Diff      string   // content diff summary
+
+	ClaimedAuthor string // as supplied by the caller when it differs from the actor; not verified
+}
+
+// The actor is the identity, falling back to the author only if there is no identity.
+func NewAuditEvent(context contextpkg.Context, operation string, type_ string, namespace string, ids ...string) *AuditEvent {
+	return &AuditEvent{
+		Timestamp:     time.Now().UTC(),
+		Actor:         GetActor(context),
+		Operation:     operation,
+		Type:          type_,
+		Namespace:     namespace,
+		IDs:           ids,
+		ClaimedAuthor: GetClaimedAuthor(context),
+	}
+}
+
+// Sets the outcome according to the error.
+func (self *AuditEvent) SetOutcome(err error) {
+	if err == nil {
+		self.Outcome = AuditOutcomeSuccess
+		self.Reason = ""
+	} else {
+		if IsNotAuthorizedError(err) {
+			self.Outcome = AuditOutcomeDenied
+		} else {
+			self.Outcome = AuditOutcomeFailure
+		}
+		self.Reason = err.Error()
+	}
+}
+
+func (self *AuditEvent) Clone() AuditEvent {
+	clone := *self
+	clone.IDs = slices.Clone(self.IDs)
+	return clone
+}
+
+//
+// SelectAuditEvents
+//
+
+type SelectAuditEvents struct {
+	Namespace string    // can be AllNamespaces; plugin events are only included for AllNamespaces
+	Since     time.Time // inclusive; zero for no lower bound
+	Until     time.Time // exclusive; zero for no upper bound
+	Actors    []string  // empty for all actors
+}
+
+func (self *SelectAuditEvents) Matches(event *AuditEvent) bool {
+	if !NamespaceMatches(event.Namespace, self.Namespace) {
+		return false
+	}
+	if !self.Since.IsZero() && event.Timestamp.Before(self.Since) {
+		return false
+	}
+	if !self.Until.IsZero() && !event.Timestamp.Before(self.Until) {
+		return false
+	}
+	return (len(self.Actors) == 0) || slices.Contains(self.Actors, event.Actor)
+}
+
+// Returns the name of the identity (which may be anonymous) if there is one. Only if there is
+// no identity, i.e. authentication is not configured, does it fall back to the author.
+func GetActor(context contextpkg.Context) string {
+	if identity := GetIdentity(context); identity != nil {
+		return identity.Name
+	}
+	return GetAuthor(context)
+}
+
+type claimedAuthorContextKey struct{}
+
+// Returns a copy of the context that carries the author as claimed by the caller, for when
+// the author is overridden by the authenticated identity.
+func ContextWithClaimedAuthor(context contextpkg.Context, author string) contextpkg.Context {
+	return contextpkg.WithValue(context, claimedAuthorContextKey{}, author)
+}
+
+// Returns the author as claimed by the caller, or empty if it is the same as the actor.
+// Claimed authors are not verified and must not be trusted.
+func GetClaimedAuthor(context contextpkg.Context) string {
+	author, ok := context.Value(claimedAuthorContextKey{}).(string)
+	if !ok {
+		author = GetAuthor(context)
+	}
+	if author != GetActor(context) {
+		return author
+	}
+	return ""
+}
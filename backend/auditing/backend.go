@@ -0,0 +1,85 @@
+package auditing
+
+import (
+	contextpkg "context"
+	"sync"
+	"time"
+
+	backendpkg "github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/commonlog"
+)
+
+// Modification tokens that are neither ended nor cancelled are forgotten after this duration.
+var ModificationTrackingDuration = time.Hour
+
+var _ backendpkg.Backend = new(AuditingBackend)
+
+//
+// AuditingBackend
+//
+
+type AuditingBackend struct {
+	Backend backendpkg.Backend
+	Sinks   []Sink
+
+	log               commonlog.Logger
+	modifications     map[string]modification // key is modification token
+	modificationsLock sync.Mutex
+}
+
+// Wraps an existing backend with recording of all mutating operations to the sinks.
+// Failing to record is logged but does not fail the operation.
+//
+// To also record denied operations, this should wrap the authorizing backend.
+func NewAuditingBackend(backend backendpkg.Backend, log commonlog.Logger, sinks ...Sink) *AuditingBackend {
+	return &AuditingBackend{
+		Backend:       backend,
+		Sinks:         sinks,
+		log:           log,
+		modifications: make(map[string]modification),
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) Connect(context contextpkg.Context) error {
+	return self.Backend.Connect(context)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) Release(context contextpkg.Context) error {
+	for _, sink := range self.Sinks {
+		if err := sink.Release(); err != nil {
+			self.log.Errorf("release audit sink: %s", err.Error())
+		}
+	}
+
+	return self.Backend.Release(context)
+}
+
+// ([fmt.Stringer] interface)
+// ([backend.Backend] interface)
+func (self *AuditingBackend) String() string {
+	return self.Backend.String()
+}
+
+func (self *AuditingBackend) record(context contextpkg.Context, event *backendpkg.AuditEvent, err error) {
+	event.SetOutcome(err)
+
+	// The operation's context may be done by now
+	context = contextpkg.WithoutCancel(context)
+
+	for _, sink := range self.Sinks {
+		if err := sink.Record(context, event); err != nil {
+			self.log.Errorf("record audit event: %s", err.Error())
+		}
+	}
+}
+
+//
+// modification
+//
+
+type modification struct {
+	deployment *backendpkg.Deployment
+	started    time.Time
+}
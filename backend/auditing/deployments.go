@@ -0,0 +1,158 @@
+package auditing
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	validationpkg "github.com/nephio-experimental/tko/validation"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) CreateDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationCreate, backend.AuditTypeDeployment, backend.NormalizeNamespace(deployment.Namespace))
+	err := self.Backend.CreateDeployment(context, deployment)
+	if deployment.DeploymentID != "" {
+		// Assigned by the backend
+		event.IDs = []string{deployment.DeploymentID}
+	}
+	event.Diff = joinDiff(DiffPackages(nil, deployment.Package), DiffMetadata(nil, deployment.Metadata))
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
+	return self.Backend.GetDeployment(context, namespace, deploymentId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationDelete, backend.AuditTypeDeployment, backend.NormalizeNamespace(namespace), deploymentId)
+	err := self.Backend.DeleteDeployment(context, namespace, deploymentId, propagation)
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ListDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, window backend.Window) (util.Results[backend.DeploymentInfo], error) {
+	return self.Backend.ListDeployments(context, selectDeployments, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationPurge, backend.AuditTypeDeployment, backend.NormalizeNamespace(selectDeployments.Namespace))
+	event.Selector = FormatSelector(selectDeployments)
+	err := self.Backend.PurgeDeployments(context, selectDeployments, propagation)
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
+	// Recorded when ended
+	if modificationToken, deployment, err := self.Backend.StartDeploymentModification(context, namespace, deploymentId); err == nil {
+		self.trackModification(modificationToken, deployment)
+		return modificationToken, deployment, nil
+	} else {
+		return "", nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) EndDeploymentModification(context contextpkg.Context, modificationToken string, package_ tkoutil.Package, validation *validationpkg.Validation) (string, error) {
+	event := backend.NewAuditEvent(context, backend.AuditOperationModify, backend.AuditTypeDeployment, "")
+
+	// The modification may have been started elsewhere, in which case we know less
+	before, tracked := self.untrackModification(modificationToken)
+	if tracked {
+		event.Namespace = before.Namespace
+		event.IDs = []string{before.DeploymentID}
+		if approved := isApproved(package_); approved != before.Approved {
+			if approved {
+				event.Operation = backend.AuditOperationApprove
+			} else {
+				event.Operation = backend.AuditOperationUnapprove
+			}
+		}
+		event.Diff = DiffPackages(before.Package, package_)
+	}
+
+	deploymentId, err := self.Backend.EndDeploymentModification(context, modificationToken, package_, validation)
+	if deploymentId != "" {
+		event.IDs = []string{deploymentId}
+	}
+	self.record(context, event, err)
+	return deploymentId, err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) CancelDeploymentModification(context contextpkg.Context, modificationToken string) error {
+	// Nothing changes, so nothing is recorded
+	self.untrackModification(modificationToken)
+	return self.Backend.CancelDeploymentModification(context, modificationToken)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	operation := backend.AuditOperationModify
+	if modifyDeployments.Approved != nil {
+		if *modifyDeployments.Approved {
+			operation = backend.AuditOperationApprove
+		} else {
+			operation = backend.AuditOperationUnapprove
+		}
+	}
+
+	event := backend.NewAuditEvent(context, operation, backend.AuditTypeDeployment, backend.NormalizeNamespace(selectDeployments.Namespace))
+	event.Selector = FormatSelector(selectDeployments)
+	event.Diff = DiffModifyDeployments(modifyDeployments)
+
+	results, err := self.Backend.ModifyDeployments(context, selectDeployments, modifyDeployments)
+	for _, result := range results {
+		if result.Modified {
+			event.IDs = append(event.IDs, result.DeploymentID)
+		}
+	}
+	self.record(context, event, err)
+	return results, err
+}
+
+// Utils
+
+func (self *AuditingBackend) trackModification(modificationToken string, deployment *backend.Deployment) {
+	self.modificationsLock.Lock()
+	defer self.modificationsLock.Unlock()
+
+	now := time.Now()
+	for modificationToken_, modification := range self.modifications {
+		if now.Sub(modification.started) > ModificationTrackingDuration {
+			delete(self.modifications, modificationToken_)
+		}
+	}
+
+	self.modifications[modificationToken] = modification{
+		deployment: deployment.Clone(true),
+		started:    now,
+	}
+}
+
+func (self *AuditingBackend) untrackModification(modificationToken string) (*backend.Deployment, bool) {
+	self.modificationsLock.Lock()
+	defer self.modificationsLock.Unlock()
+
+	if modification, ok := self.modifications[modificationToken]; ok {
+		delete(self.modifications, modificationToken)
+		return modification.deployment, true
+	}
+	return nil, false
+}
+
+func isApproved(package_ tkoutil.Package) bool {
+	if deployment, ok := tkoutil.DeploymentResourceIdentifier.GetResource(package_); ok {
+		return tkoutil.IsApprovedAnnotation(deployment)
+	}
+	return false
+}
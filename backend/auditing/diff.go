@@ -0,0 +1,173 @@
+package auditing
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+)
+
+// Summarizes the difference between two packages by resource, e.g.
+// "added: Deployment/nginx; changed: ConfigMap/config". The before package can be nil.
+// Returns an empty string if there is no difference.
+func DiffPackages(before tkoutil.Package, after tkoutil.Package) string {
+	beforeHashes := hashResources(before)
+	afterHashes := hashResources(after)
+
+	var added, changed, removed []string
+	for key, afterHash := range afterHashes {
+		if beforeHash, ok := beforeHashes[key]; !ok {
+			added = append(added, key)
+		} else if beforeHash != afterHash {
+			changed = append(changed, key)
+		}
+	}
+	for key := range beforeHashes {
+		if _, ok := afterHashes[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	return joinDiff(
+		diffList("added", added),
+		diffList("changed", changed),
+		diffList("removed", removed),
+	)
+}
+
+// Summarizes the changed metadata keys. The before metadata can be nil.
+// Returns an empty string if there is no difference.
+func DiffMetadata(before map[string]string, after map[string]string) string {
+	var changed []string
+	for key, value := range after {
+		if beforeValue, ok := before[key]; !ok || (beforeValue != value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+
+	return diffList("metadata", changed)
+}
+
+// Summarizes the changed plugin fields. The before plugin can be nil.
+// Returns an empty string if there is no difference.
+func DiffPlugins(before *backend.Plugin, after *backend.Plugin) string {
+	if before == nil {
+		return "added"
+	}
+
+	var changed []string
+	if before.Executor != after.Executor {
+		changed = append(changed, "executor")
+	}
+	if !slices.Equal(before.Arguments, after.Arguments) {
+		changed = append(changed, "arguments")
+	}
+	if DiffMetadata(before.Properties, after.Properties) != "" {
+		changed = append(changed, "properties")
+	}
+	if !slices.Equal(before.TriggersAsStrings(), after.TriggersAsStrings()) {
+		changed = append(changed, "triggers")
+	}
+
+	return diffList("changed", changed)
+}
+
+// Summarizes a bulk modification.
+func DiffModifyDeployments(modifyDeployments backend.ModifyDeployments) string {
+	var approved string
+	if modifyDeployments.Approved != nil {
+		approved = "approved: " + strconv.FormatBool(*modifyDeployments.Approved)
+	}
+
+	setMetadata := make([]string, 0, len(modifyDeployments.SetMetadata))
+	for key := range modifyDeployments.SetMetadata {
+		setMetadata = append(setMetadata, key)
+	}
+
+	return joinDiff(
+		approved,
+		diffList("metadata set", setMetadata),
+		diffList("metadata deleted", slices.Clone(modifyDeployments.DeleteMetadata)),
+	)
+}
+
+// Formats a select struct as compact JSON, omitting empty fields.
+func FormatSelector(select_ any) string {
+	if content, err := json.Marshal(select_); err == nil {
+		var fields map[string]any
+		if err := json.Unmarshal(content, &fields); err == nil {
+			for key, value := range fields {
+				if isEmpty(value) {
+					delete(fields, key)
+				}
+			}
+
+			if content, err := json.Marshal(fields); err == nil {
+				return string(content)
+			}
+		}
+	}
+
+	return ""
+}
+
+// Utils
+
+// Resources without an identifier are keyed by their index.
+func hashResources(package_ tkoutil.Package) map[string]string {
+	hashes := make(map[string]string)
+	for index, resource := range package_ {
+		var key string
+		if resourceIdentifier, ok := tkoutil.NewResourceIdentifierForResource(resource); ok {
+			key = resourceIdentifier.GVK.Kind + "/" + resourceIdentifier.Name
+		} else {
+			key = fmt.Sprintf("#%d", index)
+		}
+
+		hash, _ := tkoutil.HashPackage(tkoutil.Package{resource})
+		hashes[key] = hash
+	}
+	return hashes
+}
+
+func diffList(name string, keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	slices.Sort(keys)
+	return name + ": " + strings.Join(keys, ", ")
+}
+
+func joinDiff(parts ...string) string {
+	var parts_ []string
+	for _, part := range parts {
+		if part != "" {
+			parts_ = append(parts_, part)
+		}
+	}
+	return strings.Join(parts_, "; ")
+}
+
+func isEmpty(value any) bool {
+	switch value_ := value.(type) {
+	case nil:
+		return true
+	case string:
+		return value_ == ""
+	case []any:
+		return len(value_) == 0
+	case map[string]any:
+		return len(value_) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,18 @@
+package auditing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	return self.Backend.Watch(context, selectEvents)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	return self.Backend.GetEventRevision(context)
+}
@@ -0,0 +1,58 @@
+package auditing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationImport, backend.AuditTypeTemplate, backend.NormalizeNamespace(template.Namespace), template.TemplateID)
+
+	var before *backend.Template
+	if before_, err := self.Backend.GetTemplate(context, template.Namespace, template.TemplateID); err == nil {
+		before = before_
+	} else {
+		before = new(backend.Template)
+	}
+
+	err := self.Backend.ImportTemplate(context, template)
+	event.Diff = joinDiff(DiffPackages(before.Package, template.Package), DiffMetadata(before.Metadata, template.Metadata))
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationImport, backend.AuditTypeSite, backend.NormalizeNamespace(site.Namespace), site.SiteID)
+
+	var before *backend.Site
+	if before_, err := self.Backend.GetSite(context, site.Namespace, site.SiteID); err == nil {
+		before = before_
+	} else {
+		before = new(backend.Site)
+	}
+
+	err := self.Backend.ImportSite(context, site)
+	event.Diff = joinDiff(DiffPackages(before.Package, site.Package), DiffMetadata(before.Metadata, site.Metadata))
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationImport, backend.AuditTypeDeployment, backend.NormalizeNamespace(deployment.Namespace), deployment.DeploymentID)
+
+	var before *backend.Deployment
+	if before_, err := self.Backend.GetDeployment(context, deployment.Namespace, deployment.DeploymentID); err == nil {
+		before = before_
+	} else {
+		before = new(backend.Deployment)
+	}
+
+	err := self.Backend.ImportDeployment(context, deployment)
+	event.Diff = joinDiff(DiffPackages(before.Package, deployment.Package), DiffMetadata(before.Metadata, deployment.Metadata))
+	self.record(context, event, err)
+	return err
+}
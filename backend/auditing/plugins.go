@@ -0,0 +1,50 @@
+package auditing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) SetPlugin(context contextpkg.Context, plugin *backend.Plugin) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationRegister, backend.AuditTypePlugin, "", plugin.PluginID.String())
+
+	var before *backend.Plugin
+	if before_, err := self.Backend.GetPlugin(context, plugin.PluginID); err == nil {
+		before = before_
+	}
+
+	event.Diff = DiffPlugins(before, plugin)
+	err := self.Backend.SetPlugin(context, plugin)
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) GetPlugin(context contextpkg.Context, pluginId backend.PluginID) (*backend.Plugin, error) {
+	return self.Backend.GetPlugin(context, pluginId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) DeletePlugin(context contextpkg.Context, pluginId backend.PluginID) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationDelete, backend.AuditTypePlugin, "", pluginId.String())
+	err := self.Backend.DeletePlugin(context, pluginId)
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ListPlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins, window backend.Window) (util.Results[backend.Plugin], error) {
+	return self.Backend.ListPlugins(context, selectPlugins, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) PurgePlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationPurge, backend.AuditTypePlugin, "")
+	event.Selector = FormatSelector(selectPlugins)
+	err := self.Backend.PurgePlugins(context, selectPlugins)
+	self.record(context, event, err)
+	return err
+}
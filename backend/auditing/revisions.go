@@ -0,0 +1,29 @@
+package auditing
+
+import (
+	contextpkg "context"
+	"strconv"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	return self.Backend.ListRevisions(context, type_, namespace, objectId, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	return self.Backend.GetRevision(context, revisionId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	// Revision types are the same as audit types
+	event := backend.NewAuditEvent(context, backend.AuditOperationRevert, revisionId.Type, backend.NormalizeNamespace(revisionId.Namespace), revisionId.ObjectID)
+	event.Diff = "to revision: " + strconv.FormatUint(revisionId.Revision, 10)
+	err := self.Backend.RevertTo(context, revisionId)
+	self.record(context, event, err)
+	return err
+}
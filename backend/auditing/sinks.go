@@ -0,0 +1,124 @@
+package auditing
+
+import (
+	contextpkg "context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	backendpkg "github.com/nephio-experimental/tko/backend"
+)
+
+const (
+	SinkBackend = "backend"
+	SinkFile    = "file"
+)
+
+//
+// Sink
+//
+
+type Sink interface {
+	// Should not keep the event after returning, but may set its ID.
+	Record(context contextpkg.Context, event *backendpkg.AuditEvent) error
+	Release() error
+}
+
+//
+// BackendSink
+//
+
+// Records audit events in the backend, making them available via
+// [backend.Backend.ListAuditEvents].
+type BackendSink struct {
+	Backend backendpkg.Backend
+}
+
+func NewBackendSink(backend backendpkg.Backend) *BackendSink {
+	return &BackendSink{Backend: backend}
+}
+
+// ([Sink] interface)
+func (self *BackendSink) Record(context contextpkg.Context, event *backendpkg.AuditEvent) error {
+	// The backend owns the event argument
+	event_ := event.Clone()
+	if err := self.Backend.RecordAuditEvent(context, &event_); err == nil {
+		event.ID = event_.ID
+		return nil
+	} else {
+		return err
+	}
+}
+
+// ([Sink] interface)
+func (self *BackendSink) Release() error {
+	return nil
+}
+
+//
+// FileSink
+//
+
+// Appends audit events to a file as JSON lines, e.g. for shipping to a log aggregator.
+type FileSink struct {
+	file *os.File
+	lock sync.Mutex
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	if file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600); err == nil {
+		return &FileSink{file: file}, nil
+	} else {
+		return nil, err
+	}
+}
+
+// ([Sink] interface)
+func (self *FileSink) Record(context contextpkg.Context, event *backendpkg.AuditEvent) error {
+	if content, err := json.Marshal(fileAuditEvent{
+		ID:            event.ID,
+		Timestamp:     event.Timestamp,
+		Actor:         event.Actor,
+		Operation:     event.Operation,
+		Type:          event.Type,
+		Namespace:     event.Namespace,
+		IDs:           event.IDs,
+		Selector:      event.Selector,
+		Outcome:       event.Outcome,
+		Reason:        event.Reason,
+		Diff:          event.Diff,
+		ClaimedAuthor: event.ClaimedAuthor,
+	}); err == nil {
+		content = append(content, '\n')
+
+		self.lock.Lock()
+		defer self.lock.Unlock()
+
+		_, err = self.file.Write(content)
+		return err
+	} else {
+		return err
+	}
+}
+
+// ([Sink] interface)
+func (self *FileSink) Release() error {
+	return self.file.Close()
+}
+
+type fileAuditEvent struct {
+	ID        uint64    `json:"id,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Actor     string    `json:"actor"`
+	Operation string    `json:"operation"`
+	Type      string    `json:"type"`
+	Namespace string    `json:"namespace,omitempty"`
+	IDs       []string  `json:"ids,omitempty"`
+	Selector  string    `json:"selector,omitempty"`
+	Outcome   string    `json:"outcome"`
+	Reason    string    `json:"reason,omitempty"`
+	Diff      string    `json:"diff,omitempty"`
+
+	ClaimedAuthor string `json:"claimedAuthor,omitempty"`
+}
@@ -0,0 +1,67 @@
+package auditing
+
+import (
+	contextpkg "context"
+	"slices"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationRegister, backend.AuditTypeSite, backend.NormalizeNamespace(site.Namespace), site.SiteID)
+
+	var before *backend.Site
+	if before_, err := self.Backend.GetSite(context, site.Namespace, site.SiteID); err == nil {
+		before = before_
+	} else {
+		before = new(backend.Site)
+	}
+
+	err := self.Backend.SetSite(context, site)
+	event.Diff = joinDiff(DiffPackages(before.Package, site.Package), DiffMetadata(before.Metadata, site.Metadata))
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
+	return self.Backend.GetSite(context, namespace, siteId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationDelete, backend.AuditTypeSite, backend.NormalizeNamespace(namespace), siteId)
+	err := self.Backend.DeleteSite(context, namespace, siteId)
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ListSites(context contextpkg.Context, selectSites backend.SelectSites, window backend.Window) (util.Results[backend.SiteInfo], error) {
+	return self.Backend.ListSites(context, selectSites, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) PurgeSites(context contextpkg.Context, selectSites backend.SelectSites) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationPurge, backend.AuditTypeSite, backend.NormalizeNamespace(selectSites.Namespace))
+	event.Selector = FormatSelector(selectSites)
+	err := self.Backend.PurgeSites(context, selectSites)
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	return self.Backend.ListDeletedDeployments(context, namespace, siteId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationAcknowledge, backend.AuditTypeSite, backend.NormalizeNamespace(namespace), siteId)
+	event.Diff = diffList("deleted deployments", slices.Clone(deploymentIds))
+	err := self.Backend.AcknowledgeDeletedDeployments(context, namespace, siteId, deploymentIds)
+	self.record(context, event, err)
+	return err
+}
@@ -0,0 +1,52 @@
+package auditing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationRegister, backend.AuditTypeTemplate, backend.NormalizeNamespace(template.Namespace), template.TemplateID)
+
+	var before *backend.Template
+	if before_, err := self.Backend.GetTemplate(context, template.Namespace, template.TemplateID); err == nil {
+		before = before_
+	} else {
+		before = new(backend.Template)
+	}
+
+	err := self.Backend.SetTemplate(context, template)
+	event.Diff = joinDiff(DiffPackages(before.Package, template.Package), DiffMetadata(before.Metadata, template.Metadata))
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
+	return self.Backend.GetTemplate(context, namespace, templateId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationDelete, backend.AuditTypeTemplate, backend.NormalizeNamespace(namespace), templateId)
+	err := self.Backend.DeleteTemplate(context, namespace, templateId)
+	self.record(context, event, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) ListTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, window backend.Window) (util.Results[backend.TemplateInfo], error) {
+	return self.Backend.ListTemplates(context, selectTemplates, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuditingBackend) PurgeTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates) error {
+	event := backend.NewAuditEvent(context, backend.AuditOperationPurge, backend.AuditTypeTemplate, backend.NormalizeNamespace(selectTemplates.Namespace))
+	event.Selector = FormatSelector(selectTemplates)
+	err := self.Backend.PurgeTemplates(context, selectTemplates)
+	self.record(context, event, err)
+	return err
+}
@@ -0,0 +1,23 @@
+package authorizing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	// Audit events are recorded internally, not by callers
+	return self.Backend.RecordAuditEvent(context, event)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	if err := self.authorizeNamespaced(context, VerbList, TypeAudit, selectAuditEvents.Namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListAuditEvents(context, selectAuditEvents, window)
+}
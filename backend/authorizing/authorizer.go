@@ -0,0 +1,32 @@
+package authorizing
+
+import (
+	backendpkg "github.com/nephio-experimental/tko/backend"
+)
+
+const (
+	VerbGet      = "get"
+	VerbList     = "list"
+	VerbWatch    = "watch"
+	VerbRegister = "register" // templates, sites, and plugins
+	VerbCreate   = "create"   // deployments
+	VerbModify   = "modify"
+	VerbApprove  = "approve" // deployments
+	VerbDelete   = "delete"
+	VerbPurge    = "purge"
+
+	TypeTemplate   = "template"
+	TypeSite       = "site"
+	TypeDeployment = "deployment"
+	TypePlugin     = "plugin"
+	TypeAudit      = "audit" // only listed
+)
+
+//
+// Authorizer
+//
+
+type Authorizer interface {
+	// Namespace is empty for plugins and can be AllNamespaces for listing and watching.
+	Authorize(identity *backendpkg.Identity, verb string, type_ string, namespace string) bool
+}
@@ -0,0 +1,84 @@
+package authorizing
+
+import (
+	contextpkg "context"
+	"sync"
+	"time"
+
+	backendpkg "github.com/nephio-experimental/tko/backend"
+)
+
+// Modification tokens that are neither ended nor cancelled are forgotten after this duration.
+var ModificationTrackingDuration = time.Hour
+
+var _ backendpkg.Backend = new(AuthorizingBackend)
+
+//
+// AuthorizingBackend
+//
+
+type AuthorizingBackend struct {
+	Backend    backendpkg.Backend
+	Authorizer Authorizer
+
+	modifications     map[string]modification // key is modification token
+	modificationsLock sync.Mutex
+}
+
+// Wraps an existing backend with authorization of the identity in the context (see
+// [backend.ContextWithIdentity]). Contexts without an identity are treated as anonymous.
+func NewAuthorizingBackend(backend backendpkg.Backend, authorizer Authorizer) *AuthorizingBackend {
+	return &AuthorizingBackend{
+		Backend:       backend,
+		Authorizer:    authorizer,
+		modifications: make(map[string]modification),
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) Connect(context contextpkg.Context) error {
+	return self.Backend.Connect(context)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) Release(context contextpkg.Context) error {
+	return self.Backend.Release(context)
+}
+
+// ([fmt.Stringer] interface)
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) String() string {
+	return self.Backend.String()
+}
+
+func (self *AuthorizingBackend) authorize(context contextpkg.Context, verb string, type_ string, namespace string) error {
+	identity := backendpkg.GetIdentity(context)
+	if identity == nil {
+		identity = backendpkg.NewAnonymousIdentity()
+	}
+
+	if self.Authorizer.Authorize(identity, verb, type_, namespace) {
+		return nil
+	}
+
+	if namespace == "" {
+		return backendpkg.NewNotAuthorizedErrorf("%s cannot %s %s", identity.Name, verb, type_)
+	} else {
+		return backendpkg.NewNotAuthorizedErrorf("%s cannot %s %s in namespace %q", identity.Name, verb, type_, namespace)
+	}
+}
+
+// Namespaced types are authorized for the normalized namespace.
+func (self *AuthorizingBackend) authorizeNamespaced(context contextpkg.Context, verb string, type_ string, namespace string) error {
+	return self.authorize(context, verb, type_, backendpkg.NormalizeNamespace(namespace))
+}
+
+//
+// modification
+//
+
+type modification struct {
+	namespace string
+	approved  bool
+	started   time.Time
+}
@@ -0,0 +1,158 @@
+package authorizing
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	validationpkg "github.com/nephio-experimental/tko/validation"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) CreateDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	if err := self.authorizeNamespaced(context, VerbCreate, TypeDeployment, deployment.Namespace); err != nil {
+		return err
+	}
+
+	if deployment.Approved || isApproved(deployment.Package) {
+		if err := self.authorizeNamespaced(context, VerbApprove, TypeDeployment, deployment.Namespace); err != nil {
+			return err
+		}
+	}
+
+	return self.Backend.CreateDeployment(context, deployment)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
+	if err := self.authorizeNamespaced(context, VerbGet, TypeDeployment, namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.GetDeployment(context, namespace, deploymentId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
+	if err := self.authorizeNamespaced(context, VerbDelete, TypeDeployment, namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.DeleteDeployment(context, namespace, deploymentId, propagation)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ListDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, window backend.Window) (util.Results[backend.DeploymentInfo], error) {
+	if err := self.authorizeNamespaced(context, VerbList, TypeDeployment, selectDeployments.Namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListDeployments(context, selectDeployments, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
+	if err := self.authorizeNamespaced(context, VerbPurge, TypeDeployment, selectDeployments.Namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.PurgeDeployments(context, selectDeployments, propagation)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
+	if err := self.authorizeNamespaced(context, VerbModify, TypeDeployment, namespace); err != nil {
+		return "", nil, err
+	}
+
+	if modificationToken, deployment, err := self.Backend.StartDeploymentModification(context, namespace, deploymentId); err == nil {
+		self.trackModification(modificationToken, deployment)
+		return modificationToken, deployment, nil
+	} else {
+		return "", nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) EndDeploymentModification(context contextpkg.Context, modificationToken string, package_ tkoutil.Package, validation *validationpkg.Validation) (string, error) {
+	// Changing the approval requires approval permission
+	approved := isApproved(package_)
+	if modification, ok := self.untrackModification(modificationToken); ok {
+		if approved != modification.approved {
+			if err := self.authorizeNamespaced(context, VerbApprove, TypeDeployment, modification.namespace); err != nil {
+				return "", err
+			}
+		}
+	} else if approved {
+		// We don't know the namespace (the modification may have been started elsewhere)
+		if err := self.authorize(context, VerbApprove, TypeDeployment, backend.AllNamespaces); err != nil {
+			return "", err
+		}
+	}
+
+	return self.Backend.EndDeploymentModification(context, modificationToken, package_, validation)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) CancelDeploymentModification(context contextpkg.Context, modificationToken string) error {
+	// Holding the modification token is enough
+	self.untrackModification(modificationToken)
+	return self.Backend.CancelDeploymentModification(context, modificationToken)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	if modifyDeployments.Approved != nil {
+		if err := self.authorizeNamespaced(context, VerbApprove, TypeDeployment, selectDeployments.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	if modifyDeployments.ChangesMetadata() {
+		if err := self.authorizeNamespaced(context, VerbModify, TypeDeployment, selectDeployments.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	return self.Backend.ModifyDeployments(context, selectDeployments, modifyDeployments)
+}
+
+// Utils
+
+func (self *AuthorizingBackend) trackModification(modificationToken string, deployment *backend.Deployment) {
+	self.modificationsLock.Lock()
+	defer self.modificationsLock.Unlock()
+
+	now := time.Now()
+	for modificationToken_, modification := range self.modifications {
+		if now.Sub(modification.started) > ModificationTrackingDuration {
+			delete(self.modifications, modificationToken_)
+		}
+	}
+
+	self.modifications[modificationToken] = modification{
+		namespace: deployment.Namespace,
+		approved:  deployment.Approved,
+		started:   now,
+	}
+}
+
+func (self *AuthorizingBackend) untrackModification(modificationToken string) (modification, bool) {
+	self.modificationsLock.Lock()
+	defer self.modificationsLock.Unlock()
+
+	modification, ok := self.modifications[modificationToken]
+	if ok {
+		delete(self.modifications, modificationToken)
+	}
+	return modification, ok
+}
+
+func isApproved(package_ tkoutil.Package) bool {
+	if deployment, ok := tkoutil.DeploymentResourceIdentifier.GetResource(package_); ok {
+		return tkoutil.IsApprovedAnnotation(deployment)
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package authorizing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+var allEventKinds = []string{backend.EventKindTemplate, backend.EventKindSite, backend.EventKindDeployment, backend.EventKindPlugin}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	kinds := selectEvents.Kinds
+	if len(kinds) == 0 {
+		kinds = allEventKinds
+	}
+
+	// Event kinds are the same as authorization types
+	for _, kind := range kinds {
+		var err error
+		if kind == backend.EventKindPlugin {
+			err = self.authorize(context, VerbWatch, kind, "")
+		} else {
+			err = self.authorizeNamespaced(context, VerbWatch, kind, selectEvents.Namespace)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return self.Backend.Watch(context, selectEvents)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	// Reveals nothing but a number
+	return self.Backend.GetEventRevision(context)
+}
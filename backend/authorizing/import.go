@@ -0,0 +1,43 @@
+package authorizing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	if err := self.authorizeNamespaced(context, VerbRegister, TypeTemplate, template.Namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.ImportTemplate(context, template)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	if err := self.authorizeNamespaced(context, VerbRegister, TypeSite, site.Namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.ImportSite(context, site)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	// Importing can replace an existing deployment
+	for _, verb := range []string{VerbCreate, VerbModify} {
+		if err := self.authorizeNamespaced(context, verb, TypeDeployment, deployment.Namespace); err != nil {
+			return err
+		}
+	}
+
+	if deployment.Approved || isApproved(deployment.Package) {
+		if err := self.authorizeNamespaced(context, VerbApprove, TypeDeployment, deployment.Namespace); err != nil {
+			return err
+		}
+	}
+
+	return self.Backend.ImportDeployment(context, deployment)
+}
@@ -0,0 +1,53 @@
+package authorizing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) SetPlugin(context contextpkg.Context, plugin *backend.Plugin) error {
+	if err := self.authorize(context, VerbRegister, TypePlugin, ""); err != nil {
+		return err
+	}
+
+	return self.Backend.SetPlugin(context, plugin)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) GetPlugin(context contextpkg.Context, pluginId backend.PluginID) (*backend.Plugin, error) {
+	if err := self.authorize(context, VerbGet, TypePlugin, ""); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.GetPlugin(context, pluginId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) DeletePlugin(context contextpkg.Context, pluginId backend.PluginID) error {
+	if err := self.authorize(context, VerbDelete, TypePlugin, ""); err != nil {
+		return err
+	}
+
+	return self.Backend.DeletePlugin(context, pluginId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ListPlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins, window backend.Window) (util.Results[backend.Plugin], error) {
+	if err := self.authorize(context, VerbList, TypePlugin, ""); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListPlugins(context, selectPlugins, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) PurgePlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins) error {
+	if err := self.authorize(context, VerbPurge, TypePlugin, ""); err != nil {
+		return err
+	}
+
+	return self.Backend.PurgePlugins(context, selectPlugins)
+}
@@ -0,0 +1,156 @@
+package authorizing
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	backendpkg "github.com/nephio-experimental/tko/backend"
+	"gopkg.in/yaml.v2"
+)
+
+const Any = "*"
+
+var _ Authorizer = new(Policy)
+
+//
+// Policy
+//
+
+// Role-based authorization policy. Identities and groups are bound to roles, and each role is a
+// list of rules, any of which can allow the action.
+type Policy struct {
+	Roles    map[string][]Rule `yaml:"roles"`
+	Bindings []Binding         `yaml:"bindings"`
+}
+
+func LoadPolicy(path string) (*Policy, error) {
+	if content, err := os.ReadFile(path); err == nil {
+		var policy Policy
+		if err := yaml.UnmarshalStrict(content, &policy); err == nil {
+			if err := policy.Validate(); err == nil {
+				return &policy, nil
+			} else {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		} else {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	} else {
+		return nil, err
+	}
+}
+
+func (self *Policy) Validate() error {
+	for name, rules := range self.Roles {
+		for _, rule := range rules {
+			for _, verb := range rule.Verbs {
+				if !IsValidVerb(verb) {
+					return fmt.Errorf("role %q: unsupported verb: %s", name, verb)
+				}
+			}
+			for _, type_ := range rule.Types {
+				if !IsValidType(type_) {
+					return fmt.Errorf("role %q: unsupported type: %s", name, type_)
+				}
+			}
+		}
+	}
+
+	for _, binding := range self.Bindings {
+		if _, ok := self.Roles[binding.Role]; !ok {
+			return fmt.Errorf("binding to unknown role: %s", binding.Role)
+		}
+	}
+
+	return nil
+}
+
+// ([Authorizer] interface)
+func (self *Policy) Authorize(identity *backendpkg.Identity, verb string, type_ string, namespace string) bool {
+	for _, binding := range self.Bindings {
+		if binding.Matches(identity) {
+			for _, rule := range self.Roles[binding.Role] {
+				if rule.Allows(verb, type_, namespace) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+//
+// Rule
+//
+
+type Rule struct {
+	Verbs      []string `yaml:"verbs"`      // empty for all verbs
+	Types      []string `yaml:"types"`      // empty for all types
+	Namespaces []string `yaml:"namespaces"` // empty for all namespaces; plugins require all namespaces
+}
+
+func (self *Rule) Allows(verb string, type_ string, namespace string) bool {
+	if !matchesAny(self.Verbs, verb) || !matchesAny(self.Types, type_) {
+		return false
+	}
+
+	if (len(self.Namespaces) == 0) || slices.Contains(self.Namespaces, Any) {
+		return true
+	}
+
+	// Plugins are cluster-wide and listing all namespaces spans them all
+	if (namespace == "") || (namespace == backendpkg.AllNamespaces) {
+		return false
+	}
+
+	return slices.Contains(self.Namespaces, namespace)
+}
+
+//
+// Binding
+//
+
+type Binding struct {
+	Role       string   `yaml:"role"`
+	Identities []string `yaml:"identities"`
+	Groups     []string `yaml:"groups"`
+}
+
+func (self *Binding) Matches(identity *backendpkg.Identity) bool {
+	if slices.Contains(self.Identities, identity.Name) {
+		return true
+	}
+
+	for _, group := range self.Groups {
+		if identity.InGroup(group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Utils
+
+func IsValidVerb(verb string) bool {
+	switch verb {
+	case Any, VerbGet, VerbList, VerbWatch, VerbRegister, VerbCreate, VerbModify, VerbApprove, VerbDelete, VerbPurge:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsValidType(type_ string) bool {
+	switch type_ {
+	case Any, TypeTemplate, TypeSite, TypeDeployment, TypePlugin, TypeAudit:
+		return true
+	default:
+		return false
+	}
+}
+
+func matchesAny(values []string, value string) bool {
+	return (len(values) == 0) || slices.Contains(values, Any) || slices.Contains(values, value)
+}
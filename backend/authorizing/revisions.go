@@ -0,0 +1,66 @@
+package authorizing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	if err := self.authorizeNamespaced(context, VerbGet, type_, namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListRevisions(context, type_, namespace, objectId, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	if err := self.authorizeNamespaced(context, VerbGet, revisionId.Type, revisionId.Namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.GetRevision(context, revisionId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	// Reverting is a write of the whole object
+	verb := VerbRegister
+	if revisionId.Type == backend.RevisionTypeDeployment {
+		verb = VerbModify
+	}
+
+	if err := self.authorizeNamespaced(context, verb, revisionId.Type, revisionId.Namespace); err != nil {
+		return err
+	}
+
+	if revisionId.Type == backend.RevisionTypeDeployment {
+		// Reverting a deleted deployment recreates it (unapproved)
+		var approved bool
+		if deployment, err := self.Backend.GetDeployment(context, revisionId.Namespace, revisionId.ObjectID); err == nil {
+			approved = deployment.Approved
+		} else if backend.IsNotFoundError(err) {
+			if err := self.authorizeNamespaced(context, VerbCreate, TypeDeployment, revisionId.Namespace); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+
+		// Changing the approval (in either direction) requires approval permission
+		if revision, err := self.Backend.GetRevision(context, revisionId); err == nil {
+			if isApproved(revision.Package) != approved {
+				if err := self.authorizeNamespaced(context, VerbApprove, TypeDeployment, revisionId.Namespace); err != nil {
+					return err
+				}
+			}
+		} else {
+			return err
+		}
+	}
+
+	return self.Backend.RevertTo(context, revisionId)
+}
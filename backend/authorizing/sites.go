@@ -0,0 +1,71 @@
+package authorizing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
+	if err := self.authorizeNamespaced(context, VerbRegister, TypeSite, site.Namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.SetSite(context, site)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
+	if err := self.authorizeNamespaced(context, VerbGet, TypeSite, namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.GetSite(context, namespace, siteId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
+	if err := self.authorizeNamespaced(context, VerbDelete, TypeSite, namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.DeleteSite(context, namespace, siteId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ListSites(context contextpkg.Context, selectSites backend.SelectSites, window backend.Window) (util.Results[backend.SiteInfo], error) {
+	if err := self.authorizeNamespaced(context, VerbList, TypeSite, selectSites.Namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListSites(context, selectSites, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) PurgeSites(context contextpkg.Context, selectSites backend.SelectSites) error {
+	if err := self.authorizeNamespaced(context, VerbPurge, TypeSite, selectSites.Namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.PurgeSites(context, selectSites)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	if err := self.authorizeNamespaced(context, VerbGet, TypeSite, namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListDeletedDeployments(context, namespace, siteId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	if err := self.authorizeNamespaced(context, VerbModify, TypeSite, namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.AcknowledgeDeletedDeployments(context, namespace, siteId, deploymentIds)
+}
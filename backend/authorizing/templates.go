@@ -0,0 +1,53 @@
+package authorizing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
+	if err := self.authorizeNamespaced(context, VerbRegister, TypeTemplate, template.Namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.SetTemplate(context, template)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
+	if err := self.authorizeNamespaced(context, VerbGet, TypeTemplate, namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.GetTemplate(context, namespace, templateId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
+	if err := self.authorizeNamespaced(context, VerbDelete, TypeTemplate, namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.DeleteTemplate(context, namespace, templateId)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) ListTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, window backend.Window) (util.Results[backend.TemplateInfo], error) {
+	if err := self.authorizeNamespaced(context, VerbList, TypeTemplate, selectTemplates.Namespace); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListTemplates(context, selectTemplates, window)
+}
+
+// ([backend.Backend] interface)
+func (self *AuthorizingBackend) PurgeTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates) error {
+	if err := self.authorizeNamespaced(context, VerbPurge, TypeTemplate, selectTemplates.Namespace); err != nil {
+		return err
+	}
+
+	return self.Backend.PurgeTemplates(context, selectTemplates)
+}
@@ -18,6 +18,13 @@ type Backend interface {
 	String() string
 
 	// All the following methods can return NotImplementedError.
+	//
+	// Templates, sites, and deployments are namespaced, and IDs need only be unique within
+	// a namespace. Deployments can only be associated with a parent deployment, template,
+	// and site in their own namespace. Plugins are cluster-wide.
+	//
+	// Select structs can use AllNamespaces for listing and watching, but not for purging
+	// or modifying.
 
 	//
 	// Templates
@@ -25,15 +32,16 @@ type Backend interface {
 
 	// Owns and may change the contents of the template argument.
 	// Ignores template DeploymentIDs.
-	// Can return BadArgumentError, NotDoneError.
+	// If the template Version is not 0 then it must match the current version.
+	// Can return BadArgumentError, NotDoneError, ConflictError.
 	SetTemplate(context contextpkg.Context, template *Template) error
 
 	// Can return BadArgumentError, NotFoundError.
-	GetTemplate(context contextpkg.Context, templateId string) (*Template, error)
+	GetTemplate(context contextpkg.Context, namespace string, templateId string) (*Template, error)
 
 	// Does *not* delete associated deployments, but removes associations.
 	// Can return BadArgumentError, NotFoundError, NotDoneError.
-	DeleteTemplate(context contextpkg.Context, templateId string) error
+	DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error
 
 	// Can return BadArgumentError.
 	ListTemplates(context contextpkg.Context, selectTemplates SelectTemplates, window Window) (util.Results[TemplateInfo], error)
@@ -48,15 +56,16 @@ type Backend interface {
 
 	// Owns and may change the contents of the site argument.
 	// Ignores site DeploymentIDs.
-	// Can return BadArgumentError, NotDoneError.
+	// If the site Version is not 0 then it must match the current version.
+	// Can return BadArgumentError, NotDoneError, ConflictError.
 	SetSite(context contextpkg.Context, site *Site) error
 
 	// Can return BadArgumentError, NotFoundError.
-	GetSite(context contextpkg.Context, siteId string) (*Site, error)
+	GetSite(context contextpkg.Context, namespace string, siteId string) (*Site, error)
 
 	// Does *not* delete associated deployments, but removes association.
 	// Can return BadArgumentError, NotFoundError, NotDoneError.
-	DeleteSite(context contextpkg.Context, siteId string) error
+	DeleteSite(context contextpkg.Context, namespace string, siteId string) error
 
 	// Can return BadArgumentError.
 	ListSites(context contextpkg.Context, selectSites SelectSites, window Window) (util.Results[SiteInfo], error)
@@ -65,30 +74,42 @@ type Backend interface {
 	// Can return BadArgumentError, NotDoneError.
 	PurgeSites(context contextpkg.Context, selectSites SelectSites) error
 
+	// Deleted deployments that had been scheduled on the site are kept as tombstones until
+	// acknowledged.
+	// Results are sorted by deployment ID.
+	// Can return BadArgumentError.
+	ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[DeletedDeployment], error)
+
+	// Removes the tombstones. Deployment IDs without tombstones are ignored.
+	// Can return BadArgumentError, NotDoneError.
+	AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error
+
 	//
 	// Deployments
 	//
 
 	// Owns and may change the contents of the deployment argument.
+	// Deployment IDs are unique across namespaces.
 	// Can return BadArgumentError, NotDoneError.
 	CreateDeployment(context contextpkg.Context, deployment *Deployment) error
 
 	// Can return BadArgumentError, NotFoundError.
-	GetDeployment(context contextpkg.Context, deploymentId string) (*Deployment, error)
+	GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*Deployment, error)
 
-	// Does *not* delete child deployments, but orphans them.
+	// Child deployments are handled according to propagation (orphan if empty).
+	// Leaves a tombstone in the site if the deployment was scheduled.
 	// Can return BadArgumentError, NotFoundError, NotDoneError.
-	DeleteDeployment(context contextpkg.Context, deploymentId string) error
+	DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error
 
 	// Can return BadArgumentError.
 	ListDeployments(context contextpkg.Context, selectDeployments SelectDeployments, window Window) (util.Results[DeploymentInfo], error)
 
-	// Does *not* delete child deployments, but orphans them.
+	// Child deployments are handled according to propagation (orphan if empty).
 	// Can return BadArgumentError, NotDoneError.
-	PurgeDeployments(context contextpkg.Context, selectDeployments SelectDeployments) error
+	PurgeDeployments(context contextpkg.Context, selectDeployments SelectDeployments, propagation string) error
 
 	// Can return BadArgumentError, NotFoundError, NotDoneError, BusyError.
-	StartDeploymentModification(context contextpkg.Context, deploymentId string) (string, *Deployment, error)
+	StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *Deployment, error)
 
 	// Owns and may change the contents of the package argument.
 	// May change TemplateID, SiteID, Prepared, Approved.
@@ -100,12 +121,22 @@ type Backend interface {
 	// Can return BadArgumentError, NotFoundError, NotDoneError.
 	CancelDeploymentModification(context contextpkg.Context, modificationToken string) error
 
+	// Applies the modification to all selected deployments, in a single transaction if supported.
+	// Deployments that are currently being modified or that fail to modify are left unchanged
+	// and reported with a NotModifiedReason.
+	// Deployments that become approved are completely validated (if ModifyDeployments has a
+	// Validation); those that fail validation are likewise left unchanged.
+	// Results are sorted by deployment ID.
+	// Can return BadArgumentError, NotDoneError.
+	ModifyDeployments(context contextpkg.Context, selectDeployments SelectDeployments, modifyDeployments ModifyDeployments) ([]DeploymentModificationResult, error)
+
 	//
 	// Plugins
 	//
 
 	// Owns and may change the contents of the plugin argument.
-	// Can return BadArgumentError, NotDoneError.
+	// If the plugin Version is not 0 then it must match the current version.
+	// Can return BadArgumentError, NotDoneError, ConflictError.
 	SetPlugin(context contextpkg.Context, plugin *Plugin) error
 
 	// Can return BadArgumentError, NotFoundError.
@@ -119,4 +150,66 @@ type Backend interface {
 
 	// Can return BadArgumentError, NotDoneError.
 	PurgePlugins(context contextpkg.Context, selectPlugins SelectPlugins) error
+
+	//
+	// Revisions
+	//
+
+	// Revisions are kept even after their object is deleted.
+	// Results are sorted by revision number.
+	// Can return BadArgumentError.
+	ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window Window) (util.Results[RevisionInfo], error)
+
+	// Can return BadArgumentError, NotFoundError.
+	GetRevision(context contextpkg.Context, revisionId RevisionID) (*Revision, error)
+
+	// Creates a new revision with the contents of the reverted-to revision.
+	// Storage backends do *not* validate the reverted-to package; that is done by the
+	// validating wrapper, which performs the writes itself.
+	// Can return BadArgumentError, NotFoundError, NotDoneError, BusyError, ConflictError.
+	RevertTo(context contextpkg.Context, revisionId RevisionID) error
+
+	//
+	// Audit
+	//
+
+	// Owns the event argument and assigns its ID.
+	// Can return BadArgumentError, NotDoneError.
+	RecordAuditEvent(context contextpkg.Context, event *AuditEvent) error
+
+	// Results are sorted by ID, which is chronological.
+	// Can return BadArgumentError.
+	ListAuditEvents(context contextpkg.Context, selectAuditEvents SelectAuditEvents, window Window) (util.Results[AuditEvent], error)
+
+	//
+	// Import
+	//
+
+	// Like SetTemplate but preserves Updated (unless zero) and ignores Version.
+	// Can return BadArgumentError, NotDoneError.
+	ImportTemplate(context contextpkg.Context, template *Template) error
+
+	// Like SetSite but preserves Updated (unless zero), ignores Version, and does *not*
+	// merge the template.
+	// Can return BadArgumentError, NotDoneError.
+	ImportSite(context contextpkg.Context, site *Site) error
+
+	// Like CreateDeployment but preserves DeploymentID, Created and Updated (unless zero),
+	// and does *not* merge the template. Replaces an existing deployment with the same ID
+	// in the same namespace. The parent deployment must already exist.
+	// Can return BadArgumentError, NotDoneError.
+	ImportDeployment(context contextpkg.Context, deployment *Deployment) error
+
+	//
+	// Events
+	//
+
+	// Emits add/update/delete events in revision order until the context is done.
+	// Changes caused by cascading (e.g. association removals) do not emit events.
+	// Results can return BusyError if the consumer falls behind.
+	// Can return BadArgumentError.
+	Watch(context contextpkg.Context, selectEvents SelectEvents) (util.Results[Event], error)
+
+	// Latest event revision, usable as SinceRevision for resuming a watch.
+	GetEventRevision(context contextpkg.Context) (uint64, error)
 }
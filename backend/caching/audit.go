@@ -0,0 +1,18 @@
+package caching
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	return self.Backend.RecordAuditEvent(context, event)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	return self.Backend.ListAuditEvents(context, selectAuditEvents, window)
+}
@@ -0,0 +1,186 @@
+package caching
+
+import (
+	contextpkg "context"
+	"sync"
+	"time"
+
+	backendpkg "github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tliron/commonlog"
+)
+
+var (
+	DefaultSize   = 1_000
+	DefaultMaxAge = 10 * time.Second
+
+	// Modification tokens that are neither ended nor cancelled are forgotten after this duration.
+	ModificationTrackingDuration = time.Hour
+
+	// Delay before watching again after the watch failed.
+	WatchRetryInterval = 5 * time.Second
+)
+
+var (
+	_ backendpkg.Backend   = new(CachingBackend)
+	_ prometheus.Collector = new(CachingBackend)
+)
+
+var (
+	cacheHitsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "cache", "hits_total"),
+		"Number of backend cache hits by kind of cached object.",
+		[]string{"kind"}, nil)
+
+	cacheMissesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "cache", "misses_total"),
+		"Number of backend cache misses by kind of cached object.",
+		[]string{"kind"}, nil)
+
+	cacheEvictionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "cache", "evictions_total"),
+		"Number of backend cache evictions because of size or age by kind of cached object.",
+		[]string{"kind"}, nil)
+
+	cacheEntriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "cache", "entries"),
+		"Number of backend cache entries by kind of cached object.",
+		[]string{"kind"}, nil)
+)
+
+//
+// CachingBackend
+//
+
+type CachingBackend struct {
+	Backend backendpkg.Backend
+
+	log               commonlog.Logger
+	templates         *lru[objectKey, *backendpkg.Template]
+	sites             *lru[objectKey, *backendpkg.Site]
+	deployments       *lru[objectKey, *backendpkg.Deployment]
+	plugins           *lru[backendpkg.PluginID, *backendpkg.Plugin]
+	pluginLists       *lru[string, []backendpkg.Plugin] // key is the formatted selection and window
+	modifications     map[string]modification           // key is modification token
+	modificationsLock sync.Mutex
+	stopWatching      contextpkg.CancelFunc
+}
+
+// Wraps an existing backend with read-through caching of templates, sites, deployments, and
+// plugins. Each kind of object keeps up to size entries, evicting the least recently used.
+//
+// Entries are invalidated by writes made through this backend, and by the wrapped backend's
+// events, which also cover writes made by other instances sharing the same storage. Changes
+// that do not emit events (e.g. removed associations) are only seen by this instance once the
+// entries are older than maxAge (0 for no maximum age).
+//
+// Should wrap the storage backend, either directly or via wrappers that pass through all
+// operations (such as metering and tracing), so that all writes go through it.
+//
+// Is also a [prometheus.Collector] of the [Stats], which should be registered in
+// [metrics.Registry].
+func NewCachingBackend(backend backendpkg.Backend, size int, maxAge time.Duration, log commonlog.Logger) *CachingBackend {
+	return &CachingBackend{
+		Backend:       backend,
+		log:           log,
+		templates:     newLRU[objectKey, *backendpkg.Template](size, maxAge),
+		sites:         newLRU[objectKey, *backendpkg.Site](size, maxAge),
+		deployments:   newLRU[objectKey, *backendpkg.Deployment](size, maxAge),
+		plugins:       newLRU[backendpkg.PluginID, *backendpkg.Plugin](size, maxAge),
+		pluginLists:   newLRU[string, []backendpkg.Plugin](size, maxAge),
+		modifications: make(map[string]modification),
+	}
+}
+
+// Hit, miss, and eviction counts and current number of entries per kind of cached object.
+func (self *CachingBackend) Stats() map[string]Stats {
+	return map[string]Stats{
+		"templates":   self.templates.getStats(),
+		"sites":       self.sites.getStats(),
+		"deployments": self.deployments.getStats(),
+		"plugins":     self.plugins.getStats(),
+		"pluginLists": self.pluginLists.getStats(),
+	}
+}
+
+// ([prometheus.Collector] interface)
+func (self *CachingBackend) Describe(descs chan<- *prometheus.Desc) {
+	descs <- cacheHitsDesc
+	descs <- cacheMissesDesc
+	descs <- cacheEvictionsDesc
+	descs <- cacheEntriesDesc
+}
+
+// ([prometheus.Collector] interface)
+func (self *CachingBackend) Collect(metrics_ chan<- prometheus.Metric) {
+	for kind, stats := range self.Stats() {
+		metrics_ <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, float64(stats.Hits), kind)
+		metrics_ <- prometheus.MustNewConstMetric(cacheMissesDesc, prometheus.CounterValue, float64(stats.Misses), kind)
+		metrics_ <- prometheus.MustNewConstMetric(cacheEvictionsDesc, prometheus.CounterValue, float64(stats.Evictions), kind)
+		metrics_ <- prometheus.MustNewConstMetric(cacheEntriesDesc, prometheus.GaugeValue, float64(stats.Entries), kind)
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) Connect(context contextpkg.Context) error {
+	if err := self.Backend.Connect(context); err == nil {
+		var watchContext contextpkg.Context
+		watchContext, self.stopWatching = contextpkg.WithCancel(contextpkg.Background())
+		go self.watch(watchContext)
+		return nil
+	} else {
+		return err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) Release(context contextpkg.Context) error {
+	if self.stopWatching != nil {
+		self.stopWatching()
+	}
+
+	for kind, stats := range self.Stats() {
+		self.log.Infof("cache %s: hits=%d misses=%d evictions=%d entries=%d", kind, stats.Hits, stats.Misses, stats.Evictions, stats.Entries)
+	}
+
+	return self.Backend.Release(context)
+}
+
+// ([fmt.Stringer] interface)
+// ([backend.Backend] interface)
+func (self *CachingBackend) String() string {
+	return self.Backend.String()
+}
+
+func (self *CachingBackend) clear() {
+	self.templates.clear()
+	self.sites.clear()
+	self.deployments.clear()
+	self.plugins.clear()
+	self.pluginLists.clear()
+}
+
+//
+// objectKey
+//
+
+type objectKey struct {
+	namespace string
+	id        string
+}
+
+func newObjectKey(namespace string, id string) objectKey {
+	return objectKey{backendpkg.NormalizeNamespace(namespace), id}
+}
+
+//
+// modification
+//
+
+type modification struct {
+	deployment objectKey
+	templateId string
+	siteId     string
+	started    time.Time
+}
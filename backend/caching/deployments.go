@@ -0,0 +1,161 @@
+package caching
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	validationpkg "github.com/nephio-experimental/tko/validation"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) CreateDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	err := self.Backend.CreateDeployment(context, deployment)
+	self.invalidateDeployment(newObjectKey(deployment.Namespace, deployment.DeploymentID), deployment.TemplateID, deployment.SiteID)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
+	key := newObjectKey(namespace, deploymentId)
+	if deployment, generation, ok := self.deployments.get(key); ok {
+		return deployment.Clone(true), nil
+	} else if deployment, err := self.Backend.GetDeployment(context, namespace, deploymentId); err == nil {
+		self.deployments.put(key, deployment.Clone(true), generation)
+		return deployment, nil
+	} else {
+		return nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
+	err := self.Backend.DeleteDeployment(context, namespace, deploymentId, propagation)
+	// Child deployments may have been affected, too
+	self.deployments.clear()
+	self.templates.clear()
+	self.sites.clear()
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ListDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, window backend.Window) (util.Results[backend.DeploymentInfo], error) {
+	return self.Backend.ListDeployments(context, selectDeployments, window)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
+	err := self.Backend.PurgeDeployments(context, selectDeployments, propagation)
+	self.deployments.clear()
+	self.templates.clear()
+	self.sites.clear()
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
+	if modificationToken, deployment, err := self.Backend.StartDeploymentModification(context, namespace, deploymentId); err == nil {
+		self.trackModification(modificationToken, deployment)
+		return modificationToken, deployment, nil
+	} else {
+		return "", nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) EndDeploymentModification(context contextpkg.Context, modificationToken string, package_ tkoutil.Package, validation *validationpkg.Validation) (string, error) {
+	// The package may change the template and site associations
+	var after backend.DeploymentInfo
+	after.UpdateFromPackage(package_, false)
+
+	// The modification may have been started elsewhere, in which case we know less
+	before, tracked := self.untrackModification(modificationToken)
+
+	deploymentId, err := self.Backend.EndDeploymentModification(context, modificationToken, package_, validation)
+
+	if tracked {
+		self.invalidateDeployment(before.deployment, before.templateId, before.siteId)
+		self.invalidateDeployment(before.deployment, after.TemplateID, after.SiteID)
+	} else if deploymentId != "" {
+		self.invalidateDeploymentInAllNamespaces(deploymentId)
+		self.templates.clear()
+		self.sites.clear()
+	}
+
+	return deploymentId, err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) CancelDeploymentModification(context contextpkg.Context, modificationToken string) error {
+	// Nothing changes
+	self.untrackModification(modificationToken)
+	return self.Backend.CancelDeploymentModification(context, modificationToken)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	results, err := self.Backend.ModifyDeployments(context, selectDeployments, modifyDeployments)
+
+	// Associations are not modified
+	var keys []objectKey
+	for _, result := range results {
+		if result.Modified {
+			keys = append(keys, newObjectKey(selectDeployments.Namespace, result.DeploymentID))
+		}
+	}
+	self.deployments.invalidate(keys...)
+
+	return results, err
+}
+
+// Utils
+
+// Also invalidates the associated template and site, which list their deployments.
+func (self *CachingBackend) invalidateDeployment(key objectKey, templateId string, siteId string) {
+	self.deployments.invalidate(key)
+	if templateId != "" {
+		self.templates.invalidate(objectKey{key.namespace, templateId})
+	}
+	if siteId != "" {
+		self.sites.invalidate(objectKey{key.namespace, siteId})
+	}
+}
+
+// Deployment IDs are unique across namespaces.
+func (self *CachingBackend) invalidateDeploymentInAllNamespaces(deploymentId string) {
+	self.deployments.invalidateIf(func(key objectKey) bool {
+		return key.id == deploymentId
+	})
+}
+
+func (self *CachingBackend) trackModification(modificationToken string, deployment *backend.Deployment) {
+	self.modificationsLock.Lock()
+	defer self.modificationsLock.Unlock()
+
+	now := time.Now()
+	for modificationToken_, modification := range self.modifications {
+		if now.Sub(modification.started) > ModificationTrackingDuration {
+			delete(self.modifications, modificationToken_)
+		}
+	}
+
+	self.modifications[modificationToken] = modification{
+		deployment: newObjectKey(deployment.Namespace, deployment.DeploymentID),
+		templateId: deployment.TemplateID,
+		siteId:     deployment.SiteID,
+		started:    now,
+	}
+}
+
+func (self *CachingBackend) untrackModification(modificationToken string) (modification, bool) {
+	self.modificationsLock.Lock()
+	defer self.modificationsLock.Unlock()
+
+	if modification, ok := self.modifications[modificationToken]; ok {
+		delete(self.modifications, modificationToken)
+		return modification, true
+	}
+	return modification{}, false
+}
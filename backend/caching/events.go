@@ -0,0 +1,78 @@
+package caching
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	return self.Backend.Watch(context, selectEvents)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	return self.Backend.GetEventRevision(context)
+}
+
+// Invalidates entries according to the wrapped backend's events until the context is done.
+func (self *CachingBackend) watch(context contextpkg.Context) {
+	for {
+		if events, err := self.Backend.Watch(context, backend.SelectEvents{Namespace: backend.AllNamespaces}); err == nil {
+			err = util.IterateResults(events, func(event backend.Event) error {
+				self.invalidateEvent(event)
+				return nil
+			})
+
+			if context.Err() != nil {
+				return
+			}
+
+			// We may have missed events
+			if err != nil {
+				self.log.Warningf("cache events: %s", err.Error())
+			}
+			self.clear()
+		} else if backend.IsNotImplementedError(err) {
+			self.log.Notice("cache events not supported by backend, relying on max age")
+			return
+		} else {
+			self.log.Errorf("cache events: %s", err.Error())
+		}
+
+		select {
+		case <-context.Done():
+			return
+		case <-time.After(WatchRetryInterval):
+		}
+	}
+}
+
+func (self *CachingBackend) invalidateEvent(event backend.Event) {
+	switch event.Kind {
+	case backend.EventKindTemplate:
+		self.templates.invalidate(newObjectKey(event.Namespace, event.ID))
+
+	case backend.EventKindSite:
+		self.sites.invalidate(newObjectKey(event.Namespace, event.ID))
+
+	case backend.EventKindDeployment:
+		key := newObjectKey(event.Namespace, event.ID)
+		if deployment, ok := self.deployments.peek(key); ok {
+			self.invalidateDeployment(key, deployment.TemplateID, deployment.SiteID)
+		} else {
+			self.deployments.invalidate(key)
+		}
+
+	case backend.EventKindPlugin:
+		if pluginId, ok := backend.ParsePluginID(event.ID); ok {
+			self.plugins.invalidate(pluginId)
+		} else {
+			self.plugins.clear()
+		}
+		self.pluginLists.clear()
+	}
+}
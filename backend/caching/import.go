@@ -0,0 +1,34 @@
+package caching
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	key := newObjectKey(template.Namespace, template.TemplateID)
+	err := self.Backend.ImportTemplate(context, template)
+	self.templates.invalidate(key)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	key := newObjectKey(site.Namespace, site.SiteID)
+	err := self.Backend.ImportSite(context, site)
+	self.sites.invalidate(key)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	key := newObjectKey(deployment.Namespace, deployment.DeploymentID)
+	err := self.Backend.ImportDeployment(context, deployment)
+	// The replaced deployment may have had other associations
+	self.deployments.invalidate(key)
+	self.templates.clear()
+	self.sites.clear()
+	return err
+}
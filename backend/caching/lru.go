@@ -0,0 +1,163 @@
+package caching
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+//
+// Stats
+//
+
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64 // because of size or age, not invalidation
+	Entries   int
+}
+
+//
+// lru
+//
+
+// A size-bounded least-recently-used cache. Entries also expire after maxAge (if not 0).
+//
+// Every invalidation increments the generation. A value fetched after a miss should only be
+// put if the generation has not changed in between, so that a concurrent invalidation would
+// not be overridden by a stale value.
+type lru[K comparable, V any] struct {
+	size   int
+	maxAge time.Duration
+
+	entries    map[K]*list.Element
+	order      *list.List // front is most recently used
+	generation uint64
+	stats      Stats
+	lock       sync.Mutex
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+	added time.Time
+}
+
+func newLRU[K comparable, V any](size int, maxAge time.Duration) *lru[K, V] {
+	return &lru[K, V]{
+		size:    size,
+		maxAge:  maxAge,
+		entries: make(map[K]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Returns the current generation on a miss.
+func (self *lru[K, V]) get(key K) (V, uint64, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if element, ok := self.entries[key]; ok {
+		entry := element.Value.(*lruEntry[K, V])
+		if self.expired(entry) {
+			self.remove(element)
+			self.stats.Evictions++
+		} else {
+			self.order.MoveToFront(element)
+			self.stats.Hits++
+			return entry.value, 0, true
+		}
+	}
+
+	self.stats.Misses++
+	var value V
+	return value, self.generation, false
+}
+
+// Returns the value without counting a hit or changing the order.
+func (self *lru[K, V]) peek(key K) (V, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if element, ok := self.entries[key]; ok {
+		return element.Value.(*lruEntry[K, V]).value, true
+	}
+
+	var value V
+	return value, false
+}
+
+// Ignored if the generation has changed since the miss.
+func (self *lru[K, V]) put(key K, value V, generation uint64) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if (self.size <= 0) || (generation != self.generation) {
+		return
+	}
+
+	entry := &lruEntry[K, V]{key: key, value: value, added: time.Now()}
+	if element, ok := self.entries[key]; ok {
+		element.Value = entry
+		self.order.MoveToFront(element)
+	} else {
+		self.entries[key] = self.order.PushFront(entry)
+		for len(self.entries) > self.size {
+			self.remove(self.order.Back())
+			self.stats.Evictions++
+		}
+	}
+}
+
+func (self *lru[K, V]) invalidate(keys ...K) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.generation++
+	for _, key := range keys {
+		if element, ok := self.entries[key]; ok {
+			self.remove(element)
+		}
+	}
+}
+
+func (self *lru[K, V]) invalidateIf(filter func(key K) bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.generation++
+	for key, element := range self.entries {
+		if filter(key) {
+			self.remove(element)
+		}
+	}
+}
+
+func (self *lru[K, V]) clear() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.generation++
+	clear(self.entries)
+	self.order.Init()
+}
+
+func (self *lru[K, V]) getStats() Stats {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	stats := self.stats
+	stats.Entries = len(self.entries)
+	return stats
+}
+
+// Assumes lock is held.
+func (self *lru[K, V]) expired(entry *lruEntry[K, V]) bool {
+	return (self.maxAge > 0) && (time.Since(entry.added) > self.maxAge)
+}
+
+// Assumes lock is held.
+func (self *lru[K, V]) remove(element *list.Element) {
+	delete(self.entries, element.Value.(*lruEntry[K, V]).key)
+	self.order.Remove(element)
+}
@@ -0,0 +1,91 @@
+package caching
+
+import (
+	contextpkg "context"
+	"fmt"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) SetPlugin(context contextpkg.Context, plugin *backend.Plugin) error {
+	pluginId := plugin.PluginID
+	err := self.Backend.SetPlugin(context, plugin)
+	self.plugins.invalidate(pluginId)
+	self.pluginLists.clear()
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) GetPlugin(context contextpkg.Context, pluginId backend.PluginID) (*backend.Plugin, error) {
+	if plugin, generation, ok := self.plugins.get(pluginId); ok {
+		return plugin.Clone(), nil
+	} else if plugin, err := self.Backend.GetPlugin(context, pluginId); err == nil {
+		self.plugins.put(pluginId, plugin.Clone(), generation)
+		return plugin, nil
+	} else {
+		return nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) DeletePlugin(context contextpkg.Context, pluginId backend.PluginID) error {
+	err := self.Backend.DeletePlugin(context, pluginId)
+	self.plugins.invalidate(pluginId)
+	self.pluginLists.clear()
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ListPlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins, window backend.Window) (util.Results[backend.Plugin], error) {
+	key := pluginListKey(selectPlugins, window)
+	if plugins, generation, ok := self.pluginLists.get(key); ok {
+		return util.NewResultsSlice(clonePlugins(plugins)), nil
+	} else if results, err := self.Backend.ListPlugins(context, selectPlugins, window); err == nil {
+		if plugins, err := util.GatherResults(results); err == nil {
+			self.pluginLists.put(key, clonePlugins(plugins), generation)
+			return util.NewResultsSlice(plugins), nil
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) PurgePlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins) error {
+	err := self.Backend.PurgePlugins(context, selectPlugins)
+	self.plugins.clear()
+	self.pluginLists.clear()
+	return err
+}
+
+// Utils
+
+func pluginListKey(selectPlugins backend.SelectPlugins, window backend.Window) string {
+	var type_, executor, trigger string
+	if selectPlugins.Type != nil {
+		type_ = *selectPlugins.Type
+	}
+	if selectPlugins.Executor != nil {
+		executor = *selectPlugins.Executor
+	}
+	if selectPlugins.Trigger != nil {
+		trigger = selectPlugins.Trigger.String()
+	}
+	return fmt.Sprintf("%t|%s|%q|%t|%s|%t|%s|%d|%d",
+		selectPlugins.Type != nil, type_, selectPlugins.NamePatterns,
+		selectPlugins.Executor != nil, executor,
+		selectPlugins.Trigger != nil, trigger,
+		window.Offset, window.MaxCount)
+}
+
+func clonePlugins(plugins []backend.Plugin) []backend.Plugin {
+	plugins_ := make([]backend.Plugin, len(plugins))
+	for index, plugin := range plugins {
+		plugins_[index] = *plugin.Clone()
+	}
+	return plugins_
+}
@@ -0,0 +1,26 @@
+package caching
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	return self.Backend.ListRevisions(context, type_, namespace, objectId, window)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	return self.Backend.GetRevision(context, revisionId)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	// Reverting can change anything, including associations
+	err := self.Backend.RevertTo(context, revisionId)
+	self.clear()
+	return err
+}
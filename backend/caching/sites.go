@@ -0,0 +1,60 @@
+package caching
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
+	key := newObjectKey(site.Namespace, site.SiteID)
+	err := self.Backend.SetSite(context, site)
+	self.sites.invalidate(key)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
+	key := newObjectKey(namespace, siteId)
+	if site, generation, ok := self.sites.get(key); ok {
+		return site.Clone(true), nil
+	} else if site, err := self.Backend.GetSite(context, namespace, siteId); err == nil {
+		self.sites.put(key, site.Clone(true), generation)
+		return site, nil
+	} else {
+		return nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
+	err := self.Backend.DeleteSite(context, namespace, siteId)
+	self.sites.invalidate(newObjectKey(namespace, siteId))
+	self.deployments.clear() // associations removed
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ListSites(context contextpkg.Context, selectSites backend.SelectSites, window backend.Window) (util.Results[backend.SiteInfo], error) {
+	return self.Backend.ListSites(context, selectSites, window)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) PurgeSites(context contextpkg.Context, selectSites backend.SelectSites) error {
+	err := self.Backend.PurgeSites(context, selectSites)
+	self.sites.clear()
+	self.deployments.clear() // associations removed
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	return self.Backend.ListDeletedDeployments(context, namespace, siteId)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	return self.Backend.AcknowledgeDeletedDeployments(context, namespace, siteId, deploymentIds)
+}
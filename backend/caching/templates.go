@@ -0,0 +1,52 @@
+package caching
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
+	key := newObjectKey(template.Namespace, template.TemplateID)
+	err := self.Backend.SetTemplate(context, template)
+	self.templates.invalidate(key)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
+	key := newObjectKey(namespace, templateId)
+	if template, generation, ok := self.templates.get(key); ok {
+		return template.Clone(true), nil
+	} else if template, err := self.Backend.GetTemplate(context, namespace, templateId); err == nil {
+		self.templates.put(key, template.Clone(true), generation)
+		return template, nil
+	} else {
+		return nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
+	err := self.Backend.DeleteTemplate(context, namespace, templateId)
+	self.templates.invalidate(newObjectKey(namespace, templateId))
+	self.sites.clear() // associations removed
+	self.deployments.clear()
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) ListTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, window backend.Window) (util.Results[backend.TemplateInfo], error) {
+	return self.Backend.ListTemplates(context, selectTemplates, window)
+}
+
+// ([backend.Backend] interface)
+func (self *CachingBackend) PurgeTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates) error {
+	err := self.Backend.PurgeTemplates(context, selectTemplates)
+	self.templates.clear()
+	self.sites.clear() // associations removed
+	self.deployments.clear()
+	return err
+}
@@ -1,19 +1,39 @@
 package backend
 
 import (
+	contextpkg "context"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/nephio-experimental/tko/util"
+	validationpkg "github.com/nephio-experimental/tko/validation"
 	"github.com/tliron/go-ard"
 )
 
+const (
+	PropagationOrphan     = "orphan"     // child deployments are kept but their parent is removed
+	PropagationBackground = "background" // child deployments are deleted after the call returns
+	PropagationForeground = "foreground" // child deployments are deleted before the call returns
+
+	PropagationsDescription = "\"orphan\", \"background\", or \"foreground\""
+)
+
+func IsValidPropagation(propagation string) bool {
+	switch propagation {
+	case PropagationOrphan, PropagationBackground, PropagationForeground:
+		return true
+	default:
+		return false
+	}
+}
+
 //
 // DeploymentInfo
 //
 
 type DeploymentInfo struct {
+	Namespace          string // also the namespace of the parent deployment, template, and site
 	DeploymentID       string
 	ParentDeploymentID string
 	TemplateID         string
@@ -21,12 +41,14 @@ type DeploymentInfo struct {
 	Metadata           map[string]string
 	Created            time.Time // millisecond precision
 	Updated            time.Time // millisecond precision
+	Version            uint64    // incremented on every write
 	Prepared           bool
 	Approved           bool
 }
 
 func (self *DeploymentInfo) Clone() DeploymentInfo {
 	return DeploymentInfo{
+		Namespace:          self.Namespace,
 		DeploymentID:       self.DeploymentID,
 		ParentDeploymentID: self.ParentDeploymentID,
 		TemplateID:         self.TemplateID,
@@ -34,6 +56,7 @@ func (self *DeploymentInfo) Clone() DeploymentInfo {
 		Metadata:           util.CloneStringMap(self.Metadata),
 		Created:            self.Created,
 		Updated:            self.Updated,
+		Version:            self.Version,
 		Prepared:           self.Prepared,
 		Approved:           self.Approved,
 	}
@@ -90,13 +113,14 @@ type Deployment struct {
 	Package util.Package
 }
 
-func NewDeploymentFromBytes(parentDemploymentId string, templateId string, siteId string, metadata map[string]string, prepared bool, approved bool, packageFormat string, package_ []byte) (*Deployment, error) {
+func NewDeploymentFromBytes(namespace string, parentDemploymentId string, templateId string, siteId string, metadata map[string]string, prepared bool, approved bool, packageFormat string, package_ []byte) (*Deployment, error) {
 	if package__, err := util.DecodePackage(packageFormat, package_); err == nil {
 		if metadata == nil {
 			metadata = make(map[string]string)
 		}
 		return &Deployment{
 			DeploymentInfo: DeploymentInfo{
+				Namespace:          namespace,
 				ParentDeploymentID: parentDemploymentId,
 				TemplateID:         templateId,
 				SiteID:             siteId,
@@ -132,6 +156,12 @@ func (self *Deployment) UpdateFromPackage(withMetadata bool) {
 	self.DeploymentInfo.UpdateFromPackage(self.Package, withMetadata)
 }
 
+// Deployments that are associated with a site and are both prepared and approved are passed to
+// the site's schedulers.
+func (self *Deployment) IsScheduled() bool {
+	return (self.SiteID != "") && self.Prepared && self.Approved
+}
+
 func (self *Deployment) MergeTemplate(template *Template) {
 	self.MergeTemplateInfo(&template.TemplateInfo)
 
@@ -140,6 +170,17 @@ func (self *Deployment) MergeTemplate(template *Template) {
 	self.Package = package_
 }
 
+// Leaves only those resources in the package that are not identical to resources in the
+// template package, i.e. those that were changed or added.
+func (self *Deployment) RemoveTemplateResources(template *Template) error {
+	if package_, err := util.DiffPackage(self.Package, template.Package); err == nil {
+		self.Package = package_
+		return nil
+	} else {
+		return err
+	}
+}
+
 func (self *Deployment) MergeDeploymentResource() {
 	self.Package = util.MergePackage(self.Package, self.NewDeploymentResource())
 }
@@ -149,12 +190,133 @@ func (self *Deployment) MergeDeploymentResource() {
 //
 
 type SelectDeployments struct {
+	Namespace                string // can be AllNamespaces for listing
 	ParentDeploymentID       *string
 	TemplateIDPatterns       []string
 	TemplateMetadataPatterns map[string]string
 	SiteIDPatterns           []string
 	SiteMetadataPatterns     map[string]string
 	MetadataPatterns         map[string]string
+	MetadataSelector         string // see [selector.Parse]
+	PackageSelector          string // see [selector.Parse]
 	Prepared                 *bool
 	Approved                 *bool
 }
+
+//
+// ModifyDeployments
+//
+
+type ModifyDeployments struct {
+	Approved       *bool             // if not nil sets or clears the approved annotation
+	SetMetadata    map[string]string // merged into existing metadata
+	DeleteMetadata []string          // applied after SetMetadata
+
+	// If not nil then deployments that become approved must be completely valid
+	Validation *validationpkg.Validation
+}
+
+func (self *ModifyDeployments) IsEmpty() bool {
+	return (self.Approved == nil) && (len(self.SetMetadata) == 0) && (len(self.DeleteMetadata) == 0)
+}
+
+func (self *ModifyDeployments) ChangesMetadata() bool {
+	return (len(self.SetMetadata) > 0) || (len(self.DeleteMetadata) > 0)
+}
+
+// Changes the deployment in place. Returns true if anything was changed.
+func (self *ModifyDeployments) Apply(context contextpkg.Context, deployment *Deployment) (bool, error) {
+	var changed bool
+
+	if self.Approved != nil {
+		approved := deployment.Approved
+		if changed_, err := self.ApplyToPackage(deployment.Package); err == nil {
+			if changed_ {
+				deployment.UpdateFromPackage(false)
+				if !approved && deployment.Approved {
+					if err := self.ValidateApproved(context, deployment.Package); err != nil {
+						return false, err
+					}
+				}
+				changed = true
+			}
+		} else {
+			return false, err
+		}
+	}
+
+	if self.ChangesMetadata() {
+		if deployment.Metadata == nil {
+			deployment.Metadata = make(map[string]string)
+		}
+
+		for key, value := range self.SetMetadata {
+			if value_, ok := deployment.Metadata[key]; !ok || (value_ != value) {
+				deployment.Metadata[key] = value
+				changed = true
+			}
+		}
+
+		for _, key := range self.DeleteMetadata {
+			if _, ok := deployment.Metadata[key]; ok {
+				delete(deployment.Metadata, key)
+				changed = true
+			}
+		}
+	}
+
+	return changed, nil
+}
+
+// Only applies the approved annotation. Returns true if it was changed.
+func (self *ModifyDeployments) ApplyToPackage(package_ util.Package) (bool, error) {
+	if self.Approved != nil {
+		if deployment, ok := util.DeploymentResourceIdentifier.GetResource(package_); ok {
+			return util.SetApprovedAnnotation(deployment, *self.Approved), nil
+		} else {
+			return false, NewBadArgumentError("malformed Deployment")
+		}
+	}
+	return false, nil
+}
+
+// Complete validation, as for any approved deployment. Does nothing if there is no validation.
+func (self *ModifyDeployments) ValidateApproved(context contextpkg.Context, package_ util.Package) error {
+	if self.Validation != nil {
+		if err := self.Validation.ValidatePackage(context, package_, true); err != nil {
+			return WrapBadArgumentError(err)
+		}
+	}
+	return nil
+}
+
+//
+// DeploymentCounter
+//
+
+// Optionally implemented by storage backends that can count deployments without listing them.
+type DeploymentCounter interface {
+	CountDeployments(context contextpkg.Context) (map[DeploymentState]uint, error)
+}
+
+type DeploymentState struct {
+	Prepared bool
+	Approved bool
+}
+
+//
+// DeploymentModificationResult
+//
+
+type DeploymentModificationResult struct {
+	DeploymentID      string
+	Modified          bool   // false if already as requested or if not modified
+	Version           uint64 // after modification
+	NotModifiedReason string // if not empty then the modification failed
+}
+
+func SortDeploymentModificationResults(results []DeploymentModificationResult) {
+	slices.SortFunc(results, func(a DeploymentModificationResult, b DeploymentModificationResult) int {
+		return strings.Compare(a.DeploymentID, b.DeploymentID)
+	})
+}
@@ -179,3 +179,63 @@ func IsTimeoutError(err error) bool {
 func (self *TimeoutError) Error() string {
 	return self.message
 }
+
+//
+// ConflictError
+//
+
+type ConflictError struct {
+	message string
+}
+
+func NewConflictError(message string) *ConflictError {
+	if message == "" {
+		return &ConflictError{"conflict"}
+	} else {
+		return &ConflictError{"conflict: " + message}
+	}
+}
+
+func NewConflictErrorf(format string, a ...any) *ConflictError {
+	return NewConflictError(fmt.Sprintf(format, a...))
+}
+
+func IsConflictError(err error) bool {
+	_, ok := err.(*ConflictError)
+	return ok
+}
+
+// (error interface)
+func (self *ConflictError) Error() string {
+	return self.message
+}
+
+//
+// NotAuthorizedError
+//
+
+type NotAuthorizedError struct {
+	message string
+}
+
+func NewNotAuthorizedError(message string) *NotAuthorizedError {
+	if message == "" {
+		return &NotAuthorizedError{"not authorized"}
+	} else {
+		return &NotAuthorizedError{"not authorized: " + message}
+	}
+}
+
+func NewNotAuthorizedErrorf(format string, a ...any) *NotAuthorizedError {
+	return NewNotAuthorizedError(fmt.Sprintf(format, a...))
+}
+
+func IsNotAuthorizedError(err error) bool {
+	_, ok := err.(*NotAuthorizedError)
+	return ok
+}
+
+// (error interface)
+func (self *NotAuthorizedError) Error() string {
+	return self.message
+}
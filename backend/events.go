@@ -0,0 +1,275 @@
+package backend
+
+import (
+	contextpkg "context"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	EventTypeAdded   = "added"
+	EventTypeUpdated = "updated"
+	EventTypeDeleted = "deleted"
+
+	EventKindTemplate   = "template"
+	EventKindSite       = "site"
+	EventKindDeployment = "deployment"
+	EventKindPlugin     = "plugin"
+
+	EventKindsDescription = "\"template\", \"site\", \"deployment\", or \"plugin\""
+)
+
+var (
+	DefaultEventBufferSize   = 1_000
+	DefaultEventHistorySize  = 10_000
+	EventWatcherTooSlowError = NewBusyError("event watcher fell behind")
+	EventRevisionTooOldError = NewBadArgumentError("event revision is too old")
+)
+
+func IsValidEventKind(kind string) bool {
+	switch kind {
+	case EventKindTemplate, EventKindSite, EventKindDeployment, EventKindPlugin:
+		return true
+	default:
+		return false
+	}
+}
+
+//
+// Event
+//
+
+type Event struct {
+	Revision  uint64 // monotonically increasing
+	Type      string // "added", "updated", or "deleted"
+	Kind      string // "template", "site", "deployment", or "plugin"
+	Namespace string // empty for plugins
+	ID        string
+	Timestamp time.Time // millisecond precision
+}
+
+func NewEvent(type_ string, kind string, namespace string, id string) Event {
+	return Event{
+		Type:      type_,
+		Kind:      kind,
+		Namespace: namespace,
+		ID:        id,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+//
+// SelectEvents
+//
+
+type SelectEvents struct {
+	Namespace     string   // can be AllNamespaces; plugin events are always included
+	Kinds         []string // empty for all kinds
+	SinceRevision uint64   // 0 for only new events
+}
+
+func (self *SelectEvents) Matches(event Event) bool {
+	if (event.Kind != EventKindPlugin) && !NamespaceMatches(event.Namespace, self.Namespace) {
+		return false
+	}
+	return (len(self.Kinds) == 0) || slices.Contains(self.Kinds, event.Kind)
+}
+
+//
+// EventBroadcaster
+//
+
+// Fans out events to watchers, keeping a bounded history of recent events for resumption.
+type EventBroadcaster struct {
+	BufferSize  int
+	HistorySize int
+
+	// Optional fallback for events older than the in-memory history
+	LoadHistory func(context contextpkg.Context, sinceRevision uint64) ([]Event, error)
+
+	revision uint64
+	history  []Event
+	watchers map[*EventWatcher]struct{}
+	lock     sync.Mutex
+}
+
+func NewEventBroadcaster() *EventBroadcaster {
+	return &EventBroadcaster{
+		BufferSize:  DefaultEventBufferSize,
+		HistorySize: DefaultEventHistorySize,
+		watchers:    make(map[*EventWatcher]struct{}),
+	}
+}
+
+// Latest broadcast revision.
+func (self *EventBroadcaster) Revision() uint64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.revision
+}
+
+// Sets the latest revision without broadcasting, e.g. when resuming from persistent storage.
+func (self *EventBroadcaster) SetRevision(revision uint64) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.revision = revision
+}
+
+// If the event's revision is 0 it will be assigned the next revision. Events with revisions
+// that are not newer than the latest broadcast revision are ignored.
+//
+// Watchers that fall behind are closed with EventWatcherTooSlowError.
+func (self *EventBroadcaster) Broadcast(event Event) Event {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if event.Revision == 0 {
+		event.Revision = self.revision + 1
+	} else if event.Revision <= self.revision {
+		return event
+	}
+	self.revision = event.Revision
+
+	if self.HistorySize > 0 {
+		self.history = append(self.history, event)
+		if overflow := len(self.history) - self.HistorySize; overflow > 0 {
+			self.history = slices.Delete(self.history, 0, overflow)
+		}
+	}
+
+	for watcher := range self.watchers {
+		select {
+		case watcher.events <- event:
+		default:
+			watcher.err = EventWatcherTooSlowError
+			self.unsubscribe(watcher)
+		}
+	}
+
+	return event
+}
+
+// Can return EventRevisionTooOldError.
+func (self *EventBroadcaster) Watch(context contextpkg.Context, selectEvents SelectEvents) (*EventWatcher, error) {
+	watcher := &EventWatcher{
+		selectEvents: selectEvents,
+		context:      context,
+		events:       make(chan Event, self.BufferSize),
+		broadcaster:  self,
+	}
+
+	// Subscribe before gathering history so that we won't miss events in between
+	self.lock.Lock()
+	self.watchers[watcher] = struct{}{}
+	var history []Event
+	var historyOk bool
+	if selectEvents.SinceRevision > 0 {
+		history, historyOk = self.historySince(selectEvents.SinceRevision)
+	}
+	self.lock.Unlock()
+
+	if selectEvents.SinceRevision > 0 {
+		if historyOk {
+			watcher.history = history
+		} else if self.LoadHistory != nil {
+			var err error
+			if watcher.history, err = self.LoadHistory(context, selectEvents.SinceRevision); err != nil {
+				watcher.Release()
+				return nil, err
+			}
+		} else {
+			watcher.Release()
+			return nil, EventRevisionTooOldError
+		}
+	}
+
+	watcher.lastRevision = selectEvents.SinceRevision
+	return watcher, nil
+}
+
+// Assumes lock is held.
+func (self *EventBroadcaster) historySince(sinceRevision uint64) ([]Event, bool) {
+	if sinceRevision >= self.revision {
+		return nil, true
+	}
+
+	if (len(self.history) == 0) || (self.history[0].Revision > sinceRevision+1) {
+		return nil, false
+	}
+
+	for index, event := range self.history {
+		if event.Revision > sinceRevision {
+			return slices.Clone(self.history[index:]), true
+		}
+	}
+
+	return nil, true
+}
+
+// Assumes lock is held.
+func (self *EventBroadcaster) unsubscribe(watcher *EventWatcher) {
+	if _, ok := self.watchers[watcher]; ok {
+		delete(self.watchers, watcher)
+		close(watcher.events)
+	}
+}
+
+//
+// EventWatcher
+//
+
+type EventWatcher struct {
+	selectEvents SelectEvents
+	context      contextpkg.Context
+	history      []Event
+	events       chan Event
+	lastRevision uint64
+	err          error
+	broadcaster  *EventBroadcaster
+}
+
+// Blocks until an event is available. Returns [io.EOF] when the context is done.
+// ([util.Results] interface)
+func (self *EventWatcher) Next() (Event, error) {
+	for {
+		var event Event
+
+		if len(self.history) > 0 {
+			event = self.history[0]
+			self.history = self.history[1:]
+		} else {
+			var ok bool
+			select {
+			case event, ok = <-self.events:
+				if !ok {
+					if self.err != nil {
+						return Event{}, self.err
+					}
+					return Event{}, io.EOF
+				}
+
+			case <-self.context.Done():
+				return Event{}, io.EOF
+			}
+		}
+
+		// Skip duplicates between history and live events
+		if event.Revision <= self.lastRevision {
+			continue
+		}
+		self.lastRevision = event.Revision
+
+		if self.selectEvents.Matches(event) {
+			return event, nil
+		}
+	}
+}
+
+// ([util.Results] interface)
+func (self *EventWatcher) Release() {
+	self.broadcaster.lock.Lock()
+	defer self.broadcaster.lock.Unlock()
+	self.broadcaster.unsubscribe(self)
+}
@@ -0,0 +1,67 @@
+package backend
+
+import (
+	contextpkg "context"
+	"slices"
+)
+
+const (
+	AnonymousIdentityName = "system:anonymous"
+	AuthenticatedGroup    = "system:authenticated"
+	UnauthenticatedGroup  = "system:unauthenticated"
+)
+
+//
+// Identity
+//
+
+type Identity struct {
+	Name   string
+	Groups []string
+}
+
+// Authenticated identities are always also in AuthenticatedGroup.
+func NewIdentity(name string, groups ...string) *Identity {
+	if !slices.Contains(groups, AuthenticatedGroup) {
+		groups = append(slices.Clone(groups), AuthenticatedGroup)
+	}
+	return &Identity{
+		Name:   name,
+		Groups: groups,
+	}
+}
+
+func NewAnonymousIdentity() *Identity {
+	return &Identity{
+		Name:   AnonymousIdentityName,
+		Groups: []string{UnauthenticatedGroup},
+	}
+}
+
+func (self *Identity) IsAnonymous() bool {
+	return self.Name == AnonymousIdentityName
+}
+
+func (self *Identity) InGroup(group string) bool {
+	return slices.Contains(self.Groups, group)
+}
+
+// ([fmt.Stringer] interface)
+func (self *Identity) String() string {
+	return self.Name
+}
+
+type identityContextKey struct{}
+
+// Returns a copy of the context that carries the authenticated identity of the caller.
+func ContextWithIdentity(context contextpkg.Context, identity *Identity) contextpkg.Context {
+	return contextpkg.WithValue(context, identityContextKey{}, identity)
+}
+
+// Returns nil if the context has no identity.
+func GetIdentity(context contextpkg.Context) *Identity {
+	if identity, ok := context.Value(identityContextKey{}).(*Identity); ok {
+		return identity
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	event.ID = uint64(len(self.auditEvents)) + 1
+	self.auditEvents = append(self.auditEvents, event)
+	return nil
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	self.lock.Lock()
+
+	var events []backend.AuditEvent
+	for _, event := range self.auditEvents {
+		if selectAuditEvents.Matches(event) {
+			events = append(events, event.Clone())
+		}
+	}
+
+	self.lock.Unlock()
+
+	events = backend.ApplyWindow(events, window)
+	return util.NewResultsSlice(events), nil
+}
@@ -5,22 +5,32 @@ import (
 	"sync"
 
 	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
 )
 
 const Name = "memory"
 
-var _ backend.Backend = new(MemoryBackend)
+var (
+	_ backend.Backend           = new(MemoryBackend)
+	_ backend.DeploymentCounter = new(MemoryBackend)
+)
 
 //
 // MemoryBackend
 //
 
 type MemoryBackend struct {
-	templates   map[string]*backend.Template
-	sites       map[string]*backend.Site
-	deployments map[string]*Deployment
-	plugins     map[backend.PluginID]*backend.Plugin
+	templates          map[NamespacedID]*backend.Template
+	sites              map[NamespacedID]*backend.Site
+	deletedDeployments map[string]*backend.DeletedDeployment
+	deployments        map[string]*Deployment
+	pendingDeletes     map[string]struct{} // deployment IDs to be deleted in the background
+	plugins            map[backend.PluginID]*backend.Plugin
+	revisions          map[RevisionsKey][]*backend.Revision
+	blobs              map[string]tkoutil.Resource // see internPackage
+	auditEvents        []*backend.AuditEvent
+	events             *backend.EventBroadcaster
 
 	log                commonlog.Logger
 	modificationWindow int64 // microseconds
@@ -31,15 +41,29 @@ type MemoryBackend struct {
 // modificationWindow in seconds
 func NewMemoryBackend(modificationWindow int, log commonlog.Logger) *MemoryBackend {
 	return &MemoryBackend{
-		templates:          make(map[string]*backend.Template),
-		sites:              make(map[string]*backend.Site),
+		templates:          make(map[NamespacedID]*backend.Template),
+		sites:              make(map[NamespacedID]*backend.Site),
+		deletedDeployments: make(map[string]*backend.DeletedDeployment),
 		deployments:        make(map[string]*Deployment),
+		pendingDeletes:     make(map[string]struct{}),
 		plugins:            make(map[backend.PluginID]*backend.Plugin),
+		revisions:          make(map[RevisionsKey][]*backend.Revision),
+		blobs:              make(map[string]tkoutil.Resource),
+		events:             backend.NewEventBroadcaster(),
 		log:                log,
 		modificationWindow: int64(modificationWindow) * 1_000_000,
 	}
 }
 
+//
+// NamespacedID
+//
+
+type NamespacedID struct {
+	Namespace string
+	ID        string
+}
+
 // ([backend.Backend] interface)
 func (self *MemoryBackend) Connect(context contextpkg.Context) error {
 	self.log.Notice("connect")
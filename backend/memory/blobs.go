@@ -0,0 +1,28 @@
+package memory
+
+import (
+	tkoutil "github.com/nephio-experimental/tko/util"
+)
+
+// Replaces the package resources with shared instances of identical resources, so that
+// each distinct resource is stored only once. Stored packages must thus never be changed in
+// place.
+//
+// Blobs are never deleted, because revisions are kept, too.
+//
+// Assumes lock is held.
+func (self *MemoryBackend) internPackage(package_ tkoutil.Package) tkoutil.Package {
+	for index, resource := range package_ {
+		if hash, err := tkoutil.HashResource(resource); err == nil {
+			if resource_, ok := self.blobs[hash]; ok {
+				package_[index] = resource_
+			} else {
+				self.blobs[hash] = resource
+			}
+		} else {
+			// Will not be shared
+			self.log.Warningf("could not hash resource: %s", err.Error())
+		}
+	}
+	return package_
+}
@@ -23,7 +23,7 @@ func (self *MemoryBackend) CreateDeployment(context contextpkg.Context, deployme
 
 	// Validate parent deployment
 	if deployment.ParentDeploymentID != "" {
-		if _, ok := self.deployments[deployment.ParentDeploymentID]; !ok {
+		if parentDeployment, ok := self.deployments[deployment.ParentDeploymentID]; !ok || (parentDeployment.Namespace != deployment.Namespace) {
 			return backend.NewBadArgumentErrorf("unknown parent deployment: %s", deployment.ParentDeploymentID)
 		}
 	}
@@ -32,7 +32,7 @@ func (self *MemoryBackend) CreateDeployment(context contextpkg.Context, deployme
 	var template *backend.Template
 	if deployment.TemplateID != "" {
 		var ok bool
-		if template, ok = self.templates[deployment.TemplateID]; !ok {
+		if template, ok = self.templates[NamespacedID{deployment.Namespace, deployment.TemplateID}]; !ok {
 			return backend.NewBadArgumentErrorf("unknown template: %s", deployment.TemplateID)
 		}
 	}
@@ -41,7 +41,7 @@ func (self *MemoryBackend) CreateDeployment(context contextpkg.Context, deployme
 	var site *backend.Site
 	if deployment.SiteID != "" {
 		var ok bool
-		if site, ok = self.sites[deployment.SiteID]; !ok {
+		if site, ok = self.sites[NamespacedID{deployment.Namespace, deployment.SiteID}]; !ok {
 			return backend.NewBadArgumentErrorf("unknown site: %s", deployment.SiteID)
 		}
 	}
@@ -50,16 +50,30 @@ func (self *MemoryBackend) CreateDeployment(context contextpkg.Context, deployme
 	deployment.DeploymentID = backend.NewID()
 	deployment.Created = now
 	deployment.Updated = now
-	self.deployments[deployment.DeploymentID] = &Deployment{Deployment: deployment}
+	deployment.Version = 1
 
-	// Merge and associate with template
+	// Merge template
 	if template != nil {
 		deployment.MergeTemplate(template)
 		deployment.UpdateFromPackage(true)
-		template.AddDeployment(deployment.DeploymentID)
 	}
 	deployment.MergeDeploymentResource()
 
+	revision, err := backend.NewDeploymentRevision(context, deployment)
+	if err != nil {
+		return err
+	}
+
+	deployment.Package = self.internPackage(deployment.Package)
+	self.deployments[deployment.DeploymentID] = &Deployment{Deployment: deployment}
+	self.addRevision(revision)
+	self.broadcast(backend.EventTypeAdded, backend.EventKindDeployment, deployment.Namespace, deployment.DeploymentID)
+
+	// Associate with template
+	if template != nil {
+		template.AddDeployment(deployment.DeploymentID)
+	}
+
 	// Associate with site
 	if site != nil {
 		site.AddDeployment(deployment.DeploymentID)
@@ -69,27 +83,27 @@ func (self *MemoryBackend) CreateDeployment(context contextpkg.Context, deployme
 }
 
 // ([backend.Backend] interface)
-func (self *MemoryBackend) GetDeployment(context contextpkg.Context, deploymentId string) (*backend.Deployment, error) {
+func (self *MemoryBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	if deployment, ok := self.deployments[deploymentId]; ok {
+	if deployment, ok := self.deployments[deploymentId]; ok && (deployment.Namespace == namespace) {
 		return deployment.Deployment.Clone(true), nil
 	} else {
-		return nil, backend.NewNotFoundErrorf("deployment: %s", deploymentId)
+		return nil, backend.NewNotFoundErrorf("deployment: %s/%s", namespace, deploymentId)
 	}
 }
 
 // ([backend.Backend] interface)
-func (self *MemoryBackend) DeleteDeployment(context contextpkg.Context, deploymentId string) error {
+func (self *MemoryBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	if deployment, ok := self.deployments[deploymentId]; ok {
-		self.deleteDeployment(context, deployment)
+	if deployment, ok := self.deployments[deploymentId]; ok && (deployment.Namespace == namespace) {
+		self.deleteDeployments(context, []*Deployment{deployment}, propagation)
 		return nil
 	} else {
-		return backend.NewNotFoundErrorf("deployment: %s", deploymentId)
+		return backend.NewNotFoundErrorf("deployment: %s/%s", namespace, deploymentId)
 	}
 }
 
@@ -98,33 +112,57 @@ func (self *MemoryBackend) ListDeployments(context contextpkg.Context, selectDep
 	self.lock.Lock()
 
 	var deploymentInfos []backend.DeploymentInfo
-	self.selectDeployments(context, selectDeployments, func(context contextpkg.Context, deployment *Deployment) {
+	err := self.selectDeployments(context, selectDeployments, func(context contextpkg.Context, deployment *Deployment) {
 		deploymentInfos = append(deploymentInfos, deployment.DeploymentInfo)
 	})
 
 	self.lock.Unlock()
 
+	if err != nil {
+		return nil, err
+	}
+
 	backend.SortDeploymentInfos(deploymentInfos)
 	deploymentInfos = backend.ApplyWindow(deploymentInfos, window)
 	return util.NewResultsSlice(deploymentInfos), nil
 }
 
+// ([backend.DeploymentCounter] interface)
+func (self *MemoryBackend) CountDeployments(context contextpkg.Context) (map[backend.DeploymentState]uint, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	counts := make(map[backend.DeploymentState]uint)
+	for _, deployment := range self.deployments {
+		counts[backend.DeploymentState{Prepared: deployment.Prepared, Approved: deployment.Approved}]++
+	}
+
+	return counts, nil
+}
+
 // ([backend.Backend] interface)
-func (self *MemoryBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments) error {
+func (self *MemoryBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	self.selectDeployments(context, selectDeployments, self.deleteDeployment)
+	var deployments []*Deployment
+	if err := self.selectDeployments(context, selectDeployments, func(context contextpkg.Context, deployment *Deployment) {
+		deployments = append(deployments, deployment)
+	}); err != nil {
+		return err
+	}
+
+	self.deleteDeployments(context, deployments, propagation)
 
 	return nil
 }
 
 // ([backend.Backend] interface)
-func (self *MemoryBackend) StartDeploymentModification(context contextpkg.Context, deploymentId string) (string, *backend.Deployment, error) {
+func (self *MemoryBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	if deployment, ok := self.deployments[deploymentId]; ok {
+	if deployment, ok := self.deployments[deploymentId]; ok && (deployment.Namespace == namespace) {
 		available := deployment.CurrentModificationToken == ""
 
 		if !available {
@@ -134,12 +172,12 @@ func (self *MemoryBackend) StartDeploymentModification(context contextpkg.Contex
 		if available {
 			deployment.CurrentModificationToken = backend.NewID()
 			deployment.CurrentModificationTimestamp = time.Now().UnixMicro()
-			return deployment.CurrentModificationToken, deployment.Deployment, nil
+			return deployment.CurrentModificationToken, deployment.Deployment.Clone(true), nil
 		} else {
-			return "", nil, backend.NewBusyErrorf("deployment: %s", deploymentId)
+			return "", nil, backend.NewBusyErrorf("deployment: %s/%s", namespace, deploymentId)
 		}
 	} else {
-		return "", nil, backend.NewNotFoundErrorf("deployment: %s", deploymentId)
+		return "", nil, backend.NewNotFoundErrorf("deployment: %s/%s", namespace, deploymentId)
 	}
 }
 
@@ -160,7 +198,7 @@ func (self *MemoryBackend) EndDeploymentModification(context contextpkg.Context,
 
 				if validation != nil {
 					// Complete validation when fully prepared
-					if err := validation.ValidatePackage(package_, deployment.Prepared); err != nil {
+					if err := validation.ValidatePackage(context, package_, deployment.Prepared); err != nil {
 						return "", err
 					}
 				}
@@ -170,7 +208,7 @@ func (self *MemoryBackend) EndDeploymentModification(context contextpkg.Context,
 				var template *backend.Template
 				if deployment.TemplateID != "" {
 					var ok bool
-					if template, ok = self.templates[deployment.TemplateID]; !ok {
+					if template, ok = self.templates[NamespacedID{deployment.Namespace, deployment.TemplateID}]; !ok {
 						return "", backend.NewBadArgumentErrorf("unknown template: %s", deployment.TemplateID)
 					}
 				}
@@ -180,16 +218,21 @@ func (self *MemoryBackend) EndDeploymentModification(context contextpkg.Context,
 				var site *backend.Site
 				if deployment.SiteID != "" {
 					var ok bool
-					if site, ok = self.sites[deployment.SiteID]; !ok {
+					if site, ok = self.sites[NamespacedID{deployment.Namespace, deployment.SiteID}]; !ok {
 						return "", backend.NewBadArgumentErrorf("unknown site: %s", deployment.SiteID)
 					}
 				}
 
+				revision, err := backend.NewDeploymentRevision(context, deployment.Deployment)
+				if err != nil {
+					return "", err
+				}
+
 				// Update template assocation
 
 				if deployment.TemplateID != originalTemplateId {
 					if originalTemplateId != "" {
-						if template, ok := self.templates[originalTemplateId]; ok {
+						if template, ok := self.templates[NamespacedID{deployment.Namespace, originalTemplateId}]; ok {
 							template.RemoveDeployment(originalTemplateId)
 						} else {
 							self.log.Warningf("missing template: %s", originalTemplateId)
@@ -205,7 +248,7 @@ func (self *MemoryBackend) EndDeploymentModification(context contextpkg.Context,
 
 				if deployment.SiteID != originalSiteId {
 					if originalSiteId != "" {
-						if site, ok := self.sites[originalSiteId]; ok {
+						if site, ok := self.sites[NamespacedID{deployment.Namespace, originalSiteId}]; ok {
 							site.RemoveDeployment(originalSiteId)
 						} else {
 							self.log.Warningf("missing site: %s", originalSiteId)
@@ -218,7 +261,11 @@ func (self *MemoryBackend) EndDeploymentModification(context contextpkg.Context,
 				}
 
 				deployment.Updated = time.Now().UTC()
+				deployment.Version++
+				deployment.Package = self.internPackage(deployment.Package)
 				self.deployments[deploymentId] = deployment
+				self.addRevision(revision)
+				self.broadcast(backend.EventTypeUpdated, backend.EventKindDeployment, deployment.Namespace, deploymentId)
 
 				return deploymentId, nil
 			} else {
@@ -248,14 +295,243 @@ func (self *MemoryBackend) CancelDeploymentModification(context contextpkg.Conte
 	return backend.NewNotFoundErrorf("modification token: %s", modificationToken)
 }
 
+// ([backend.Backend] interface)
+func (self *MemoryBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	// Holding the lock and committing only at the end makes it a single transaction
+	var results []backend.DeploymentModificationResult
+	var modifiedDeployments []*backend.Deployment
+	var revisions []*backend.Revision
+	var err error
+	if err_ := self.selectDeployments(context, selectDeployments, func(context contextpkg.Context, deployment *Deployment) {
+		if err != nil {
+			return
+		}
+
+		result := backend.DeploymentModificationResult{
+			DeploymentID: deployment.DeploymentID,
+			Version:      deployment.Version,
+		}
+
+		if (deployment.CurrentModificationToken != "") && !self.hasModificationExpired(deployment) {
+			result.NotModifiedReason = "busy"
+			results = append(results, result)
+			return
+		}
+
+		deployment_ := deployment.Clone(true)
+		if modified, err_ := modifyDeployments.Apply(context, deployment_); err_ == nil {
+			if modified {
+				deployment_.Updated = time.Now().UTC()
+				deployment_.Version++
+
+				var revision *backend.Revision
+				if revision, err = backend.NewDeploymentRevision(context, deployment_); err != nil {
+					return
+				}
+
+				modifiedDeployments = append(modifiedDeployments, deployment_)
+				revisions = append(revisions, revision)
+
+				result.Modified = true
+				result.Version = deployment_.Version
+			}
+		} else {
+			result.NotModifiedReason = err_.Error()
+		}
+
+		results = append(results, result)
+	}); err_ != nil {
+		return nil, err_
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for index, deployment := range modifiedDeployments {
+		deployment.Package = self.internPackage(deployment.Package)
+		self.deployments[deployment.DeploymentID] = &Deployment{Deployment: deployment}
+		self.addRevision(revisions[index])
+		self.broadcast(backend.EventTypeUpdated, backend.EventKindDeployment, deployment.Namespace, deployment.DeploymentID)
+	}
+
+	backend.SortDeploymentModificationResults(results)
+	return results, nil
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	// Validate parent deployment
+	if deployment.ParentDeploymentID != "" {
+		if parentDeployment, ok := self.deployments[deployment.ParentDeploymentID]; !ok || (parentDeployment.Namespace != deployment.Namespace) {
+			return backend.NewBadArgumentErrorf("unknown parent deployment: %s", deployment.ParentDeploymentID)
+		}
+	}
+
+	// Validate template
+	var template *backend.Template
+	if deployment.TemplateID != "" {
+		var ok bool
+		if template, ok = self.templates[NamespacedID{deployment.Namespace, deployment.TemplateID}]; !ok {
+			return backend.NewBadArgumentErrorf("unknown template: %s", deployment.TemplateID)
+		}
+	}
+
+	// Validate site
+	var site *backend.Site
+	if deployment.SiteID != "" {
+		var ok bool
+		if site, ok = self.sites[NamespacedID{deployment.Namespace, deployment.SiteID}]; !ok {
+			return backend.NewBadArgumentErrorf("unknown site: %s", deployment.SiteID)
+		}
+	}
+
+	var version uint64
+	eventType := backend.EventTypeAdded
+	if originalDeployment, ok := self.deployments[deployment.DeploymentID]; ok {
+		if originalDeployment.Namespace != deployment.Namespace {
+			return backend.NewBadArgumentErrorf("deployment exists in another namespace: %s", deployment.DeploymentID)
+		}
+
+		version = originalDeployment.Version
+		eventType = backend.EventTypeUpdated
+
+		// Remove original associations
+		if originalDeployment.TemplateID != "" {
+			if template, ok := self.templates[NamespacedID{originalDeployment.Namespace, originalDeployment.TemplateID}]; ok {
+				template.RemoveDeployment(originalDeployment.DeploymentID)
+			}
+		}
+		if originalDeployment.SiteID != "" {
+			if site, ok := self.sites[NamespacedID{originalDeployment.Namespace, originalDeployment.SiteID}]; ok {
+				site.RemoveDeployment(originalDeployment.DeploymentID)
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	if deployment.Created.IsZero() {
+		deployment.Created = now
+	}
+	if deployment.Updated.IsZero() {
+		deployment.Updated = now
+	}
+	deployment.Version = version + 1
+
+	revision, err := backend.NewDeploymentRevision(context, deployment)
+	if err != nil {
+		return err
+	}
+
+	deployment.Package = self.internPackage(deployment.Package)
+	self.deployments[deployment.DeploymentID] = &Deployment{Deployment: deployment}
+	self.addRevision(revision)
+	self.broadcast(eventType, backend.EventKindDeployment, deployment.Namespace, deployment.DeploymentID)
+
+	// Associate with template
+	if template != nil {
+		template.AddDeployment(deployment.DeploymentID)
+	}
+
+	// Associate with site
+	if site != nil {
+		site.AddDeployment(deployment.DeploymentID)
+	}
+
+	return nil
+}
+
 // Utils
 
+// Caller must hold the lock.
+func (self *MemoryBackend) deleteDeployments(context contextpkg.Context, deployments []*Deployment, propagation string) {
+	var descendants []*Deployment
+	switch propagation {
+	case backend.PropagationBackground, backend.PropagationForeground:
+		descendants = self.getDeploymentDescendants(deployments)
+	}
+
+	if propagation == backend.PropagationForeground {
+		for _, descendant := range descendants {
+			self.deleteDeployment(context, descendant)
+		}
+	}
+
+	for _, deployment := range deployments {
+		self.deleteDeployment(context, deployment)
+	}
+
+	if (propagation == backend.PropagationBackground) && (len(descendants) > 0) {
+		// Marked while the lock is held, so that they are deleted even if this goroutine is
+		// preceded by another one
+		for _, descendant := range descendants {
+			self.pendingDeletes[descendant.DeploymentID] = struct{}{}
+		}
+
+		// The request context will be done by then
+		go func() {
+			self.lock.Lock()
+			defer self.lock.Unlock()
+			self.reapDeployments(contextpkg.Background())
+		}()
+	}
+}
+
+// Deletes all deployments that are pending deletion.
+// Caller must hold the lock.
+func (self *MemoryBackend) reapDeployments(context contextpkg.Context) {
+	for deploymentId := range self.pendingDeletes {
+		// Might have been deleted in the meantime
+		if deployment, ok := self.deployments[deploymentId]; ok {
+			self.deleteDeployment(context, deployment)
+		}
+	}
+	clear(self.pendingDeletes)
+}
+
+// Excludes the argument deployments. Deepest descendants are first.
+// Caller must hold the lock.
+func (self *MemoryBackend) getDeploymentDescendants(deployments []*Deployment) []*Deployment {
+	ids := make(map[string]struct{})
+	for _, deployment := range deployments {
+		ids[deployment.DeploymentID] = struct{}{}
+	}
+
+	var descendants []*Deployment
+	parents := deployments
+	for len(parents) > 0 {
+		var children []*Deployment
+		for _, parent := range parents {
+			for _, deployment := range self.deployments {
+				if deployment.ParentDeploymentID == parent.DeploymentID {
+					if _, ok := ids[deployment.DeploymentID]; !ok {
+						ids[deployment.DeploymentID] = struct{}{}
+						children = append(children, deployment)
+					}
+				}
+			}
+		}
+		descendants = append(children, descendants...)
+		parents = children
+	}
+
+	return descendants
+}
+
 func (self *MemoryBackend) deleteDeployment(context contextpkg.Context, deployment *Deployment) {
 	delete(self.deployments, deployment.DeploymentID)
+	delete(self.pendingDeletes, deployment.DeploymentID)
+	self.broadcast(backend.EventTypeDeleted, backend.EventKindDeployment, deployment.Namespace, deployment.DeploymentID)
 
 	// Remove association from template
 	if deployment.TemplateID != "" {
-		if template, ok := self.templates[deployment.TemplateID]; ok {
+		if template, ok := self.templates[NamespacedID{deployment.Namespace, deployment.TemplateID}]; ok {
 			template.RemoveDeployment(deployment.DeploymentID)
 		} else {
 			self.log.Warningf("missing template: %s", deployment.TemplateID)
@@ -264,8 +540,13 @@ func (self *MemoryBackend) deleteDeployment(context contextpkg.Context, deployme
 
 	// Remove association from site
 	if deployment.SiteID != "" {
-		if site, ok := self.sites[deployment.SiteID]; ok {
+		if site, ok := self.sites[NamespacedID{deployment.Namespace, deployment.SiteID}]; ok {
 			site.RemoveDeployment(deployment.DeploymentID)
+			if deployment.IsScheduled() {
+				deletedDeployment := backend.NewDeletedDeployment(deployment.Deployment)
+				deletedDeployment.Package = self.internPackage(deletedDeployment.Package)
+				self.deletedDeployments[deployment.DeploymentID] = deletedDeployment
+			}
 		} else {
 			self.log.Warningf("missing site: %s", deployment.SiteID)
 		}
@@ -279,13 +560,22 @@ func (self *MemoryBackend) deleteDeployment(context contextpkg.Context, deployme
 	}
 }
 
-func (self *MemoryBackend) selectDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, f func(context contextpkg.Context, deployment *Deployment)) {
+func (self *MemoryBackend) selectDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, f func(context contextpkg.Context, deployment *Deployment)) error {
+	selectors, err := backend.ParseSelectors(selectDeployments.MetadataSelector, selectDeployments.PackageSelector)
+	if err != nil {
+		return err
+	}
+
 	filterPrepared := (selectDeployments.Prepared != nil) && (*selectDeployments.Prepared == true)
 	filterNotPrepared := (selectDeployments.Prepared != nil) && (*selectDeployments.Prepared == false)
 	filterApproved := (selectDeployments.Approved != nil) && (*selectDeployments.Approved == true)
 	filterNotApproved := (selectDeployments.Approved != nil) && (*selectDeployments.Approved == false)
 
 	for _, deployment := range self.deployments {
+		if !backend.NamespaceMatches(deployment.Namespace, selectDeployments.Namespace) {
+			continue
+		}
+
 		if filterPrepared && !deployment.Prepared {
 			continue
 		}
@@ -315,7 +605,7 @@ func (self *MemoryBackend) selectDeployments(context contextpkg.Context, selectD
 		}
 
 		if (selectDeployments.TemplateMetadataPatterns != nil) && (len(selectDeployments.TemplateMetadataPatterns) > 0) {
-			if template, ok := self.templates[deployment.TemplateID]; ok {
+			if template, ok := self.templates[NamespacedID{deployment.Namespace, deployment.TemplateID}]; ok {
 				if !backend.MetadataMatchesPatterns(template.Metadata, selectDeployments.TemplateMetadataPatterns) {
 					continue
 				}
@@ -329,7 +619,7 @@ func (self *MemoryBackend) selectDeployments(context contextpkg.Context, selectD
 		}
 
 		if (selectDeployments.SiteMetadataPatterns != nil) && (len(selectDeployments.SiteMetadataPatterns) > 0) {
-			if site, ok := self.sites[deployment.SiteID]; ok {
+			if site, ok := self.sites[NamespacedID{deployment.Namespace, deployment.SiteID}]; ok {
 				if !backend.MetadataMatchesPatterns(site.Metadata, selectDeployments.SiteMetadataPatterns) {
 					continue
 				}
@@ -338,8 +628,14 @@ func (self *MemoryBackend) selectDeployments(context contextpkg.Context, selectD
 			}
 		}
 
+		if !selectors.Matches(deployment.Metadata, deployment.Package) {
+			continue
+		}
+
 		f(context, deployment)
 	}
+
+	return nil
 }
 
 func (self *MemoryBackend) hasModificationExpired(deployment *Deployment) bool {
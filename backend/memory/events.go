@@ -0,0 +1,25 @@
+package memory
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	return self.events.Watch(context, selectEvents)
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	return self.events.Revision(), nil
+}
+
+// Utils
+
+// Assumes lock is held.
+func (self *MemoryBackend) broadcast(type_ string, kind string, namespace string, id string) {
+	self.events.Broadcast(backend.NewEvent(type_, kind, namespace, id))
+}
@@ -12,7 +12,20 @@ func (self *MemoryBackend) SetPlugin(context contextpkg.Context, plugin *backend
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
+	var version uint64
+	eventType := backend.EventTypeAdded
+	if originalPlugin, ok := self.plugins[plugin.PluginID]; ok {
+		version = originalPlugin.Version
+		eventType = backend.EventTypeUpdated
+	}
+
+	if err := backend.CheckVersion(backend.EventKindPlugin, plugin.PluginID.String(), plugin.Version, version); err != nil {
+		return err
+	}
+
+	plugin.Version = version + 1
 	self.plugins[plugin.PluginID] = plugin
+	self.broadcast(eventType, backend.EventKindPlugin, "", plugin.PluginID.String())
 
 	return nil
 }
@@ -36,6 +49,7 @@ func (self *MemoryBackend) DeletePlugin(context contextpkg.Context, pluginId bac
 
 	if _, ok := self.plugins[pluginId]; ok {
 		delete(self.plugins, pluginId)
+		self.broadcast(backend.EventTypeDeleted, backend.EventKindPlugin, "", pluginId.String())
 		return nil
 	} else {
 		return backend.NewNotFoundErrorf("plugin: %s", pluginId)
@@ -65,6 +79,7 @@ func (self *MemoryBackend) PurgePlugins(context contextpkg.Context, selectPlugin
 
 	self.selectPlugins(context, selectPlugins, func(context contextpkg.Context, plugin *backend.Plugin) {
 		delete(self.plugins, plugin.PluginID)
+		self.broadcast(backend.EventTypeDeleted, backend.EventKindPlugin, "", plugin.PluginID.String())
 	})
 
 	return nil
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+type RevisionsKey struct {
+	Type      string
+	Namespace string
+	ObjectID  string
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	self.lock.Lock()
+
+	revisions := self.revisions[RevisionsKey{type_, namespace, objectId}]
+	revisionInfos := make([]backend.RevisionInfo, len(revisions))
+	for index, revision := range revisions {
+		revisionInfos[index] = revision.RevisionInfo.Clone()
+	}
+
+	self.lock.Unlock()
+
+	revisionInfos = backend.ApplyWindow(revisionInfos, window)
+	return util.NewResultsSlice(revisionInfos), nil
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if revision, ok := self.getRevision(revisionId); ok {
+		return revision.Clone(), nil
+	} else {
+		return nil, backend.NewNotFoundErrorf("revision: %s", revisionId.String())
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	self.lock.Lock()
+	revision, ok := self.getRevision(revisionId)
+	self.lock.Unlock()
+
+	if ok {
+		return backend.RevertToRevision(context, self, revision)
+	} else {
+		return backend.NewNotFoundErrorf("revision: %s", revisionId.String())
+	}
+}
+
+// Utils
+
+// Assumes lock is held.
+func (self *MemoryBackend) addRevision(revision *backend.Revision) {
+	key := RevisionsKey{revision.Type, revision.Namespace, revision.ObjectID}
+	revisions := self.revisions[key]
+	revision.Revision = uint64(len(revisions)) + 1
+	revision.Package = self.internPackage(revision.Package)
+	self.revisions[key] = append(revisions, revision)
+}
+
+// Assumes lock is held.
+func (self *MemoryBackend) getRevision(revisionId backend.RevisionID) (*backend.Revision, bool) {
+	revisions := self.revisions[RevisionsKey{revisionId.Type, revisionId.Namespace, revisionId.ObjectID}]
+	if (revisionId.Revision > 0) && (revisionId.Revision <= uint64(len(revisions))) {
+		return revisions[revisionId.Revision-1], true
+	}
+	return nil, false
+}
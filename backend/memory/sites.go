@@ -10,54 +10,37 @@ import (
 
 // ([backend.Backend] interface)
 func (self *MemoryBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
-	self.lock.Lock()
-	defer self.lock.Unlock()
-
-	var originalDeploymentIds []string
-	if originalSite, ok := self.sites[site.SiteID]; ok {
-		originalDeploymentIds = originalSite.DeploymentIDs
-	}
-
-	// Validate and merge template
-	if site.TemplateID != "" {
-		if template, ok := self.templates[site.TemplateID]; ok {
-			site.MergeTemplate(template)
-		} else {
-			return backend.NewBadArgumentErrorf("unknown template: %s", site.TemplateID)
-		}
-	}
-
-	// Restore associated deployments
-	site.DeploymentIDs = originalDeploymentIds
-
-	site.Updated = time.Now().UTC()
-	self.sites[site.SiteID] = site
+	return self.setSite(context, site, false)
+}
 
-	return nil
+// ([backend.Backend] interface)
+func (self *MemoryBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	site.Version = 0
+	return self.setSite(context, site, true)
 }
 
 // ([backend.Backend] interface)
-func (self *MemoryBackend) GetSite(context contextpkg.Context, siteId string) (*backend.Site, error) {
+func (self *MemoryBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	if site, ok := self.sites[siteId]; ok {
+	if site, ok := self.sites[NamespacedID{namespace, siteId}]; ok {
 		return site.Clone(true), nil
 	} else {
-		return nil, backend.NewNotFoundErrorf("site: %s", siteId)
+		return nil, backend.NewNotFoundErrorf("site: %s/%s", namespace, siteId)
 	}
 }
 
 // ([backend.Backend] interface)
-func (self *MemoryBackend) DeleteSite(context contextpkg.Context, siteId string) error {
+func (self *MemoryBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	if site, ok := self.sites[siteId]; ok {
+	if site, ok := self.sites[NamespacedID{namespace, siteId}]; ok {
 		self.deleteSite(context, site)
 		return nil
 	} else {
-		return backend.NewNotFoundErrorf("site: %s", siteId)
+		return backend.NewNotFoundErrorf("site: %s/%s", namespace, siteId)
 	}
 }
 
@@ -66,12 +49,16 @@ func (self *MemoryBackend) ListSites(context contextpkg.Context, selectSites bac
 	self.lock.Lock()
 
 	var siteInfos []backend.SiteInfo
-	self.selectSites(context, selectSites, func(context contextpkg.Context, site *backend.Site) {
+	err := self.selectSites(context, selectSites, func(context contextpkg.Context, site *backend.Site) {
 		siteInfos = append(siteInfos, site.SiteInfo)
 	})
 
 	self.lock.Unlock()
 
+	if err != nil {
+		return nil, err
+	}
+
 	backend.SortSiteInfos(siteInfos)
 	siteInfos = backend.ApplyWindow(siteInfos, window)
 	return util.NewResultsSlice(siteInfos), nil
@@ -82,26 +69,120 @@ func (self *MemoryBackend) PurgeSites(context contextpkg.Context, selectSites ba
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	self.selectSites(context, selectSites, self.deleteSite)
+	return self.selectSites(context, selectSites, self.deleteSite)
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	self.lock.Lock()
+
+	var deletedDeployments []backend.DeletedDeployment
+	for _, deletedDeployment := range self.deletedDeployments {
+		if (deletedDeployment.Namespace == namespace) && (deletedDeployment.SiteID == siteId) {
+			deletedDeployments = append(deletedDeployments, deletedDeployment.Clone())
+		}
+	}
+
+	self.lock.Unlock()
+
+	backend.SortDeletedDeployments(deletedDeployments)
+	return util.NewResultsSlice(deletedDeployments), nil
+}
+
+// ([backend.Backend] interface)
+func (self *MemoryBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, deploymentId := range deploymentIds {
+		if deletedDeployment, ok := self.deletedDeployments[deploymentId]; ok && (deletedDeployment.Namespace == namespace) && (deletedDeployment.SiteID == siteId) {
+			delete(self.deletedDeployments, deploymentId)
+		}
+	}
 
 	return nil
 }
 
 // Utils
 
+func (self *MemoryBackend) setSite(context contextpkg.Context, site *backend.Site, importing bool) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var originalDeploymentIds []string
+	var version uint64
+	eventType := backend.EventTypeAdded
+	if originalSite, ok := self.sites[NamespacedID{site.Namespace, site.SiteID}]; ok {
+		originalDeploymentIds = originalSite.DeploymentIDs
+		version = originalSite.Version
+		eventType = backend.EventTypeUpdated
+	}
+
+	if err := backend.CheckVersion(backend.EventKindSite, site.SiteID, site.Version, version); err != nil {
+		return err
+	}
+
+	// Validate and merge template
+	if site.TemplateID != "" {
+		if template, ok := self.templates[NamespacedID{site.Namespace, site.TemplateID}]; ok {
+			if !importing {
+				site.MergeTemplate(template)
+			}
+		} else {
+			return backend.NewBadArgumentErrorf("unknown template: %s", site.TemplateID)
+		}
+	}
+
+	// Restore associated deployments
+	site.DeploymentIDs = originalDeploymentIds
+
+	revision, err := backend.NewSiteRevision(context, site)
+	if err != nil {
+		return err
+	}
+
+	if !importing || site.Updated.IsZero() {
+		site.Updated = time.Now().UTC()
+	}
+	site.Version = version + 1
+	site.Package = self.internPackage(site.Package)
+	self.sites[NamespacedID{site.Namespace, site.SiteID}] = site
+	self.addRevision(revision)
+	self.broadcast(eventType, backend.EventKindSite, site.Namespace, site.SiteID)
+
+	return nil
+}
+
 func (self *MemoryBackend) deleteSite(context contextpkg.Context, site *backend.Site) {
-	delete(self.sites, site.SiteID)
+	delete(self.sites, NamespacedID{site.Namespace, site.SiteID})
+	self.broadcast(backend.EventTypeDeleted, backend.EventKindSite, site.Namespace, site.SiteID)
+
+	// Remove tombstones
+	for deploymentId, deletedDeployment := range self.deletedDeployments {
+		if (deletedDeployment.Namespace == site.Namespace) && (deletedDeployment.SiteID == site.SiteID) {
+			delete(self.deletedDeployments, deploymentId)
+		}
+	}
 
 	// Remove deployment associations
 	for _, deployment := range self.deployments {
-		if deployment.SiteID == site.SiteID {
+		if (deployment.Namespace == site.Namespace) && (deployment.SiteID == site.SiteID) {
 			deployment.SiteID = ""
 		}
 	}
 }
 
-func (self *MemoryBackend) selectSites(context contextpkg.Context, selectSites backend.SelectSites, f func(context contextpkg.Context, site *backend.Site)) {
+func (self *MemoryBackend) selectSites(context contextpkg.Context, selectSites backend.SelectSites, f func(context contextpkg.Context, site *backend.Site)) error {
+	selectors, err := backend.ParseSelectors(selectSites.MetadataSelector, selectSites.PackageSelector)
+	if err != nil {
+		return err
+	}
+
 	for _, site := range self.sites {
+		if !backend.NamespaceMatches(site.Namespace, selectSites.Namespace) {
+			continue
+		}
+
 		if !backend.IDMatchesPatterns(site.TemplateID, selectSites.TemplateIDPatterns) {
 			continue
 		}
@@ -114,6 +195,12 @@ func (self *MemoryBackend) selectSites(context contextpkg.Context, selectSites b
 			continue
 		}
 
+		if !selectors.Matches(site.Metadata, site.Package) {
+			continue
+		}
+
 		f(context, site)
 	}
+
+	return nil
 }
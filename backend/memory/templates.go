@@ -10,42 +10,37 @@ import (
 
 // ([backend.Backend] interface)
 func (self *MemoryBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
-	self.lock.Lock()
-	defer self.lock.Unlock()
-
-	// Keep associated deployments
-	if originalTemplate, ok := self.templates[template.TemplateID]; ok {
-		template.DeploymentIDs = originalTemplate.DeploymentIDs
-	}
-
-	template.Updated = time.Now().UTC()
-	self.templates[template.TemplateID] = template
+	return self.setTemplate(context, template, false)
+}
 
-	return nil
+// ([backend.Backend] interface)
+func (self *MemoryBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	template.Version = 0
+	return self.setTemplate(context, template, true)
 }
 
 // ([backend.Backend] interface)
-func (self *MemoryBackend) GetTemplate(context contextpkg.Context, templateId string) (*backend.Template, error) {
+func (self *MemoryBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	if template, ok := self.templates[templateId]; ok {
+	if template, ok := self.templates[NamespacedID{namespace, templateId}]; ok {
 		return template.Clone(true), nil
 	} else {
-		return nil, backend.NewNotFoundErrorf("template: %s", templateId)
+		return nil, backend.NewNotFoundErrorf("template: %s/%s", namespace, templateId)
 	}
 }
 
 // ([backend.Backend] interface)
-func (self *MemoryBackend) DeleteTemplate(context contextpkg.Context, templateId string) error {
+func (self *MemoryBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	if template, ok := self.templates[templateId]; ok {
+	if template, ok := self.templates[NamespacedID{namespace, templateId}]; ok {
 		self.deleteTemplate(context, template)
 		return nil
 	} else {
-		return backend.NewNotFoundErrorf("template: %s", templateId)
+		return backend.NewNotFoundErrorf("template: %s/%s", namespace, templateId)
 	}
 }
 
@@ -54,12 +49,16 @@ func (self *MemoryBackend) ListTemplates(context contextpkg.Context, selectTempl
 	self.lock.Lock()
 
 	var templateInfos []backend.TemplateInfo
-	self.selectTemplates(context, selectTemplates, func(context contextpkg.Context, template *backend.Template) {
+	err := self.selectTemplates(context, selectTemplates, func(context contextpkg.Context, template *backend.Template) {
 		templateInfos = append(templateInfos, template.TemplateInfo)
 	})
 
 	self.lock.Unlock()
 
+	if err != nil {
+		return nil, err
+	}
+
 	backend.SortTemplateInfos(templateInfos)
 	templateInfos = backend.ApplyWindow(templateInfos, window)
 	return util.NewResultsSlice(templateInfos), nil
@@ -70,33 +69,75 @@ func (self *MemoryBackend) PurgeTemplates(context contextpkg.Context, selectTemp
 	self.lock.Lock()
 	defer self.lock.Unlock()
 
-	self.selectTemplates(context, selectTemplates, self.deleteTemplate)
-
-	return nil
+	return self.selectTemplates(context, selectTemplates, self.deleteTemplate)
 }
 
 // Utils
 
+func (self *MemoryBackend) setTemplate(context contextpkg.Context, template *backend.Template, importing bool) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	// Keep associated deployments
+	var version uint64
+	eventType := backend.EventTypeAdded
+	if originalTemplate, ok := self.templates[NamespacedID{template.Namespace, template.TemplateID}]; ok {
+		template.DeploymentIDs = originalTemplate.DeploymentIDs
+		version = originalTemplate.Version
+		eventType = backend.EventTypeUpdated
+	}
+
+	if err := backend.CheckVersion(backend.EventKindTemplate, template.TemplateID, template.Version, version); err != nil {
+		return err
+	}
+
+	revision, err := backend.NewTemplateRevision(context, template)
+	if err != nil {
+		return err
+	}
+
+	if !importing || template.Updated.IsZero() {
+		template.Updated = time.Now().UTC()
+	}
+	template.Version = version + 1
+	template.Package = self.internPackage(template.Package)
+	self.templates[NamespacedID{template.Namespace, template.TemplateID}] = template
+	self.addRevision(revision)
+	self.broadcast(eventType, backend.EventKindTemplate, template.Namespace, template.TemplateID)
+
+	return nil
+}
+
 func (self *MemoryBackend) deleteTemplate(context contextpkg.Context, template *backend.Template) {
-	delete(self.templates, template.TemplateID)
+	delete(self.templates, NamespacedID{template.Namespace, template.TemplateID})
+	self.broadcast(backend.EventTypeDeleted, backend.EventKindTemplate, template.Namespace, template.TemplateID)
 
 	// Remove site associations
 	for _, site := range self.sites {
-		if site.TemplateID == template.TemplateID {
+		if (site.Namespace == template.Namespace) && (site.TemplateID == template.TemplateID) {
 			site.TemplateID = ""
 		}
 	}
 
 	// Remove deployment associations
 	for _, deployment := range self.deployments {
-		if deployment.TemplateID == template.TemplateID {
+		if (deployment.Namespace == template.Namespace) && (deployment.TemplateID == template.TemplateID) {
 			deployment.TemplateID = ""
 		}
 	}
 }
 
-func (self *MemoryBackend) selectTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, f func(context contextpkg.Context, template *backend.Template)) {
+func (self *MemoryBackend) selectTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, f func(context contextpkg.Context, template *backend.Template)) error {
+	selectors, err := backend.ParseSelectors(selectTemplates.MetadataSelector, selectTemplates.PackageSelector)
+	if err != nil {
+		return err
+	}
+
 	for _, template := range self.templates {
+		if !backend.NamespaceMatches(template.Namespace, selectTemplates.Namespace) {
+			continue
+		}
+
 		if !backend.IDMatchesPatterns(template.TemplateID, selectTemplates.TemplateIDPatterns) {
 			continue
 		}
@@ -105,6 +146,12 @@ func (self *MemoryBackend) selectTemplates(context contextpkg.Context, selectTem
 			continue
 		}
 
+		if !selectors.Matches(template.Metadata, template.Package) {
+			continue
+		}
+
 		f(context, template)
 	}
+
+	return nil
 }
@@ -0,0 +1,25 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	start := time.Now()
+	err := self.Backend.RecordAuditEvent(context, event)
+	self.observe("recordAuditEvent", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	start := time.Now()
+	results, err := self.Backend.ListAuditEvents(context, selectAuditEvents, window)
+	self.observe("listAuditEvents", start, err)
+	return results, err
+}
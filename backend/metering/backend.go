@@ -0,0 +1,114 @@
+package metering
+
+import (
+	contextpkg "context"
+	"strconv"
+	"time"
+
+	backendpkg "github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tliron/kutil/util"
+)
+
+// Timeout for counting deployments when collecting metrics.
+var CollectTimeout = 10 * time.Second
+
+var (
+	_ backendpkg.Backend   = new(MeteringBackend)
+	_ prometheus.Collector = new(MeteringBackend)
+)
+
+var deploymentsDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(metrics.Namespace, "", "deployments"),
+	"Number of deployments by prepared and approved state.",
+	[]string{"prepared", "approved"}, nil)
+
+//
+// MeteringBackend
+//
+
+type MeteringBackend struct {
+	Backend backendpkg.Backend
+}
+
+// Wraps an existing backend with operation latency metrics. For list and watch operations
+// only the time until the results are available is measured.
+//
+// Is also a [prometheus.Collector] of deployment counts, which should be registered
+// in [metrics.Registry]. The counts are gathered from the wrapped backend on every scrape,
+// via [backend.DeploymentCounter] if it implements it, otherwise by listing all deployments.
+func NewMeteringBackend(backend backendpkg.Backend) *MeteringBackend {
+	return &MeteringBackend{
+		Backend: backend,
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) Connect(context contextpkg.Context) error {
+	return self.Backend.Connect(context)
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) Release(context contextpkg.Context) error {
+	return self.Backend.Release(context)
+}
+
+// ([fmt.Stringer] interface)
+// ([backend.Backend] interface)
+func (self *MeteringBackend) String() string {
+	return self.Backend.String()
+}
+
+// ([prometheus.Collector] interface)
+func (self *MeteringBackend) Describe(descs chan<- *prometheus.Desc) {
+	descs <- deploymentsDesc
+}
+
+// ([prometheus.Collector] interface)
+func (self *MeteringBackend) Collect(metrics_ chan<- prometheus.Metric) {
+	context, cancel := contextpkg.WithTimeout(contextpkg.Background(), CollectTimeout)
+	defer cancel()
+
+	if counts, err := self.countDeployments(context); err == nil {
+		for _, prepared := range []bool{false, true} {
+			for _, approved := range []bool{false, true} {
+				metrics_ <- prometheus.MustNewConstMetric(deploymentsDesc, prometheus.GaugeValue, float64(counts[backendpkg.DeploymentState{Prepared: prepared, Approved: approved}]), strconv.FormatBool(prepared), strconv.FormatBool(approved))
+			}
+		}
+	} else {
+		metrics_ <- prometheus.NewInvalidMetric(deploymentsDesc, err)
+	}
+}
+
+func (self *MeteringBackend) countDeployments(context contextpkg.Context) (map[backendpkg.DeploymentState]uint, error) {
+	if counter, ok := self.Backend.(backendpkg.DeploymentCounter); ok {
+		return counter.CountDeployments(context)
+	}
+
+	counts := make(map[backendpkg.DeploymentState]uint)
+	window := backendpkg.Window{MaxCount: int(backendpkg.MaxMaxCount)}
+	for {
+		if results, err := self.Backend.ListDeployments(context, backendpkg.SelectDeployments{Namespace: backendpkg.AllNamespaces}, window); err == nil {
+			var count uint
+			if err := util.IterateResults(results, func(deploymentInfo backendpkg.DeploymentInfo) error {
+				counts[backendpkg.DeploymentState{Prepared: deploymentInfo.Prepared, Approved: deploymentInfo.Approved}]++
+				count++
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+
+			if count < window.Limit() {
+				return counts, nil
+			}
+			window.Offset += count
+		} else {
+			return nil, err
+		}
+	}
+}
+
+func (self *MeteringBackend) observe(operation string, start time.Time, err error) {
+	metrics.ObserveBackendOperation(operation, start, err, backendpkg.IsBusyError(err))
+}
@@ -0,0 +1,83 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	validationpkg "github.com/nephio-experimental/tko/validation"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) CreateDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	start := time.Now()
+	err := self.Backend.CreateDeployment(context, deployment)
+	self.observe("createDeployment", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
+	start := time.Now()
+	deployment, err := self.Backend.GetDeployment(context, namespace, deploymentId)
+	self.observe("getDeployment", start, err)
+	return deployment, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
+	start := time.Now()
+	err := self.Backend.DeleteDeployment(context, namespace, deploymentId, propagation)
+	self.observe("deleteDeployment", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ListDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, window backend.Window) (util.Results[backend.DeploymentInfo], error) {
+	start := time.Now()
+	results, err := self.Backend.ListDeployments(context, selectDeployments, window)
+	self.observe("listDeployments", start, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
+	start := time.Now()
+	err := self.Backend.PurgeDeployments(context, selectDeployments, propagation)
+	self.observe("purgeDeployments", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
+	start := time.Now()
+	modificationToken, deployment, err := self.Backend.StartDeploymentModification(context, namespace, deploymentId)
+	self.observe("startDeploymentModification", start, err)
+	return modificationToken, deployment, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) EndDeploymentModification(context contextpkg.Context, modificationToken string, package_ tkoutil.Package, validation *validationpkg.Validation) (string, error) {
+	start := time.Now()
+	deploymentId, err := self.Backend.EndDeploymentModification(context, modificationToken, package_, validation)
+	self.observe("endDeploymentModification", start, err)
+	return deploymentId, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) CancelDeploymentModification(context contextpkg.Context, modificationToken string) error {
+	start := time.Now()
+	err := self.Backend.CancelDeploymentModification(context, modificationToken)
+	self.observe("cancelDeploymentModification", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	start := time.Now()
+	results, err := self.Backend.ModifyDeployments(context, selectDeployments, modifyDeployments)
+	self.observe("modifyDeployments", start, err)
+	return results, err
+}
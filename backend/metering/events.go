@@ -0,0 +1,25 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	start := time.Now()
+	results, err := self.Backend.Watch(context, selectEvents)
+	self.observe("watch", start, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	start := time.Now()
+	revision, err := self.Backend.GetEventRevision(context)
+	self.observe("getEventRevision", start, err)
+	return revision, err
+}
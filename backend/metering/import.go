@@ -0,0 +1,32 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	start := time.Now()
+	err := self.Backend.ImportTemplate(context, template)
+	self.observe("importTemplate", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	start := time.Now()
+	err := self.Backend.ImportSite(context, site)
+	self.observe("importSite", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	start := time.Now()
+	err := self.Backend.ImportDeployment(context, deployment)
+	self.observe("importDeployment", start, err)
+	return err
+}
@@ -0,0 +1,49 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) SetPlugin(context contextpkg.Context, plugin *backend.Plugin) error {
+	start := time.Now()
+	err := self.Backend.SetPlugin(context, plugin)
+	self.observe("setPlugin", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) GetPlugin(context contextpkg.Context, pluginId backend.PluginID) (*backend.Plugin, error) {
+	start := time.Now()
+	plugin, err := self.Backend.GetPlugin(context, pluginId)
+	self.observe("getPlugin", start, err)
+	return plugin, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) DeletePlugin(context contextpkg.Context, pluginId backend.PluginID) error {
+	start := time.Now()
+	err := self.Backend.DeletePlugin(context, pluginId)
+	self.observe("deletePlugin", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ListPlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins, window backend.Window) (util.Results[backend.Plugin], error) {
+	start := time.Now()
+	results, err := self.Backend.ListPlugins(context, selectPlugins, window)
+	self.observe("listPlugins", start, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) PurgePlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins) error {
+	start := time.Now()
+	err := self.Backend.PurgePlugins(context, selectPlugins)
+	self.observe("purgePlugins", start, err)
+	return err
+}
@@ -0,0 +1,33 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	start := time.Now()
+	results, err := self.Backend.ListRevisions(context, type_, namespace, objectId, window)
+	self.observe("listRevisions", start, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	start := time.Now()
+	revision, err := self.Backend.GetRevision(context, revisionId)
+	self.observe("getRevision", start, err)
+	return revision, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	start := time.Now()
+	err := self.Backend.RevertTo(context, revisionId)
+	self.observe("revertTo", start, err)
+	return err
+}
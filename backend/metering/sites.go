@@ -0,0 +1,65 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
+	start := time.Now()
+	err := self.Backend.SetSite(context, site)
+	self.observe("setSite", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
+	start := time.Now()
+	site, err := self.Backend.GetSite(context, namespace, siteId)
+	self.observe("getSite", start, err)
+	return site, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
+	start := time.Now()
+	err := self.Backend.DeleteSite(context, namespace, siteId)
+	self.observe("deleteSite", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ListSites(context contextpkg.Context, selectSites backend.SelectSites, window backend.Window) (util.Results[backend.SiteInfo], error) {
+	start := time.Now()
+	results, err := self.Backend.ListSites(context, selectSites, window)
+	self.observe("listSites", start, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) PurgeSites(context contextpkg.Context, selectSites backend.SelectSites) error {
+	start := time.Now()
+	err := self.Backend.PurgeSites(context, selectSites)
+	self.observe("purgeSites", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	start := time.Now()
+	results, err := self.Backend.ListDeletedDeployments(context, namespace, siteId)
+	self.observe("listDeletedDeployments", start, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	start := time.Now()
+	err := self.Backend.AcknowledgeDeletedDeployments(context, namespace, siteId, deploymentIds)
+	self.observe("acknowledgeDeletedDeployments", start, err)
+	return err
+}
@@ -0,0 +1,49 @@
+package metering
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
+	start := time.Now()
+	err := self.Backend.SetTemplate(context, template)
+	self.observe("setTemplate", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
+	start := time.Now()
+	template, err := self.Backend.GetTemplate(context, namespace, templateId)
+	self.observe("getTemplate", start, err)
+	return template, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
+	start := time.Now()
+	err := self.Backend.DeleteTemplate(context, namespace, templateId)
+	self.observe("deleteTemplate", start, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) ListTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, window backend.Window) (util.Results[backend.TemplateInfo], error) {
+	start := time.Now()
+	results, err := self.Backend.ListTemplates(context, selectTemplates, window)
+	self.observe("listTemplates", start, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *MeteringBackend) PurgeTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates) error {
+	start := time.Now()
+	err := self.Backend.PurgeTemplates(context, selectTemplates)
+	self.observe("purgeTemplates", start, err)
+	return err
+}
@@ -0,0 +1,32 @@
+package backend
+
+const (
+	DefaultNamespace = "default"
+	AllNamespaces    = "*" // can only be used for listing and watching
+)
+
+// Empty namespace is DefaultNamespace.
+func NormalizeNamespace(namespace string) string {
+	if namespace == "" {
+		return DefaultNamespace
+	}
+	return namespace
+}
+
+func NamespaceMatches(namespace string, selectedNamespace string) bool {
+	return (selectedNamespace == AllNamespaces) || (namespace == selectedNamespace)
+}
+
+// Sorts by namespace first.
+func CompareNamespacedIDs(aNamespace string, aId string, bNamespace string, bId string) int {
+	if aNamespace < bNamespace {
+		return -1
+	} else if aNamespace > bNamespace {
+		return 1
+	} else if aId < bId {
+		return -1
+	} else if aId > bId {
+		return 1
+	}
+	return 0
+}
@@ -48,6 +48,7 @@ type Plugin struct {
 	Arguments  []string
 	Properties map[string]string
 	Triggers   []util.GVK
+	Version    uint64 // incremented on every write
 }
 
 func NewPlugin(type_ string, name string, executor string, arguments []string, properties map[string]string, triggers []util.GVK) *Plugin {
@@ -81,6 +82,7 @@ func (self *Plugin) Clone() *Plugin {
 		Executor:   self.Executor,
 		Arguments:  util.CloneStringList(self.Arguments),
 		Properties: util.CloneStringMap(self.Properties),
+		Version:    self.Version,
 	}
 }
 
@@ -0,0 +1,234 @@
+package backend
+
+import (
+	contextpkg "context"
+	"strconv"
+	"time"
+
+	"github.com/nephio-experimental/tko/util"
+)
+
+const (
+	RevisionTypeTemplate   = "template"
+	RevisionTypeSite       = "site"
+	RevisionTypeDeployment = "deployment"
+
+	RevisionIDSeparator = "@"
+
+	RevisionTypesDescription = "\"template\", \"site\", or \"deployment\""
+)
+
+func IsValidRevisionType(type_ string) bool {
+	switch type_ {
+	case RevisionTypeTemplate, RevisionTypeSite, RevisionTypeDeployment:
+		return true
+	default:
+		return false
+	}
+}
+
+//
+// RevisionID
+//
+
+type RevisionID struct {
+	Type      string
+	Namespace string
+	ObjectID  string
+	Revision  uint64
+}
+
+func NewRevisionID(type_ string, namespace string, objectId string, revision uint64) RevisionID {
+	return RevisionID{
+		Type:      type_,
+		Namespace: namespace,
+		ObjectID:  objectId,
+		Revision:  revision,
+	}
+}
+
+// ([fmt.Stringer] interface)
+func (self *RevisionID) String() string {
+	return self.Type + ":" + self.Namespace + "/" + self.ObjectID + RevisionIDSeparator + strconv.FormatUint(self.Revision, 10)
+}
+
+//
+// RevisionInfo
+//
+
+type RevisionInfo struct {
+	RevisionID
+	Author     string
+	Created    time.Time // millisecond precision
+	Hash       string
+	TemplateID string
+	Metadata   map[string]string
+}
+
+func (self *RevisionInfo) Clone() RevisionInfo {
+	return RevisionInfo{
+		RevisionID: self.RevisionID,
+		Author:     self.Author,
+		Created:    self.Created,
+		Hash:       self.Hash,
+		TemplateID: self.TemplateID,
+		Metadata:   util.CloneStringMap(self.Metadata),
+	}
+}
+
+//
+// Revision
+//
+
+type Revision struct {
+	RevisionInfo
+	Package util.Package
+}
+
+// Creates a revision with a cloned copy of the package. The revision number is left
+// empty and should be assigned by the backend.
+func NewRevision(context contextpkg.Context, type_ string, namespace string, objectId string, templateId string, metadata map[string]string, package_ util.Package) (*Revision, error) {
+	if hash, err := util.HashPackage(package_); err == nil {
+		return &Revision{
+			RevisionInfo: RevisionInfo{
+				RevisionID: RevisionID{
+					Type:      type_,
+					Namespace: namespace,
+					ObjectID:  objectId,
+				},
+				Author:     GetAuthor(context),
+				Created:    time.Now().UTC(),
+				Hash:       hash,
+				TemplateID: templateId,
+				Metadata:   util.CloneStringMap(metadata),
+			},
+			Package: util.ClonePackage(package_),
+		}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func NewTemplateRevision(context contextpkg.Context, template *Template) (*Revision, error) {
+	return NewRevision(context, RevisionTypeTemplate, template.Namespace, template.TemplateID, "", template.Metadata, template.Package)
+}
+
+func NewSiteRevision(context contextpkg.Context, site *Site) (*Revision, error) {
+	return NewRevision(context, RevisionTypeSite, site.Namespace, site.SiteID, site.TemplateID, site.Metadata, site.Package)
+}
+
+func NewDeploymentRevision(context contextpkg.Context, deployment *Deployment) (*Revision, error) {
+	return NewRevision(context, RevisionTypeDeployment, deployment.Namespace, deployment.DeploymentID, deployment.TemplateID, deployment.Metadata, deployment.Package)
+}
+
+func (self *Revision) Clone() *Revision {
+	return &Revision{
+		RevisionInfo: self.RevisionInfo.Clone(),
+		Package:      util.ClonePackage(self.Package),
+	}
+}
+
+func (self *Revision) EncodePackage(format string) ([]byte, error) {
+	return util.EncodePackage(format, self.Package)
+}
+
+// Writes the contents of the revision as the current state of its object, recreating it if
+// it was deleted. This will in turn create a new revision.
+//
+// Templates and sites are written conditionally on their current version, so this can
+// return ConflictError if they are changed concurrently. Deployments are imported while
+// holding a modification, so this can return BusyError if the deployment is currently being
+// modified. Deleted deployments are recreated without a parent deployment, because revisions
+// do not record it. Writes go through the backend, so pass a validating backend in order to
+// validate them.
+func RevertToRevision(context contextpkg.Context, backend Backend, revision *Revision) error {
+	switch revision.Type {
+	case RevisionTypeTemplate:
+		var version uint64
+		if template, err := backend.GetTemplate(context, revision.Namespace, revision.ObjectID); err == nil {
+			version = template.Version
+		} else if !IsNotFoundError(err) {
+			return err
+		}
+
+		return backend.SetTemplate(context, &Template{
+			TemplateInfo: TemplateInfo{
+				Namespace:  revision.Namespace,
+				TemplateID: revision.ObjectID,
+				Metadata:   util.CloneStringMap(revision.Metadata),
+				Version:    version,
+			},
+			Package: util.ClonePackage(revision.Package),
+		})
+
+	case RevisionTypeSite:
+		var version uint64
+		if site, err := backend.GetSite(context, revision.Namespace, revision.ObjectID); err == nil {
+			version = site.Version
+		} else if !IsNotFoundError(err) {
+			return err
+		}
+
+		return backend.SetSite(context, &Site{
+			SiteInfo: SiteInfo{
+				Namespace:  revision.Namespace,
+				SiteID:     revision.ObjectID,
+				TemplateID: revision.TemplateID,
+				Metadata:   util.CloneStringMap(revision.Metadata),
+				Version:    version,
+			},
+			Package: util.ClonePackage(revision.Package),
+		})
+
+	case RevisionTypeDeployment:
+		deployment := Deployment{
+			DeploymentInfo: DeploymentInfo{
+				Namespace:    revision.Namespace,
+				DeploymentID: revision.ObjectID,
+				Metadata:     util.CloneStringMap(revision.Metadata),
+			},
+			Package: util.ClonePackage(revision.Package),
+		}
+		deployment.UpdateFromPackage(false)
+
+		modificationToken, current, err := backend.StartDeploymentModification(context, revision.Namespace, revision.ObjectID)
+		if err == nil {
+			// Importing replaces the deployment and thus ends the modification
+			deployment.ParentDeploymentID = current.ParentDeploymentID
+			deployment.Created = current.Created
+		} else if !IsNotFoundError(err) {
+			return err
+		}
+
+		if err := backend.ImportDeployment(context, &deployment); err == nil {
+			return nil
+		} else {
+			if modificationToken != "" {
+				backend.CancelDeploymentModification(context, modificationToken)
+			}
+			return err
+		}
+
+	default:
+		return NewBadArgumentErrorf("unsupported revision type: %s", revision.Type)
+	}
+}
+
+//
+// Author
+//
+
+type authorContextKey struct{}
+
+// Returns a copy of the context that carries the author of writes, which will be
+// recorded in revisions.
+func ContextWithAuthor(context contextpkg.Context, author string) contextpkg.Context {
+	return contextpkg.WithValue(context, authorContextKey{}, author)
+}
+
+func GetAuthor(context contextpkg.Context) string {
+	if author, ok := context.Value(authorContextKey{}).(string); ok {
+		return author
+	}
+	return ""
+}
@@ -0,0 +1,316 @@
+package selector
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Numbers in string values must match this in order to be compared numerically.
+var NumberRE = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// Returns the value at the key and true if it exists.
+type Lookup func(key Key) (any, bool)
+
+//
+// Expression
+//
+
+type Expression interface {
+	Evaluate(lookup Lookup) bool
+
+	// Canonical source.
+	String() string
+}
+
+//
+// Key
+//
+
+// Path segments.
+type Key []string
+
+// Segments joined with ".".
+func (self Key) Name() string {
+	return strings.Join(self, ".")
+}
+
+// ([fmt.Stringer] interface)
+func (self Key) String() string {
+	segments := make([]string, len(self))
+	for index, segment := range self {
+		segments[index] = quote(segment, true)
+	}
+	return strings.Join(segments, ".")
+}
+
+//
+// And
+//
+
+type And []Expression
+
+// ([Expression] interface)
+func (self And) Evaluate(lookup Lookup) bool {
+	for _, expression := range self {
+		if !expression.Evaluate(lookup) {
+			return false
+		}
+	}
+	return true
+}
+
+// ([Expression] interface)
+// ([fmt.Stringer] interface)
+func (self And) String() string {
+	strings_ := make([]string, len(self))
+	for index, expression := range self {
+		if _, ok := expression.(Or); ok {
+			strings_[index] = "(" + expression.String() + ")"
+		} else {
+			strings_[index] = expression.String()
+		}
+	}
+	return strings.Join(strings_, " && ")
+}
+
+//
+// Or
+//
+
+type Or []Expression
+
+// ([Expression] interface)
+func (self Or) Evaluate(lookup Lookup) bool {
+	for _, expression := range self {
+		if expression.Evaluate(lookup) {
+			return true
+		}
+	}
+	return false
+}
+
+// ([Expression] interface)
+// ([fmt.Stringer] interface)
+func (self Or) String() string {
+	strings_ := make([]string, len(self))
+	for index, expression := range self {
+		strings_[index] = expression.String()
+	}
+	return strings.Join(strings_, " || ")
+}
+
+//
+// Not
+//
+
+type Not struct {
+	Expression Expression
+}
+
+// ([Expression] interface)
+func (self Not) Evaluate(lookup Lookup) bool {
+	return !self.Expression.Evaluate(lookup)
+}
+
+// ([Expression] interface)
+// ([fmt.Stringer] interface)
+func (self Not) String() string {
+	if exists, ok := self.Expression.(Exists); ok {
+		return "!" + exists.Key.String()
+	}
+	return "!(" + self.Expression.String() + ")"
+}
+
+//
+// Exists
+//
+
+type Exists struct {
+	Key Key
+}
+
+// ([Expression] interface)
+func (self Exists) Evaluate(lookup Lookup) bool {
+	_, ok := lookup(self.Key)
+	return ok
+}
+
+// ([Expression] interface)
+// ([fmt.Stringer] interface)
+func (self Exists) String() string {
+	return self.Key.String()
+}
+
+//
+// Compare
+//
+
+type Compare struct {
+	Key      Key
+	Operator string // "=", "!=", "<", "<=", ">", or ">="
+	Value    string
+	Number   float64 // for "<", "<=", ">", and ">="
+}
+
+func (self Compare) IsNumeric() bool {
+	switch self.Operator {
+	case "<", "<=", ">", ">=":
+		return true
+	default:
+		return false
+	}
+}
+
+// ([Expression] interface)
+func (self Compare) Evaluate(lookup Lookup) bool {
+	value, ok := lookup(self.Key)
+
+	switch self.Operator {
+	case "=":
+		if ok {
+			if value_, ok := ToString(value); ok {
+				return value_ == self.Value
+			}
+		}
+		return false
+
+	case "!=":
+		if ok {
+			if value_, ok := ToString(value); ok {
+				return value_ != self.Value
+			}
+		}
+		return true
+	}
+
+	if ok {
+		if number, ok := ToNumber(value); ok {
+			switch self.Operator {
+			case "<":
+				return number < self.Number
+			case "<=":
+				return number <= self.Number
+			case ">":
+				return number > self.Number
+			case ">=":
+				return number >= self.Number
+			}
+		}
+	}
+
+	return false
+}
+
+// ([Expression] interface)
+// ([fmt.Stringer] interface)
+func (self Compare) String() string {
+	return self.Key.String() + " " + self.Operator + " " + quote(self.Value, false)
+}
+
+//
+// In
+//
+
+type In struct {
+	Key    Key
+	Values []string
+	Not    bool // "notin"
+}
+
+// ([Expression] interface)
+func (self In) Evaluate(lookup Lookup) bool {
+	if value, ok := lookup(self.Key); ok {
+		if value_, ok := ToString(value); ok {
+			return slices.Contains(self.Values, value_) != self.Not
+		}
+	}
+	return self.Not
+}
+
+// ([Expression] interface)
+// ([fmt.Stringer] interface)
+func (self In) String() string {
+	values := make([]string, len(self.Values))
+	for index, value := range self.Values {
+		values[index] = quote(value, false)
+	}
+
+	operator := " in "
+	if self.Not {
+		operator = " notin "
+	}
+
+	return self.Key.String() + operator + "(" + strings.Join(values, ", ") + ")"
+}
+
+// Utils
+
+// Converts scalars to strings. Returns false for other values.
+func ToString(value any) (string, bool) {
+	switch value_ := value.(type) {
+	case string:
+		return value_, true
+	case bool:
+		return strconv.FormatBool(value_), true
+	case int:
+		return strconv.FormatInt(int64(value_), 10), true
+	case int64:
+		return strconv.FormatInt(value_, 10), true
+	case int32:
+		return strconv.FormatInt(int64(value_), 10), true
+	case uint:
+		return strconv.FormatUint(uint64(value_), 10), true
+	case uint64:
+		return strconv.FormatUint(value_, 10), true
+	case uint32:
+		return strconv.FormatUint(uint64(value_), 10), true
+	case float64:
+		return strconv.FormatFloat(value_, 'f', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(value_), 'f', -1, 32), true
+	default:
+		return "", false
+	}
+}
+
+// Converts numbers and strings matching [NumberRE] to float64. Returns false for other
+// values.
+func ToNumber(value any) (float64, bool) {
+	switch value_ := value.(type) {
+	case string:
+		if NumberRE.MatchString(value_) {
+			if number, err := strconv.ParseFloat(value_, 64); err == nil {
+				return number, true
+			}
+		}
+		return 0, false
+	case int:
+		return float64(value_), true
+	case int64:
+		return float64(value_), true
+	case int32:
+		return float64(value_), true
+	case uint:
+		return float64(value_), true
+	case uint64:
+		return float64(value_), true
+	case uint32:
+		return float64(value_), true
+	case float64:
+		return value_, true
+	case float32:
+		return float64(value_), true
+	default:
+		return 0, false
+	}
+}
+
+// Quotes the string if it would otherwise not be parsed as a single word (or key segment).
+func quote(s string, segment bool) string {
+	if (s == "") || (s == "in") || (s == "notin") || strings.ContainsAny(s, operatorRunes+"\"' \t\n\\") || (segment && strings.Contains(s, ".")) {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,124 @@
+package selector
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const operatorRunes = "()!=<>,&|"
+
+//
+// token
+//
+
+type token struct {
+	operator string   // empty for words
+	segments []string // for words; separated by unquoted "."
+	quoted   bool     // for words; true if any part was quoted
+	position int
+}
+
+func (self token) isWord() bool {
+	return self.operator == ""
+}
+
+// Keywords cannot be quoted.
+func (self token) isKeyword(keyword string) bool {
+	return self.isWord() && !self.quoted && (self.text() == keyword)
+}
+
+func (self token) text() string {
+	return strings.Join(self.segments, ".")
+}
+
+// ([fmt.Stringer] interface)
+func (self token) String() string {
+	if self.isWord() {
+		return fmt.Sprintf("%q", self.text())
+	}
+	return self.operator
+}
+
+func lex(source string) ([]token, error) {
+	var tokens []token
+	runes := []rune(source)
+	length := len(runes)
+
+	for index := 0; index < length; {
+		rune_ := runes[index]
+
+		if unicode.IsSpace(rune_) {
+			index++
+			continue
+		}
+
+		if strings.ContainsRune(operatorRunes, rune_) {
+			operator := string(rune_)
+			if index < length-1 {
+				switch two := operator + string(runes[index+1]); two {
+				case "!=", "==", "<=", ">=", "&&", "||":
+					operator = two
+				}
+			}
+
+			if (operator == "&") || (operator == "|") {
+				return nil, fmt.Errorf("unexpected %q at %d (did you mean %q?)", operator, index, operator+operator)
+			}
+
+			tokens = append(tokens, token{operator: operator, position: index})
+			index += len(operator)
+			continue
+		}
+
+		// Word
+		word := token{position: index}
+		var segment strings.Builder
+		for index < length {
+			rune_ = runes[index]
+			if unicode.IsSpace(rune_) || strings.ContainsRune(operatorRunes, rune_) {
+				break
+			}
+
+			switch rune_ {
+			case '.':
+				word.segments = append(word.segments, segment.String())
+				segment.Reset()
+				index++
+
+			case '"', '\'':
+				word.quoted = true
+				quote := rune_
+				start := index
+				index++
+				closed := false
+				for index < length {
+					rune_ = runes[index]
+					index++
+					if rune_ == '\\' {
+						if index < length {
+							segment.WriteRune(runes[index])
+							index++
+						}
+					} else if rune_ == quote {
+						closed = true
+						break
+					} else {
+						segment.WriteRune(rune_)
+					}
+				}
+				if !closed {
+					return nil, fmt.Errorf("unterminated quote at %d", start)
+				}
+
+			default:
+				segment.WriteRune(rune_)
+				index++
+			}
+		}
+		word.segments = append(word.segments, segment.String())
+		tokens = append(tokens, word)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,69 @@
+package selector
+
+import (
+	"strconv"
+
+	"github.com/tliron/go-ard"
+)
+
+// Looks up keys in metadata. Key segments are joined with ".", so that "a.b" and
+// a.b refer to the same metadata key.
+func MetadataLookup(metadata map[string]string) Lookup {
+	return func(key Key) (any, bool) {
+		value, ok := metadata[key.Name()]
+		return value, ok
+	}
+}
+
+// Looks up keys in a resource. Key segments are map keys or list indexes.
+func ResourceLookup(resource ard.Map) Lookup {
+	return func(key Key) (any, bool) {
+		var value any = resource
+		var ok bool
+		for _, segment := range key {
+			switch value_ := value.(type) {
+			case ard.Map:
+				if value, ok = value_[segment]; !ok {
+					return nil, false
+				}
+
+			case ard.StringMap:
+				if value, ok = value_[segment]; !ok {
+					return nil, false
+				}
+
+			case ard.List:
+				if index, err := strconv.ParseUint(segment, 10, 64); (err == nil) && (index < uint64(len(value_))) {
+					value = value_[index]
+				} else {
+					return nil, false
+				}
+
+			default:
+				return nil, false
+			}
+		}
+		return value, true
+	}
+}
+
+// True if the expression is nil or if it matches the metadata.
+func MetadataMatches(expression Expression, metadata map[string]string) bool {
+	if expression == nil {
+		return true
+	}
+	return expression.Evaluate(MetadataLookup(metadata))
+}
+
+// True if the expression is nil or if it matches at least one resource in the package.
+func PackageMatches(expression Expression, package_ []ard.Map) bool {
+	if expression == nil {
+		return true
+	}
+	for _, resource := range package_ {
+		if expression.Evaluate(ResourceLookup(resource)) {
+			return true
+		}
+	}
+	return false
+}
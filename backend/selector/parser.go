@@ -0,0 +1,256 @@
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Parses a selector expression. Returns nil (with no error) for an empty source, which
+// should be treated as matching everything.
+//
+// Predicates are:
+//
+//   - key (exists)
+//   - !key (does not exist)
+//   - key = value, key == value, key != value (!= also matches if the key does not exist)
+//   - key < number, key <= number, key > number, key >= number
+//   - key in (value, ...), key notin (value, ...) (notin also matches if the key does not exist)
+//
+// Predicates can be combined with "&&" (or ","), "||", "!(...)", and grouped with
+// parentheses. "&&" binds tighter than "||". Keys are paths separated by "." and both keys
+// and values can be quoted with '"' or "'", e.g. metadata.annotations."nephio.org/approved".
+func Parse(source string) (Expression, error) {
+	if tokens, err := lex(source); err == nil {
+		if len(tokens) == 0 {
+			return nil, nil
+		}
+
+		parser := parser{tokens: tokens}
+		if expression, err := parser.parseOr(); err == nil {
+			if token, ok := parser.peek(); ok {
+				return nil, fmt.Errorf("unexpected %s at %d", token, token.position)
+			}
+			return expression, nil
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
+//
+// parser
+//
+
+type parser struct {
+	tokens []token
+	index  int
+}
+
+func (self *parser) peek() (token, bool) {
+	if self.index < len(self.tokens) {
+		return self.tokens[self.index], true
+	}
+	return token{}, false
+}
+
+func (self *parser) next() (token, error) {
+	if token, ok := self.peek(); ok {
+		self.index++
+		return token, nil
+	}
+	return token{}, errors.New("unexpected end")
+}
+
+func (self *parser) nextOperator(operator string) error {
+	if token, err := self.next(); err == nil {
+		if token.operator != operator {
+			return fmt.Errorf("expected %q at %d, found %s", operator, token.position, token)
+		}
+		return nil
+	} else {
+		return err
+	}
+}
+
+func (self *parser) nextWord() (token, error) {
+	if token, err := self.next(); err == nil {
+		if !token.isWord() {
+			return token, fmt.Errorf("expected key or value at %d, found %s", token.position, token)
+		}
+		return token, nil
+	} else {
+		return token, err
+	}
+}
+
+func (self *parser) parseOr() (Expression, error) {
+	if expression, err := self.parseAnd(); err == nil {
+		or := Or{expression}
+		for {
+			if token, ok := self.peek(); ok && (token.operator == "||") {
+				self.index++
+				if expression, err := self.parseAnd(); err == nil {
+					or = append(or, expression)
+				} else {
+					return nil, err
+				}
+			} else {
+				break
+			}
+		}
+
+		if len(or) == 1 {
+			return or[0], nil
+		}
+		return or, nil
+	} else {
+		return nil, err
+	}
+}
+
+func (self *parser) parseAnd() (Expression, error) {
+	if expression, err := self.parseUnary(); err == nil {
+		and := And{expression}
+		for {
+			if token, ok := self.peek(); ok && ((token.operator == "&&") || (token.operator == ",")) {
+				self.index++
+				if expression, err := self.parseUnary(); err == nil {
+					and = append(and, expression)
+				} else {
+					return nil, err
+				}
+			} else {
+				break
+			}
+		}
+
+		if len(and) == 1 {
+			return and[0], nil
+		}
+		return and, nil
+	} else {
+		return nil, err
+	}
+}
+
+func (self *parser) parseUnary() (Expression, error) {
+	token, err := self.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token.operator {
+	case "!":
+		if next, ok := self.peek(); ok && (next.operator == "(") {
+			self.index++
+			if expression, err := self.parseGroup(); err == nil {
+				return Not{expression}, nil
+			} else {
+				return nil, err
+			}
+		} else if key, err := self.nextWord(); err == nil {
+			return Not{Exists{Key(key.segments)}}, nil
+		} else {
+			return nil, err
+		}
+
+	case "(":
+		return self.parseGroup()
+
+	case "":
+		return self.parsePredicate(Key(token.segments))
+
+	default:
+		return nil, fmt.Errorf("unexpected %s at %d", token, token.position)
+	}
+}
+
+// Assumes "(" was consumed.
+func (self *parser) parseGroup() (Expression, error) {
+	if expression, err := self.parseOr(); err == nil {
+		if err := self.nextOperator(")"); err == nil {
+			return expression, nil
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
+func (self *parser) parsePredicate(key Key) (Expression, error) {
+	token, ok := self.peek()
+	if !ok {
+		return Exists{key}, nil
+	}
+
+	switch token.operator {
+	case "=", "==", "!=":
+		self.index++
+		if value, err := self.nextWord(); err == nil {
+			operator := token.operator
+			if operator == "==" {
+				operator = "="
+			}
+			return Compare{Key: key, Operator: operator, Value: value.text()}, nil
+		} else {
+			return nil, err
+		}
+
+	case "<", "<=", ">", ">=":
+		self.index++
+		if value, err := self.nextWord(); err == nil {
+			if number, err := strconv.ParseFloat(value.text(), 64); err == nil {
+				return Compare{Key: key, Operator: token.operator, Value: value.text(), Number: number}, nil
+			} else {
+				return nil, fmt.Errorf("expected number at %d, found %s", value.position, value)
+			}
+		} else {
+			return nil, err
+		}
+
+	case "":
+		if token.isKeyword("in") || token.isKeyword("notin") {
+			self.index++
+			if values, err := self.parseValues(); err == nil {
+				return In{Key: key, Values: values, Not: token.isKeyword("notin")}, nil
+			} else {
+				return nil, err
+			}
+		}
+		return nil, fmt.Errorf("unexpected %s at %d", token, token.position)
+	}
+
+	return Exists{key}, nil
+}
+
+func (self *parser) parseValues() ([]string, error) {
+	if err := self.nextOperator("("); err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for {
+		if value, err := self.nextWord(); err == nil {
+			values = append(values, value.text())
+		} else {
+			return nil, err
+		}
+
+		if token, err := self.next(); err == nil {
+			switch token.operator {
+			case ",":
+				continue
+			case ")":
+				return values, nil
+			default:
+				return nil, fmt.Errorf("expected \",\" or \")\" at %d, found %s", token.position, token)
+			}
+		} else {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package selector
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLex(t *testing.T) {
+	tests := []struct {
+		source string
+		tokens []string
+	}{
+		{`a`, []string{`"a"`}},
+		{`a.b=c`, []string{`"a.b"`, `=`, `"c"`}},
+		{`a==b && c!=d`, []string{`"a"`, `==`, `"b"`, `&&`, `"c"`, `!=`, `"d"`}},
+		{`a<=1||b>=2`, []string{`"a"`, `<=`, `"1"`, `||`, `"b"`, `>=`, `"2"`}},
+		{`!(a, b)`, []string{`!`, `(`, `"a"`, `,`, `"b"`, `)`}},
+		{`a."b.c"='x y'`, []string{`"a.b.c"`, `=`, `"x y"`}},
+		{`"a\"b"`, []string{`"a\"b"`}},
+	}
+
+	for _, test := range tests {
+		if tokens, err := lex(test.source); err == nil {
+			strings_ := make([]string, len(tokens))
+			for index, token := range tokens {
+				strings_[index] = token.String()
+			}
+			if !slices.Equal(strings_, test.tokens) {
+				t.Errorf("lex(%q) = %v, expected %v", test.source, strings_, test.tokens)
+			}
+		} else {
+			t.Errorf("lex(%q): %s", test.source, err)
+		}
+	}
+}
+
+func TestLexErrors(t *testing.T) {
+	for _, source := range []string{`a & b`, `a | b`, `"a`, `a='b`} {
+		if _, err := lex(source); err == nil {
+			t.Errorf("lex(%q): expected an error", source)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		source    string
+		canonical string
+	}{
+		{``, ``},
+		{`a`, `a`},
+		{`!a`, `!a`},
+		{`a=b`, `a = b`},
+		{`a==b`, `a = b`},
+		{`a != b`, `a != b`},
+		{`a < 1.5`, `a < 1.5`},
+		{`a in (x, y)`, `a in (x, y)`},
+		{`a notin (x)`, `a notin (x)`},
+		{`a, b`, `a && b`},
+		{`a || b && c`, `a || b && c`},
+		{`(a || b) && c`, `(a || b) && c`},
+		{`!(a = b)`, `!(a = b)`},
+		{`a."b.c" = 'x y'`, `a."b.c" = "x y"`},
+		{`a = "in"`, `a = "in"`},
+	}
+
+	for _, test := range tests {
+		if expression, err := Parse(test.source); err == nil {
+			var canonical string
+			if expression != nil {
+				canonical = expression.String()
+			}
+			if canonical != test.canonical {
+				t.Errorf("Parse(%q) = %q, expected %q", test.source, canonical, test.canonical)
+			}
+
+			// The canonical source must parse to the same expression
+			if expression != nil {
+				if expression_, err := Parse(canonical); err == nil {
+					if expression_.String() != canonical {
+						t.Errorf("Parse(%q) = %q, expected it to be canonical", canonical, expression_.String())
+					}
+				} else {
+					t.Errorf("Parse(%q): %s", canonical, err)
+				}
+			}
+		} else {
+			t.Errorf("Parse(%q): %s", test.source, err)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, source := range []string{`=`, `a =`, `a < b`, `a in`, `a in (`, `a in (x`, `(a`, `a)`, `a b`, `a && `, `!`} {
+		if _, err := Parse(source); err == nil {
+			t.Errorf("Parse(%q): expected an error", source)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	metadata := map[string]string{
+		"a":     "x",
+		"n":     "16",
+		"f":     "1.5",
+		"s":     "abc",
+		"a.b":   "y",
+		"empty": "",
+	}
+
+	tests := []struct {
+		source  string
+		matches bool
+	}{
+		{`a`, true},
+		{`z`, false},
+		{`!z`, true},
+		{`a = x`, true},
+		{`a = y`, false},
+		{`a != y`, true},
+		{`z != y`, true},
+		{`a.b = y`, true},
+		{`"a.b" = y`, true},
+		{`empty = ""`, true},
+		{`n > 8`, true},
+		{`n >= 16`, true},
+		{`n < 16`, false},
+		{`f <= 1.5`, true},
+		{`s > 0`, false},
+		{`s < 0`, false},
+		{`z < 0`, false},
+		{`a in (x, y)`, true},
+		{`a notin (x, y)`, false},
+		{`z in (x)`, false},
+		{`z notin (x)`, true},
+		{`a = y || n > 8`, true},
+		{`a = x && n > 100`, false},
+		{`!(a = x && n > 100)`, true},
+	}
+
+	for _, test := range tests {
+		if expression, err := Parse(test.source); err == nil {
+			if matches := MetadataMatches(expression, metadata); matches != test.matches {
+				t.Errorf("%q matches = %t, expected %t", test.source, matches, test.matches)
+			}
+		} else {
+			t.Errorf("Parse(%q): %s", test.source, err)
+		}
+	}
+}
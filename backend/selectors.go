@@ -0,0 +1,40 @@
+package backend
+
+import (
+	"github.com/nephio-experimental/tko/backend/selector"
+	"github.com/nephio-experimental/tko/util"
+)
+
+//
+// Selectors
+//
+
+// Parsed selector expressions. Nil expressions match everything.
+type Selectors struct {
+	Metadata selector.Expression
+	Package  selector.Expression
+}
+
+// Returns a [BadArgumentError] if either selector is malformed.
+func ParseSelectors(metadataSelector string, packageSelector string) (Selectors, error) {
+	var self Selectors
+	var err error
+
+	if self.Metadata, err = selector.Parse(metadataSelector); err != nil {
+		return self, NewBadArgumentErrorf("metadata selector: %s", err)
+	}
+
+	if self.Package, err = selector.Parse(packageSelector); err != nil {
+		return self, NewBadArgumentErrorf("package selector: %s", err)
+	}
+
+	return self, nil
+}
+
+func (self Selectors) IsEmpty() bool {
+	return (self.Metadata == nil) && (self.Package == nil)
+}
+
+func (self Selectors) Matches(metadata map[string]string, package_ util.Package) bool {
+	return selector.MetadataMatches(self.Metadata, metadata) && selector.PackageMatches(self.Package, package_)
+}
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"io"
 	"slices"
 	"strings"
 	"time"
@@ -13,28 +14,34 @@ import (
 //
 
 type SiteInfo struct {
+	Namespace     string
 	SiteID        string
 	TemplateID    string
 	Metadata      map[string]string
 	Updated       time.Time // millisecond precision
+	Version       uint64    // incremented on every write
 	DeploymentIDs []string
 }
 
 func (self *SiteInfo) Clone(withDeployments bool) SiteInfo {
 	if withDeployments {
 		return SiteInfo{
+			Namespace:     self.Namespace,
 			SiteID:        self.SiteID,
 			TemplateID:    self.TemplateID,
 			Metadata:      util.CloneStringMap(self.Metadata),
 			Updated:       self.Updated,
+			Version:       self.Version,
 			DeploymentIDs: util.CloneStringList(self.DeploymentIDs),
 		}
 	} else {
 		return SiteInfo{
+			Namespace:  self.Namespace,
 			SiteID:     self.SiteID,
 			TemplateID: self.TemplateID,
 			Metadata:   util.CloneStringMap(self.Metadata),
 			Updated:    self.Updated,
+			Version:    self.Version,
 		}
 	}
 }
@@ -59,7 +66,7 @@ func (self *SiteInfo) MergeTemplateInfo(templateInfo *TemplateInfo) {
 
 func SortSiteInfos(siteInfos []SiteInfo) {
 	slices.SortFunc(siteInfos, func(a SiteInfo, b SiteInfo) int {
-		return strings.Compare(a.SiteID, b.SiteID)
+		return CompareNamespacedIDs(a.Namespace, a.SiteID, b.Namespace, b.SiteID)
 	})
 }
 
@@ -72,19 +79,32 @@ type Site struct {
 	Package util.Package
 }
 
-func NewSiteFromBytes(siteId string, templateId string, metadata map[string]string, packageFormat string, package_ []byte) (*Site, error) {
+func NewSite(namespace string, siteId string, templateId string, metadata map[string]string, package_ util.Package) *Site {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	return &Site{
+		SiteInfo: SiteInfo{
+			Namespace:  namespace,
+			SiteID:     siteId,
+			TemplateID: templateId,
+			Metadata:   metadata,
+		},
+		Package: package_,
+	}
+}
+
+func NewSiteFromBytes(namespace string, siteId string, templateId string, metadata map[string]string, packageFormat string, package_ []byte) (*Site, error) {
 	if package__, err := util.DecodePackage(packageFormat, package_); err == nil {
-		if metadata == nil {
-			metadata = make(map[string]string)
-		}
-		return &Site{
-			SiteInfo: SiteInfo{
-				SiteID:     siteId,
-				TemplateID: templateId,
-				Metadata:   metadata,
-			},
-			Package: package__,
-		}, nil
+		return NewSite(namespace, siteId, templateId, metadata, package__), nil
+	} else {
+		return nil, err
+	}
+}
+
+func NewSiteFromReader(namespace string, siteId string, templateId string, metadata map[string]string, packageFormat string, reader io.Reader) (*Site, error) {
+	if package_, err := util.ReadPackage(packageFormat, reader); err == nil {
+		return NewSite(namespace, siteId, templateId, metadata, package_), nil
 	} else {
 		return nil, err
 	}
@@ -126,12 +146,58 @@ func (self *Site) MergeTemplate(template *Template) {
 	self.Package = package_
 }
 
+//
+// DeletedDeployment
+//
+
+// Tombstone for a deployment that was deleted after it had been scheduled on a site.
+type DeletedDeployment struct {
+	Namespace    string
+	DeploymentID string
+	SiteID       string
+	Deleted      time.Time    // millisecond precision
+	Package      util.Package // last package before deletion
+}
+
+func NewDeletedDeployment(deployment *Deployment) *DeletedDeployment {
+	return &DeletedDeployment{
+		Namespace:    deployment.Namespace,
+		DeploymentID: deployment.DeploymentID,
+		SiteID:       deployment.SiteID,
+		Deleted:      time.Now().UTC(),
+		Package:      util.ClonePackage(deployment.Package),
+	}
+}
+
+func (self *DeletedDeployment) Clone() DeletedDeployment {
+	return DeletedDeployment{
+		Namespace:    self.Namespace,
+		DeploymentID: self.DeploymentID,
+		SiteID:       self.SiteID,
+		Deleted:      self.Deleted,
+		Package:      util.ClonePackage(self.Package),
+	}
+}
+
+func (self *DeletedDeployment) EncodePackage(format string) ([]byte, error) {
+	return util.EncodePackage(format, self.Package)
+}
+
+func SortDeletedDeployments(deletedDeployments []DeletedDeployment) {
+	slices.SortFunc(deletedDeployments, func(a DeletedDeployment, b DeletedDeployment) int {
+		return strings.Compare(a.DeploymentID, b.DeploymentID)
+	})
+}
+
 //
 // SelectSites
 //
 
 type SelectSites struct {
+	Namespace          string // can be AllNamespaces for listing
 	SiteIDPatterns     []string
 	TemplateIDPatterns []string
 	MetadataPatterns   map[string]string
+	MetadataSelector   string // see [selector.Parse]
+	PackageSelector    string // see [selector.Parse]
 }
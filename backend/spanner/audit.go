@@ -0,0 +1,18 @@
+package spanner
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	return backend.NewNotImplementedError("RecordAuditEvent")
+}
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	return nil, backend.NewNotImplementedError("ListAuditEvents")
+}
@@ -15,12 +15,12 @@ func (self *SpannerBackend) CreateDeployment(context contextpkg.Context, deploym
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) GetDeployment(context contextpkg.Context, deploymentId string) (*backend.Deployment, error) {
+func (self *SpannerBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
 	return nil, nil
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) DeleteDeployment(context contextpkg.Context, deploymentId string) error {
+func (self *SpannerBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
 	return nil
 }
 
@@ -30,12 +30,12 @@ func (self *SpannerBackend) ListDeployments(context contextpkg.Context, selectDe
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments) error {
+func (self *SpannerBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
 	return backend.NewNotImplementedError("PurgeDeployments")
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) StartDeploymentModification(context contextpkg.Context, deploymentId string) (string, *backend.Deployment, error) {
+func (self *SpannerBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
 	return "", nil, nil
 }
 
@@ -48,3 +48,8 @@ func (self *SpannerBackend) EndDeploymentModification(context contextpkg.Context
 func (self *SpannerBackend) CancelDeploymentModification(context contextpkg.Context, modificationToken string) error {
 	return nil
 }
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	return nil, backend.NewNotImplementedError("ModifyDeployments")
+}
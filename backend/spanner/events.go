@@ -0,0 +1,18 @@
+package spanner
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	return nil, backend.NewNotImplementedError("Watch")
+}
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	return 0, backend.NewNotImplementedError("GetEventRevision")
+}
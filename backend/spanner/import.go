@@ -0,0 +1,22 @@
+package spanner
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	return backend.NewNotImplementedError("ImportTemplate")
+}
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	return backend.NewNotImplementedError("ImportSite")
+}
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	return backend.NewNotImplementedError("ImportDeployment")
+}
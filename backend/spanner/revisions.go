@@ -0,0 +1,23 @@
+package spanner
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	return nil, backend.NewNotImplementedError("ListRevisions")
+}
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	return nil, backend.NewNotImplementedError("GetRevision")
+}
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	return backend.NewNotImplementedError("RevertTo")
+}
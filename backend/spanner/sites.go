@@ -13,12 +13,12 @@ func (self *SpannerBackend) SetSite(context contextpkg.Context, site *backend.Si
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) GetSite(context contextpkg.Context, siteId string) (*backend.Site, error) {
+func (self *SpannerBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
 	return nil, nil
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) DeleteSite(context contextpkg.Context, siteId string) error {
+func (self *SpannerBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
 	return nil
 }
 
@@ -31,3 +31,13 @@ func (self *SpannerBackend) ListSites(context contextpkg.Context, selectSites ba
 func (self *SpannerBackend) PurgeSites(context contextpkg.Context, selectSites backend.SelectSites) error {
 	return backend.NewNotImplementedError("PurgeSites")
 }
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	return nil, backend.NewNotImplementedError("ListDeletedDeployments")
+}
+
+// ([backend.Backend] interface)
+func (self *SpannerBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	return backend.NewNotImplementedError("AcknowledgeDeletedDeployments")
+}
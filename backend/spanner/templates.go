@@ -13,12 +13,12 @@ func (self *SpannerBackend) SetTemplate(context contextpkg.Context, template *ba
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) GetTemplate(context contextpkg.Context, templateId string) (*backend.Template, error) {
+func (self *SpannerBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
 	return nil, nil
 }
 
 // ([backend.Backend] interface)
-func (self *SpannerBackend) DeleteTemplate(context contextpkg.Context, templateId string) error {
+func (self *SpannerBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
 	return nil
 }
 
@@ -0,0 +1,131 @@
+package sql
+
+import (
+	contextpkg "context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	var idsJson []byte
+	if len(event.IDs) > 0 {
+		var err error
+		if idsJson, err = json.Marshal(event.IDs); err != nil {
+			return err
+		}
+	}
+
+	rows, err := self.statements.PreparedInsertAuditEvent.QueryContext(context, event.Timestamp, event.Actor, event.Operation, event.Type, event.Namespace, idsJson, nilIfEmptyString(event.Selector), event.Outcome, nilIfEmptyString(event.Reason), nilIfEmptyString(event.Diff), nilIfEmptyString(event.ClaimedAuthor))
+	if err != nil {
+		return err
+	}
+	defer self.closeRows(rows)
+
+	if rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err == nil {
+			event.ID = uint64(id)
+		} else {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	sql := self.statements.SelectAuditEvents
+	var args SqlArgs
+	var where SqlWhere
+
+	args.AddValue(window.Offset)
+	args.AddValue(window.Limit())
+
+	if selectAuditEvents.Namespace != backend.AllNamespaces {
+		where.Add(`namespace = ` + args.Add(selectAuditEvents.Namespace))
+	}
+
+	if !selectAuditEvents.Since.IsZero() {
+		where.Add(`timestamp >= ` + args.Add(selectAuditEvents.Since.UTC()))
+	}
+
+	if !selectAuditEvents.Until.IsZero() {
+		where.Add(`timestamp < ` + args.Add(selectAuditEvents.Until.UTC()))
+	}
+
+	if len(selectAuditEvents.Actors) > 0 {
+		actors := make([]string, len(selectAuditEvents.Actors))
+		for index, actor := range selectAuditEvents.Actors {
+			actors[index] = `actor = ` + args.Add(actor)
+		}
+		where.Add(strings.Join(actors, ` OR `))
+	}
+
+	sql = where.Apply(sql)
+	self.log.Debugf("generated SQL:\n%s", sql)
+
+	rows, err := self.db.QueryContext(context, sql, args.Args...)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := util.NewResultsStream[backend.AuditEvent](func() {
+		self.closeRows(rows)
+	})
+
+	go func() {
+		for rows.Next() {
+			var id int64
+			var timestamp time.Time
+			var actor, operation, type_, namespace, outcome string
+			var selector, reason, diff, claimedAuthor *string
+			var idsJson []byte
+			if err := rows.Scan(&id, &timestamp, &actor, &operation, &type_, &namespace, &idsJson, &selector, &outcome, &reason, &diff, &claimedAuthor); err == nil {
+				event := backend.AuditEvent{
+					ID:        uint64(id),
+					Timestamp: timestamp,
+					Actor:     actor,
+					Operation: operation,
+					Type:      type_,
+					Namespace: namespace,
+					Outcome:   outcome,
+				}
+
+				if selector != nil {
+					event.Selector = *selector
+				}
+				if reason != nil {
+					event.Reason = *reason
+				}
+				if diff != nil {
+					event.Diff = *diff
+				}
+				if claimedAuthor != nil {
+					event.ClaimedAuthor = *claimedAuthor
+				}
+
+				if len(idsJson) > 0 {
+					if err := json.Unmarshal(idsJson, &event.IDs); err != nil {
+						stream.Close(err)
+						return
+					}
+				}
+
+				stream.Send(event)
+			} else {
+				stream.Close(err)
+				return
+			}
+		}
+
+		stream.Close(nil)
+	}()
+
+	return stream, nil
+}
@@ -6,13 +6,18 @@ import (
 	"fmt"
 
 	"github.com/nephio-experimental/tko/backend"
-	"github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
 )
 
-const PostgreSQLName = "postgresql"
+const (
+	PostgreSQLName = "postgresql"
+	SQLiteName     = "sqlite"
+)
 
-var _ backend.Backend = new(SQLBackend)
+var (
+	_ backend.Backend           = new(SQLBackend)
+	_ backend.DeploymentCounter = new(SQLBackend)
+)
 
 //
 // SQLBackend
@@ -20,13 +25,18 @@ var _ backend.Backend = new(SQLBackend)
 
 type SQLBackend struct {
 	DropTablesFirst bool
+	AutoMigrate     bool // otherwise Connect fails if there are pending schema migrations
 
 	driver        string
 	dataSource    string
-	packageFormat string
+	packageFormat string // for packages stored before blobs were introduced
 
 	statements *Statements
 	db         *sql.DB
+	events     *backend.EventBroadcaster
+	stopEvents contextpkg.CancelFunc
+	reap       chan struct{}
+	stopReaper contextpkg.CancelFunc
 
 	log                     commonlog.Logger
 	maxModificationDuration int64 // microseconds
@@ -56,7 +66,7 @@ func (self *SQLBackend) Connect(context contextpkg.Context) error {
 			}
 		}
 
-		err = self.statements.CreateTables(context)
+		err = self.checkSchema(context)
 		if err != nil {
 			return err
 		}
@@ -66,15 +76,44 @@ func (self *SQLBackend) Connect(context contextpkg.Context) error {
 			return err
 		}
 
+		if err = self.startEvents(context); err != nil {
+			return err
+		}
+
+		self.startReaper()
 		return nil
 	} else {
 		return err
 	}
 }
 
+// Applies pending schema migrations and returns the schema version before they were
+// applied. With dryRun the migrations are validated but not committed.
+//
+// Can be called without [SQLBackend.Connect].
+func (self *SQLBackend) Migrate(context contextpkg.Context, dryRun bool) (uint, []Migration, error) {
+	if self.db == nil {
+		self.log.Noticef("migrate: driver=%s dataSource=%s", self.driver, self.dataSource)
+		var err error
+		if self.db, err = sql.Open(self.driver, self.dataSource); err == nil {
+			self.statements = NewStatements(self.driver, self.db, self.log)
+		} else {
+			return 0, nil, err
+		}
+	}
+
+	return self.statements.Migrate(context, dryRun)
+}
+
 // ([backend.Backend] interface)
 func (self *SQLBackend) Release(context contextpkg.Context) error {
 	self.log.Noticef("release: driver=%s dataSource=%s", self.driver, self.dataSource)
+	if self.stopEvents != nil {
+		self.stopEvents()
+	}
+	if self.stopReaper != nil {
+		self.stopReaper()
+	}
 	if self.statements != nil {
 		self.statements.Release()
 	}
@@ -93,6 +132,30 @@ func (self *SQLBackend) String() string {
 
 // Utils
 
+func (self *SQLBackend) checkSchema(context contextpkg.Context) error {
+	version, err := self.statements.SchemaVersion(context)
+	if err != nil {
+		return err
+	}
+
+	latestVersion := self.statements.LatestSchemaVersion()
+	if version > latestVersion {
+		return fmt.Errorf("database schema version %d is newer than supported version %d, upgrade this binary", version, latestVersion)
+	}
+
+	if version < latestVersion {
+		if self.AutoMigrate {
+			_, _, err = self.statements.Migrate(context, false)
+			return err
+		} else {
+			return fmt.Errorf("database schema version %d is older than supported version %d, run \"tko-data migrate\"", version, latestVersion)
+		}
+	}
+
+	self.log.Infof("database schema version: %d", version)
+	return nil
+}
+
 func (self *SQLBackend) rollback(tx *sql.Tx) {
 	if err := tx.Rollback(); err != nil {
 		self.log.Error("tx.Rollback: " + err.Error())
@@ -105,10 +168,7 @@ func (self *SQLBackend) closeRows(rows *sql.Rows) {
 	}
 }
 
-func (self *SQLBackend) encodePackage(package_ util.Package) ([]byte, error) {
-	return util.EncodePackage(self.packageFormat, package_)
-}
-
-func (self *SQLBackend) decodePackage(content []byte) (util.Package, error) {
-	return util.DecodePackage(self.packageFormat, content)
+// Generates SQL for matching a value to a regular expression.
+func (self *SQLBackend) regexp(value string, pattern string) string {
+	return value + " " + self.statements.RegexpOperator + " " + pattern
 }
@@ -0,0 +1,147 @@
+package sql
+
+import (
+	contextpkg "context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/nephio-experimental/tko/util"
+	"github.com/tliron/go-ard"
+)
+
+// Packages are stored as lists of resource hashes, while the resources themselves are stored
+// as content-addressed blobs that are shared by all templates, sites, deployments, and
+// revisions. Thus identical resources are stored only once.
+//
+// Blobs are never deleted, because revisions are kept, too.
+
+// The encoded list of hashes is marked with this CBOR tag ("tko"), so that it can be told
+// apart from packages stored in full before blobs were introduced.
+const blobHashesTag = 0x746b6f
+
+// Blobs are selected in batches of this size, to stay within the database's limit of
+// parameters per statement.
+var BlobSelectionBatchSize = 500
+
+// Stores the package resources as blobs and returns the encoded list of their hashes.
+func (self *SQLBackend) encodePackage(context contextpkg.Context, tx *sql.Tx, package_ util.Package) ([]byte, error) {
+	upsertBlob := tx.StmtContext(context, self.statements.PreparedUpsertBlob)
+
+	hashes := make([]string, len(package_))
+	for index, resource := range package_ {
+		if content, hash, err := util.EncodeResourceBlob(resource); err == nil {
+			if _, err := upsertBlob.ExecContext(context, hash, content); err != nil {
+				return nil, err
+			}
+			hashes[index] = hash
+		} else {
+			return nil, err
+		}
+	}
+
+	return cbor.Marshal(cbor.Tag{Number: blobHashesTag, Content: hashes})
+}
+
+// Reconstructs the package from blobs. Rows must not be open on the querier.
+func (self *SQLBackend) decodePackage(context contextpkg.Context, querier querier, content []byte) (util.Package, error) {
+	// CBOR major type 6 is a tag
+	if (len(content) == 0) || (content[0]>>5 != 6) {
+		// Packages stored before blobs were introduced are encoded in full
+		return util.DecodePackage(self.packageFormat, content)
+	}
+
+	var tag cbor.RawTag
+	if err := cbor.Unmarshal(content, &tag); err != nil {
+		return nil, fmt.Errorf("malformed package: %w", err)
+	}
+	if tag.Number != blobHashesTag {
+		return nil, fmt.Errorf("malformed package: unsupported tag: %d", tag.Number)
+	}
+
+	var hashes []string
+	if err := cbor.Unmarshal(tag.Content, &hashes); err != nil {
+		return nil, fmt.Errorf("malformed package: %w", err)
+	}
+
+	if len(hashes) == 0 {
+		return util.Package{}, nil
+	}
+
+	blobs, err := self.selectBlobs(context, querier, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	package_ := make(util.Package, len(hashes))
+	used := make(map[string]struct{}, len(hashes))
+	for index, hash := range hashes {
+		if resource, ok := blobs[hash]; ok {
+			// The same resource can appear more than once, but must not be shared
+			if _, ok := used[hash]; ok {
+				resource = ard.Copy(resource).(util.Resource)
+			} else {
+				used[hash] = struct{}{}
+			}
+			package_[index] = resource
+		} else {
+			return nil, fmt.Errorf("missing blob: %s", hash)
+		}
+	}
+
+	return package_, nil
+}
+
+func (self *SQLBackend) selectBlobs(context contextpkg.Context, querier querier, hashes []string) (map[string]util.Resource, error) {
+	// The same resource can appear more than once in a package
+	unique := make([]string, 0, len(hashes))
+	seen := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		if _, ok := seen[hash]; !ok {
+			seen[hash] = struct{}{}
+			unique = append(unique, hash)
+		}
+	}
+
+	blobs := make(map[string]util.Resource, len(unique))
+	for start := 0; start < len(unique); start += BlobSelectionBatchSize {
+		end := min(start+BlobSelectionBatchSize, len(unique))
+		if err := self.selectBlobsBatch(context, querier, unique[start:end], blobs); err != nil {
+			return nil, err
+		}
+	}
+
+	return blobs, nil
+}
+
+func (self *SQLBackend) selectBlobsBatch(context contextpkg.Context, querier querier, hashes []string, blobs map[string]util.Resource) error {
+	var args SqlArgs
+	placeholders := make([]string, len(hashes))
+	for index, hash := range hashes {
+		placeholders[index] = args.Add(hash)
+	}
+
+	sql := self.statements.SelectBlobs + ` WHERE hash IN (` + strings.Join(placeholders, ", ") + `)`
+	self.log.Debugf("generated SQL:\n%s", sql)
+
+	rows, err := querier.QueryContext(context, sql, args.Args...)
+	if err != nil {
+		return err
+	}
+	defer self.closeRows(rows)
+
+	for rows.Next() {
+		var hash string
+		var content []byte
+		if err := rows.Scan(&hash, &content); err == nil {
+			if blobs[hash], err = util.DecodeResourceBlob(content); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
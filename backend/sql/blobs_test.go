@@ -0,0 +1,83 @@
+package sql
+
+import (
+	contextpkg "context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/nephio-experimental/tko/util"
+	"github.com/tliron/commonlog"
+)
+
+func TestPackageBlobs(t *testing.T) {
+	context := contextpkg.Background()
+
+	sqlBackend := NewSQLBackend("sqlite", SQLiteDataSource(filepath.Join(t.TempDir(), "tko.db")), "cbor", 10, commonlog.GetLogger("test"))
+	sqlBackend.AutoMigrate = true
+	if err := sqlBackend.Connect(context); err != nil {
+		t.Fatal(err)
+	}
+	defer sqlBackend.Release(context)
+
+	// Exercise batching of blob selection
+	defer func(batchSize int) { BlobSelectionBatchSize = batchSize }(BlobSelectionBatchSize)
+	BlobSelectionBatchSize = 2
+
+	package_ := util.Package{
+		{"kind": "Cluster", "spec": util.Resource{"region": "eu"}},
+		{"kind": "Network"},
+		{"kind": "Cluster", "spec": util.Resource{"region": "eu"}},
+		{"kind": "Workload"},
+		{"kind": "Network"},
+	}
+
+	tx, err := sqlBackend.db.BeginTx(context, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlBackend.rollback(tx)
+
+	content, err := sqlBackend.encodePackage(context, tx, package_)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if package__, err := sqlBackend.decodePackage(context, tx, content); err == nil {
+		if !reflect.DeepEqual(package__, package_) {
+			t.Errorf("%v, expected %v", package__, package_)
+		}
+	} else {
+		t.Fatal(err)
+	}
+
+	// Packages stored in full
+	if content, err := util.EncodePackage("cbor", package_); err == nil {
+		if package__, err := sqlBackend.decodePackage(context, tx, content); err == nil {
+			if !reflect.DeepEqual(package__, package_) {
+				t.Errorf("%v, expected %v", package__, package_)
+			}
+		} else {
+			t.Fatal(err)
+		}
+	} else {
+		t.Fatal(err)
+	}
+
+	// Malformed hash lists must not be mistaken for packages stored in full
+	for _, content := range [][]byte{content[:len(content)-1], mustMarshal(t, cbor.Tag{Number: 1, Content: []string{}})} {
+		if _, err := sqlBackend.decodePackage(context, tx, content); err == nil {
+			t.Errorf("%x: expected an error", content)
+		}
+	}
+}
+
+func mustMarshal(t *testing.T, value any) []byte {
+	if content, err := cbor.Marshal(value); err == nil {
+		return content
+	} else {
+		t.Fatal(err)
+		return nil
+	}
+}
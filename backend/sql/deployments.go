@@ -3,6 +3,7 @@ package sql
 import (
 	contextpkg "context"
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/nephio-experimental/tko/backend"
@@ -11,6 +12,11 @@ import (
 	"github.com/tliron/kutil/util"
 )
 
+var (
+	DeploymentsReapInterval  = time.Minute
+	DeploymentsReapBatchSize = 100
+)
+
 // ([backend.Backend] interface)
 func (self *SQLBackend) CreateDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
 	if tx, err := self.db.BeginTx(context, nil); err == nil {
@@ -23,7 +29,7 @@ func (self *SQLBackend) CreateDeployment(context contextpkg.Context, deployment
 
 		var package_ []byte
 		var err error
-		if package_, err = self.encodePackage(deployment.Package); err != nil {
+		if package_, err = self.encodePackage(context, tx, deployment.Package); err != nil {
 			self.rollback(tx)
 			return err
 		}
@@ -32,9 +38,10 @@ func (self *SQLBackend) CreateDeployment(context contextpkg.Context, deployment
 		now := time.Now().UTC()
 		deployment.Created = now
 		deployment.Updated = now
+		deployment.Version = 1
 
 		insertDeployment := tx.StmtContext(context, self.statements.PreparedInsertDeployment)
-		if _, err := insertDeployment.ExecContext(context, deployment.DeploymentID, nilIfEmptyString(deployment.ParentDeploymentID), nilIfEmptyString(deployment.TemplateID), nilIfEmptyString(deployment.SiteID), deployment.Created, deployment.Updated, deployment.Prepared, deployment.Approved, package_); err != nil {
+		if _, err := insertDeployment.ExecContext(context, deployment.DeploymentID, deployment.Namespace, nilIfEmptyString(deployment.ParentDeploymentID), nilIfEmptyString(deployment.TemplateID), nilIfEmptyString(deployment.SiteID), deployment.Created, deployment.Updated, deployment.Prepared, deployment.Approved, package_); err != nil {
 			self.rollback(tx)
 			return err
 		}
@@ -54,6 +61,21 @@ func (self *SQLBackend) CreateDeployment(context contextpkg.Context, deployment
 			return err
 		}
 
+		if revision, err := backend.NewDeploymentRevision(context, deployment); err == nil {
+			if err := self.insertRevision(context, tx, revision); err != nil {
+				self.rollback(tx)
+				return err
+			}
+		} else {
+			self.rollback(tx)
+			return err
+		}
+
+		if err := self.insertEvents(context, tx, backend.NewEvent(backend.EventTypeAdded, backend.EventKindDeployment, deployment.Namespace, deployment.DeploymentID)); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
 		return tx.Commit()
 	} else {
 		return err
@@ -61,8 +83,8 @@ func (self *SQLBackend) CreateDeployment(context contextpkg.Context, deployment
 }
 
 // ([backend.Backend] interface)
-func (self *SQLBackend) GetDeployment(context contextpkg.Context, deploymentId string) (*backend.Deployment, error) {
-	rows, err := self.statements.PreparedSelectDeployment.QueryContext(context, deploymentId)
+func (self *SQLBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
+	rows, err := self.statements.PreparedSelectDeployment.QueryContext(context, namespace, deploymentId)
 	if err != nil {
 		return nil, err
 	}
@@ -71,28 +93,34 @@ func (self *SQLBackend) GetDeployment(context contextpkg.Context, deploymentId s
 	if rows.Next() {
 		var parentDeploymentId, templateId, siteId *string
 		var created, updated time.Time
+		var version uint64
 		var prepared, approved bool
 		var metadataJson, package_ []byte
-		if err := rows.Scan(&parentDeploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &prepared, &approved, &package_); err == nil {
-			return self.newDeployment(deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, prepared, approved, package_)
+		if err := rows.Scan(&parentDeploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &version, &prepared, &approved, &package_); err == nil {
+			self.closeRows(rows)
+			return self.newDeployment(context, self.db, namespace, deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, version, prepared, approved, package_)
 		} else {
 			return nil, err
 		}
 	}
 
-	return nil, backend.NewNotFoundErrorf("deployment: %s", deploymentId)
+	return nil, backend.NewNotFoundErrorf("deployment: %s/%s", namespace, deploymentId)
 }
 
 // ([backend.Backend] interface)
-func (self *SQLBackend) DeleteDeployment(context contextpkg.Context, deploymentId string) error {
-	// Will cascade delete deployments_metadata, templates_deployments, sites_deployments
-	if result, err := self.statements.PreparedDeleteDeployment.ExecContext(context, deploymentId); err == nil {
-		if count, err := result.RowsAffected(); err == nil {
-			if count == 0 {
-				return backend.NewNotFoundErrorf("deployment: %s", deploymentId)
+func (self *SQLBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		if descendantIds, err := self.deleteDeployments(context, tx, namespace, []string{deploymentId}, propagation); err == nil {
+			if err := tx.Commit(); err == nil {
+				if len(descendantIds) > 0 {
+					self.wakeReaper()
+				}
+				return nil
+			} else {
+				return err
 			}
-			return nil
 		} else {
+			self.rollback(tx)
 			return err
 		}
 	} else {
@@ -102,100 +130,31 @@ func (self *SQLBackend) DeleteDeployment(context contextpkg.Context, deploymentI
 
 // ([backend.Backend] interface)
 func (self *SQLBackend) ListDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, window backend.Window) (util.Results[backend.DeploymentInfo], error) {
-	sql := self.statements.SelectDeployments
-	var with SqlWith
-	var where SqlWhere
-	var args SqlArgs
-
-	args.AddValue(window.Offset)
-	args.AddValue(window.Limit())
-
-	if (selectDeployments.ParentDeploymentID != nil) && (*selectDeployments.ParentDeploymentID != "") {
-		where.Add(`parent_deployment_id = ` + args.Add(selectDeployments.ParentDeploymentID))
-	}
-
-	if len(selectDeployments.MetadataPatterns) > 0 {
-		for key, pattern := range selectDeployments.MetadataPatterns {
-			key = args.Add(key)
-			pattern = args.Add(backend.PatternRE(pattern))
-			with.Add(`SELECT deployment_id FROM deployments_metadata WHERE (key = `+key+`) AND (value ~ `+pattern+`)`,
-				`deployments`, `deployment_id`)
-		}
-	}
-
-	for _, pattern := range selectDeployments.TemplateIDPatterns {
-		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`deployments.template_id ~ ` + pattern)
-	}
-
-	if len(selectDeployments.TemplateMetadataPatterns) > 0 {
-		for key, pattern := range selectDeployments.TemplateMetadataPatterns {
-			key = args.Add(key)
-			pattern = args.Add(backend.PatternRE(pattern))
-			with.Add(`SELECT template_id FROM templates_metadata WHERE (key = `+key+`) AND (value ~ `+pattern+`)`,
-				`deployments`, `template_id`)
-		}
-	}
-
-	for _, pattern := range selectDeployments.SiteIDPatterns {
-		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`deployments.site_id ~ ` + pattern)
-	}
-
-	if len(selectDeployments.SiteMetadataPatterns) > 0 {
-		for key, pattern := range selectDeployments.SiteMetadataPatterns {
-			key = args.Add(key)
-			pattern = args.Add(backend.PatternRE(pattern))
-			with.Add(`SELECT site_id FROM sites_metadata WHERE (key = `+key+`) AND (value ~ `+pattern+`)`,
-				`deployments`, `site_id`)
-		}
-	}
-
-	if selectDeployments.Prepared != nil {
-		switch *selectDeployments.Prepared {
-		case true:
-			where.Add(`prepared`)
-		case false:
-			where.Add(`NOT prepared`)
-		}
+	sql, args, selection, err := self.selectDeploymentsSql(selectDeployments, window)
+	if err != nil {
+		return nil, err
 	}
 
-	if selectDeployments.Approved != nil {
-		switch *selectDeployments.Approved {
-		case true:
-			where.Add(`approved`)
-		case false:
-			where.Add(`NOT approved`)
-		}
+	querier, release, err := self.selectPackagesQuerier(context, selection)
+	if err != nil {
+		return nil, err
 	}
 
-	sql = with.Apply(sql)
-	sql = where.Apply(sql)
-	self.log.Debugf("generated SQL:\n%s", sql)
-
-	rows, err := self.db.QueryContext(context, sql, args.Args...)
+	rows, err := querier.QueryContext(context, sql, args.Args...)
 	if err != nil {
+		release()
 		return nil, err
 	}
 
 	stream := util.NewResultsStream[backend.DeploymentInfo](func() {
 		self.closeRows(rows)
+		release()
 	})
 
 	go func() {
 		for rows.Next() {
-			var deploymentId string
-			var parentDeploymentId, templateId, siteId *string
-			var metadataJson []byte
-			var created, updated time.Time
-			var prepared, approved bool
-			if err := rows.Scan(&deploymentId, &parentDeploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &prepared, &approved); err == nil {
-				if deploymentInfo, err := self.newDeploymentInfo(deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, prepared, approved); err == nil {
-					stream.Send(deploymentInfo)
-				} else {
-					stream.Close(err)
-					return
-				}
+			if deploymentInfo, err := self.scanDeploymentInfo(rows); err == nil {
+				stream.Send(deploymentInfo)
 			} else {
 				stream.Close(err)
 				return
@@ -208,86 +167,59 @@ func (self *SQLBackend) ListDeployments(context contextpkg.Context, selectDeploy
 	return stream, nil
 }
 
-// ([backend.Backend] interface)
-func (self *SQLBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments) error {
-	sql := self.statements.DeleteDeployments
-	var args SqlArgs
-	var where SqlWhere
-
-	if (selectDeployments.ParentDeploymentID != nil) && (*selectDeployments.ParentDeploymentID != "") {
-		where.Add(`parent_deployment_id = ` + args.Add(selectDeployments.ParentDeploymentID))
-	}
-
-	if len(selectDeployments.MetadataPatterns) > 0 {
-		where.Add(`deployments.deployment_id = deployments_metadata.deployment_id`)
-		for key, pattern := range selectDeployments.MetadataPatterns {
-			key = args.Add(key)
-			pattern = args.Add(backend.PatternRE(pattern))
-			where.Add(`deployments_metadata.key = ` + key)
-			where.Add(`deployments_metadata.value ~ ` + pattern)
-		}
-	}
-
-	for _, pattern := range selectDeployments.TemplateIDPatterns {
-		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`deployments.template_id ~ ` + pattern)
-	}
-
-	if len(selectDeployments.TemplateMetadataPatterns) > 0 {
-		where.Add(`deployments.template_id = templates_metadata.template_id`)
-		for key, pattern := range selectDeployments.TemplateMetadataPatterns {
-			key = args.Add(key)
-			pattern = args.Add(backend.PatternRE(pattern))
-			where.Add(`templates_metadata.key = ` + key)
-			where.Add(`templates_metadata.value ~ ` + pattern)
-		}
-	}
-
-	for _, pattern := range selectDeployments.SiteIDPatterns {
-		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`deployments.site_id ~ ` + pattern)
+// ([backend.DeploymentCounter] interface)
+func (self *SQLBackend) CountDeployments(context contextpkg.Context) (map[backend.DeploymentState]uint, error) {
+	rows, err := self.statements.PreparedCountDeployments.QueryContext(context)
+	if err != nil {
+		return nil, err
 	}
+	defer self.closeRows(rows)
 
-	if len(selectDeployments.SiteMetadataPatterns) > 0 {
-		where.Add(`deployments.site_id = sites_metadata.site_id`)
-		for key, pattern := range selectDeployments.TemplateMetadataPatterns {
-			key = args.Add(key)
-			pattern = args.Add(backend.PatternRE(pattern))
-			where.Add(`sites_metadata.key = ` + key)
-			where.Add(`sites_metadata.value ~ ` + pattern)
+	counts := make(map[backend.DeploymentState]uint)
+	for rows.Next() {
+		var prepared, approved bool
+		var count uint
+		if err := rows.Scan(&prepared, &approved, &count); err == nil {
+			counts[backend.DeploymentState{Prepared: prepared, Approved: approved}] = count
+		} else {
+			return nil, err
 		}
 	}
 
-	if selectDeployments.Prepared != nil {
-		switch *selectDeployments.Prepared {
-		case true:
-			where.Add(`prepared`)
-		case false:
-			where.Add(`NOT prepared`)
-		}
-	}
+	return counts, rows.Err()
+}
 
-	if selectDeployments.Approved != nil {
-		switch *selectDeployments.Approved {
-		case true:
-			where.Add(`approved`)
-		case false:
-			where.Add(`NOT approved`)
+// ([backend.Backend] interface)
+func (self *SQLBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		if deploymentIds, err := self.selectDeploymentIds(context, tx, selectDeployments); err == nil {
+			if descendantIds, err := self.deleteDeployments(context, tx, selectDeployments.Namespace, deploymentIds, propagation); err == nil {
+				if err := tx.Commit(); err == nil {
+					if len(descendantIds) > 0 {
+						self.wakeReaper()
+					}
+					return nil
+				} else {
+					return err
+				}
+			} else {
+				self.rollback(tx)
+				return err
+			}
+		} else {
+			self.rollback(tx)
+			return err
 		}
+	} else {
+		return err
 	}
-
-	sql = where.Apply(sql)
-	self.log.Debugf("generated SQL:\n%s", sql)
-
-	_, err := self.db.ExecContext(context, sql, args.Args...)
-	return err
 }
 
 // ([backend.Backend] interface)
-func (self *SQLBackend) StartDeploymentModification(context contextpkg.Context, deploymentId string) (string, *backend.Deployment, error) {
+func (self *SQLBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
 	if tx, err := self.db.BeginTx(context, nil); err == nil {
 		selectDeploymentWithModification := tx.StmtContext(context, self.statements.PreparedSelectDeploymentWithModification)
-		rows, err := selectDeploymentWithModification.QueryContext(context, deploymentId)
+		rows, err := selectDeploymentWithModification.QueryContext(context, namespace, deploymentId)
 		if err != nil {
 			self.rollback(tx)
 			return "", nil, err
@@ -296,10 +228,11 @@ func (self *SQLBackend) StartDeploymentModification(context contextpkg.Context,
 		if rows.Next() {
 			var parentDeploymentId, templateId, siteId, modificationToken *string
 			var created, updated time.Time
+			var version uint64
 			var prepared, approved bool
 			var metadataJson, package_ []byte
 			var modificationTimestamp *int64
-			if err := rows.Scan(&parentDeploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &prepared, &approved, &package_, &modificationToken, &modificationTimestamp); err == nil {
+			if err := rows.Scan(&parentDeploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &version, &prepared, &approved, &package_, &modificationToken, &modificationTimestamp); err == nil {
 				self.closeRows(rows)
 
 				available := (modificationToken == nil) || (*modificationToken == "")
@@ -309,10 +242,10 @@ func (self *SQLBackend) StartDeploymentModification(context contextpkg.Context,
 
 				if !available {
 					self.rollback(tx)
-					return "", nil, backend.NewBusyErrorf("deployment: %s", deploymentId)
+					return "", nil, backend.NewBusyErrorf("deployment: %s/%s", namespace, deploymentId)
 				}
 
-				if deployment, err := self.newDeployment(deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, prepared, approved, package_); err == nil {
+				if deployment, err := self.newDeployment(context, tx, namespace, deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, version, prepared, approved, package_); err == nil {
 					modificationToken_ := backend.NewID()
 					modificationTimestamp_ := time.Now().UnixMicro()
 
@@ -339,7 +272,7 @@ func (self *SQLBackend) StartDeploymentModification(context contextpkg.Context,
 		} else {
 			self.closeRows(rows)
 			self.rollback(tx)
-			return "", nil, backend.NewNotFoundErrorf("deployment: %s", deploymentId)
+			return "", nil, backend.NewNotFoundErrorf("deployment: %s/%s", namespace, deploymentId)
 		}
 	} else {
 		return "", nil, err
@@ -357,13 +290,14 @@ func (self *SQLBackend) EndDeploymentModification(context contextpkg.Context, mo
 		}
 
 		if rows.Next() {
-			var deploymentId string
+			var deploymentId, namespace string
 			var templateId, siteId *string
 			var metadataJson []byte
 			var created, updated time.Time
+			var version uint64
 			var prepared, approved bool
 			var modificationTimestamp *int64
-			if err := rows.Scan(&deploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &prepared, &approved, &modificationTimestamp); err == nil {
+			if err := rows.Scan(&deploymentId, &namespace, &templateId, &siteId, &metadataJson, &created, &updated, &version, &prepared, &approved, &modificationTimestamp); err == nil {
 				self.closeRows(rows)
 
 				if self.hasModificationExpired(modificationTimestamp) {
@@ -372,7 +306,7 @@ func (self *SQLBackend) EndDeploymentModification(context contextpkg.Context, mo
 				}
 
 				var deploymentInfo backend.DeploymentInfo
-				if deploymentInfo, err = self.newDeploymentInfo(deploymentId, nil, templateId, siteId, metadataJson, created, updated, prepared, approved); err != nil {
+				if deploymentInfo, err = self.newDeploymentInfo(namespace, deploymentId, nil, templateId, siteId, metadataJson, created, updated, version, prepared, approved); err != nil {
 					self.rollback(tx)
 					return "", err
 				}
@@ -389,7 +323,7 @@ func (self *SQLBackend) EndDeploymentModification(context contextpkg.Context, mo
 
 				if validation != nil {
 					// Complete validation when fully prepared
-					if err := validation.ValidatePackage(package_, deployment.Prepared); err != nil {
+					if err := validation.ValidatePackage(context, package_, deployment.Prepared); err != nil {
 						self.rollback(tx)
 						return "", err
 					}
@@ -397,12 +331,13 @@ func (self *SQLBackend) EndDeploymentModification(context contextpkg.Context, mo
 
 				var package_ []byte
 				var err error
-				if package_, err = self.encodePackage(deployment.Package); err != nil {
+				if package_, err = self.encodePackage(context, tx, deployment.Package); err != nil {
 					self.rollback(tx)
 					return "", err
 				}
 
 				deployment.Updated = time.Now().UTC()
+				deployment.Version++
 
 				updateDeployment := tx.StmtContext(context, self.statements.PreparedUpdateDeployment)
 				if _, err := updateDeployment.ExecContext(context, deployment.DeploymentID, deployment.Updated, deployment.Prepared, deployment.Approved, package_); err != nil {
@@ -453,6 +388,23 @@ func (self *SQLBackend) EndDeploymentModification(context contextpkg.Context, mo
 					}
 				}
 
+				// Add revision
+
+				if revision, err := backend.NewDeploymentRevision(context, &deployment); err == nil {
+					if err := self.insertRevision(context, tx, revision); err != nil {
+						self.rollback(tx)
+						return "", err
+					}
+				} else {
+					self.rollback(tx)
+					return "", err
+				}
+
+				if err := self.insertEvents(context, tx, backend.NewEvent(backend.EventTypeUpdated, backend.EventKindDeployment, namespace, deploymentId)); err != nil {
+					self.rollback(tx)
+					return "", err
+				}
+
 				if err := tx.Commit(); err == nil {
 					return deploymentId, nil
 				} else {
@@ -489,14 +441,253 @@ func (self *SQLBackend) CancelDeploymentModification(context contextpkg.Context,
 	}
 }
 
+// ([backend.Backend] interface)
+func (self *SQLBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		// Select all IDs first, because modifying can change which deployments are selected
+		var deploymentIds []string
+		if deploymentIds, err = self.selectDeploymentIds(context, tx, selectDeployments); err != nil {
+			self.rollback(tx)
+			return nil, err
+		}
+
+		results := make([]backend.DeploymentModificationResult, 0, len(deploymentIds))
+		var events []backend.Event
+		for _, deploymentId := range deploymentIds {
+			if result, err := self.modifyDeployment(context, tx, selectDeployments.Namespace, deploymentId, modifyDeployments); err == nil {
+				results = append(results, result)
+				if result.Modified {
+					events = append(events, backend.NewEvent(backend.EventTypeUpdated, backend.EventKindDeployment, selectDeployments.Namespace, deploymentId))
+				}
+			} else if backend.IsNotFoundError(err) {
+				continue
+			} else {
+				self.rollback(tx)
+				return nil, err
+			}
+		}
+
+		if len(events) > 0 {
+			if err := self.insertEvents(context, tx, events...); err != nil {
+				self.rollback(tx)
+				return nil, err
+			}
+		}
+
+		if err := tx.Commit(); err == nil {
+			return results, nil
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	now := time.Now().UTC()
+	if deployment.Created.IsZero() {
+		deployment.Created = now
+	}
+	if deployment.Updated.IsZero() {
+		deployment.Updated = now
+	}
+
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		var package_ []byte
+		var err error
+		if package_, err = self.encodePackage(context, tx, deployment.Package); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		// Conflicts if the deployment exists in another namespace
+		if version, err := self.upsertWithVersion(context, tx, self.statements.PreparedImportDeployment, backend.EventKindDeployment, deployment.DeploymentID, deployment.DeploymentID, deployment.Namespace, nilIfEmptyString(deployment.ParentDeploymentID), nilIfEmptyString(deployment.TemplateID), nilIfEmptyString(deployment.SiteID), deployment.Created, deployment.Updated, deployment.Prepared, deployment.Approved, package_); err == nil {
+			deployment.Version = version
+		} else {
+			self.rollback(tx)
+			return err
+		}
+
+		eventType := backend.EventTypeAdded
+		if deployment.Version > 1 {
+			eventType = backend.EventTypeUpdated
+		}
+
+		if err := self.updateDeploymentMetadata(context, tx, deployment); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		// Replace associations
+
+		deleteTemplateDeployment := tx.StmtContext(context, self.statements.PreparedDeleteTemplateDeployment)
+		if _, err := deleteTemplateDeployment.ExecContext(context, deployment.DeploymentID); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		if err := self.upsertTemplateDeployment(context, tx, deployment); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		deleteSiteDeployment := tx.StmtContext(context, self.statements.PreparedDeleteSiteDeployment)
+		if _, err := deleteSiteDeployment.ExecContext(context, deployment.DeploymentID); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		if err := self.upsertSiteDeployment(context, tx, deployment); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		if revision, err := backend.NewDeploymentRevision(context, deployment); err == nil {
+			if err := self.insertRevision(context, tx, revision); err != nil {
+				self.rollback(tx)
+				return err
+			}
+		} else {
+			self.rollback(tx)
+			return err
+		}
+
+		if err := self.insertEvents(context, tx, backend.NewEvent(eventType, backend.EventKindDeployment, deployment.Namespace, deployment.DeploymentID)); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		return tx.Commit()
+	} else {
+		return err
+	}
+}
+
 // Utils
 
-func (self *SQLBackend) newDeploymentInfo(deploymentId string, parentDeploymentId *string, templateId *string, siteId *string, metadataJson []byte, created time.Time, updated time.Time, prepared bool, approved bool) (backend.DeploymentInfo, error) {
+// The packages of the returned selection must be selected before executing the SQL.
+func (self *SQLBackend) selectDeploymentsSql(selectDeployments backend.SelectDeployments, window backend.Window) (string, SqlArgs, packageSelection, error) {
+	sql := self.statements.SelectDeployments
+	var with SqlWith
+	var where SqlWhere
+	var args SqlArgs
+	var selection packageSelection
+
+	args.AddValue(window.Offset)
+	args.AddValue(window.Limit())
+
+	if selectDeployments.Namespace != backend.AllNamespaces {
+		where.Add(`deployments.namespace = ` + args.Add(selectDeployments.Namespace))
+	}
+
+	if (selectDeployments.ParentDeploymentID != nil) && (*selectDeployments.ParentDeploymentID != "") {
+		where.Add(`parent_deployment_id = ` + args.Add(selectDeployments.ParentDeploymentID))
+	}
+
+	if len(selectDeployments.MetadataPatterns) > 0 {
+		for key, pattern := range selectDeployments.MetadataPatterns {
+			key = args.Add(key)
+			pattern = args.Add(backend.PatternRE(pattern))
+			with.Add(`SELECT deployment_id FROM deployments_metadata WHERE (key = `+key+`) AND (`+self.regexp(`value`, pattern)+`)`,
+				`deployments`, `deployment_id`)
+		}
+	}
+
+	for _, pattern := range selectDeployments.TemplateIDPatterns {
+		pattern = args.Add(backend.IDPatternRE(pattern))
+		where.Add(self.regexp(`deployments.template_id`, pattern))
+	}
+
+	if len(selectDeployments.TemplateMetadataPatterns) > 0 {
+		for key, pattern := range selectDeployments.TemplateMetadataPatterns {
+			key = args.Add(key)
+			pattern = args.Add(backend.PatternRE(pattern))
+			with.Add(`SELECT namespace, template_id FROM templates_metadata WHERE (key = `+key+`) AND (`+self.regexp(`value`, pattern)+`)`,
+				`deployments`, `namespace`, `template_id`)
+		}
+	}
+
+	for _, pattern := range selectDeployments.SiteIDPatterns {
+		pattern = args.Add(backend.IDPatternRE(pattern))
+		where.Add(self.regexp(`deployments.site_id`, pattern))
+	}
+
+	if len(selectDeployments.SiteMetadataPatterns) > 0 {
+		for key, pattern := range selectDeployments.SiteMetadataPatterns {
+			key = args.Add(key)
+			pattern = args.Add(backend.PatternRE(pattern))
+			with.Add(`SELECT namespace, site_id FROM sites_metadata WHERE (key = `+key+`) AND (`+self.regexp(`value`, pattern)+`)`,
+				`deployments`, `namespace`, `site_id`)
+		}
+	}
+
+	if selectDeployments.Prepared != nil {
+		switch *selectDeployments.Prepared {
+		case true:
+			where.Add(`prepared`)
+		case false:
+			where.Add(`NOT prepared`)
+		}
+	}
+
+	if selectDeployments.Approved != nil {
+		switch *selectDeployments.Approved {
+		case true:
+			where.Add(`approved`)
+		case false:
+			where.Add(`NOT approved`)
+		}
+	}
+
+	if selectors, err := backend.ParseSelectors(selectDeployments.MetadataSelector, selectDeployments.PackageSelector); err == nil {
+		if selectors.Metadata != nil {
+			where.Add(self.metadataSelectorSql(selectors.Metadata, &args, `deployments_metadata`, `deployments`, `deployment_id`))
+		}
+
+		if selectors.Package != nil {
+			selection = packageSelection{
+				Statement:  self.statements.SelectDeploymentPackages,
+				IDColumn:   `deployment_id`,
+				Namespace:  selectDeployments.Namespace,
+				Expression: selectors.Package,
+			}
+			where.Add(selectedPackagesSql(`deployments.namespace`, `deployments.deployment_id`))
+		}
+	} else {
+		return "", args, selection, err
+	}
+
+	sql = with.Apply(sql)
+	sql = where.Apply(sql)
+	self.log.Debugf("generated SQL:\n%s", sql)
+
+	return sql, args, selection, nil
+}
+
+func (self *SQLBackend) scanDeploymentInfo(rows *sql.Rows) (backend.DeploymentInfo, error) {
+	var deploymentId, namespace string
+	var parentDeploymentId, templateId, siteId *string
+	var metadataJson []byte
+	var created, updated time.Time
+	var version uint64
+	var prepared, approved bool
+	if err := rows.Scan(&deploymentId, &namespace, &parentDeploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &version, &prepared, &approved); err == nil {
+		return self.newDeploymentInfo(namespace, deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, version, prepared, approved)
+	} else {
+		return backend.DeploymentInfo{}, err
+	}
+}
+
+func (self *SQLBackend) newDeploymentInfo(namespace string, deploymentId string, parentDeploymentId *string, templateId *string, siteId *string, metadataJson []byte, created time.Time, updated time.Time, version uint64, prepared bool, approved bool) (backend.DeploymentInfo, error) {
 	deploymentInfo := backend.DeploymentInfo{
+		Namespace:    namespace,
 		DeploymentID: deploymentId,
 		Metadata:     make(map[string]string),
 		Created:      created,
 		Updated:      updated,
+		Version:      version,
 		Prepared:     prepared,
 		Approved:     approved,
 	}
@@ -520,10 +711,10 @@ func (self *SQLBackend) newDeploymentInfo(deploymentId string, parentDeploymentI
 	return deploymentInfo, nil
 }
 
-func (self *SQLBackend) newDeployment(deploymentId string, parentDeploymentId *string, templateId *string, siteId *string, metadataJson []byte, created time.Time, updated time.Time, prepared bool, approved bool, package_ []byte) (*backend.Deployment, error) {
-	if deploymentInfo, err := self.newDeploymentInfo(deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, prepared, approved); err == nil {
+func (self *SQLBackend) newDeployment(context contextpkg.Context, querier querier, namespace string, deploymentId string, parentDeploymentId *string, templateId *string, siteId *string, metadataJson []byte, created time.Time, updated time.Time, version uint64, prepared bool, approved bool, package_ []byte) (*backend.Deployment, error) {
+	if deploymentInfo, err := self.newDeploymentInfo(namespace, deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, version, prepared, approved); err == nil {
 		deployment := backend.Deployment{DeploymentInfo: deploymentInfo}
-		if deployment.Package, err = self.decodePackage(package_); err == nil {
+		if deployment.Package, err = self.decodePackage(context, querier, package_); err == nil {
 			return &deployment, nil
 		} else {
 			return nil, err
@@ -535,7 +726,7 @@ func (self *SQLBackend) newDeployment(deploymentId string, parentDeploymentId *s
 
 func (self *SQLBackend) mergeDeploymentTemplate(context contextpkg.Context, tx *sql.Tx, deployment *backend.Deployment) error {
 	if deployment.TemplateID != "" {
-		if template, err := self.getTemplateTx(context, tx, deployment.TemplateID); err == nil {
+		if template, err := self.getTemplateTx(context, tx, deployment.Namespace, deployment.TemplateID); err == nil {
 			deployment.MergeTemplate(template)
 		} else {
 			return err
@@ -568,7 +759,7 @@ func (self *SQLBackend) upsertDeploymentMetadata(context contextpkg.Context, tx
 func (self *SQLBackend) upsertTemplateDeployment(context contextpkg.Context, tx *sql.Tx, deployment *backend.Deployment) error {
 	if deployment.TemplateID != "" {
 		upsertTemplateDeployment := tx.StmtContext(context, self.statements.PreparedUpsertTemplateDeployment)
-		if _, err := upsertTemplateDeployment.ExecContext(context, deployment.TemplateID, deployment.DeploymentID); err != nil {
+		if _, err := upsertTemplateDeployment.ExecContext(context, deployment.Namespace, deployment.TemplateID, deployment.DeploymentID); err != nil {
 			self.rollback(tx)
 			return err
 		}
@@ -580,7 +771,7 @@ func (self *SQLBackend) upsertTemplateDeployment(context contextpkg.Context, tx
 func (self *SQLBackend) upsertSiteDeployment(context contextpkg.Context, tx *sql.Tx, deployment *backend.Deployment) error {
 	if deployment.SiteID != "" {
 		upsertSiteDeployment := tx.StmtContext(context, self.statements.PreparedUpsertSiteDeployment)
-		if _, err := upsertSiteDeployment.ExecContext(context, deployment.SiteID, deployment.DeploymentID); err != nil {
+		if _, err := upsertSiteDeployment.ExecContext(context, deployment.Namespace, deployment.SiteID, deployment.DeploymentID); err != nil {
 			self.rollback(tx)
 			return err
 		}
@@ -596,3 +787,311 @@ func (self *SQLBackend) hasModificationExpired(modificationTimestamp *int64) boo
 	delta := time.Now().UnixMicro() - *modificationTimestamp
 	return delta > self.maxModificationDuration
 }
+
+// Sorted by deployment ID.
+func (self *SQLBackend) selectDeploymentIds(context contextpkg.Context, tx *sql.Tx, selectDeployments backend.SelectDeployments) ([]string, error) {
+	var deploymentIds []string
+	window := backend.Window{MaxCount: int(backend.MaxMaxCount)}
+	for {
+		sql, args, selection, err := self.selectDeploymentsSql(selectDeployments, window)
+		if err != nil {
+			return nil, err
+		}
+
+		// The selected packages are the same for all windows
+		if window.Offset == 0 {
+			if err := self.selectPackages(context, tx, selection); err != nil {
+				return nil, err
+			}
+		}
+
+		rows, err := tx.QueryContext(context, sql, args.Args...)
+		if err != nil {
+			return nil, err
+		}
+
+		var count uint
+		for rows.Next() {
+			if deploymentInfo, err := self.scanDeploymentInfo(rows); err == nil {
+				deploymentIds = append(deploymentIds, deploymentInfo.DeploymentID)
+				count++
+			} else {
+				self.closeRows(rows)
+				return nil, err
+			}
+		}
+		self.closeRows(rows)
+
+		if count < window.Limit() {
+			return deploymentIds, nil
+		}
+
+		window.Offset += count
+	}
+}
+
+func (self *SQLBackend) modifyDeployment(context contextpkg.Context, tx *sql.Tx, namespace string, deploymentId string, modifyDeployments backend.ModifyDeployments) (backend.DeploymentModificationResult, error) {
+	result := backend.DeploymentModificationResult{DeploymentID: deploymentId}
+
+	selectDeploymentWithModification := tx.StmtContext(context, self.statements.PreparedSelectDeploymentWithModification)
+	rows, err := selectDeploymentWithModification.QueryContext(context, namespace, deploymentId)
+	if err != nil {
+		return result, err
+	}
+
+	var deployment *backend.Deployment
+	if rows.Next() {
+		var parentDeploymentId, templateId, siteId, modificationToken *string
+		var created, updated time.Time
+		var version uint64
+		var prepared, approved bool
+		var metadataJson, package_ []byte
+		var modificationTimestamp *int64
+		if err := rows.Scan(&parentDeploymentId, &templateId, &siteId, &metadataJson, &created, &updated, &version, &prepared, &approved, &package_, &modificationToken, &modificationTimestamp); err == nil {
+			self.closeRows(rows)
+
+			result.Version = version
+
+			if (modificationToken != nil) && (*modificationToken != "") && !self.hasModificationExpired(modificationTimestamp) {
+				result.NotModifiedReason = "busy"
+				return result, nil
+			}
+
+			if deployment, err = self.newDeployment(context, tx, namespace, deploymentId, parentDeploymentId, templateId, siteId, metadataJson, created, updated, version, prepared, approved, package_); err != nil {
+				return result, err
+			}
+		} else {
+			self.closeRows(rows)
+			return result, err
+		}
+	} else {
+		self.closeRows(rows)
+		return result, backend.NewNotFoundErrorf("deployment: %s/%s", namespace, deploymentId)
+	}
+
+	originalMetadata := tkoutil.CloneStringMap(deployment.Metadata)
+	if modified, err := modifyDeployments.Apply(context, deployment); err == nil {
+		if !modified {
+			return result, nil
+		}
+	} else {
+		result.NotModifiedReason = err.Error()
+		return result, nil
+	}
+
+	var package_ []byte
+	if package_, err = self.encodePackage(context, tx, deployment.Package); err != nil {
+		return result, err
+	}
+
+	deployment.Updated = time.Now().UTC()
+
+	// The deployment was read without a lock, so only update it if it has not changed and no
+	// modification has been started since
+	expired := time.Now().UnixMicro() - self.maxModificationDuration
+	updateDeployment := tx.StmtContext(context, self.statements.PreparedUpdateUnmodifiedDeployment)
+	if err := updateDeployment.QueryRowContext(context, deployment.DeploymentID, deployment.Updated, deployment.Prepared, deployment.Approved, package_, deployment.Version, expired).Scan(&deployment.Version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			result.NotModifiedReason = "busy"
+			return result, nil
+		}
+		return result, err
+	}
+
+	if !tkoutil.StringMapEquals(originalMetadata, deployment.Metadata) {
+		if err := self.updateDeploymentMetadata(context, tx, deployment); err != nil {
+			return result, err
+		}
+	}
+
+	if revision, err := backend.NewDeploymentRevision(context, deployment); err == nil {
+		if err := self.insertRevision(context, tx, revision); err != nil {
+			return result, err
+		}
+	} else {
+		return result, err
+	}
+
+	result.Modified = true
+	result.Version = deployment.Version
+	return result, nil
+}
+
+// Returns descendant IDs to be deleted in the background. They are marked as pending deletion
+// within the transaction, and then deleted by the reaper (see [SQLBackend.reapDeployments]).
+// Descendants are always in the same namespace.
+func (self *SQLBackend) deleteDeployments(context contextpkg.Context, tx *sql.Tx, namespace string, deploymentIds []string, propagation string) ([]string, error) {
+	var descendantIds []string
+	switch propagation {
+	case backend.PropagationBackground, backend.PropagationForeground:
+		var err error
+		if descendantIds, err = self.selectDeploymentDescendantIds(context, tx, deploymentIds); err != nil {
+			return nil, err
+		}
+	}
+
+	var events []backend.Event
+
+	if propagation == backend.PropagationForeground {
+		for _, descendantId := range descendantIds {
+			if err := self.deleteDeployment(context, tx, namespace, descendantId); err == nil {
+				events = append(events, backend.NewEvent(backend.EventTypeDeleted, backend.EventKindDeployment, namespace, descendantId))
+			} else if !backend.IsNotFoundError(err) {
+				return nil, err
+			}
+		}
+		descendantIds = nil
+	} else if len(descendantIds) > 0 {
+		insertDeploymentPendingDelete := tx.StmtContext(context, self.statements.PreparedInsertDeploymentPendingDelete)
+		for _, descendantId := range descendantIds {
+			if _, err := insertDeploymentPendingDelete.ExecContext(context, descendantId); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, deploymentId := range deploymentIds {
+		if err := self.deleteDeployment(context, tx, namespace, deploymentId); err == nil {
+			events = append(events, backend.NewEvent(backend.EventTypeDeleted, backend.EventKindDeployment, namespace, deploymentId))
+		} else {
+			return nil, err
+		}
+	}
+
+	if err := self.insertEvents(context, tx, events...); err != nil {
+		return nil, err
+	}
+
+	return descendantIds, nil
+}
+
+// Orphans the children, so that the foreign key will not cascade the delete.
+// Leaves a tombstone in the site if the deployment was scheduled.
+func (self *SQLBackend) deleteDeployment(context contextpkg.Context, tx *sql.Tx, namespace string, deploymentId string) error {
+	orphanDeploymentChildren := tx.StmtContext(context, self.statements.PreparedOrphanDeploymentChildren)
+	if _, err := orphanDeploymentChildren.ExecContext(context, deploymentId); err != nil {
+		return err
+	}
+
+	insertSiteDeletedDeployment := tx.StmtContext(context, self.statements.PreparedInsertSiteDeletedDeployment)
+	if _, err := insertSiteDeletedDeployment.ExecContext(context, deploymentId, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	// Will cascade delete deployments_metadata, templates_deployments, sites_deployments
+	deleteDeployment := tx.StmtContext(context, self.statements.PreparedDeleteDeployment)
+	if result, err := deleteDeployment.ExecContext(context, namespace, deploymentId); err == nil {
+		if count, err := result.RowsAffected(); err == nil {
+			if count == 0 {
+				return backend.NewNotFoundErrorf("deployment: %s/%s", namespace, deploymentId)
+			}
+			return nil
+		} else {
+			return err
+		}
+	} else {
+		return err
+	}
+}
+
+// Excludes the argument deployments. Deepest descendants are first.
+func (self *SQLBackend) selectDeploymentDescendantIds(context contextpkg.Context, tx *sql.Tx, deploymentIds []string) ([]string, error) {
+	ids := make(map[string]struct{})
+	for _, deploymentId := range deploymentIds {
+		ids[deploymentId] = struct{}{}
+	}
+
+	var descendantIds []string
+	selectDeploymentDescendants := tx.StmtContext(context, self.statements.PreparedSelectDeploymentDescendants)
+	for _, deploymentId := range deploymentIds {
+		rows, err := selectDeploymentDescendants.QueryContext(context, deploymentId)
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			if descendantId, err := scanID(rows); err == nil {
+				if _, ok := ids[descendantId]; !ok {
+					ids[descendantId] = struct{}{}
+					descendantIds = append(descendantIds, descendantId)
+				}
+			} else {
+				self.closeRows(rows)
+				return nil, err
+			}
+		}
+		self.closeRows(rows)
+	}
+
+	return descendantIds, nil
+}
+
+func (self *SQLBackend) startReaper() {
+	self.reap = make(chan struct{}, 1)
+
+	var context contextpkg.Context
+	context, self.stopReaper = contextpkg.WithCancel(contextpkg.Background())
+
+	// The first pass resumes deletions that were pending before we started
+	go func() {
+		for {
+			if err := self.reapDeployments(context); err != nil {
+				if context.Err() != nil {
+					return
+				}
+				self.log.Errorf("background delete of deployments: %s", err.Error())
+			}
+
+			select {
+			case <-self.reap:
+			case <-time.After(DeploymentsReapInterval):
+			case <-context.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (self *SQLBackend) wakeReaper() {
+	select {
+	case self.reap <- struct{}{}:
+	default:
+	}
+}
+
+// Deletes the deployments that are pending deletion until there are none left. Their markers
+// are removed together with them by the foreign key.
+func (self *SQLBackend) reapDeployments(context contextpkg.Context) error {
+	for {
+		rows, err := self.statements.PreparedSelectDeploymentPendingDeletes.QueryContext(context, DeploymentsReapBatchSize)
+		if err != nil {
+			return err
+		}
+
+		var namespacedIds [][2]string
+		for rows.Next() {
+			var namespace, deploymentId string
+			if err := rows.Scan(&namespace, &deploymentId); err == nil {
+				namespacedIds = append(namespacedIds, [2]string{namespace, deploymentId})
+			} else {
+				self.closeRows(rows)
+				return err
+			}
+		}
+		self.closeRows(rows)
+
+		if len(namespacedIds) == 0 {
+			return nil
+		}
+
+		// Their descendants are pending deletion, too, so we can orphan them
+		for _, namespacedId := range namespacedIds {
+			if err := self.DeleteDeployment(context, namespacedId[0], namespacedId[1], backend.PropagationOrphan); err != nil {
+				// Might have been deleted in the meantime
+				if !backend.IsNotFoundError(err) {
+					return err
+				}
+			}
+		}
+	}
+}
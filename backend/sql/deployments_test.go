@@ -0,0 +1,55 @@
+package sql
+
+import (
+	contextpkg "context"
+	"path/filepath"
+	"testing"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/commonlog"
+)
+
+func TestModifyDeploymentsBusy(t *testing.T) {
+	context := contextpkg.Background()
+
+	sqlBackend := NewSQLBackend("sqlite", SQLiteDataSource(filepath.Join(t.TempDir(), "tko.db")), "cbor", 10, commonlog.GetLogger("test"))
+	sqlBackend.AutoMigrate = true
+	if err := sqlBackend.Connect(context); err != nil {
+		t.Fatal(err)
+	}
+	defer sqlBackend.Release(context)
+
+	deployment := backend.Deployment{DeploymentInfo: backend.DeploymentInfo{Namespace: "namespace"}}
+	if err := sqlBackend.CreateDeployment(context, &deployment); err != nil {
+		t.Fatal(err)
+	}
+
+	selectDeployments := backend.SelectDeployments{Namespace: "namespace"}
+	modifyDeployments := backend.ModifyDeployments{SetMetadata: map[string]string{"key": "value"}}
+
+	// A deployment being modified must be left unchanged
+	modificationToken, _, err := sqlBackend.StartDeploymentModification(context, "namespace", deployment.DeploymentID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results, err := sqlBackend.ModifyDeployments(context, selectDeployments, modifyDeployments); err == nil {
+		if (len(results) != 1) || results[0].Modified || (results[0].NotModifiedReason != "busy") {
+			t.Errorf("%+v, expected busy", results)
+		}
+	} else {
+		t.Fatal(err)
+	}
+
+	if err := sqlBackend.CancelDeploymentModification(context, modificationToken); err != nil {
+		t.Fatal(err)
+	}
+
+	if results, err := sqlBackend.ModifyDeployments(context, selectDeployments, modifyDeployments); err == nil {
+		if (len(results) != 1) || !results[0].Modified || (results[0].Version != 2) {
+			t.Errorf("%+v, expected modified at version 2", results)
+		}
+	} else {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,331 @@
+package sql
+
+import (
+	contextpkg "context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+var (
+	EventsPollInterval = 5 * time.Second
+	EventsRetention    = 24 * time.Hour
+)
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	return self.events.Watch(context, selectEvents)
+}
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	rows, err := self.statements.PreparedSelectEventsRevision.QueryContext(context)
+	if err != nil {
+		return 0, err
+	}
+	defer self.closeRows(rows)
+
+	if rows.Next() {
+		var revision int64
+		if err := rows.Scan(&revision); err == nil {
+			return uint64(revision), nil
+		} else {
+			return 0, err
+		}
+	}
+
+	return 0, rows.Err()
+}
+
+// Utils
+
+func (self *SQLBackend) insertEvents(context contextpkg.Context, tx *sql.Tx, events ...backend.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	// Lock until commit so that events are committed in order of revision. Otherwise a reader
+	// could see a later revision before an earlier one is committed and then skip the earlier
+	// one. Note that this serializes all writing transactions (of all kinds) from this point
+	// until their commit, which is why callers insert events as late as possible in their
+	// transactions. (SQLite serializes writers anyway.)
+	if self.statements.LockEvents != "" {
+		if _, err := tx.ExecContext(context, self.statements.LockEvents); err != nil {
+			return err
+		}
+	}
+
+	insertEvent := tx.StmtContext(context, self.statements.PreparedInsertEvent)
+	for _, event := range events {
+		if _, err := insertEvent.ExecContext(context, event.Type, event.Kind, event.Namespace, event.ID, event.Timestamp); err != nil {
+			return err
+		}
+	}
+
+	// Notifications are sent on commit
+	if self.statements.NotifyEvents != "" {
+		if _, err := tx.ExecContext(context, self.statements.NotifyEvents); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *SQLBackend) startEvents(context contextpkg.Context) error {
+	self.events = backend.NewEventBroadcaster()
+	self.events.LoadHistory = self.selectEvents
+
+	if revision, err := self.GetEventRevision(context); err == nil {
+		self.events.SetRevision(revision)
+	} else {
+		return err
+	}
+
+	var eventsContext contextpkg.Context
+	eventsContext, self.stopEvents = contextpkg.WithCancel(contextpkg.Background())
+	go self.watchEvents(eventsContext)
+
+	return nil
+}
+
+func (self *SQLBackend) watchEvents(context contextpkg.Context) {
+	for {
+		var err error
+		if self.statements.ListenEvents != "" {
+			err = self.listenEvents(context)
+		} else {
+			err = self.pollEvents(context)
+		}
+
+		if context.Err() != nil {
+			return
+		}
+
+		self.log.Errorf("events: %s", err.Error())
+
+		select {
+		case <-time.After(EventsPollInterval):
+		case <-context.Done():
+			return
+		}
+	}
+}
+
+// Uses PostgreSQL LISTEN/NOTIFY on a dedicated connection, with polling as a fallback.
+func (self *SQLBackend) listenEvents(context contextpkg.Context) error {
+	conn, err := self.db.Conn(context)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("LISTEN requires the pgx driver")
+		}
+		pgxConn := stdlibConn.Conn()
+
+		if _, err := pgxConn.Exec(context, self.statements.ListenEvents); err != nil {
+			return err
+		}
+		defer func() {
+			if _, err := pgxConn.Exec(contextpkg.Background(), self.statements.UnlistenEvents); err != nil {
+				self.log.Error(err.Error())
+			}
+		}()
+
+		lastCleanup := time.Now()
+		for {
+			if err := self.fetchEvents(context); err != nil {
+				return err
+			}
+
+			if time.Since(lastCleanup) > time.Hour {
+				self.deleteOldEvents(context)
+				lastCleanup = time.Now()
+			}
+
+			waitContext, cancel := contextpkg.WithTimeout(context, EventsPollInterval)
+			_, err := pgxConn.WaitForNotification(waitContext)
+			cancel()
+			if err != nil {
+				if context.Err() != nil {
+					return context.Err()
+				} else if !errors.Is(err, contextpkg.DeadlineExceeded) {
+					return err
+				}
+			}
+		}
+	})
+}
+
+func (self *SQLBackend) pollEvents(context contextpkg.Context) error {
+	ticker := time.NewTicker(EventsPollInterval)
+	defer ticker.Stop()
+
+	lastCleanup := time.Now()
+	for {
+		if err := self.fetchEvents(context); err != nil {
+			return err
+		}
+
+		if time.Since(lastCleanup) > time.Hour {
+			self.deleteOldEvents(context)
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-context.Done():
+			return context.Err()
+		}
+	}
+}
+
+// Broadcasts events committed since the last broadcast revision.
+func (self *SQLBackend) fetchEvents(context contextpkg.Context) error {
+	if events, err := self.selectEvents(context, self.events.Revision()); err == nil {
+		for _, event := range events {
+			self.events.Broadcast(event)
+		}
+		return nil
+	} else {
+		return err
+	}
+}
+
+func (self *SQLBackend) selectEvents(context contextpkg.Context, sinceRevision uint64) ([]backend.Event, error) {
+	rows, err := self.statements.PreparedSelectEvents.QueryContext(context, int64(sinceRevision))
+	if err != nil {
+		return nil, err
+	}
+	defer self.closeRows(rows)
+
+	var events []backend.Event
+	for rows.Next() {
+		var revision int64
+		var event backend.Event
+		if err := rows.Scan(&revision, &event.Type, &event.Kind, &event.Namespace, &event.ID, &event.Timestamp); err == nil {
+			event.Revision = uint64(revision)
+			events = append(events, event)
+		} else {
+			return nil, err
+		}
+	}
+
+	return events, rows.Err()
+}
+
+func (self *SQLBackend) deleteOldEvents(context contextpkg.Context) {
+	if _, err := self.statements.PreparedDeleteEvents.ExecContext(context, time.Now().UTC().Add(-EventsRetention)); err != nil {
+		self.log.Errorf("delete old events: %s", err.Error())
+	}
+}
+
+// The disassociate statements are executed with the namespace and ID of each deleted object.
+// The packages are selected in the same transaction, see [packageSelection].
+func (self *SQLBackend) purge(context contextpkg.Context, statement string, args []any, selection packageSelection, kind string, namespace string, scanId func(rows *sql.Rows) (string, error), disassociateStmts ...*sql.Stmt) error {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		if err := self.selectPackages(context, tx, selection); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		rows, err := tx.QueryContext(context, statement, args...)
+		if err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		var ids []string
+		for rows.Next() {
+			if id, err := scanId(rows); err == nil {
+				ids = append(ids, id)
+			} else {
+				self.closeRows(rows)
+				self.rollback(tx)
+				return err
+			}
+		}
+		self.closeRows(rows)
+
+		var events []backend.Event
+		for _, id := range ids {
+			if err := self.disassociate(context, tx, namespace, id, disassociateStmts); err != nil {
+				self.rollback(tx)
+				return err
+			}
+			events = append(events, backend.NewEvent(backend.EventTypeDeleted, kind, namespace, id))
+		}
+
+		if err := self.insertEvents(context, tx, events...); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		return tx.Commit()
+	} else {
+		return err
+	}
+}
+
+// The disassociate statements are executed with the namespace and ID of the deleted object.
+func (self *SQLBackend) deleteWithEvent(context contextpkg.Context, stmt *sql.Stmt, disassociateStmts []*sql.Stmt, kind string, namespace string, id string, args ...any) error {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		if result, err := tx.StmtContext(context, stmt).ExecContext(context, args...); err == nil {
+			if count, err := result.RowsAffected(); err == nil {
+				if count == 0 {
+					self.rollback(tx)
+					return backend.NewNotFoundErrorf("%s: %s", kind, id)
+				}
+			} else {
+				self.rollback(tx)
+				return err
+			}
+		} else {
+			self.rollback(tx)
+			return err
+		}
+
+		if err := self.disassociate(context, tx, namespace, id, disassociateStmts); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		if err := self.insertEvents(context, tx, backend.NewEvent(backend.EventTypeDeleted, kind, namespace, id)); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		return tx.Commit()
+	} else {
+		return err
+	}
+}
+
+func (self *SQLBackend) disassociate(context contextpkg.Context, tx *sql.Tx, namespace string, id string, disassociateStmts []*sql.Stmt) error {
+	for _, disassociateStmt := range disassociateStmts {
+		if _, err := tx.StmtContext(context, disassociateStmt).ExecContext(context, namespace, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanID(rows *sql.Rows) (string, error) {
+	var id string
+	err := rows.Scan(&id)
+	return id, err
+}
+
+func scanPluginID(rows *sql.Rows) (string, error) {
+	var pluginId backend.PluginID
+	err := rows.Scan(&pluginId.Type, &pluginId.Name)
+	return pluginId.String(), err
+}
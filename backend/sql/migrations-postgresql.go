@@ -0,0 +1,376 @@
+package sql
+
+// The statements are literal so that released migrations never change. Schema changes always
+// require a new migration appended to the end.
+var postgresqlMigrations = []Migration{
+	{
+		// The schema as it was before migrations were introduced. Because its statements all
+		// use "IF NOT EXISTS" it can also be applied to such existing databases.
+		Version:     1,
+		Description: "initial schema",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS templates (
+					template_id TEXT NOT NULL PRIMARY KEY,
+					updated TIMESTAMP,
+					package BYTEA
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS templates_metadata (
+					template_id TEXT NOT NULL,
+					key TEXT NOT NULL,
+					value TEXT NOT NULL,
+					UNIQUE (template_id, key),
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (template_id)
+						REFERENCES templates (template_id) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS templates_metadata_index ON templates_metadata (key, value)`,
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS sites (
+					site_id TEXT NOT NULL PRIMARY KEY,
+					template_id TEXT,
+					updated TIMESTAMP,
+					package BYTEA,
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (template_id)
+						REFERENCES templates (template_id) ON DELETE SET NULL
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS sites_metadata (
+					site_id TEXT NOT NULL,
+					key TEXT NOT NULL,
+					value TEXT NOT NULL,
+					UNIQUE (site_id, key),
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (site_id)
+						REFERENCES sites (site_id) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS sites_metadata_index ON sites_metadata (key, value)`,
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS deployments (
+					deployment_id TEXT NOT NULL PRIMARY KEY,
+					parent_deployment_id TEXT,
+					template_id TEXT,
+					site_id TEXT,
+					created TIMESTAMP,
+					updated TIMESTAMP,
+					prepared BOOLEAN,
+					approved BOOLEAN,
+					package BYTEA,
+					modification_token TEXT,
+					modification_timestamp BIGINT,
+					CONSTRAINT fk_parent_deployment_id
+						FOREIGN KEY (parent_deployment_id)
+						REFERENCES deployments (deployment_id) ON DELETE CASCADE,
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (template_id)
+						REFERENCES templates (template_id) ON DELETE SET NULL,
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (site_id)
+						REFERENCES sites (site_id) ON DELETE SET NULL
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS deployments_metadata (
+					deployment_id TEXT NOT NULL,
+					key TEXT NOT NULL,
+					value TEXT NOT NULL,
+					UNIQUE (deployment_id, key),
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (deployment_id)
+						REFERENCES deployments (deployment_id) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS deployments_metadata_index ON deployments_metadata (key, value)`,
+			`CREATE INDEX IF NOT EXISTS deployments_prepared_index ON deployments (prepared)`,
+			`CREATE INDEX IF NOT EXISTS deployments_approved_index ON deployments (approved)`,
+			`CREATE INDEX IF NOT EXISTS deployments_modification_index ON deployments (modification_token)`,
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS templates_deployments (
+					template_id TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					UNIQUE (deployment_id),
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (template_id)
+						REFERENCES templates (template_id) ON DELETE CASCADE,
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (deployment_id)
+						REFERENCES deployments (deployment_id) ON DELETE CASCADE
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS sites_deployments (
+					site_id TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					UNIQUE (deployment_id),
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (site_id)
+						REFERENCES sites (site_id) ON DELETE CASCADE,
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (deployment_id)
+						REFERENCES deployments (deployment_id) ON DELETE CASCADE
+				)
+			`),
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS plugins (
+					type TEXT NOT NULL,
+					name TEXT NOT NULL,
+					executor TEXT NOT NULL,
+					arguments TEXT,
+					properties TEXT,
+					PRIMARY KEY (type, name)
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS plugins_type_index ON plugins (type)`,
+			`CREATE INDEX IF NOT EXISTS plugins_executor_index ON plugins (executor)`,
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS plugins_triggers (
+					plugin_type TEXT NOT NULL,
+					plugin_name TEXT NOT NULL,
+					"group" TEXT NOT NULL,
+					version TEXT NOT NULL,
+					kind TEXT NOT NULL,
+					UNIQUE (plugin_type, plugin_name, "group", version, kind),
+					CONSTRAINT fk_plugin_id
+						FOREIGN KEY (plugin_type, plugin_name)
+						REFERENCES plugins (type, name) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS plugins_triggers_index ON plugins_triggers ("group", version, kind)`,
+		},
+	},
+	{
+		Version:     2,
+		Description: "revisions",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE revisions (
+					type TEXT NOT NULL,
+					namespace TEXT NOT NULL,
+					object_id TEXT NOT NULL,
+					revision BIGINT NOT NULL,
+					author TEXT NOT NULL,
+					created TIMESTAMP,
+					hash TEXT NOT NULL,
+					template_id TEXT,
+					metadata TEXT,
+					package BYTEA,
+					PRIMARY KEY (type, namespace, object_id, revision)
+				)
+			`),
+		},
+	},
+	{
+		Version:     3,
+		Description: "events",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE events (
+					revision BIGSERIAL PRIMARY KEY,
+					type TEXT NOT NULL,
+					kind TEXT NOT NULL,
+					namespace TEXT NOT NULL,
+					object_id TEXT NOT NULL,
+					timestamp TIMESTAMP
+				)
+			`),
+		},
+	},
+	{
+		// Existing objects start at version 1, as if they had just been created
+		Version:     4,
+		Description: "object versions",
+		Statements: []string{
+			`ALTER TABLE templates ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+			`ALTER TABLE sites ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+			`ALTER TABLE deployments ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+			`ALTER TABLE plugins ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+			`UPDATE templates SET version = 1`,
+			`UPDATE sites SET version = 1`,
+			`UPDATE deployments SET version = 1`,
+			`UPDATE plugins SET version = 1`,
+		},
+	},
+	{
+		// Existing objects are moved to the "default" namespace. Deployment IDs are unique across
+		// namespaces, but parents, templates, and sites must be in the same namespace.
+		//
+		// A composite foreign key cannot "SET NULL" just the template_id or site_id, so the
+		// associations are instead removed explicitly before the deferred check.
+		Version:     5,
+		Description: "namespaces",
+		Statements: []string{
+			`ALTER TABLE templates_metadata DROP CONSTRAINT fk_template_id`,
+			`ALTER TABLE templates_deployments DROP CONSTRAINT fk_template_id`,
+			`ALTER TABLE sites DROP CONSTRAINT fk_template_id`,
+			`ALTER TABLE sites_metadata DROP CONSTRAINT fk_site_id`,
+			`ALTER TABLE sites_deployments DROP CONSTRAINT fk_site_id`,
+			`ALTER TABLE deployments DROP CONSTRAINT fk_parent_deployment_id`,
+			`ALTER TABLE deployments DROP CONSTRAINT fk_template_id`,
+			`ALTER TABLE deployments DROP CONSTRAINT fk_site_id`,
+
+			`ALTER TABLE templates ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default'`,
+			`ALTER TABLE templates DROP CONSTRAINT templates_pkey`,
+			`ALTER TABLE templates ADD PRIMARY KEY (namespace, template_id)`,
+
+			`ALTER TABLE templates_metadata ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default'`,
+			`ALTER TABLE templates_metadata DROP CONSTRAINT templates_metadata_template_id_key_key`,
+			`ALTER TABLE templates_metadata ADD UNIQUE (namespace, template_id, key)`,
+			CleanSQL(`
+				ALTER TABLE templates_metadata ADD CONSTRAINT fk_template_id
+					FOREIGN KEY (namespace, template_id)
+					REFERENCES templates (namespace, template_id) ON DELETE CASCADE
+			`),
+
+			`ALTER TABLE sites ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default'`,
+			`ALTER TABLE sites DROP CONSTRAINT sites_pkey`,
+			`ALTER TABLE sites ADD PRIMARY KEY (namespace, site_id)`,
+			CleanSQL(`
+				ALTER TABLE sites ADD CONSTRAINT fk_template_id
+					FOREIGN KEY (namespace, template_id)
+					REFERENCES templates (namespace, template_id) DEFERRABLE INITIALLY DEFERRED
+			`),
+
+			`ALTER TABLE sites_metadata ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default'`,
+			`ALTER TABLE sites_metadata DROP CONSTRAINT sites_metadata_site_id_key_key`,
+			`ALTER TABLE sites_metadata ADD UNIQUE (namespace, site_id, key)`,
+			CleanSQL(`
+				ALTER TABLE sites_metadata ADD CONSTRAINT fk_site_id
+					FOREIGN KEY (namespace, site_id)
+					REFERENCES sites (namespace, site_id) ON DELETE CASCADE
+			`),
+
+			`ALTER TABLE deployments ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default'`,
+			`ALTER TABLE deployments ADD UNIQUE (namespace, deployment_id)`,
+			CleanSQL(`
+				ALTER TABLE deployments ADD CONSTRAINT fk_parent_deployment_id
+					FOREIGN KEY (namespace, parent_deployment_id)
+					REFERENCES deployments (namespace, deployment_id) ON DELETE CASCADE
+			`),
+			CleanSQL(`
+				ALTER TABLE deployments ADD CONSTRAINT fk_template_id
+					FOREIGN KEY (namespace, template_id)
+					REFERENCES templates (namespace, template_id) DEFERRABLE INITIALLY DEFERRED
+			`),
+			CleanSQL(`
+				ALTER TABLE deployments ADD CONSTRAINT fk_site_id
+					FOREIGN KEY (namespace, site_id)
+					REFERENCES sites (namespace, site_id) DEFERRABLE INITIALLY DEFERRED
+			`),
+
+			`ALTER TABLE templates_deployments ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default'`,
+			CleanSQL(`
+				ALTER TABLE templates_deployments ADD CONSTRAINT fk_template_id
+					FOREIGN KEY (namespace, template_id)
+					REFERENCES templates (namespace, template_id) ON DELETE CASCADE
+			`),
+
+			`ALTER TABLE sites_deployments ADD COLUMN namespace TEXT NOT NULL DEFAULT 'default'`,
+			CleanSQL(`
+				ALTER TABLE sites_deployments ADD CONSTRAINT fk_site_id
+					FOREIGN KEY (namespace, site_id)
+					REFERENCES sites (namespace, site_id) ON DELETE CASCADE
+			`),
+
+			`ALTER TABLE templates ALTER COLUMN namespace DROP DEFAULT`,
+			`ALTER TABLE templates_metadata ALTER COLUMN namespace DROP DEFAULT`,
+			`ALTER TABLE templates_deployments ALTER COLUMN namespace DROP DEFAULT`,
+			`ALTER TABLE sites ALTER COLUMN namespace DROP DEFAULT`,
+			`ALTER TABLE sites_metadata ALTER COLUMN namespace DROP DEFAULT`,
+			`ALTER TABLE sites_deployments ALTER COLUMN namespace DROP DEFAULT`,
+			`ALTER TABLE deployments ALTER COLUMN namespace DROP DEFAULT`,
+		},
+	},
+	{
+		Version:     6,
+		Description: "site deleted deployments",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE sites_deleted_deployments (
+					namespace TEXT NOT NULL,
+					site_id TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					deleted TIMESTAMP,
+					package BYTEA,
+					UNIQUE (deployment_id),
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (namespace, site_id)
+						REFERENCES sites (namespace, site_id) ON DELETE CASCADE
+				)
+			`),
+		},
+	},
+	{
+		Version:     7,
+		Description: "audit events",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE audit_events (
+					id BIGSERIAL PRIMARY KEY,
+					timestamp TIMESTAMP,
+					actor TEXT NOT NULL,
+					operation TEXT NOT NULL,
+					type TEXT NOT NULL,
+					namespace TEXT NOT NULL,
+					ids TEXT,
+					selector TEXT,
+					outcome TEXT NOT NULL,
+					reason TEXT,
+					diff TEXT
+				)
+			`),
+			`CREATE INDEX audit_events_timestamp_index ON audit_events (timestamp)`,
+			`CREATE INDEX audit_events_actor_index ON audit_events (actor)`,
+		},
+	},
+	{
+		// Packages stored before this migration are still read as they are, and are converted
+		// when they are next stored
+		Version:     8,
+		Description: "package blobs",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE blobs (
+					hash TEXT PRIMARY KEY,
+					content BYTEA NOT NULL
+				)
+			`),
+		},
+	},
+	{
+		// Deployments marked for background deletion, so that the deletion can be resumed
+		// after a failure or restart
+		Version:     9,
+		Description: "deployment pending deletes",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE deployments_pending_deletes (
+					namespace TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					PRIMARY KEY (namespace, deployment_id),
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (namespace, deployment_id)
+						REFERENCES deployments (namespace, deployment_id) ON DELETE CASCADE
+				)
+			`),
+		},
+	},
+	{
+		// Authors supplied by callers are no longer recorded as the actor when there is an
+		// authenticated identity
+		Version:     10,
+		Description: "audit event claimed authors",
+		Statements: []string{
+			`ALTER TABLE audit_events ADD COLUMN claimed_author TEXT`,
+		},
+	},
+}
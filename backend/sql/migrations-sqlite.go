@@ -0,0 +1,269 @@
+package sql
+
+// The statements are literal so that released migrations never change. Schema changes always
+// require a new migration appended to the end.
+//
+// SQLite support was added after namespaces, versions, revisions, events, and audit events, so
+// unlike PostgreSQL its initial schema already includes them.
+var sqliteMigrations = []Migration{
+	{
+		Version:     1,
+		Description: "initial schema",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS templates (
+					namespace TEXT NOT NULL,
+					template_id TEXT NOT NULL,
+					updated TIMESTAMP,
+					version BIGINT NOT NULL DEFAULT 0,
+					package BLOB,
+					PRIMARY KEY (namespace, template_id)
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS templates_metadata (
+					namespace TEXT NOT NULL,
+					template_id TEXT NOT NULL,
+					key TEXT NOT NULL,
+					value TEXT NOT NULL,
+					UNIQUE (namespace, template_id, key),
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (namespace, template_id)
+						REFERENCES templates (namespace, template_id) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS templates_metadata_index ON templates_metadata (key, value)`,
+
+			// A composite foreign key cannot "SET NULL" just the template_id, so the
+			// association is removed explicitly before the deferred check
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS sites (
+					namespace TEXT NOT NULL,
+					site_id TEXT NOT NULL,
+					template_id TEXT,
+					updated TIMESTAMP,
+					version BIGINT NOT NULL DEFAULT 0,
+					package BLOB,
+					PRIMARY KEY (namespace, site_id),
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (namespace, template_id)
+						REFERENCES templates (namespace, template_id) DEFERRABLE INITIALLY DEFERRED
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS sites_metadata (
+					namespace TEXT NOT NULL,
+					site_id TEXT NOT NULL,
+					key TEXT NOT NULL,
+					value TEXT NOT NULL,
+					UNIQUE (namespace, site_id, key),
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (namespace, site_id)
+						REFERENCES sites (namespace, site_id) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS sites_metadata_index ON sites_metadata (key, value)`,
+
+			// Deployment IDs are unique across namespaces, but parents, templates, and sites
+			// must be in the same namespace
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS deployments (
+					deployment_id TEXT NOT NULL PRIMARY KEY,
+					namespace TEXT NOT NULL,
+					parent_deployment_id TEXT,
+					template_id TEXT,
+					site_id TEXT,
+					created TIMESTAMP,
+					updated TIMESTAMP,
+					version BIGINT NOT NULL DEFAULT 0,
+					prepared BOOLEAN,
+					approved BOOLEAN,
+					package BLOB,
+					modification_token TEXT,
+					modification_timestamp BIGINT,
+					UNIQUE (namespace, deployment_id),
+					CONSTRAINT fk_parent_deployment_id
+						FOREIGN KEY (namespace, parent_deployment_id)
+						REFERENCES deployments (namespace, deployment_id) ON DELETE CASCADE,
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (namespace, template_id)
+						REFERENCES templates (namespace, template_id) DEFERRABLE INITIALLY DEFERRED,
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (namespace, site_id)
+						REFERENCES sites (namespace, site_id) DEFERRABLE INITIALLY DEFERRED
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS deployments_metadata (
+					deployment_id TEXT NOT NULL,
+					key TEXT NOT NULL,
+					value TEXT NOT NULL,
+					UNIQUE (deployment_id, key),
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (deployment_id)
+						REFERENCES deployments (deployment_id) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS deployments_metadata_index ON deployments_metadata (key, value)`,
+			`CREATE INDEX IF NOT EXISTS deployments_prepared_index ON deployments (prepared)`,
+			`CREATE INDEX IF NOT EXISTS deployments_approved_index ON deployments (approved)`,
+			`CREATE INDEX IF NOT EXISTS deployments_modification_index ON deployments (modification_token)`,
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS templates_deployments (
+					namespace TEXT NOT NULL,
+					template_id TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					UNIQUE (deployment_id),
+					CONSTRAINT fk_template_id
+						FOREIGN KEY (namespace, template_id)
+						REFERENCES templates (namespace, template_id) ON DELETE CASCADE,
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (deployment_id)
+						REFERENCES deployments (deployment_id) ON DELETE CASCADE
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS sites_deployments (
+					namespace TEXT NOT NULL,
+					site_id TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					UNIQUE (deployment_id),
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (namespace, site_id)
+						REFERENCES sites (namespace, site_id) ON DELETE CASCADE,
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (deployment_id)
+						REFERENCES deployments (deployment_id) ON DELETE CASCADE
+				)
+			`),
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS sites_deleted_deployments (
+					namespace TEXT NOT NULL,
+					site_id TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					deleted TIMESTAMP,
+					package BLOB,
+					UNIQUE (deployment_id),
+					CONSTRAINT fk_site_id
+						FOREIGN KEY (namespace, site_id)
+						REFERENCES sites (namespace, site_id) ON DELETE CASCADE
+				)
+			`),
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS plugins (
+					type TEXT NOT NULL,
+					name TEXT NOT NULL,
+					executor TEXT NOT NULL,
+					arguments TEXT,
+					properties TEXT,
+					version BIGINT NOT NULL DEFAULT 0,
+					PRIMARY KEY (type, name)
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS plugins_type_index ON plugins (type)`,
+			`CREATE INDEX IF NOT EXISTS plugins_executor_index ON plugins (executor)`,
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS plugins_triggers (
+					plugin_type TEXT NOT NULL,
+					plugin_name TEXT NOT NULL,
+					"group" TEXT NOT NULL,
+					version TEXT NOT NULL,
+					kind TEXT NOT NULL,
+					UNIQUE (plugin_type, plugin_name, "group", version, kind),
+					CONSTRAINT fk_plugin_id
+						FOREIGN KEY (plugin_type, plugin_name)
+						REFERENCES plugins (type, name) ON DELETE CASCADE
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS plugins_triggers_index ON plugins_triggers ("group", version, kind)`,
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS revisions (
+					type TEXT NOT NULL,
+					namespace TEXT NOT NULL,
+					object_id TEXT NOT NULL,
+					revision BIGINT NOT NULL,
+					author TEXT NOT NULL,
+					created TIMESTAMP,
+					hash TEXT NOT NULL,
+					template_id TEXT,
+					metadata TEXT,
+					package BLOB,
+					PRIMARY KEY (type, namespace, object_id, revision)
+				)
+			`),
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS audit_events (
+					id INTEGER PRIMARY KEY AUTOINCREMENT,
+					timestamp TIMESTAMP,
+					actor TEXT NOT NULL,
+					operation TEXT NOT NULL,
+					type TEXT NOT NULL,
+					namespace TEXT NOT NULL,
+					ids TEXT,
+					selector TEXT,
+					outcome TEXT NOT NULL,
+					reason TEXT,
+					diff TEXT
+				)
+			`),
+			`CREATE INDEX IF NOT EXISTS audit_events_timestamp_index ON audit_events (timestamp)`,
+			`CREATE INDEX IF NOT EXISTS audit_events_actor_index ON audit_events (actor)`,
+
+			CleanSQL(`
+				CREATE TABLE IF NOT EXISTS events (
+					revision INTEGER PRIMARY KEY AUTOINCREMENT,
+					type TEXT NOT NULL,
+					kind TEXT NOT NULL,
+					namespace TEXT NOT NULL,
+					object_id TEXT NOT NULL,
+					timestamp TIMESTAMP
+				)
+			`),
+		},
+	},
+	{
+		// Packages stored before this migration are still read as they are, and are converted
+		// when they are next stored
+		Version:     2,
+		Description: "package blobs",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE blobs (
+					hash TEXT PRIMARY KEY,
+					content BLOB NOT NULL
+				)
+			`),
+		},
+	},
+	{
+		// Deployments marked for background deletion, so that the deletion can be resumed
+		// after a failure or restart
+		Version:     3,
+		Description: "deployment pending deletes",
+		Statements: []string{
+			CleanSQL(`
+				CREATE TABLE deployments_pending_deletes (
+					namespace TEXT NOT NULL,
+					deployment_id TEXT NOT NULL,
+					PRIMARY KEY (namespace, deployment_id),
+					CONSTRAINT fk_deployment_id
+						FOREIGN KEY (namespace, deployment_id)
+						REFERENCES deployments (namespace, deployment_id) ON DELETE CASCADE
+				)
+			`),
+		},
+	},
+	{
+		// Authors supplied by callers are no longer recorded as the actor when there is an
+		// authenticated identity
+		Version:     4,
+		Description: "audit event claimed authors",
+		Statements: []string{
+			`ALTER TABLE audit_events ADD COLUMN claimed_author TEXT`,
+		},
+	},
+}
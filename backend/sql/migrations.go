@@ -0,0 +1,167 @@
+package sql
+
+import (
+	contextpkg "context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+//
+// Migration
+//
+
+// Migrations are applied in order of version, each in its own transaction. Once released a
+// migration must never be changed; schema changes always require a new migration.
+type Migration struct {
+	Version     uint
+	Description string
+	Statements  []string
+}
+
+// The schema version that this binary expects.
+func (self *Statements) LatestSchemaVersion() uint {
+	if length := len(self.Migrations); length > 0 {
+		return self.Migrations[length-1].Version
+	}
+	return 0
+}
+
+// Returns the current schema version. 0 means that no migrations have been applied.
+func (self *Statements) SchemaVersion(context contextpkg.Context) (uint, error) {
+	if _, err := self.db.ExecContext(context, self.CreateSchemaMigrations); err != nil {
+		return 0, err
+	}
+
+	return self.selectSchemaVersion(context, self.db)
+}
+
+// Applies the pending migrations and returns the schema version before they were applied
+// as well as the applied migrations. With dryRun all the pending migrations are applied in
+// a single transaction that is then rolled back, so nothing is changed.
+//
+// Fails if the database schema is newer than [Statements.LatestSchemaVersion].
+func (self *Statements) Migrate(context contextpkg.Context, dryRun bool) (uint, []Migration, error) {
+	for index, migration := range self.Migrations {
+		if migration.Version != uint(index+1) {
+			return 0, nil, fmt.Errorf("schema migration out of order: %d", migration.Version)
+		}
+	}
+
+	if dryRun {
+		tx, err := self.db.BeginTx(context, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		defer self.rollback(tx)
+
+		return self.migrate(context, tx, self.Migrations)
+	}
+
+	// Each migration is committed separately, so that a failed migration does not roll back
+	// those before it
+	var version uint
+	var applied []Migration
+	for index, migration := range self.Migrations {
+		if version_, applied_, err := self.migrateOne(context, migration); err == nil {
+			if index == 0 {
+				version = version_
+			}
+			applied = append(applied, applied_...)
+		} else {
+			return version, applied, err
+		}
+	}
+
+	return version, applied, nil
+}
+
+func (self *Statements) migrateOne(context contextpkg.Context, migration Migration) (uint, []Migration, error) {
+	tx, err := self.db.BeginTx(context, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if version, applied, err := self.migrate(context, tx, []Migration{migration}); err == nil {
+		if len(applied) > 0 {
+			if err := tx.Commit(); err != nil {
+				return 0, nil, err
+			}
+		} else {
+			self.rollback(tx)
+		}
+		return version, applied, nil
+	} else {
+		self.rollback(tx)
+		return 0, nil, err
+	}
+}
+
+// Applies those migrations that are newer than the current schema version.
+func (self *Statements) migrate(context contextpkg.Context, tx *sql.Tx, migrations []Migration) (uint, []Migration, error) {
+	if _, err := tx.ExecContext(context, self.CreateSchemaMigrations); err != nil {
+		return 0, nil, err
+	}
+
+	// Lock until commit so that concurrent instances do not apply the same migration
+	if self.LockSchemaMigrations != "" {
+		if _, err := tx.ExecContext(context, self.LockSchemaMigrations); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	version, err := self.selectSchemaVersion(context, tx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if latestVersion := self.LatestSchemaVersion(); version > latestVersion {
+		return version, nil, fmt.Errorf("database schema version %d is newer than supported version %d", version, latestVersion)
+	}
+
+	var applied []Migration
+	for _, migration := range migrations {
+		if migration.Version <= version {
+			continue
+		}
+
+		self.log.Noticef("applying schema migration %d: %s", migration.Version, migration.Description)
+		for _, statement := range migration.Statements {
+			self.log.Debugf("executing SQL:\n%s", statement)
+			if _, err := tx.ExecContext(context, statement); err != nil {
+				return version, nil, fmt.Errorf("schema migration %d: %w", migration.Version, err)
+			}
+		}
+
+		if _, err := tx.ExecContext(context, self.InsertSchemaMigration, migration.Version, migration.Description, time.Now().UTC()); err != nil {
+			return version, nil, err
+		}
+
+		applied = append(applied, migration)
+	}
+
+	return version, applied, nil
+}
+
+func (self *Statements) selectSchemaVersion(context contextpkg.Context, querier querier) (uint, error) {
+	rows, err := querier.QueryContext(context, self.SelectSchemaVersion)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var version uint
+	if rows.Next() {
+		if err := rows.Scan(&version); err != nil {
+			return 0, err
+		}
+	}
+
+	return version, rows.Err()
+}
+
+func (self *Statements) rollback(tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil {
+		self.log.Error("tx.Rollback: " + err.Error())
+	}
+}
@@ -21,13 +21,34 @@ func (self *SQLBackend) SetPlugin(context contextpkg.Context, plugin *backend.Pl
 	}
 
 	if tx, err := self.db.BeginTx(context, nil); err == nil {
-		upsertPlugin := tx.StmtContext(context, self.statements.PreparedUpsertPlugin)
-		if _, err := upsertPlugin.ExecContext(context, plugin.Type, plugin.Name, plugin.Executor, argumentsJson, propertiesJson); err == nil {
+		eventType := backend.EventTypeAdded
+		if version, exists, err := self.selectVersion(context, tx, self.statements.PreparedSelectPluginVersion, plugin.Type, plugin.Name); err == nil {
+			if exists {
+				eventType = backend.EventTypeUpdated
+			}
+
+			if err := backend.CheckVersion(backend.EventKindPlugin, plugin.PluginID.String(), plugin.Version, version); err != nil {
+				self.rollback(tx)
+				return err
+			}
+		} else {
+			self.rollback(tx)
+			return err
+		}
+
+		if version, err := self.upsertWithVersion(context, tx, self.statements.PreparedUpsertPlugin, backend.EventKindPlugin, plugin.PluginID.String(), plugin.Type, plugin.Name, plugin.Executor, argumentsJson, propertiesJson, plugin.Version); err == nil {
+			plugin.Version = version
+
 			if err := self.updatePluginTriggers(context, tx, plugin); err != nil {
 				self.rollback(tx)
 				return err
 			}
 
+			if err := self.insertEvents(context, tx, backend.NewEvent(eventType, backend.EventKindPlugin, "", plugin.PluginID.String())); err != nil {
+				self.rollback(tx)
+				return err
+			}
+
 			return tx.Commit()
 		} else {
 			self.rollback(tx)
@@ -48,9 +69,10 @@ func (self *SQLBackend) GetPlugin(context contextpkg.Context, pluginId backend.P
 
 	if rows.Next() {
 		var executor string
+		var version uint64
 		var argumentsJson, propertiesJson, triggersJson []byte
-		if err := rows.Scan(&executor, &argumentsJson, &propertiesJson, &triggersJson); err == nil {
-			if plugin, err := self.newPlugin(pluginId, executor, argumentsJson, propertiesJson, triggersJson); err == nil {
+		if err := rows.Scan(&executor, &argumentsJson, &propertiesJson, &version, &triggersJson); err == nil {
+			if plugin, err := self.newPlugin(pluginId, executor, argumentsJson, propertiesJson, version, triggersJson); err == nil {
 				return &plugin, nil
 			} else {
 				return nil, err
@@ -66,18 +88,7 @@ func (self *SQLBackend) GetPlugin(context contextpkg.Context, pluginId backend.P
 // ([backend.Backend] interface)
 func (self *SQLBackend) DeletePlugin(context contextpkg.Context, pluginId backend.PluginID) error {
 	// Will cascade delete plugins_triggers
-	if result, err := self.statements.PreparedDeletePlugin.ExecContext(context, pluginId.Type, pluginId.Name); err == nil {
-		if count, err := result.RowsAffected(); err == nil {
-			if count == 0 {
-				return backend.NewNotFoundErrorf("plugin: %s", pluginId)
-			}
-			return nil
-		} else {
-			return err
-		}
-	} else {
-		return err
-	}
+	return self.deleteWithEvent(context, self.statements.PreparedDeletePlugin, nil, backend.EventKindPlugin, "", pluginId.String(), pluginId.Type, pluginId.Name)
 }
 
 // ([backend.Backend] interface)
@@ -97,7 +108,7 @@ func (self *SQLBackend) ListPlugins(context contextpkg.Context, selectPlugins ba
 
 	for _, pattern := range selectPlugins.NamePatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`plugins.name ~ ` + pattern)
+		where.Add(self.regexp(`plugins.name`, pattern))
 	}
 
 	if (selectPlugins.Executor != nil) && (*selectPlugins.Executor != "") {
@@ -129,9 +140,10 @@ func (self *SQLBackend) ListPlugins(context contextpkg.Context, selectPlugins ba
 	go func() {
 		for rows.Next() {
 			var type_, name, executor string
+			var version uint64
 			var argumentsJson, propertiesJson, triggersJson []byte
-			if err := rows.Scan(&type_, &name, &executor, &argumentsJson, &propertiesJson, &triggersJson); err == nil {
-				if plugin, err := self.newPlugin(backend.PluginID{Type: type_, Name: name}, executor, argumentsJson, propertiesJson, triggersJson); err == nil {
+			if err := rows.Scan(&type_, &name, &executor, &argumentsJson, &propertiesJson, &version, &triggersJson); err == nil {
+				if plugin, err := self.newPlugin(backend.PluginID{Type: type_, Name: name}, executor, argumentsJson, propertiesJson, version, triggersJson); err == nil {
 					stream.Send(plugin)
 				} else {
 					stream.Close(err)
@@ -162,7 +174,7 @@ func (self *SQLBackend) PurgePlugins(context contextpkg.Context, selectPlugins b
 
 	for _, pattern := range selectPlugins.NamePatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`plugins.name ~ ` + pattern)
+		where.Add(self.regexp(`plugins.name`, pattern))
 	}
 
 	if (selectPlugins.Executor != nil) && (*selectPlugins.Executor != "") {
@@ -177,24 +189,25 @@ func (self *SQLBackend) PurgePlugins(context contextpkg.Context, selectPlugins b
 		version := args.Add(selectPlugins.Trigger.Version)
 		kind := args.Add(selectPlugins.Trigger.Kind)
 		where.Add(`"group" = ` + group)
-		where.Add(`version = ` + version)
+		where.Add(`plugins_triggers.version = ` + version)
 		where.Add(`kind = ` + kind)
 	}
 
 	sql = where.Apply(sql)
+	sql += "\nRETURNING plugins.type, plugins.name"
 	self.log.Debugf("generated SQL:\n%s", sql)
 
-	_, err := self.db.ExecContext(context, sql, args.Args...)
-	return err
+	return self.purge(context, sql, args.Args, packageSelection{}, backend.EventKindPlugin, "", scanPluginID)
 }
 
 // Utils
 
-func (self *SQLBackend) newPlugin(pluginId backend.PluginID, executor string, argumentsJson []byte, propertiesJson []byte, triggersJson []byte) (backend.Plugin, error) {
+func (self *SQLBackend) newPlugin(pluginId backend.PluginID, executor string, argumentsJson []byte, propertiesJson []byte, version uint64, triggersJson []byte) (backend.Plugin, error) {
 	plugin := backend.Plugin{
 		PluginID:   pluginId,
 		Executor:   executor,
 		Properties: make(map[string]string),
+		Version:    version,
 	}
 
 	if err := jsonUnmarshallStringArray(argumentsJson, &plugin.Arguments); err != nil {
@@ -0,0 +1,143 @@
+package sql
+
+import (
+	contextpkg "context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	rows, err := self.statements.PreparedSelectRevisions.QueryContext(context, type_, namespace, objectId, window.Offset, window.Limit())
+	if err != nil {
+		return nil, err
+	}
+
+	stream := util.NewResultsStream[backend.RevisionInfo](func() {
+		self.closeRows(rows)
+	})
+
+	go func() {
+		for rows.Next() {
+			var revision int64
+			var author, hash string
+			var created time.Time
+			var templateId *string
+			var metadataJson []byte
+			if err := rows.Scan(&revision, &author, &created, &hash, &templateId, &metadataJson); err == nil {
+				if revisionInfo, err := self.newRevisionInfo(backend.NewRevisionID(type_, namespace, objectId, uint64(revision)), author, created, hash, templateId, metadataJson); err == nil {
+					stream.Send(revisionInfo)
+				} else {
+					stream.Close(err)
+					return
+				}
+			} else {
+				stream.Close(err)
+				return
+			}
+		}
+
+		stream.Close(nil)
+	}()
+
+	return stream, nil
+}
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	rows, err := self.statements.PreparedSelectRevision.QueryContext(context, revisionId.Type, revisionId.Namespace, revisionId.ObjectID, int64(revisionId.Revision))
+	if err != nil {
+		return nil, err
+	}
+	defer self.closeRows(rows)
+
+	if rows.Next() {
+		var author, hash string
+		var created time.Time
+		var templateId *string
+		var metadataJson, package_ []byte
+		if err := rows.Scan(&author, &created, &hash, &templateId, &metadataJson, &package_); err == nil {
+			self.closeRows(rows)
+			if revisionInfo, err := self.newRevisionInfo(revisionId, author, created, hash, templateId, metadataJson); err == nil {
+				revision := backend.Revision{RevisionInfo: revisionInfo}
+				if revision.Package, err = self.decodePackage(context, self.db, package_); err == nil {
+					return &revision, nil
+				} else {
+					return nil, err
+				}
+			} else {
+				return nil, err
+			}
+		} else {
+			return nil, err
+		}
+	}
+
+	return nil, backend.NewNotFoundErrorf("revision: %s", revisionId.String())
+}
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	if revision, err := self.GetRevision(context, revisionId); err == nil {
+		return backend.RevertToRevision(context, self, revision)
+	} else {
+		return err
+	}
+}
+
+// Utils
+
+func (self *SQLBackend) newRevisionInfo(revisionId backend.RevisionID, author string, created time.Time, hash string, templateId *string, metadataJson []byte) (backend.RevisionInfo, error) {
+	revisionInfo := backend.RevisionInfo{
+		RevisionID: revisionId,
+		Author:     author,
+		Created:    created,
+		Hash:       hash,
+		Metadata:   make(map[string]string),
+	}
+
+	if templateId != nil {
+		revisionInfo.TemplateID = *templateId
+	}
+
+	if len(metadataJson) > 0 {
+		if err := jsonUnmarshallStringMap(metadataJson, revisionInfo.Metadata); err != nil {
+			return backend.RevisionInfo{}, err
+		}
+	}
+
+	return revisionInfo, nil
+}
+
+func (self *SQLBackend) insertRevision(context contextpkg.Context, tx *sql.Tx, revision *backend.Revision) error {
+	var metadataJson, package_ []byte
+	var err error
+	if metadataJson, err = json.Marshal(revision.Metadata); err != nil {
+		return err
+	}
+	if package_, err = self.encodePackage(context, tx, revision.Package); err != nil {
+		return err
+	}
+
+	insertRevision := tx.StmtContext(context, self.statements.PreparedInsertRevision)
+	rows, err := insertRevision.QueryContext(context, revision.Type, revision.Namespace, revision.ObjectID, revision.Author, revision.Created, revision.Hash, nilIfEmptyString(revision.TemplateID), metadataJson, package_)
+	if err != nil {
+		return err
+	}
+	defer self.closeRows(rows)
+
+	if rows.Next() {
+		var revision_ int64
+		if err := rows.Scan(&revision_); err == nil {
+			revision.Revision = uint64(revision_)
+		} else {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,66 @@
+package sql
+
+import (
+	contextpkg "context"
+	"path/filepath"
+	"testing"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/util"
+	"github.com/tliron/commonlog"
+)
+
+func TestRevertDeployment(t *testing.T) {
+	context := contextpkg.Background()
+
+	sqlBackend := NewSQLBackend("sqlite", SQLiteDataSource(filepath.Join(t.TempDir(), "tko.db")), "cbor", 10, commonlog.GetLogger("test"))
+	sqlBackend.AutoMigrate = true
+	if err := sqlBackend.Connect(context); err != nil {
+		t.Fatal(err)
+	}
+	defer sqlBackend.Release(context)
+
+	deployment := backend.Deployment{DeploymentInfo: backend.DeploymentInfo{
+		Namespace: "namespace",
+		Metadata:  map[string]string{"key": "original"},
+	}}
+	if err := sqlBackend.CreateDeployment(context, &deployment); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sqlBackend.ModifyDeployments(context, backend.SelectDeployments{Namespace: "namespace"}, backend.ModifyDeployments{
+		SetMetadata: map[string]string{"key": "modified"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	revisionId := backend.NewRevisionID(backend.RevisionTypeDeployment, "namespace", deployment.DeploymentID, 1)
+
+	// Metadata must be reverted with the package
+	if err := sqlBackend.RevertTo(context, revisionId); err != nil {
+		t.Fatal(err)
+	}
+	assertDeploymentMetadata(t, sqlBackend, deployment.DeploymentID, "original")
+
+	// Deleted deployments must be recreated
+	if err := sqlBackend.DeleteDeployment(context, "namespace", deployment.DeploymentID, backend.PropagationOrphan); err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlBackend.RevertTo(context, revisionId); err != nil {
+		t.Fatal(err)
+	}
+	assertDeploymentMetadata(t, sqlBackend, deployment.DeploymentID, "original")
+}
+
+func assertDeploymentMetadata(t *testing.T, sqlBackend *SQLBackend, deploymentId string, value string) {
+	if deployment, err := sqlBackend.GetDeployment(contextpkg.Background(), "namespace", deploymentId); err == nil {
+		if deployment.Metadata["key"] != value {
+			t.Errorf("metadata %v, expected key=%s", deployment.Metadata, value)
+		}
+		if _, ok := util.DeploymentResourceIdentifier.GetResource(deployment.Package); !ok {
+			t.Errorf("package %v, expected a Deployment resource", deployment.Package)
+		}
+	} else {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,226 @@
+package sql
+
+import (
+	contextpkg "context"
+	"database/sql"
+	"strings"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/backend/selector"
+)
+
+// Packages are selected in batches of this size, see [packageSelection].
+var PackageSelectionBatchSize = 1000
+
+// [sql.DB], [sql.Conn], and [sql.Tx].
+type querier interface {
+	QueryContext(context contextpkg.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Generates SQL for a metadata selector expression. The metadata table (aliased as "m") is
+// correlated to the selected table by the ID columns.
+func (self *SQLBackend) metadataSelectorSql(expression selector.Expression, args *SqlArgs, metadataTable string, table string, ids ...string) string {
+	exists := func(key selector.Key, condition string) string {
+		ons := make([]string, len(ids))
+		for index, id := range ids {
+			ons[index] = "(m." + id + " = " + table + "." + id + ")"
+		}
+
+		sql := "EXISTS (SELECT 1 FROM " + metadataTable + " AS m WHERE " + strings.Join(ons, " AND ") + " AND (m.key = " + args.Add(key.Name()) + ")"
+		if condition != "" {
+			sql += " AND (" + condition + ")"
+		}
+		return sql + ")"
+	}
+
+	switch expression_ := expression.(type) {
+	case selector.And:
+		clauses := make([]string, len(expression_))
+		for index, expression__ := range expression_ {
+			clauses[index] = "(" + self.metadataSelectorSql(expression__, args, metadataTable, table, ids...) + ")"
+		}
+		return strings.Join(clauses, " AND ")
+
+	case selector.Or:
+		clauses := make([]string, len(expression_))
+		for index, expression__ := range expression_ {
+			clauses[index] = "(" + self.metadataSelectorSql(expression__, args, metadataTable, table, ids...) + ")"
+		}
+		return strings.Join(clauses, " OR ")
+
+	case selector.Not:
+		return "NOT (" + self.metadataSelectorSql(expression_.Expression, args, metadataTable, table, ids...) + ")"
+
+	case selector.Exists:
+		return exists(expression_.Key, "")
+
+	case selector.Compare:
+		switch expression_.Operator {
+		case "=":
+			return exists(expression_.Key, "m.value = "+args.Add(expression_.Value))
+
+		case "!=":
+			// Also matches if the key does not exist
+			return "NOT " + exists(expression_.Key, "m.value = "+args.Add(expression_.Value))
+
+		default:
+			// The cast is guarded so that non-numeric values are not compared
+			numberRe := args.Add(selector.NumberRE.String())
+			return exists(expression_.Key, "(CASE WHEN "+self.regexp(`m.value`, numberRe)+" THEN CAST(m.value AS DOUBLE PRECISION) END) "+expression_.Operator+" "+args.Add(expression_.Number))
+		}
+
+	case selector.In:
+		values := make([]string, len(expression_.Values))
+		for index, value := range expression_.Values {
+			values[index] = args.Add(value)
+		}
+
+		sql := exists(expression_.Key, "m.value IN ("+strings.Join(values, ", ")+")")
+		if expression_.Not {
+			// Also matches if the key does not exist
+			sql = "NOT " + sql
+		}
+		return sql
+	}
+
+	return "1 = 0"
+}
+
+//
+// packageSelection
+//
+
+// Packages are stored encoded and so cannot be queried in SQL. Instead, we decode and
+// evaluate them here in batches, and insert the IDs of the matching packages into a
+// temporary table that the query can refer to via [selectedPackagesSql].
+//
+// Temporary tables belong to a connection, so the query must be executed on the same
+// connection (or transaction) on which the packages were selected.
+type packageSelection struct {
+	Statement  string              // must select the namespace, the ID, and the package, in that order
+	IDColumn   string              // for ordering the batches
+	Namespace  string              // can be AllNamespaces
+	Expression selector.Expression // nil to select nothing
+}
+
+// Both [sql.Conn] and [sql.Tx], which are bound to a single connection.
+type connection interface {
+	querier
+	ExecContext(context contextpkg.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Does nothing if the selection has no expression.
+func (self *SQLBackend) selectPackages(context contextpkg.Context, connection connection, selection packageSelection) error {
+	if selection.Expression == nil {
+		return nil
+	}
+
+	if _, err := connection.ExecContext(context, self.statements.CreateSelectedPackages); err != nil {
+		return err
+	}
+	if _, err := connection.ExecContext(context, self.statements.ClearSelectedPackages); err != nil {
+		return err
+	}
+
+	// Packages are decoded after the rows are closed, because decoding queries blobs
+	type encodedPackage struct {
+		namespace string
+		id        string
+		package_  []byte
+	}
+
+	var last *encodedPackage
+	for {
+		var args SqlArgs
+		var where SqlWhere
+
+		if selection.Namespace != backend.AllNamespaces {
+			where.Add(`namespace = ` + args.Add(selection.Namespace))
+		}
+
+		// Keyset pagination
+		if last != nil {
+			namespace := args.Add(last.namespace)
+			where.Add(`(namespace > ` + namespace + `) OR ((namespace = ` + namespace + `) AND (` + selection.IDColumn + ` > ` + args.Add(last.id) + `))`)
+		}
+
+		sql := where.Apply(selection.Statement)
+		sql += "\nORDER BY namespace, " + selection.IDColumn + "\nLIMIT " + args.Add(PackageSelectionBatchSize)
+		self.log.Debugf("generated SQL:\n%s", sql)
+
+		rows, err := connection.QueryContext(context, sql, args.Args...)
+		if err != nil {
+			return err
+		}
+
+		var encodedPackages []encodedPackage
+		for rows.Next() {
+			var encodedPackage_ encodedPackage
+			if err := rows.Scan(&encodedPackage_.namespace, &encodedPackage_.id, &encodedPackage_.package_); err == nil {
+				encodedPackages = append(encodedPackages, encodedPackage_)
+			} else {
+				self.closeRows(rows)
+				return err
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			self.closeRows(rows)
+			return err
+		}
+		self.closeRows(rows)
+
+		for _, encodedPackage_ := range encodedPackages {
+			if package_, err := self.decodePackage(context, connection, encodedPackage_.package_); err == nil {
+				if selector.PackageMatches(selection.Expression, package_) {
+					if _, err := connection.ExecContext(context, self.statements.InsertSelectedPackage, encodedPackage_.namespace, encodedPackage_.id); err != nil {
+						return err
+					}
+				}
+			} else {
+				return err
+			}
+		}
+
+		if len(encodedPackages) < PackageSelectionBatchSize {
+			return nil
+		}
+
+		last = &encodedPackages[len(encodedPackages)-1]
+	}
+}
+
+// Selects the packages on a dedicated connection if the selection has an expression,
+// otherwise returns the pool. The returned function must be called when done with it.
+func (self *SQLBackend) selectPackagesQuerier(context contextpkg.Context, selection packageSelection) (querier, func(), error) {
+	if selection.Expression == nil {
+		return self.db, func() {}, nil
+	}
+
+	conn, err := self.db.Conn(context)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := func() {
+		// The temporary table outlives the connection's return to the pool, so we empty it
+		if _, err := conn.ExecContext(contextpkg.WithoutCancel(context), self.statements.ClearSelectedPackages); err != nil {
+			self.log.Error("clear selected packages: " + err.Error())
+		}
+		if err := conn.Close(); err != nil {
+			self.log.Error("conn.Close: " + err.Error())
+		}
+	}
+
+	if err := self.selectPackages(context, conn, selection); err == nil {
+		return conn, release, nil
+	} else {
+		release()
+		return nil, nil, err
+	}
+}
+
+// Generates SQL for matching the packages selected by [SQLBackend.selectPackages].
+func selectedPackagesSql(namespaceColumn string, idColumn string) string {
+	return "EXISTS (SELECT 1 FROM selected_packages WHERE (selected_packages.namespace = " + namespaceColumn + ") AND (selected_packages.id = " + idColumn + "))"
+}
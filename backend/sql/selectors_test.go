@@ -0,0 +1,209 @@
+package sql
+
+import (
+	contextpkg "context"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/backend/memory"
+	"github.com/nephio-experimental/tko/util"
+	"github.com/tliron/commonlog"
+	kutil "github.com/tliron/kutil/util"
+)
+
+var testSelectors = []string{
+	``,
+	`tier`,
+	`!tier`,
+	`tier = gold`,
+	`tier != gold`,
+	`tier in (gold, silver)`,
+	`tier notin (gold, silver)`,
+	`cpus > 8`,
+	`cpus >= 16`,
+	`cpus < 16`,
+	`cpus <= 1.5`,
+	`cpus > -1`,
+	`cloud.region = eu`,
+	`"cloud.region" in (eu, us)`,
+	`tier = gold || cpus < 4`,
+	`tier = gold && cpus < 4`,
+	`!(tier = gold || cloud.region = us)`,
+	`(tier || cpus) && !cloud.region`,
+}
+
+var testPackageSelectors = []string{
+	``,
+	`kind = Cluster`,
+	`kind = Cluster && spec.region in (eu, us)`,
+	`spec.cpus >= 16`,
+	`spec.zones.1 = b`,
+	`!spec.region`,
+	`kind != Cluster`,
+	`spec.region notin (eu)`,
+}
+
+func TestSelectorParity(t *testing.T) {
+	context := contextpkg.Background()
+
+	sqlBackend := NewSQLBackend("sqlite", SQLiteDataSource(filepath.Join(t.TempDir(), "tko.db")), "cbor", 10, commonlog.GetLogger("test"))
+	sqlBackend.AutoMigrate = true
+	if err := sqlBackend.Connect(context); err != nil {
+		t.Fatal(err)
+	}
+	defer sqlBackend.Release(context)
+
+	// Exercise pagination of package selection
+	defer func(batchSize int) { PackageSelectionBatchSize = batchSize }(PackageSelectionBatchSize)
+	PackageSelectionBatchSize = 3
+
+	memoryBackend := memory.NewMemoryBackend(10, commonlog.GetLogger("test"))
+
+	templates := testTemplates()
+	for _, template := range templates {
+		for _, backend_ := range []backend.Backend{sqlBackend, memoryBackend} {
+			template_ := template.Clone(false)
+			if err := backend_.SetTemplate(context, template_); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for _, metadataSelector := range testSelectors {
+		for _, packageSelector := range testPackageSelectors {
+			selectors, err := backend.ParseSelectors(metadataSelector, packageSelector)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var expected []string
+			for _, template := range templates {
+				if selectors.Matches(template.Metadata, template.Package) {
+					expected = append(expected, template.TemplateID)
+				}
+			}
+
+			selectTemplates := backend.SelectTemplates{
+				Namespace:        backend.AllNamespaces,
+				MetadataSelector: metadataSelector,
+				PackageSelector:  packageSelector,
+			}
+
+			for _, backend_ := range []backend.Backend{sqlBackend, memoryBackend} {
+				if templateInfos, err := backend_.ListTemplates(context, selectTemplates, backend.Window{MaxCount: -1}); err == nil {
+					if templateInfos_, err := kutil.GatherResults(templateInfos); err == nil {
+						var ids []string
+						for _, templateInfo := range templateInfos_ {
+							ids = append(ids, templateInfo.TemplateID)
+						}
+						slices.Sort(ids)
+
+						if !slices.Equal(ids, expected) {
+							t.Errorf("%s: metadata selector %q, package selector %q: %v, expected %v", backend_, metadataSelector, packageSelector, ids, expected)
+						}
+					} else {
+						t.Fatal(err)
+					}
+				} else {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+func TestPurgeWithPackageSelector(t *testing.T) {
+	context := contextpkg.Background()
+
+	sqlBackend := NewSQLBackend("sqlite", SQLiteDataSource(filepath.Join(t.TempDir(), "tko.db")), "cbor", 10, commonlog.GetLogger("test"))
+	sqlBackend.AutoMigrate = true
+	if err := sqlBackend.Connect(context); err != nil {
+		t.Fatal(err)
+	}
+	defer sqlBackend.Release(context)
+
+	templates := testTemplates()
+	for _, template := range templates {
+		if err := sqlBackend.SetTemplate(context, template.Clone(false)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const packageSelector = `kind = Cluster && spec.region in (eu, us)`
+	selectors, err := backend.ParseSelectors(``, packageSelector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected []string
+	for _, template := range templates {
+		if (template.Namespace != "namespace0") || !selectors.Matches(template.Metadata, template.Package) {
+			expected = append(expected, template.TemplateID)
+		}
+	}
+
+	if err := sqlBackend.PurgeTemplates(context, backend.SelectTemplates{
+		Namespace:       "namespace0",
+		PackageSelector: packageSelector,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if templateInfos, err := sqlBackend.ListTemplates(context, backend.SelectTemplates{Namespace: backend.AllNamespaces}, backend.Window{MaxCount: -1}); err == nil {
+		if templateInfos_, err := kutil.GatherResults(templateInfos); err == nil {
+			var ids []string
+			for _, templateInfo := range templateInfos_ {
+				ids = append(ids, templateInfo.TemplateID)
+			}
+			slices.Sort(ids)
+
+			if !slices.Equal(ids, expected) {
+				t.Errorf("%v, expected %v", ids, expected)
+			}
+		} else {
+			t.Fatal(err)
+		}
+	} else {
+		t.Fatal(err)
+	}
+}
+
+// Sorted by template ID.
+func testTemplates() []*backend.Template {
+	metadatas := []map[string]string{
+		{},
+		{"tier": "gold"},
+		{"tier": "silver", "cpus": "16"},
+		{"tier": "bronze", "cpus": "8", "cloud.region": "eu"},
+		{"cpus": "1.5", "cloud.region": "us"},
+		{"cpus": "-3"},
+		{"cpus": "many", "tier": ""},
+		{"tier": "gold", "cpus": "2", "cloud.region": "eu"},
+	}
+
+	packages := []util.Package{
+		nil,
+		{{"kind": "Cluster", "spec": util.Resource{"region": "eu", "cpus": 16}}},
+		{{"kind": "Cluster", "spec": util.Resource{"region": "ap"}}},
+		{{"kind": "Network"}, {"kind": "Cluster", "spec": util.Resource{"region": "us", "cpus": 4}}},
+		{{"kind": "Network", "spec": util.Resource{"zones": []any{"a", "b"}}}},
+	}
+
+	var templates []*backend.Template
+	for index, metadata := range metadatas {
+		for index_, package_ := range packages {
+			templates = append(templates, &backend.Template{
+				TemplateInfo: backend.TemplateInfo{
+					Namespace:  "namespace" + strconv.Itoa(index%2),
+					TemplateID: "template" + strconv.Itoa(index) + strconv.Itoa(index_),
+					Metadata:   metadata,
+				},
+				Package: package_,
+			})
+		}
+	}
+	return templates
+}
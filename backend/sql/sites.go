@@ -11,40 +11,18 @@ import (
 
 // ([backend.Backend] interface)
 func (self *SQLBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
-	if tx, err := self.db.BeginTx(context, nil); err == nil {
-		if err := self.mergeSiteTemplate(context, tx, site); err != nil {
-			self.rollback(tx)
-			return err
-		}
-
-		var package_ []byte
-		var err error
-		if package_, err = self.encodePackage(site.Package); err != nil {
-			self.rollback(tx)
-			return err
-		}
-
-		site.Updated = time.Now().UTC()
-		upsertSite := tx.StmtContext(context, self.statements.PreparedUpsertSite)
-		if _, err := upsertSite.ExecContext(context, site.SiteID, nilIfEmptyString(site.TemplateID), site.Updated, package_); err == nil {
-			if err := self.updateSiteMetadata(context, tx, site); err != nil {
-				self.rollback(tx)
-				return err
-			}
+	return self.setSite(context, site, false)
+}
 
-			return tx.Commit()
-		} else {
-			self.rollback(tx)
-			return err
-		}
-	} else {
-		return err
-	}
+// ([backend.Backend] interface)
+func (self *SQLBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	site.Version = 0
+	return self.setSite(context, site, true)
 }
 
 // ([backend.Backend] interface)
-func (self *SQLBackend) GetSite(context contextpkg.Context, siteId string) (*backend.Site, error) {
-	rows, err := self.statements.PreparedSelectSite.QueryContext(context, siteId)
+func (self *SQLBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
+	rows, err := self.statements.PreparedSelectSite.QueryContext(context, namespace, siteId)
 	if err != nil {
 		return nil, err
 	}
@@ -53,32 +31,23 @@ func (self *SQLBackend) GetSite(context contextpkg.Context, siteId string) (*bac
 	if rows.Next() {
 		var templateId *string
 		var updated time.Time
+		var version uint64
 		var package_, metadataJson, deploymentIdsJson []byte
-		if err := rows.Scan(&templateId, &updated, &package_, &metadataJson, &deploymentIdsJson); err == nil {
-			return self.newSite(siteId, templateId, updated, metadataJson, deploymentIdsJson, package_)
+		if err := rows.Scan(&templateId, &updated, &version, &package_, &metadataJson, &deploymentIdsJson); err == nil {
+			self.closeRows(rows)
+			return self.newSite(context, self.db, namespace, siteId, templateId, updated, version, metadataJson, deploymentIdsJson, package_)
 		} else {
 			return nil, err
 		}
 	}
 
-	return nil, backend.NewNotFoundErrorf("site: %s", siteId)
+	return nil, backend.NewNotFoundErrorf("site: %s/%s", namespace, siteId)
 }
 
 // ([backend.Backend] interface)
-func (self *SQLBackend) DeleteSite(context contextpkg.Context, siteId string) error {
-	// Will cascade delete sites_metadata, templates_deployments, sites_deployments
-	if result, err := self.statements.PreparedDeleteSite.ExecContext(context, siteId); err == nil {
-		if count, err := result.RowsAffected(); err == nil {
-			if count == 0 {
-				return backend.NewNotFoundErrorf("site: %s", siteId)
-			}
-			return nil
-		} else {
-			return err
-		}
-	} else {
-		return err
-	}
+func (self *SQLBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
+	// Will cascade delete sites_metadata, sites_deployments, sites_deleted_deployments
+	return self.deleteWithEvent(context, self.statements.PreparedDeleteSite, self.siteDisassociateStmts(), backend.EventKindSite, namespace, siteId, namespace, siteId)
 }
 
 // ([backend.Backend] interface)
@@ -87,50 +56,81 @@ func (self *SQLBackend) ListSites(context contextpkg.Context, selectSites backen
 	var args SqlArgs
 	var with SqlWith
 	var where SqlWhere
+	var selection packageSelection
 
 	args.AddValue(window.Offset)
 	args.AddValue(window.Limit())
 
+	if selectSites.Namespace != backend.AllNamespaces {
+		where.Add(`sites.namespace = ` + args.Add(selectSites.Namespace))
+	}
+
 	for _, pattern := range selectSites.SiteIDPatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`sites.site_id ~ ` + pattern)
+		where.Add(self.regexp(`sites.site_id`, pattern))
 	}
 
 	for _, pattern := range selectSites.TemplateIDPatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`template_id ~ ` + pattern)
+		where.Add(self.regexp(`template_id`, pattern))
 	}
 
 	if len(selectSites.MetadataPatterns) > 0 {
 		for key, pattern := range selectSites.MetadataPatterns {
 			key = args.Add(key)
 			pattern = args.Add(backend.PatternRE(pattern))
-			with.Add(`SELECT site_id FROM sites_metadata WHERE (key = `+key+`) AND (value ~ `+pattern+`)`,
-				`sites`, `site_id`)
+			with.Add(`SELECT namespace, site_id FROM sites_metadata WHERE (key = `+key+`) AND (`+self.regexp(`value`, pattern)+`)`,
+				`sites`, `namespace`, `site_id`)
 		}
 	}
 
+	if selectors, err := backend.ParseSelectors(selectSites.MetadataSelector, selectSites.PackageSelector); err == nil {
+		if selectors.Metadata != nil {
+			where.Add(self.metadataSelectorSql(selectors.Metadata, &args, `sites_metadata`, `sites`, `namespace`, `site_id`))
+		}
+
+		if selectors.Package != nil {
+			selection = packageSelection{
+				Statement:  self.statements.SelectSitePackages,
+				IDColumn:   `site_id`,
+				Namespace:  selectSites.Namespace,
+				Expression: selectors.Package,
+			}
+			where.Add(selectedPackagesSql(`sites.namespace`, `sites.site_id`))
+		}
+	} else {
+		return nil, err
+	}
+
 	sql = with.Apply(sql)
 	sql = where.Apply(sql)
 	self.log.Debugf("generated SQL:\n%s", sql)
 
-	rows, err := self.db.QueryContext(context, sql, args.Args...)
+	querier, release, err := self.selectPackagesQuerier(context, selection)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := querier.QueryContext(context, sql, args.Args...)
 	if err != nil {
+		release()
 		return nil, err
 	}
 
 	stream := util.NewResultsStream[backend.SiteInfo](func() {
 		self.closeRows(rows)
+		release()
 	})
 
 	go func() {
 		for rows.Next() {
-			var siteId string
+			var namespace, siteId string
 			var templateId *string
 			var updated time.Time
+			var version uint64
 			var metadataJson, deploymentIdsJson []byte
-			if err := rows.Scan(&siteId, &templateId, &updated, &metadataJson, &deploymentIdsJson); err == nil {
-				if siteInfo, err := self.newSiteInfo(siteId, templateId, updated, metadataJson, deploymentIdsJson); err == nil {
+			if err := rows.Scan(&namespace, &siteId, &templateId, &updated, &version, &metadataJson, &deploymentIdsJson); err == nil {
+				if siteInfo, err := self.newSiteInfo(namespace, siteId, templateId, updated, version, metadataJson, deploymentIdsJson); err == nil {
 					stream.Send(siteInfo)
 				} else {
 					stream.Close(err)
@@ -153,41 +153,190 @@ func (self *SQLBackend) PurgeSites(context contextpkg.Context, selectSites backe
 	sql := self.statements.DeleteSites
 	var args SqlArgs
 	var where SqlWhere
+	var selection packageSelection
+
+	where.Add(`sites.namespace = ` + args.Add(selectSites.Namespace))
 
 	for _, pattern := range selectSites.SiteIDPatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`sites.site_id ~ ` + pattern)
+		where.Add(self.regexp(`sites.site_id`, pattern))
 	}
 
 	for _, pattern := range selectSites.TemplateIDPatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`template_id ~ ` + pattern)
+		where.Add(self.regexp(`template_id`, pattern))
 	}
 
 	if len(selectSites.MetadataPatterns) > 0 {
+		where.Add(`sites.namespace = sites_metadata.namespace`)
 		where.Add(`sites.site_id = sites_metadata.site_id`)
 		for key, pattern := range selectSites.MetadataPatterns {
 			key = args.Add(key)
 			pattern = args.Add(backend.PatternRE(pattern))
 			where.Add(`key = ` + key)
-			where.Add(`value ~ ` + pattern)
+			where.Add(self.regexp(`value`, pattern))
 		}
 	}
 
+	if selectors, err := backend.ParseSelectors(selectSites.MetadataSelector, selectSites.PackageSelector); err == nil {
+		if selectors.Metadata != nil {
+			where.Add(self.metadataSelectorSql(selectors.Metadata, &args, `sites_metadata`, `sites`, `namespace`, `site_id`))
+		}
+
+		if selectors.Package != nil {
+			selection = packageSelection{
+				Statement:  self.statements.SelectSitePackages,
+				IDColumn:   `site_id`,
+				Namespace:  selectSites.Namespace,
+				Expression: selectors.Package,
+			}
+			where.Add(selectedPackagesSql(`sites.namespace`, `sites.site_id`))
+		}
+	} else {
+		return err
+	}
+
 	sql = where.Apply(sql)
+	sql += "\nRETURNING sites.site_id"
 	self.log.Debugf("generated SQL:\n%s", sql)
 
-	_, err := self.db.ExecContext(context, sql, args.Args...)
-	return err
+	return self.purge(context, sql, args.Args, selection, backend.EventKindSite, selectSites.Namespace, scanID, self.siteDisassociateStmts()...)
+}
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	rows, err := self.statements.PreparedSelectSiteDeletedDeployments.QueryContext(context, namespace, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := util.NewResultsStream[backend.DeletedDeployment](func() {
+		self.closeRows(rows)
+	})
+
+	go func() {
+		for rows.Next() {
+			var deploymentId string
+			var deleted time.Time
+			var package_ []byte
+			if err := rows.Scan(&deploymentId, &deleted, &package_); err == nil {
+				if package__, err := self.decodePackage(context, self.db, package_); err == nil {
+					stream.Send(backend.DeletedDeployment{
+						Namespace:    namespace,
+						DeploymentID: deploymentId,
+						SiteID:       siteId,
+						Deleted:      deleted,
+						Package:      package__,
+					})
+				} else {
+					stream.Close(err)
+					return
+				}
+			} else {
+				stream.Close(err)
+				return
+			}
+		}
+
+		stream.Close(nil)
+	}()
+
+	return stream, nil
+}
+
+// ([backend.Backend] interface)
+func (self *SQLBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		deleteSiteDeletedDeployment := tx.StmtContext(context, self.statements.PreparedDeleteSiteDeletedDeployment)
+		for _, deploymentId := range deploymentIds {
+			if _, err := deleteSiteDeletedDeployment.ExecContext(context, namespace, siteId, deploymentId); err != nil {
+				self.rollback(tx)
+				return err
+			}
+		}
+
+		return tx.Commit()
+	} else {
+		return err
+	}
 }
 
 // Utils
 
-func (self *SQLBackend) newSiteInfo(siteId string, templateId *string, updated time.Time, metadataJson []byte, deploymentIdsJson []byte) (backend.SiteInfo, error) {
+func (self *SQLBackend) setSite(context contextpkg.Context, site *backend.Site, importing bool) error {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		if !importing {
+			if err := self.mergeSiteTemplate(context, tx, site); err != nil {
+				self.rollback(tx)
+				return err
+			}
+		}
+
+		var package_ []byte
+		var err error
+		if package_, err = self.encodePackage(context, tx, site.Package); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		eventType := backend.EventTypeAdded
+		if version, exists, err := self.selectVersion(context, tx, self.statements.PreparedSelectSiteVersion, site.Namespace, site.SiteID); err == nil {
+			if exists {
+				eventType = backend.EventTypeUpdated
+			}
+
+			if err := backend.CheckVersion(backend.EventKindSite, site.SiteID, site.Version, version); err != nil {
+				self.rollback(tx)
+				return err
+			}
+		} else {
+			self.rollback(tx)
+			return err
+		}
+
+		if !importing || site.Updated.IsZero() {
+			site.Updated = time.Now().UTC()
+		}
+		if version, err := self.upsertWithVersion(context, tx, self.statements.PreparedUpsertSite, backend.EventKindSite, site.SiteID, site.Namespace, site.SiteID, nilIfEmptyString(site.TemplateID), site.Updated, package_, site.Version); err == nil {
+			site.Version = version
+
+			if err := self.updateSiteMetadata(context, tx, site); err != nil {
+				self.rollback(tx)
+				return err
+			}
+
+			if revision, err := backend.NewSiteRevision(context, site); err == nil {
+				if err := self.insertRevision(context, tx, revision); err != nil {
+					self.rollback(tx)
+					return err
+				}
+			} else {
+				self.rollback(tx)
+				return err
+			}
+
+			if err := self.insertEvents(context, tx, backend.NewEvent(eventType, backend.EventKindSite, site.Namespace, site.SiteID)); err != nil {
+				self.rollback(tx)
+				return err
+			}
+
+			return tx.Commit()
+		} else {
+			self.rollback(tx)
+			return err
+		}
+	} else {
+		return err
+	}
+}
+
+func (self *SQLBackend) newSiteInfo(namespace string, siteId string, templateId *string, updated time.Time, version uint64, metadataJson []byte, deploymentIdsJson []byte) (backend.SiteInfo, error) {
 	siteInfo := backend.SiteInfo{
-		SiteID:   siteId,
-		Metadata: make(map[string]string),
-		Updated:  updated,
+		Namespace: namespace,
+		SiteID:    siteId,
+		Metadata:  make(map[string]string),
+		Updated:   updated,
+		Version:   version,
 	}
 
 	if templateId != nil {
@@ -205,10 +354,10 @@ func (self *SQLBackend) newSiteInfo(siteId string, templateId *string, updated t
 	return siteInfo, nil
 }
 
-func (self *SQLBackend) newSite(siteId string, templateId *string, updated time.Time, metadataJson []byte, deploymentIdsJson []byte, package_ []byte) (*backend.Site, error) {
-	if siteInfo, err := self.newSiteInfo(siteId, templateId, updated, metadataJson, deploymentIdsJson); err == nil {
+func (self *SQLBackend) newSite(context contextpkg.Context, querier querier, namespace string, siteId string, templateId *string, updated time.Time, version uint64, metadataJson []byte, deploymentIdsJson []byte, package_ []byte) (*backend.Site, error) {
+	if siteInfo, err := self.newSiteInfo(namespace, siteId, templateId, updated, version, metadataJson, deploymentIdsJson); err == nil {
 		site := backend.Site{SiteInfo: siteInfo}
-		if site.Package, err = self.decodePackage(package_); err == nil {
+		if site.Package, err = self.decodePackage(context, querier, package_); err == nil {
 			return &site, nil
 		} else {
 			return nil, err
@@ -220,7 +369,7 @@ func (self *SQLBackend) newSite(siteId string, templateId *string, updated time.
 
 func (self *SQLBackend) mergeSiteTemplate(context contextpkg.Context, tx *sql.Tx, site *backend.Site) error {
 	if site.TemplateID != "" {
-		if template, err := self.getTemplateTx(context, tx, site.TemplateID); err == nil {
+		if template, err := self.getTemplateTx(context, tx, site.Namespace, site.TemplateID); err == nil {
 			site.MergeTemplate(template)
 		} else {
 			return err
@@ -232,16 +381,23 @@ func (self *SQLBackend) mergeSiteTemplate(context contextpkg.Context, tx *sql.Tx
 
 func (self *SQLBackend) updateSiteMetadata(context contextpkg.Context, tx *sql.Tx, site *backend.Site) error {
 	deleteSiteMetadata := tx.StmtContext(context, self.statements.PreparedDeleteSiteMetadata)
-	if _, err := deleteSiteMetadata.ExecContext(context, site.SiteID); err != nil {
+	if _, err := deleteSiteMetadata.ExecContext(context, site.Namespace, site.SiteID); err != nil {
 		return err
 	}
 
 	upsertSiteMetadata := tx.StmtContext(context, self.statements.PreparedUpsertSiteMetadata)
 	for key, value := range site.Metadata {
-		if _, err := upsertSiteMetadata.ExecContext(context, site.SiteID, key, value); err != nil {
+		if _, err := upsertSiteMetadata.ExecContext(context, site.Namespace, site.SiteID, key, value); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// See templateDisassociateStmts.
+func (self *SQLBackend) siteDisassociateStmts() []*sql.Stmt {
+	return []*sql.Stmt{
+		self.statements.PreparedDisassociateSiteDeployments,
+	}
+}
@@ -9,241 +9,170 @@ import (
 )
 
 func NewPostgresqlStatements(db *sql.DB, log commonlog.Logger) *Statements {
-	return &Statements{
+	statements := &Statements{
 		db:  db,
 		log: log,
 
+		RegexpOperator: `~`,
+
 		// Templates
 
-		CreateTemplates: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS templates (
-				template_id TEXT NOT NULL PRIMARY KEY,
-				updated TIMESTAMP,
-				package BYTEA
-			)
-		`),
-		DropTemplates: `DROP TABLE IF EXISTS templates`,
-		CreateTemplatesMetadata: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS templates_metadata (
-				template_id TEXT NOT NULL,
-				key TEXT NOT NULL,
-				value TEXT NOT NULL,
-				UNIQUE (template_id, key),
-				CONSTRAINT fk_template_id
-					FOREIGN KEY (template_id)
-					REFERENCES templates (template_id) ON DELETE CASCADE
-			)
-		`),
-		DropTemplatesMetadata:        `DROP TABLE IF EXISTS templates_metadata`,
-		CreateTemplatesMetadataIndex: `CREATE INDEX IF NOT EXISTS templates_metadata_index ON templates_metadata (key, value)`,
-		DropTemplatesMetadataIndex:   `DROP INDEX IF EXISTS templates_metadata_index`,
-		CreateTemplatesDeployments: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS templates_deployments (
-				template_id TEXT NOT NULL,
-				deployment_id TEXT NOT NULL,
-				UNIQUE (deployment_id),
-				CONSTRAINT fk_template_id
-					FOREIGN KEY (template_id)
-					REFERENCES templates (template_id) ON DELETE CASCADE,
-				CONSTRAINT fk_deployment_id
-					FOREIGN KEY (deployment_id)
-					REFERENCES deployments (deployment_id) ON DELETE CASCADE
-			)
-		`),
-		DropTemplatesDeployments: `DROP TABLE IF EXISTS templates_deployments`,
+		DropTemplates:              `DROP TABLE IF EXISTS templates`,
+		DropTemplatesMetadata:      `DROP TABLE IF EXISTS templates_metadata`,
+		DropTemplatesMetadataIndex: `DROP INDEX IF EXISTS templates_metadata_index`,
+		DropTemplatesDeployments:   `DROP TABLE IF EXISTS templates_deployments`,
 
 		UpsertTemplate: CleanSQL(`
-			INSERT INTO templates (template_id, updated, package)
-			VALUES ($1, $2, $3)
-			ON CONFLICT (template_id)
+			INSERT INTO templates (namespace, template_id, updated, package, version)
+			VALUES ($1, $2, $3, $4, 1)
+			ON CONFLICT (namespace, template_id)
 				DO UPDATE SET
-				updated = $2, package = $3
+				updated = $3, package = $4, version = templates.version + 1
+				WHERE $5 IN (0, templates.version)
+			RETURNING version
 		`),
 		UpsertTemplateMetadata: CleanSQL(`
-			INSERT INTO templates_metadata (template_id, key, value)
-			VALUES ($1, $2, $3)
-			ON CONFLICT (template_id, key)
+			INSERT INTO templates_metadata (namespace, template_id, key, value)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (namespace, template_id, key)
 				DO UPDATE SET
-				value = $3
+				value = $4
 		`),
 		UpsertTemplateDeployment: CleanSQL(`
-			INSERT INTO templates_deployments (template_id, deployment_id)
-			VALUES ($1, $2)
+			INSERT INTO templates_deployments (namespace, template_id, deployment_id)
+			VALUES ($1, $2, $3)
 			ON CONFLICT (deployment_id)
 				DO UPDATE SET
-				template_id = $1
+				namespace = $1, template_id = $2
 		`),
 		SelectTemplate: CleanSQL(`
-			SELECT updated, package, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			SELECT updated, version, package, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
 			FROM templates
-			LEFT JOIN templates_metadata ON templates.template_id = templates_metadata.template_id
-			LEFT JOIN templates_deployments ON templates.template_id = templates_deployments.template_id
-			WHERE templates.template_id = $1
-			GROUP BY templates.template_id
-		`),
-		DeleteTemplate:           `DELETE FROM templates WHERE template_id = $1`,
-		DeleteTemplateMetadata:   `DELETE FROM templates_metadata WHERE template_id = $1`,
+			LEFT JOIN templates_metadata ON templates.namespace = templates_metadata.namespace AND templates.template_id = templates_metadata.template_id
+			LEFT JOIN templates_deployments ON templates.namespace = templates_deployments.namespace AND templates.template_id = templates_deployments.template_id
+			WHERE templates.namespace = $1 AND templates.template_id = $2
+			GROUP BY templates.namespace, templates.template_id
+		`),
+		SelectTemplateVersion:    `SELECT version FROM templates WHERE namespace = $1 AND template_id = $2`,
+		DeleteTemplate:           `DELETE FROM templates WHERE namespace = $1 AND template_id = $2`,
+		DeleteTemplateMetadata:   `DELETE FROM templates_metadata WHERE namespace = $1 AND template_id = $2`,
 		DeleteTemplateDeployment: `DELETE FROM templates_deployments WHERE deployment_id = $1`,
 		DeleteTemplates: CleanSQL(`
 			DELETE FROM templates
 			USING templates_metadata
 		`),
 		SelectTemplates: CleanSQL(`
-			SELECT templates.template_id, updated, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			SELECT templates.namespace, templates.template_id, updated, version, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
 			FROM templates
-			LEFT JOIN templates_metadata ON templates.template_id = templates_metadata.template_id
-			LEFT JOIN templates_deployments ON templates.template_id = templates_deployments.template_id
-			GROUP BY templates.template_id
-			ORDER BY templates.template_id
+			LEFT JOIN templates_metadata ON templates.namespace = templates_metadata.namespace AND templates.template_id = templates_metadata.template_id
+			LEFT JOIN templates_deployments ON templates.namespace = templates_deployments.namespace AND templates.template_id = templates_deployments.template_id
+			GROUP BY templates.namespace, templates.template_id
+			ORDER BY templates.namespace, templates.template_id
 			LIMIT $2 OFFSET $1
 		`),
+		SelectTemplatePackages:          `SELECT namespace, template_id, package FROM templates`,
+		DisassociateTemplateSites:       `UPDATE sites SET template_id = NULL WHERE namespace = $1 AND template_id = $2`,
+		DisassociateTemplateDeployments: `UPDATE deployments SET template_id = NULL WHERE namespace = $1 AND template_id = $2`,
 
 		// Sites
 
-		CreateSites: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS sites (
-				site_id TEXT NOT NULL PRIMARY KEY,
-				template_id TEXT,
-				updated TIMESTAMP,
-				package BYTEA,
-				CONSTRAINT fk_template_id
-					FOREIGN KEY (template_id)
-					REFERENCES templates (template_id) ON DELETE SET NULL
-			)
-		`),
-		DropSites: `DROP TABLE IF EXISTS sites`,
-		CreateSitesMetadata: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS sites_metadata (
-				site_id TEXT NOT NULL,
-				key TEXT NOT NULL,
-				value TEXT NOT NULL,
-				UNIQUE (site_id, key),
-				CONSTRAINT fk_site_id
-					FOREIGN KEY (site_id)
-					REFERENCES sites (site_id) ON DELETE CASCADE
-			)
-		`),
-		DropSitesMetadata:        `DROP TABLE IF EXISTS sites_metadata`,
-		CreateSitesMetadataIndex: `CREATE INDEX IF NOT EXISTS sites_metadata_index ON sites_metadata (key, value)`,
-		DropSitesMetadataIndex:   `DROP INDEX IF EXISTS sites_metadata_index`,
-		CreateSitesDeployments: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS sites_deployments (
-				site_id TEXT NOT NULL,
-				deployment_id TEXT NOT NULL,
-				UNIQUE (deployment_id),
-				CONSTRAINT fk_site_id
-					FOREIGN KEY (site_id)
-					REFERENCES sites (site_id) ON DELETE CASCADE,
-				CONSTRAINT fk_deployment_id
-					FOREIGN KEY (deployment_id)
-					REFERENCES deployments (deployment_id) ON DELETE CASCADE
-			)
-		`),
-		DropSitesDeployments: `DROP TABLE IF EXISTS sites_deployments`,
+		DropSites:                   `DROP TABLE IF EXISTS sites`,
+		DropSitesMetadata:           `DROP TABLE IF EXISTS sites_metadata`,
+		DropSitesMetadataIndex:      `DROP INDEX IF EXISTS sites_metadata_index`,
+		DropSitesDeployments:        `DROP TABLE IF EXISTS sites_deployments`,
+		DropSitesDeletedDeployments: `DROP TABLE IF EXISTS sites_deleted_deployments`,
 
 		UpsertSite: CleanSQL(`
-			INSERT INTO sites (site_id, template_id, updated, package)
-			VALUES ($1, $2, $3, $4)
-			ON CONFLICT (site_id)
+			INSERT INTO sites (namespace, site_id, template_id, updated, package, version)
+			VALUES ($1, $2, $3, $4, $5, 1)
+			ON CONFLICT (namespace, site_id)
 				DO UPDATE SET
-				template_id = $2, updated = $3, package = $4
+				template_id = $3, updated = $4, package = $5, version = sites.version + 1
+				WHERE $6 IN (0, sites.version)
+			RETURNING version
 		`),
 		UpsertSiteMetadata: CleanSQL(`
-			INSERT INTO sites_metadata (site_id, key, value)
-			VALUES ($1, $2, $3)
-			ON CONFLICT (site_id, key)
+			INSERT INTO sites_metadata (namespace, site_id, key, value)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (namespace, site_id, key)
 				DO UPDATE SET
-				value = $3
+				value = $4
 		`),
 		UpsertSiteDeployment: CleanSQL(`
-			INSERT INTO sites_deployments (site_id, deployment_id)
-			VALUES ($1, $2)
+			INSERT INTO sites_deployments (namespace, site_id, deployment_id)
+			VALUES ($1, $2, $3)
 			ON CONFLICT (deployment_id)
 				DO UPDATE SET
-				site_id = $1
+				namespace = $1, site_id = $2
 		`),
 		SelectSite: CleanSQL(`
-			SELECT template_id, updated, package, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			SELECT template_id, updated, version, package, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
 			FROM sites
-			LEFT JOIN sites_metadata ON sites.site_id = sites_metadata.site_id
-			LEFT JOIN sites_deployments ON sites.site_id = sites_deployments.site_id
-			WHERE sites.site_id = $1
-			GROUP BY sites.site_id
-		`),
-		DeleteSite:           `DELETE FROM sites WHERE site_id = $1`,
-		DeleteSiteMetadata:   `DELETE FROM sites_metadata WHERE site_id = $1`,
+			LEFT JOIN sites_metadata ON sites.namespace = sites_metadata.namespace AND sites.site_id = sites_metadata.site_id
+			LEFT JOIN sites_deployments ON sites.namespace = sites_deployments.namespace AND sites.site_id = sites_deployments.site_id
+			WHERE sites.namespace = $1 AND sites.site_id = $2
+			GROUP BY sites.namespace, sites.site_id
+		`),
+		SelectSiteVersion:    `SELECT version FROM sites WHERE namespace = $1 AND site_id = $2`,
+		DeleteSite:           `DELETE FROM sites WHERE namespace = $1 AND site_id = $2`,
+		DeleteSiteMetadata:   `DELETE FROM sites_metadata WHERE namespace = $1 AND site_id = $2`,
 		DeleteSiteDeployment: `DELETE FROM sites_deployments WHERE deployment_id = $1`,
 		DeleteSites: CleanSQL(`
 			DELETE FROM sites
 			USING sites_metadata
 		`),
 		SelectSites: CleanSQL(`
-			SELECT sites.site_id, template_id, updated, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			SELECT sites.namespace, sites.site_id, template_id, updated, version, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), JSON_AGG (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
 			FROM sites
-			LEFT JOIN sites_metadata ON sites.site_id = sites_metadata.site_id
-			LEFT JOIN sites_deployments ON sites.site_id = sites_deployments.site_id
-			GROUP BY sites.site_id
-			ORDER BY sites.site_id
+			LEFT JOIN sites_metadata ON sites.namespace = sites_metadata.namespace AND sites.site_id = sites_metadata.site_id
+			LEFT JOIN sites_deployments ON sites.namespace = sites_deployments.namespace AND sites.site_id = sites_deployments.site_id
+			GROUP BY sites.namespace, sites.site_id
+			ORDER BY sites.namespace, sites.site_id
 			LIMIT $2 OFFSET $1
 		`),
+		SelectSitePackages:          `SELECT namespace, site_id, package FROM sites`,
+		DisassociateSiteDeployments: `UPDATE deployments SET site_id = NULL WHERE namespace = $1 AND site_id = $2`,
+		InsertSiteDeletedDeployment: CleanSQL(`
+			INSERT INTO sites_deleted_deployments (namespace, site_id, deployment_id, deleted, package)
+			SELECT namespace, site_id, deployment_id, $2, package
+			FROM deployments
+			WHERE (deployment_id = $1) AND (site_id IS NOT NULL) AND prepared AND approved
+			ON CONFLICT (deployment_id)
+				DO NOTHING
+		`),
+		SelectSiteDeletedDeployments: CleanSQL(`
+			SELECT deployment_id, deleted, package
+			FROM sites_deleted_deployments
+			WHERE namespace = $1 AND site_id = $2
+			ORDER BY deployment_id
+		`),
+		DeleteSiteDeletedDeployment: `DELETE FROM sites_deleted_deployments WHERE (namespace = $1) AND (site_id = $2) AND (deployment_id = $3)`,
 
 		// Deployments
 
-		CreateDeployments: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS deployments (
-				deployment_id TEXT NOT NULL PRIMARY KEY,
-				parent_deployment_id TEXT,
-				template_id TEXT,
-				site_id TEXT,
-				created TIMESTAMP,
-				updated TIMESTAMP,
-				prepared BOOLEAN,
-				approved BOOLEAN,
-				package BYTEA,
-				modification_token TEXT,
-				modification_timestamp BIGINT,
-				CONSTRAINT fk_parent_deployment_id
-					FOREIGN KEY (parent_deployment_id)
-					REFERENCES deployments (deployment_id) ON DELETE CASCADE,
-				CONSTRAINT fk_template_id
-					FOREIGN KEY (template_id)
-					REFERENCES templates (template_id) ON DELETE SET NULL,
-				CONSTRAINT fk_site_id
-					FOREIGN KEY (site_id)
-					REFERENCES sites (site_id) ON DELETE SET NULL
-			)
-		`),
-		DropDeployments: `DROP TABLE IF EXISTS deployments`,
-		CreateDeploymentsMetadata: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS deployments_metadata (
-				deployment_id TEXT NOT NULL,
-				key TEXT NOT NULL,
-				value TEXT NOT NULL,
-				UNIQUE (deployment_id, key),
-				CONSTRAINT fk_deployment_id
-					FOREIGN KEY (deployment_id)
-					REFERENCES deployments (deployment_id) ON DELETE CASCADE
-			)
-		`),
-		DropDeploymentsMetadata:            `DROP TABLE IF EXISTS deployments_metadata`,
-		CreateDeploymentsMetadataIndex:     `CREATE INDEX IF NOT EXISTS deployments_metadata_index ON deployments_metadata (key, value)`,
-		DropDeploymentsMetadataIndex:       `DROP INDEX IF EXISTS deployments_metadata_index`,
-		CreateDeploymentsPreparedIndex:     `CREATE INDEX IF NOT EXISTS deployments_prepared_index ON deployments (prepared)`,
-		DropDeploymentsPreparedIndex:       `DROP INDEX IF EXISTS deployments_prepared_index`,
-		CreateDeploymentsApprovedIndex:     `CREATE INDEX IF NOT EXISTS deployments_approved_index ON deployments (approved)`,
-		DropDeploymentsApprovedIndex:       `DROP INDEX IF EXISTS deployments_approved_index`,
-		CreateDeploymentsModificationIndex: `CREATE INDEX IF NOT EXISTS deployments_modification_index ON deployments (modification_token)`,
-		DropDeploymentsModificationIndex:   `DROP INDEX IF EXISTS deployments_modification_index`,
+		DropDeployments:                  `DROP TABLE IF EXISTS deployments`,
+		DropDeploymentsMetadata:          `DROP TABLE IF EXISTS deployments_metadata`,
+		DropDeploymentsMetadataIndex:     `DROP INDEX IF EXISTS deployments_metadata_index`,
+		DropDeploymentsPreparedIndex:     `DROP INDEX IF EXISTS deployments_prepared_index`,
+		DropDeploymentsApprovedIndex:     `DROP INDEX IF EXISTS deployments_approved_index`,
+		DropDeploymentsModificationIndex: `DROP INDEX IF EXISTS deployments_modification_index`,
+		DropDeploymentsPendingDeletes:    `DROP TABLE IF EXISTS deployments_pending_deletes`,
 
 		InsertDeployment: CleanSQL(`
-			INSERT INTO deployments (deployment_id, parent_deployment_id, template_id, site_id, created, updated, prepared, approved, package)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			INSERT INTO deployments (deployment_id, namespace, parent_deployment_id, template_id, site_id, created, updated, version, prepared, approved, package)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, 1, $8, $9, $10)
 		`),
 		UpdateDeployment: CleanSQL(`
 			UPDATE deployments
-			SET updated = $2, prepared = $3, approved = $4, package = $5, modification_token = NULL, modification_timestamp = 0
+			SET updated = $2, version = version + 1, prepared = $3, approved = $4, package = $5, modification_token = NULL, modification_timestamp = 0
 			WHERE deployment_id = $1
 		`),
+		UpdateUnmodifiedDeployment: CleanSQL(`
+			UPDATE deployments
+			SET updated = $2, version = version + 1, prepared = $3, approved = $4, package = $5, modification_token = NULL, modification_timestamp = 0
+			WHERE deployment_id = $1 AND version = $6 AND (modification_token IS NULL OR modification_token = '' OR modification_timestamp < $7)
+			RETURNING version
+		`),
 		UpsertDeploymentMetadata: CleanSQL(`
 			INSERT INTO deployments_metadata (deployment_id, key, value)
 			VALUES ($1, $2, $3)
@@ -252,21 +181,21 @@ func NewPostgresqlStatements(db *sql.DB, log commonlog.Logger) *Statements {
 				value = $3
 		`),
 		SelectDeployment: CleanSQL(`
-			SELECT parent_deployment_id, template_id, site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, prepared, approved, package
+			SELECT parent_deployment_id, template_id, site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved, package
 			FROM deployments
 			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
-			WHERE deployments.deployment_id = $1
+			WHERE deployments.namespace = $1 AND deployments.deployment_id = $2
 			GROUP BY deployments.deployment_id
 		`),
 		SelectDeploymentWithModification: CleanSQL(`
-			SELECT parent_deployment_id, template_id, site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, prepared, approved, package, modification_token, modification_timestamp
+			SELECT parent_deployment_id, template_id, site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved, package, modification_token, modification_timestamp
 			FROM deployments
 			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
-			WHERE deployments.deployment_id = $1
+			WHERE deployments.namespace = $1 AND deployments.deployment_id = $2
 			GROUP BY deployments.deployment_id
 		`),
 		SelectDeploymentByModification: CleanSQL(`
-			SELECT deployments.deployment_id, template_id, site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, prepared, approved, modification_timestamp
+			SELECT deployments.deployment_id, deployments.namespace, template_id, site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved, modification_timestamp
 			FROM deployments
 			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
 			WHERE modification_token = $1
@@ -282,61 +211,77 @@ func NewPostgresqlStatements(db *sql.DB, log commonlog.Logger) *Statements {
 			SET modification_token = NULL, modification_timestamp = 0
 			WHERE modification_token = $1
 		`),
-		DeleteDeployment:         `DELETE FROM deployments WHERE deployment_id = $1`,
+		DeleteDeployment:         `DELETE FROM deployments WHERE namespace = $1 AND deployment_id = $2`,
 		DeleteDeploymentMetadata: `DELETE FROM deployments_metadata WHERE deployment_id = $1`,
-		DeleteDeployments: CleanSQL(`
-			DELETE FROM deployments
-			USING deployments_metadata, templates_metadata, sites_metadata
-		`),
 		SelectDeployments: CleanSQL(`
-			SELECT deployments.deployment_id, parent_deployment_id, deployments.template_id, deployments.site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, prepared, approved
+			SELECT deployments.deployment_id, deployments.namespace, parent_deployment_id, deployments.template_id, deployments.site_id, JSON_AGG (ARRAY [key, value]) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved
 			FROM deployments
 			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
 			GROUP BY deployments.deployment_id
 			ORDER BY deployments.deployment_id
 			LIMIT $2 OFFSET $1
 		`),
-
-		// Plugins
-
-		CreatePlugins: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS plugins (
-				type TEXT NOT NULL,
-				name TEXT NOT NULL,
-				executor TEXT NOT NULL,
-				arguments TEXT,
-				properties TEXT,
-				PRIMARY KEY (type, name)
+		SelectDeploymentPackages: `SELECT namespace, deployment_id, package FROM deployments`,
+		CountDeployments:         `SELECT prepared, approved, COUNT(*) FROM deployments GROUP BY prepared, approved`,
+		SelectDeploymentDescendants: CleanSQL(`
+			WITH RECURSIVE descendants (deployment_id, depth) AS (
+				SELECT deployment_id, 1
+				FROM deployments
+				WHERE parent_deployment_id = $1
+				UNION ALL
+				SELECT deployments.deployment_id, descendants.depth + 1
+				FROM deployments
+				JOIN descendants ON deployments.parent_deployment_id = descendants.deployment_id
 			)
+			SELECT deployment_id
+			FROM descendants
+			ORDER BY depth DESC, deployment_id
 		`),
-		DropPlugins:                `DROP TABLE IF EXISTS plugins`,
-		CreatePluginsTypeIndex:     `CREATE INDEX IF NOT EXISTS plugins_type_index ON plugins (type)`,
-		DropPluginsTypeIndex:       `DROP INDEX IF EXISTS plugins_type_index`,
-		CreatePluginsExecutorIndex: `CREATE INDEX IF NOT EXISTS plugins_executor_index ON plugins (executor)`,
-		DropPluginsExecutorIndex:   `DROP INDEX IF EXISTS plugins_executor_index`,
-		CreatePluginsTriggers: CleanSQL(`
-			CREATE TABLE IF NOT EXISTS plugins_triggers (
-				plugin_type TEXT NOT NULL,
-				plugin_name TEXT NOT NULL,
-				"group" TEXT NOT NULL,
-				version TEXT NOT NULL,
-				kind TEXT NOT NULL,
-				UNIQUE (plugin_type, plugin_name, "group", version, kind),
-				CONSTRAINT fk_plugin_id
-					FOREIGN KEY (plugin_type, plugin_name)
-					REFERENCES plugins (type, name) ON DELETE CASCADE
-			)
+		OrphanDeploymentChildren: CleanSQL(`
+			UPDATE deployments
+			SET parent_deployment_id = NULL
+			WHERE parent_deployment_id = $1
 		`),
-		DropPluginsTriggers:        `DROP TABLE IF EXISTS plugins_triggers`,
-		CreatePluginsTriggersIndex: `CREATE INDEX IF NOT EXISTS plugins_triggers_index ON plugins_triggers ("group", version, kind)`,
-		DropPluginsTriggersIndex:   `DROP INDEX IF EXISTS plugins_triggers_index`,
+		InsertDeploymentPendingDelete: CleanSQL(`
+			INSERT INTO deployments_pending_deletes (namespace, deployment_id)
+			SELECT namespace, deployment_id
+			FROM deployments
+			WHERE deployment_id = $1
+			ON CONFLICT (namespace, deployment_id)
+				DO NOTHING
+		`),
+		SelectDeploymentPendingDeletes: CleanSQL(`
+			SELECT namespace, deployment_id
+			FROM deployments_pending_deletes
+			ORDER BY namespace, deployment_id
+			LIMIT $1
+		`),
+		ImportDeployment: CleanSQL(`
+			INSERT INTO deployments (deployment_id, namespace, parent_deployment_id, template_id, site_id, created, updated, version, prepared, approved, package)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, 1, $8, $9, $10)
+			ON CONFLICT (deployment_id)
+				DO UPDATE SET
+				parent_deployment_id = $3, template_id = $4, site_id = $5, created = $6, updated = $7, version = deployments.version + 1, prepared = $8, approved = $9, package = $10, modification_token = NULL, modification_timestamp = 0
+				WHERE deployments.namespace = $2
+			RETURNING version
+		`),
+
+		// Plugins
+
+		DropPlugins:              `DROP TABLE IF EXISTS plugins`,
+		DropPluginsTypeIndex:     `DROP INDEX IF EXISTS plugins_type_index`,
+		DropPluginsExecutorIndex: `DROP INDEX IF EXISTS plugins_executor_index`,
+		DropPluginsTriggers:      `DROP TABLE IF EXISTS plugins_triggers`,
+		DropPluginsTriggersIndex: `DROP INDEX IF EXISTS plugins_triggers_index`,
 
 		UpsertPlugin: CleanSQL(`
-			INSERT INTO plugins (type, name, executor, arguments, properties)
-			VALUES ($1, $2, $3, $4, $5)
+			INSERT INTO plugins (type, name, executor, arguments, properties, version)
+			VALUES ($1, $2, $3, $4, $5, 1)
 			ON CONFLICT (type, name)
 				DO UPDATE SET
-				executor = $3, arguments = $4, properties = $5
+				executor = $3, arguments = $4, properties = $5, version = plugins.version + 1
+				WHERE $6 IN (0, plugins.version)
+			RETURNING version
 		`),
 		InsertPluginTrigger: CleanSQL(`
 			INSERT INTO plugins_triggers (plugin_type, plugin_name, "group", version, kind)
@@ -345,12 +290,13 @@ func NewPostgresqlStatements(db *sql.DB, log commonlog.Logger) *Statements {
 				DO NOTHING
 		`),
 		SelectPlugin: CleanSQL(`
-			SELECT executor, arguments, properties, JSON_AGG (ARRAY ["group", version, kind]) FILTER (WHERE "group" IS NOT NULL)
+			SELECT executor, arguments, properties, plugins.version, JSON_AGG (ARRAY ["group", plugins_triggers.version, kind]) FILTER (WHERE "group" IS NOT NULL)
 			FROM plugins
 			LEFT JOIN plugins_triggers ON plugins.type = plugins_triggers.plugin_type AND plugins.name = plugins_triggers.plugin_name
-			WHERE type = $1 AND name = $2
-			GROUP BY type, name
+			WHERE plugins.type = $1 AND plugins.name = $2
+			GROUP BY plugins.type, plugins.name
 		`),
+		SelectPluginVersion:  `SELECT version FROM plugins WHERE type = $1 AND name = $2`,
 		DeletePlugin:         `DELETE FROM plugins WHERE type = $1 AND name = $2`,
 		DeletePluginTriggers: `DELETE FROM plugins_triggers WHERE plugin_type = $1 AND plugin_name = $2`,
 		DeletePlugins: CleanSQL(`
@@ -358,12 +304,118 @@ func NewPostgresqlStatements(db *sql.DB, log commonlog.Logger) *Statements {
 			USING plugins_triggers
 		`),
 		SelectPlugins: CleanSQL(`
-			SELECT plugins.type, plugins.name, executor, arguments, properties, JSON_AGG (ARRAY ["group", version, kind]) FILTER (WHERE "group" IS NOT NULL)
+			SELECT plugins.type, plugins.name, executor, arguments, properties, plugins.version, JSON_AGG (ARRAY ["group", plugins_triggers.version, kind]) FILTER (WHERE "group" IS NOT NULL)
 			FROM plugins
 			LEFT JOIN plugins_triggers ON plugins.type = plugins_triggers.plugin_type AND plugins.name = plugins_triggers.plugin_name
 			GROUP BY plugins.type, plugins.name
 			ORDER BY plugins.type, plugins.name
 			LIMIT $2 OFFSET $1
 		`),
+
+		// Revisions
+
+		DropRevisions: `DROP TABLE IF EXISTS revisions`,
+
+		InsertRevision: CleanSQL(`
+			INSERT INTO revisions (type, namespace, object_id, revision, author, created, hash, template_id, metadata, package)
+			SELECT $1, $2, $3, COALESCE(MAX(revision), 0) + 1, $4, $5, $6, $7, $8, $9
+			FROM revisions
+			WHERE type = $1 AND namespace = $2 AND object_id = $3
+			RETURNING revision
+		`),
+		SelectRevision: CleanSQL(`
+			SELECT author, created, hash, template_id, metadata, package
+			FROM revisions
+			WHERE type = $1 AND namespace = $2 AND object_id = $3 AND revision = $4
+		`),
+		SelectRevisions: CleanSQL(`
+			SELECT revision, author, created, hash, template_id, metadata
+			FROM revisions
+			WHERE type = $1 AND namespace = $2 AND object_id = $3
+			ORDER BY revision
+			LIMIT $5 OFFSET $4
+		`),
+
+		// Audit
+
+		DropAuditEvents:               `DROP TABLE IF EXISTS audit_events`,
+		DropAuditEventsTimestampIndex: `DROP INDEX IF EXISTS audit_events_timestamp_index`,
+		DropAuditEventsActorIndex:     `DROP INDEX IF EXISTS audit_events_actor_index`,
+
+		InsertAuditEvent: CleanSQL(`
+			INSERT INTO audit_events (timestamp, actor, operation, type, namespace, ids, selector, outcome, reason, diff, claimed_author)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			RETURNING id
+		`),
+		SelectAuditEvents: CleanSQL(`
+			SELECT id, timestamp, actor, operation, type, namespace, ids, selector, outcome, reason, diff, claimed_author
+			FROM audit_events
+			GROUP BY id
+			ORDER BY id
+			LIMIT $2 OFFSET $1
+		`),
+
+		// Events
+
+		DropEvents: `DROP TABLE IF EXISTS events`,
+
+		LockEvents:     `LOCK TABLE events IN EXCLUSIVE MODE`,
+		NotifyEvents:   `SELECT pg_notify('tko_events', '')`,
+		ListenEvents:   `LISTEN tko_events`,
+		UnlistenEvents: `UNLISTEN tko_events`,
+		InsertEvent: CleanSQL(`
+			INSERT INTO events (type, kind, namespace, object_id, timestamp)
+			VALUES ($1, $2, $3, $4, $5)
+		`),
+		SelectEvents: CleanSQL(`
+			SELECT revision, type, kind, namespace, object_id, timestamp
+			FROM events
+			WHERE revision > $1
+			ORDER BY revision
+		`),
+		SelectEventsRevision: `SELECT COALESCE(MAX(revision), 0) FROM events`,
+		DeleteEvents:         `DELETE FROM events WHERE timestamp < $1`,
+
+		// Package selection
+
+		CreateSelectedPackages: CleanSQL(`
+			CREATE TEMPORARY TABLE IF NOT EXISTS selected_packages (
+				namespace TEXT NOT NULL,
+				id TEXT NOT NULL,
+				PRIMARY KEY (namespace, id)
+			)
+		`),
+		ClearSelectedPackages: `DELETE FROM selected_packages`,
+		InsertSelectedPackage: `INSERT INTO selected_packages (namespace, id) VALUES ($1, $2)`,
+
+		// Blobs
+
+		DropBlobs: `DROP TABLE IF EXISTS blobs`,
+
+		UpsertBlob: CleanSQL(`
+			INSERT INTO blobs (hash, content)
+			VALUES ($1, $2)
+			ON CONFLICT (hash) DO NOTHING
+		`),
+		SelectBlobs: `SELECT hash, content FROM blobs`,
+
+		// Schema
+
+		CreateSchemaMigrations: CleanSQL(`
+			CREATE TABLE IF NOT EXISTS schema_migrations (
+				version BIGINT PRIMARY KEY,
+				description TEXT NOT NULL,
+				applied TIMESTAMP NOT NULL
+			)
+		`),
+		DropSchemaMigrations: `DROP TABLE IF EXISTS schema_migrations`,
+
+		LockSchemaMigrations:  `LOCK TABLE schema_migrations IN EXCLUSIVE MODE`,
+		SelectSchemaVersion:   `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`,
+		InsertSchemaMigration: `INSERT INTO schema_migrations (version, description, applied) VALUES ($1, $2, $3)`,
 	}
+
+	statements.Migrations = postgresqlMigrations
+
+	return statements
 }
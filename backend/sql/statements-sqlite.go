@@ -0,0 +1,506 @@
+package sql
+
+import (
+	contextpkg "context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/tliron/commonlog"
+	"modernc.org/sqlite"
+)
+
+const SQLiteDefaultDataSource = "tko.db"
+
+var SQLiteBusyTimeout = 10_000 // milliseconds
+
+// Ensures transactions acquire the write lock when they begin, avoiding deadlocks between
+// concurrent read-then-write transactions.
+func SQLiteDataSource(dataSource string) string {
+	if strings.Contains(dataSource, "_txlock=") {
+		return dataSource
+	} else if strings.Contains(dataSource, "?") {
+		return dataSource + "&_txlock=immediate"
+	} else {
+		return dataSource + "?_txlock=immediate"
+	}
+}
+
+func NewSQLiteStatements(db *sql.DB, log commonlog.Logger) *Statements {
+	statements := &Statements{
+		db:  db,
+		log: log,
+
+		RegexpOperator: `REGEXP`,
+
+		// Templates
+
+		DropTemplates:              `DROP TABLE IF EXISTS templates`,
+		DropTemplatesMetadata:      `DROP TABLE IF EXISTS templates_metadata`,
+		DropTemplatesMetadataIndex: `DROP INDEX IF EXISTS templates_metadata_index`,
+		DropTemplatesDeployments:   `DROP TABLE IF EXISTS templates_deployments`,
+
+		UpsertTemplate: CleanSQL(`
+			INSERT INTO templates (namespace, template_id, updated, package, version)
+			VALUES ($1, $2, $3, $4, 1)
+			ON CONFLICT (namespace, template_id)
+				DO UPDATE SET
+				updated = $3, package = $4, version = templates.version + 1
+				WHERE $5 IN (0, templates.version)
+			RETURNING version
+		`),
+		UpsertTemplateMetadata: CleanSQL(`
+			INSERT INTO templates_metadata (namespace, template_id, key, value)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (namespace, template_id, key)
+				DO UPDATE SET
+				value = $4
+		`),
+		UpsertTemplateDeployment: CleanSQL(`
+			INSERT INTO templates_deployments (namespace, template_id, deployment_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (deployment_id)
+				DO UPDATE SET
+				namespace = $1, template_id = $2
+		`),
+		SelectTemplate: CleanSQL(`
+			SELECT updated, version, package, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), JSON_GROUP_ARRAY (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			FROM templates
+			LEFT JOIN templates_metadata ON templates.namespace = templates_metadata.namespace AND templates.template_id = templates_metadata.template_id
+			LEFT JOIN templates_deployments ON templates.namespace = templates_deployments.namespace AND templates.template_id = templates_deployments.template_id
+			WHERE templates.namespace = $1 AND templates.template_id = $2
+			GROUP BY templates.namespace, templates.template_id
+		`),
+		SelectTemplateVersion:    `SELECT version FROM templates WHERE namespace = $1 AND template_id = $2`,
+		DeleteTemplate:           `DELETE FROM templates WHERE namespace = $1 AND template_id = $2`,
+		DeleteTemplateMetadata:   `DELETE FROM templates_metadata WHERE namespace = $1 AND template_id = $2`,
+		DeleteTemplateDeployment: `DELETE FROM templates_deployments WHERE deployment_id = $1`,
+		DeleteTemplates: CleanSQL(`
+			DELETE FROM templates
+			WHERE (namespace, template_id) IN (
+			SELECT templates.namespace, templates.template_id
+			FROM templates
+			LEFT JOIN templates_metadata ON templates.namespace = templates_metadata.namespace AND templates.template_id = templates_metadata.template_id
+			GROUP BY templates.namespace, templates.template_id
+			)
+		`),
+		SelectTemplates: CleanSQL(`
+			SELECT templates.namespace, templates.template_id, updated, version, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), JSON_GROUP_ARRAY (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			FROM templates
+			LEFT JOIN templates_metadata ON templates.namespace = templates_metadata.namespace AND templates.template_id = templates_metadata.template_id
+			LEFT JOIN templates_deployments ON templates.namespace = templates_deployments.namespace AND templates.template_id = templates_deployments.template_id
+			GROUP BY templates.namespace, templates.template_id
+			ORDER BY templates.namespace, templates.template_id
+			LIMIT $2 OFFSET $1
+		`),
+		SelectTemplatePackages:          `SELECT namespace, template_id, package FROM templates`,
+		DisassociateTemplateSites:       `UPDATE sites SET template_id = NULL WHERE namespace = $1 AND template_id = $2`,
+		DisassociateTemplateDeployments: `UPDATE deployments SET template_id = NULL WHERE namespace = $1 AND template_id = $2`,
+
+		// Sites
+
+		DropSites:                   `DROP TABLE IF EXISTS sites`,
+		DropSitesMetadata:           `DROP TABLE IF EXISTS sites_metadata`,
+		DropSitesMetadataIndex:      `DROP INDEX IF EXISTS sites_metadata_index`,
+		DropSitesDeployments:        `DROP TABLE IF EXISTS sites_deployments`,
+		DropSitesDeletedDeployments: `DROP TABLE IF EXISTS sites_deleted_deployments`,
+
+		UpsertSite: CleanSQL(`
+			INSERT INTO sites (namespace, site_id, template_id, updated, package, version)
+			VALUES ($1, $2, $3, $4, $5, 1)
+			ON CONFLICT (namespace, site_id)
+				DO UPDATE SET
+				template_id = $3, updated = $4, package = $5, version = sites.version + 1
+				WHERE $6 IN (0, sites.version)
+			RETURNING version
+		`),
+		UpsertSiteMetadata: CleanSQL(`
+			INSERT INTO sites_metadata (namespace, site_id, key, value)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (namespace, site_id, key)
+				DO UPDATE SET
+				value = $4
+		`),
+		UpsertSiteDeployment: CleanSQL(`
+			INSERT INTO sites_deployments (namespace, site_id, deployment_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (deployment_id)
+				DO UPDATE SET
+				namespace = $1, site_id = $2
+		`),
+		SelectSite: CleanSQL(`
+			SELECT template_id, updated, version, package, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), JSON_GROUP_ARRAY (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			FROM sites
+			LEFT JOIN sites_metadata ON sites.namespace = sites_metadata.namespace AND sites.site_id = sites_metadata.site_id
+			LEFT JOIN sites_deployments ON sites.namespace = sites_deployments.namespace AND sites.site_id = sites_deployments.site_id
+			WHERE sites.namespace = $1 AND sites.site_id = $2
+			GROUP BY sites.namespace, sites.site_id
+		`),
+		SelectSiteVersion:    `SELECT version FROM sites WHERE namespace = $1 AND site_id = $2`,
+		DeleteSite:           `DELETE FROM sites WHERE namespace = $1 AND site_id = $2`,
+		DeleteSiteMetadata:   `DELETE FROM sites_metadata WHERE namespace = $1 AND site_id = $2`,
+		DeleteSiteDeployment: `DELETE FROM sites_deployments WHERE deployment_id = $1`,
+		DeleteSites: CleanSQL(`
+			DELETE FROM sites
+			WHERE (namespace, site_id) IN (
+			SELECT sites.namespace, sites.site_id
+			FROM sites
+			LEFT JOIN sites_metadata ON sites.namespace = sites_metadata.namespace AND sites.site_id = sites_metadata.site_id
+			GROUP BY sites.namespace, sites.site_id
+			)
+		`),
+		SelectSites: CleanSQL(`
+			SELECT sites.namespace, sites.site_id, template_id, updated, version, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), JSON_GROUP_ARRAY (DISTINCT deployment_id) FILTER (WHERE deployment_id IS NOT NULL)
+			FROM sites
+			LEFT JOIN sites_metadata ON sites.namespace = sites_metadata.namespace AND sites.site_id = sites_metadata.site_id
+			LEFT JOIN sites_deployments ON sites.namespace = sites_deployments.namespace AND sites.site_id = sites_deployments.site_id
+			GROUP BY sites.namespace, sites.site_id
+			ORDER BY sites.namespace, sites.site_id
+			LIMIT $2 OFFSET $1
+		`),
+		SelectSitePackages:          `SELECT namespace, site_id, package FROM sites`,
+		DisassociateSiteDeployments: `UPDATE deployments SET site_id = NULL WHERE namespace = $1 AND site_id = $2`,
+		InsertSiteDeletedDeployment: CleanSQL(`
+			INSERT INTO sites_deleted_deployments (namespace, site_id, deployment_id, deleted, package)
+			SELECT namespace, site_id, deployment_id, $2, package
+			FROM deployments
+			WHERE (deployment_id = $1) AND (site_id IS NOT NULL) AND prepared AND approved
+			ON CONFLICT (deployment_id)
+				DO NOTHING
+		`),
+		SelectSiteDeletedDeployments: CleanSQL(`
+			SELECT deployment_id, deleted, package
+			FROM sites_deleted_deployments
+			WHERE namespace = $1 AND site_id = $2
+			ORDER BY deployment_id
+		`),
+		DeleteSiteDeletedDeployment: `DELETE FROM sites_deleted_deployments WHERE (namespace = $1) AND (site_id = $2) AND (deployment_id = $3)`,
+
+		// Deployments
+
+		DropDeployments:                  `DROP TABLE IF EXISTS deployments`,
+		DropDeploymentsMetadata:          `DROP TABLE IF EXISTS deployments_metadata`,
+		DropDeploymentsMetadataIndex:     `DROP INDEX IF EXISTS deployments_metadata_index`,
+		DropDeploymentsPreparedIndex:     `DROP INDEX IF EXISTS deployments_prepared_index`,
+		DropDeploymentsApprovedIndex:     `DROP INDEX IF EXISTS deployments_approved_index`,
+		DropDeploymentsModificationIndex: `DROP INDEX IF EXISTS deployments_modification_index`,
+		DropDeploymentsPendingDeletes:    `DROP TABLE IF EXISTS deployments_pending_deletes`,
+
+		InsertDeployment: CleanSQL(`
+			INSERT INTO deployments (deployment_id, namespace, parent_deployment_id, template_id, site_id, created, updated, version, prepared, approved, package)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, 1, $8, $9, $10)
+		`),
+		UpdateDeployment: CleanSQL(`
+			UPDATE deployments
+			SET updated = $2, version = version + 1, prepared = $3, approved = $4, package = $5, modification_token = NULL, modification_timestamp = 0
+			WHERE deployment_id = $1
+		`),
+		UpdateUnmodifiedDeployment: CleanSQL(`
+			UPDATE deployments
+			SET updated = $2, version = version + 1, prepared = $3, approved = $4, package = $5, modification_token = NULL, modification_timestamp = 0
+			WHERE deployment_id = $1 AND version = $6 AND (modification_token IS NULL OR modification_token = '' OR modification_timestamp < $7)
+			RETURNING version
+		`),
+		UpsertDeploymentMetadata: CleanSQL(`
+			INSERT INTO deployments_metadata (deployment_id, key, value)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (deployment_id, key)
+				DO UPDATE SET
+				value = $3
+		`),
+		SelectDeployment: CleanSQL(`
+			SELECT parent_deployment_id, template_id, site_id, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved, package
+			FROM deployments
+			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
+			WHERE deployments.namespace = $1 AND deployments.deployment_id = $2
+			GROUP BY deployments.deployment_id
+		`),
+		SelectDeploymentWithModification: CleanSQL(`
+			SELECT parent_deployment_id, template_id, site_id, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved, package, modification_token, modification_timestamp
+			FROM deployments
+			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
+			WHERE deployments.namespace = $1 AND deployments.deployment_id = $2
+			GROUP BY deployments.deployment_id
+		`),
+		SelectDeploymentByModification: CleanSQL(`
+			SELECT deployments.deployment_id, deployments.namespace, template_id, site_id, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved, modification_timestamp
+			FROM deployments
+			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
+			WHERE modification_token = $1
+			GROUP BY deployments.deployment_id
+		`),
+		UpdateDeploymentModification: CleanSQL(`
+			UPDATE deployments
+			SET modification_token = $1, modification_timestamp = $2
+			WHERE deployment_id = $3
+		`),
+		ResetDeploymentModification: CleanSQL(`
+			UPDATE deployments
+			SET modification_token = NULL, modification_timestamp = 0
+			WHERE modification_token = $1
+		`),
+		DeleteDeployment:         `DELETE FROM deployments WHERE namespace = $1 AND deployment_id = $2`,
+		DeleteDeploymentMetadata: `DELETE FROM deployments_metadata WHERE deployment_id = $1`,
+		SelectDeployments: CleanSQL(`
+			SELECT deployments.deployment_id, deployments.namespace, parent_deployment_id, deployments.template_id, deployments.site_id, JSON_GROUP_ARRAY (JSON_ARRAY (key, value)) FILTER (WHERE key IS NOT NULL), created, updated, version, prepared, approved
+			FROM deployments
+			LEFT JOIN deployments_metadata ON deployments.deployment_id = deployments_metadata.deployment_id
+			GROUP BY deployments.deployment_id
+			ORDER BY deployments.deployment_id
+			LIMIT $2 OFFSET $1
+		`),
+		SelectDeploymentPackages: `SELECT namespace, deployment_id, package FROM deployments`,
+		CountDeployments:         `SELECT prepared, approved, COUNT(*) FROM deployments GROUP BY prepared, approved`,
+		SelectDeploymentDescendants: CleanSQL(`
+			WITH RECURSIVE descendants (deployment_id, depth) AS (
+				SELECT deployment_id, 1
+				FROM deployments
+				WHERE parent_deployment_id = $1
+				UNION ALL
+				SELECT deployments.deployment_id, descendants.depth + 1
+				FROM deployments
+				JOIN descendants ON deployments.parent_deployment_id = descendants.deployment_id
+			)
+			SELECT deployment_id
+			FROM descendants
+			ORDER BY depth DESC, deployment_id
+		`),
+		OrphanDeploymentChildren: CleanSQL(`
+			UPDATE deployments
+			SET parent_deployment_id = NULL
+			WHERE parent_deployment_id = $1
+		`),
+		InsertDeploymentPendingDelete: CleanSQL(`
+			INSERT INTO deployments_pending_deletes (namespace, deployment_id)
+			SELECT namespace, deployment_id
+			FROM deployments
+			WHERE deployment_id = $1
+			ON CONFLICT (namespace, deployment_id)
+				DO NOTHING
+		`),
+		SelectDeploymentPendingDeletes: CleanSQL(`
+			SELECT namespace, deployment_id
+			FROM deployments_pending_deletes
+			ORDER BY namespace, deployment_id
+			LIMIT $1
+		`),
+		ImportDeployment: CleanSQL(`
+			INSERT INTO deployments (deployment_id, namespace, parent_deployment_id, template_id, site_id, created, updated, version, prepared, approved, package)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, 1, $8, $9, $10)
+			ON CONFLICT (deployment_id)
+				DO UPDATE SET
+				parent_deployment_id = $3, template_id = $4, site_id = $5, created = $6, updated = $7, version = deployments.version + 1, prepared = $8, approved = $9, package = $10, modification_token = NULL, modification_timestamp = 0
+				WHERE deployments.namespace = $2
+			RETURNING version
+		`),
+
+		// Plugins
+
+		DropPlugins:              `DROP TABLE IF EXISTS plugins`,
+		DropPluginsTypeIndex:     `DROP INDEX IF EXISTS plugins_type_index`,
+		DropPluginsExecutorIndex: `DROP INDEX IF EXISTS plugins_executor_index`,
+		DropPluginsTriggers:      `DROP TABLE IF EXISTS plugins_triggers`,
+		DropPluginsTriggersIndex: `DROP INDEX IF EXISTS plugins_triggers_index`,
+
+		UpsertPlugin: CleanSQL(`
+			INSERT INTO plugins (type, name, executor, arguments, properties, version)
+			VALUES ($1, $2, $3, $4, $5, 1)
+			ON CONFLICT (type, name)
+				DO UPDATE SET
+				executor = $3, arguments = $4, properties = $5, version = plugins.version + 1
+				WHERE $6 IN (0, plugins.version)
+			RETURNING version
+		`),
+		InsertPluginTrigger: CleanSQL(`
+			INSERT INTO plugins_triggers (plugin_type, plugin_name, "group", version, kind)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (plugin_type, plugin_name, "group", version, kind)
+				DO NOTHING
+		`),
+		SelectPlugin: CleanSQL(`
+			SELECT executor, arguments, properties, plugins.version, JSON_GROUP_ARRAY (JSON_ARRAY ("group", plugins_triggers.version, kind)) FILTER (WHERE "group" IS NOT NULL)
+			FROM plugins
+			LEFT JOIN plugins_triggers ON plugins.type = plugins_triggers.plugin_type AND plugins.name = plugins_triggers.plugin_name
+			WHERE plugins.type = $1 AND plugins.name = $2
+			GROUP BY plugins.type, plugins.name
+		`),
+		SelectPluginVersion:  `SELECT version FROM plugins WHERE type = $1 AND name = $2`,
+		DeletePlugin:         `DELETE FROM plugins WHERE type = $1 AND name = $2`,
+		DeletePluginTriggers: `DELETE FROM plugins_triggers WHERE plugin_type = $1 AND plugin_name = $2`,
+		DeletePlugins: CleanSQL(`
+			DELETE FROM plugins
+			WHERE (type, name) IN (
+			SELECT plugins.type, plugins.name
+			FROM plugins
+			LEFT JOIN plugins_triggers ON plugins.type = plugins_triggers.plugin_type AND plugins.name = plugins_triggers.plugin_name
+			GROUP BY plugins.type, plugins.name
+			)
+		`),
+		SelectPlugins: CleanSQL(`
+			SELECT plugins.type, plugins.name, executor, arguments, properties, plugins.version, JSON_GROUP_ARRAY (JSON_ARRAY ("group", plugins_triggers.version, kind)) FILTER (WHERE "group" IS NOT NULL)
+			FROM plugins
+			LEFT JOIN plugins_triggers ON plugins.type = plugins_triggers.plugin_type AND plugins.name = plugins_triggers.plugin_name
+			GROUP BY plugins.type, plugins.name
+			ORDER BY plugins.type, plugins.name
+			LIMIT $2 OFFSET $1
+		`),
+
+		// Revisions
+
+		DropRevisions: `DROP TABLE IF EXISTS revisions`,
+
+		InsertRevision: CleanSQL(`
+			INSERT INTO revisions (type, namespace, object_id, revision, author, created, hash, template_id, metadata, package)
+			SELECT $1, $2, $3, COALESCE(MAX(revision), 0) + 1, $4, $5, $6, $7, $8, $9
+			FROM revisions
+			WHERE type = $1 AND namespace = $2 AND object_id = $3
+			RETURNING revision
+		`),
+		SelectRevision: CleanSQL(`
+			SELECT author, created, hash, template_id, metadata, package
+			FROM revisions
+			WHERE type = $1 AND namespace = $2 AND object_id = $3 AND revision = $4
+		`),
+		SelectRevisions: CleanSQL(`
+			SELECT revision, author, created, hash, template_id, metadata
+			FROM revisions
+			WHERE type = $1 AND namespace = $2 AND object_id = $3
+			ORDER BY revision
+			LIMIT $5 OFFSET $4
+		`),
+
+		// Audit
+
+		DropAuditEvents:               `DROP TABLE IF EXISTS audit_events`,
+		DropAuditEventsTimestampIndex: `DROP INDEX IF EXISTS audit_events_timestamp_index`,
+		DropAuditEventsActorIndex:     `DROP INDEX IF EXISTS audit_events_actor_index`,
+
+		InsertAuditEvent: CleanSQL(`
+			INSERT INTO audit_events (timestamp, actor, operation, type, namespace, ids, selector, outcome, reason, diff, claimed_author)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			RETURNING id
+		`),
+		SelectAuditEvents: CleanSQL(`
+			SELECT id, timestamp, actor, operation, type, namespace, ids, selector, outcome, reason, diff, claimed_author
+			FROM audit_events
+			GROUP BY id
+			ORDER BY id
+			LIMIT $2 OFFSET $1
+		`),
+
+		// Events
+
+		DropEvents: `DROP TABLE IF EXISTS events`,
+
+		// Transactions are serialized by "_txlock=immediate", so events are committed in
+		// order of revision, and in lieu of LISTEN/NOTIFY new events are polled
+		InsertEvent: CleanSQL(`
+			INSERT INTO events (type, kind, namespace, object_id, timestamp)
+			VALUES ($1, $2, $3, $4, $5)
+		`),
+		SelectEvents: CleanSQL(`
+			SELECT revision, type, kind, namespace, object_id, timestamp
+			FROM events
+			WHERE revision > $1
+			ORDER BY revision
+		`),
+		SelectEventsRevision: `SELECT COALESCE(MAX(revision), 0) FROM events`,
+		DeleteEvents:         `DELETE FROM events WHERE timestamp < $1`,
+
+		// Package selection
+
+		CreateSelectedPackages: CleanSQL(`
+			CREATE TEMPORARY TABLE IF NOT EXISTS selected_packages (
+				namespace TEXT NOT NULL,
+				id TEXT NOT NULL,
+				PRIMARY KEY (namespace, id)
+			)
+		`),
+		ClearSelectedPackages: `DELETE FROM selected_packages`,
+		InsertSelectedPackage: `INSERT INTO selected_packages (namespace, id) VALUES ($1, $2)`,
+
+		// Blobs
+
+		DropBlobs: `DROP TABLE IF EXISTS blobs`,
+
+		UpsertBlob: CleanSQL(`
+			INSERT INTO blobs (hash, content)
+			VALUES ($1, $2)
+			ON CONFLICT (hash) DO NOTHING
+		`),
+		SelectBlobs: `SELECT hash, content FROM blobs`,
+
+		// Schema
+
+		CreateSchemaMigrations: CleanSQL(`
+			CREATE TABLE IF NOT EXISTS schema_migrations (
+				version BIGINT PRIMARY KEY,
+				description TEXT NOT NULL,
+				applied TIMESTAMP NOT NULL
+			)
+		`),
+		DropSchemaMigrations: `DROP TABLE IF EXISTS schema_migrations`,
+
+		// Transactions are serialized by "_txlock=immediate"
+		SelectSchemaVersion:   `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`,
+		InsertSchemaMigration: `INSERT INTO schema_migrations (version, description, applied) VALUES ($1, $2, $3)`,
+	}
+
+	statements.Migrations = sqliteMigrations
+
+	return statements
+}
+
+// Utils
+
+var sqliteRegexps sync.Map
+
+func init() {
+	// SQLite calls "regexp(pattern, value)" for "value REGEXP pattern"
+	if err := sqlite.RegisterDeterministicScalarFunction("regexp", 2, func(context *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		pattern, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("regexp pattern is not a string: %T", args[0])
+		}
+
+		var value string
+		switch value_ := args[1].(type) {
+		case nil:
+			return nil, nil
+		case string:
+			value = value_
+		case []byte:
+			value = string(value_)
+		default:
+			value = fmt.Sprintf("%v", value_)
+		}
+
+		if re, ok := sqliteRegexps.Load(pattern); ok {
+			return re.(*regexp.Regexp).MatchString(value), nil
+		} else if re, err := regexp.Compile(pattern); err == nil {
+			sqliteRegexps.Store(pattern, re)
+			return re.MatchString(value), nil
+		} else {
+			return nil, err
+		}
+	}); err != nil {
+		panic(err)
+	}
+
+	// Foreign keys are required for cascading deletes
+	sqlite.RegisterConnectionHook(func(conn sqlite.ExecQuerierContext, dataSource string) error {
+		for _, pragma := range []string{
+			`PRAGMA foreign_keys = ON`,
+			fmt.Sprintf(`PRAGMA busy_timeout = %d`, SQLiteBusyTimeout),
+			`PRAGMA journal_mode = WAL`,
+		} {
+			if _, err := conn.ExecContext(contextpkg.Background(), pragma, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
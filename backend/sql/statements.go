@@ -17,65 +17,68 @@ import (
 //
 
 type Statements struct {
+	// Dialect
+
+	RegexpOperator string // left operand is the value, right operand is the pattern
+
 	// Templates
 
-	CreateTemplates              string
-	DropTemplates                string
-	CreateTemplatesMetadata      string
-	DropTemplatesMetadata        string
-	CreateTemplatesMetadataIndex string
-	DropTemplatesMetadataIndex   string
-	CreateTemplatesDeployments   string
-	DropTemplatesDeployments     string
-
-	UpsertTemplate           string
-	UpsertTemplateMetadata   string
-	UpsertTemplateDeployment string
-	SelectTemplate           string
-	DeleteTemplate           string
-	DeleteTemplateMetadata   string
-	DeleteTemplateDeployment string
-	DeleteTemplates          string
-	SelectTemplates          string
+	DropTemplates              string
+	DropTemplatesMetadata      string
+	DropTemplatesMetadataIndex string
+	DropTemplatesDeployments   string
+
+	UpsertTemplate                  string
+	UpsertTemplateMetadata          string
+	UpsertTemplateDeployment        string
+	SelectTemplate                  string
+	SelectTemplateVersion           string
+	DeleteTemplate                  string
+	DeleteTemplateMetadata          string
+	DeleteTemplateDeployment        string
+	DeleteTemplates                 string
+	SelectTemplates                 string
+	SelectTemplatePackages          string
+	DisassociateTemplateSites       string
+	DisassociateTemplateDeployments string
 
 	// Sites
 
-	CreateSites              string
-	DropSites                string
-	CreateSitesMetadata      string
-	DropSitesMetadata        string
-	CreateSitesMetadataIndex string
-	DropSitesMetadataIndex   string
-	CreateSitesDeployments   string
-	DropSitesDeployments     string
-
-	UpsertSite           string
-	UpsertSiteMetadata   string
-	UpsertSiteDeployment string
-	SelectSite           string
-	DeleteSite           string
-	DeleteSiteMetadata   string
-	DeleteSiteDeployment string
-	DeleteSites          string
-	SelectSites          string
+	DropSites                   string
+	DropSitesMetadata           string
+	DropSitesMetadataIndex      string
+	DropSitesDeployments        string
+	DropSitesDeletedDeployments string
+
+	UpsertSite                   string
+	UpsertSiteMetadata           string
+	UpsertSiteDeployment         string
+	SelectSite                   string
+	SelectSiteVersion            string
+	DeleteSite                   string
+	DeleteSiteMetadata           string
+	DeleteSiteDeployment         string
+	DeleteSites                  string
+	SelectSites                  string
+	SelectSitePackages           string
+	DisassociateSiteDeployments  string
+	InsertSiteDeletedDeployment  string
+	SelectSiteDeletedDeployments string
+	DeleteSiteDeletedDeployment  string
 
 	// Deployments
 
-	CreateDeployments                  string
-	DropDeployments                    string
-	CreateDeploymentsMetadata          string
-	DropDeploymentsMetadata            string
-	CreateDeploymentsMetadataIndex     string
-	DropDeploymentsMetadataIndex       string
-	CreateDeploymentsPreparedIndex     string
-	DropDeploymentsPreparedIndex       string
-	CreateDeploymentsApprovedIndex     string
-	DropDeploymentsApprovedIndex       string
-	CreateDeploymentsModificationIndex string
-	DropDeploymentsModificationIndex   string
+	DropDeployments                  string
+	DropDeploymentsMetadata          string
+	DropDeploymentsMetadataIndex     string
+	DropDeploymentsPreparedIndex     string
+	DropDeploymentsApprovedIndex     string
+	DropDeploymentsModificationIndex string
+	DropDeploymentsPendingDeletes    string
 
 	InsertDeployment                 string
 	UpdateDeployment                 string
+	UpdateUnmodifiedDeployment       string
 	UpsertDeploymentMetadata         string
 	SelectDeployment                 string
 	SelectDeploymentWithModification string
@@ -84,30 +87,87 @@ type Statements struct {
 	ResetDeploymentModification      string
 	DeleteDeployment                 string
 	DeleteDeploymentMetadata         string
-	DeleteDeployments                string
 	SelectDeployments                string
+	SelectDeploymentPackages         string
+	CountDeployments                 string
+	SelectDeploymentDescendants      string
+	ImportDeployment                 string
+	OrphanDeploymentChildren         string
+	InsertDeploymentPendingDelete    string
+	SelectDeploymentPendingDeletes   string
 
 	// Plugins
 
-	CreatePlugins              string
-	DropPlugins                string
-	CreatePluginsTypeIndex     string
-	DropPluginsTypeIndex       string
-	CreatePluginsExecutorIndex string
-	DropPluginsExecutorIndex   string
-	CreatePluginsTriggers      string
-	DropPluginsTriggers        string
-	CreatePluginsTriggersIndex string
-	DropPluginsTriggersIndex   string
+	DropPlugins              string
+	DropPluginsTypeIndex     string
+	DropPluginsExecutorIndex string
+	DropPluginsTriggers      string
+	DropPluginsTriggersIndex string
 
 	UpsertPlugin         string
 	InsertPluginTrigger  string
 	SelectPlugin         string
+	SelectPluginVersion  string
 	DeletePlugin         string
 	DeletePluginTriggers string
 	DeletePlugins        string
 	SelectPlugins        string
 
+	// Revisions
+
+	DropRevisions string
+
+	InsertRevision  string
+	SelectRevision  string
+	SelectRevisions string
+
+	// Audit
+
+	DropAuditEvents               string
+	DropAuditEventsTimestampIndex string
+	DropAuditEventsActorIndex     string
+
+	InsertAuditEvent  string
+	SelectAuditEvents string
+
+	// Events
+
+	DropEvents string
+
+	LockEvents           string // optional
+	NotifyEvents         string // optional
+	ListenEvents         string // optional; requires the pgx driver
+	UnlistenEvents       string // optional; requires the pgx driver
+	InsertEvent          string
+	SelectEvents         string
+	SelectEventsRevision string
+	DeleteEvents         string
+
+	// Package selection (temporary table)
+
+	CreateSelectedPackages string
+	ClearSelectedPackages  string
+	InsertSelectedPackage  string
+
+	// Blobs
+
+	DropBlobs string
+
+	UpsertBlob  string
+	SelectBlobs string // WHERE is generated
+
+	// Schema
+
+	CreateSchemaMigrations string
+	DropSchemaMigrations   string
+
+	LockSchemaMigrations  string // optional
+	SelectSchemaVersion   string
+	InsertSchemaMigration string
+
+	// Ordered by version; see [Statements.Migrate]
+	Migrations []Migration
+
 	// These statements will be automatically prepared and released
 	// The source SQL field has the same name without the "Prepared" prefix
 
@@ -126,8 +186,15 @@ type Statements struct {
 	PreparedDeleteSite                            *sql.Stmt
 	PreparedDeleteSiteMetadata                    *sql.Stmt
 	PreparedDeleteSiteDeployment                  *sql.Stmt
+	PreparedDisassociateTemplateSites             *sql.Stmt
+	PreparedDisassociateTemplateDeployments       *sql.Stmt
+	PreparedDisassociateSiteDeployments           *sql.Stmt
+	PreparedInsertSiteDeletedDeployment           *sql.Stmt
+	PreparedSelectSiteDeletedDeployments          *sql.Stmt
+	PreparedDeleteSiteDeletedDeployment           *sql.Stmt
 	PreparedInsertDeployment                      *sql.Stmt
 	PreparedUpdateDeployment                      *sql.Stmt
+	PreparedUpdateUnmodifiedDeployment            *sql.Stmt
 	PreparedUpsertDeploymentMetadata              *sql.Stmt
 	PreparedSelectDeployment                      *sql.Stmt
 	PreparedSelectDeploymentWithModification      *sql.Stmt
@@ -136,11 +203,29 @@ type Statements struct {
 	PreparedResetDeploymentModification           *sql.Stmt
 	PreparedDeleteDeployment                      *sql.Stmt
 	PreparedDeleteDeploymentMetadata              *sql.Stmt
+	PreparedSelectDeploymentDescendants           *sql.Stmt
+	PreparedOrphanDeploymentChildren              *sql.Stmt
+	PreparedImportDeployment                      *sql.Stmt
+	PreparedInsertDeploymentPendingDelete         *sql.Stmt
+	PreparedSelectDeploymentPendingDeletes        *sql.Stmt
+	PreparedCountDeployments                      *sql.Stmt
 	PreparedUpsertPlugin                          *sql.Stmt
 	PreparedInsertPluginTrigger                   *sql.Stmt
 	PreparedSelectPlugin                          *sql.Stmt
 	PreparedDeletePlugin                          *sql.Stmt
 	PreparedDeletePluginTriggers                  *sql.Stmt
+	PreparedInsertRevision                        *sql.Stmt
+	PreparedSelectRevision                        *sql.Stmt
+	PreparedSelectRevisions                       *sql.Stmt
+	PreparedInsertAuditEvent                      *sql.Stmt
+	PreparedInsertEvent                           *sql.Stmt
+	PreparedSelectEvents                          *sql.Stmt
+	PreparedSelectEventsRevision                  *sql.Stmt
+	PreparedDeleteEvents                          *sql.Stmt
+	PreparedSelectTemplateVersion                 *sql.Stmt
+	PreparedSelectSiteVersion                     *sql.Stmt
+	PreparedSelectPluginVersion                   *sql.Stmt
+	PreparedUpsertBlob                            *sql.Stmt
 
 	db  *sql.DB
 	log commonlog.Logger
@@ -151,6 +236,9 @@ func NewStatements(driver string, db *sql.DB, log commonlog.Logger) *Statements
 	case "pgx":
 		return NewPostgresqlStatements(db, log)
 
+	case "sqlite":
+		return NewSQLiteStatements(db, log)
+
 	default:
 		panic(fmt.Sprintf("unsupported SQL driver: %s", driver))
 	}
@@ -180,45 +268,27 @@ func (self *Statements) Release() {
 	}
 }
 
-func (self *Statements) CreateTables(context contextpkg.Context) error {
-	return self.execAll(context, true,
-		self.CreateTemplates,
-		self.CreateTemplatesMetadata,
-		self.CreateTemplatesMetadataIndex,
-
-		self.CreateSites,
-		self.CreateSitesMetadata,
-		self.CreateSitesMetadataIndex,
-
-		self.CreateDeployments,
-		self.CreateDeploymentsMetadata,
-		self.CreateDeploymentsMetadataIndex,
-		self.CreateDeploymentsPreparedIndex,
-		self.CreateDeploymentsApprovedIndex,
-		self.CreateDeploymentsModificationIndex,
-
-		self.CreateTemplatesDeployments,
-		self.CreateSitesDeployments,
-
-		self.CreatePlugins,
-		self.CreatePluginsTypeIndex,
-		self.CreatePluginsExecutorIndex,
-		self.CreatePluginsTriggers,
-		self.CreatePluginsTriggersIndex,
-	)
-}
-
 func (self *Statements) DropTables(context contextpkg.Context) error {
 	return self.execAll(context, false,
+		self.DropEvents,
+
+		self.DropAuditEventsActorIndex,
+		self.DropAuditEventsTimestampIndex,
+		self.DropAuditEvents,
+
+		self.DropRevisions,
+
 		self.DropPluginsTriggersIndex,
 		self.DropPluginsTriggers,
 		self.DropPluginsTypeIndex,
 		self.DropPluginsExecutorIndex,
 		self.DropPlugins,
 
+		self.DropSitesDeletedDeployments,
 		self.DropSitesDeployments,
 		self.DropTemplatesDeployments,
 
+		self.DropDeploymentsPendingDeletes,
 		self.DropDeploymentsMetadataIndex,
 		self.DropDeploymentsMetadata,
 		self.DropDeploymentsModificationIndex,
@@ -233,6 +303,10 @@ func (self *Statements) DropTables(context contextpkg.Context) error {
 		self.DropTemplatesMetadataIndex,
 		self.DropTemplatesMetadata,
 		self.DropTemplates,
+
+		self.DropBlobs,
+
+		self.DropSchemaMigrations,
 	)
 }
 
@@ -11,51 +11,24 @@ import (
 
 // ([backend.Backend] interface)
 func (self *SQLBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
-	var package_ []byte
-	var err error
-	if package_, err = self.encodePackage(template.Package); err != nil {
-		return err
-	}
-
-	if tx, err := self.db.BeginTx(context, nil); err == nil {
-		template.Updated = time.Now().UTC()
-		upsertTemplate := tx.StmtContext(context, self.statements.PreparedUpsertTemplate)
-		if _, err := upsertTemplate.ExecContext(context, template.TemplateID, template.Updated, package_); err == nil {
-			if err := self.updateTemplateMetadata(context, tx, template); err != nil {
-				self.rollback(tx)
-				return err
-			}
+	return self.setTemplate(context, template, false)
+}
 
-			return tx.Commit()
-		} else {
-			self.rollback(tx)
-			return err
-		}
-	} else {
-		return err
-	}
+// ([backend.Backend] interface)
+func (self *SQLBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	template.Version = 0
+	return self.setTemplate(context, template, true)
 }
 
 // ([backend.Backend] interface)
-func (self *SQLBackend) GetTemplate(context contextpkg.Context, templateId string) (*backend.Template, error) {
-	return self.getTemplateStmt(context, self.statements.PreparedSelectTemplate, templateId)
+func (self *SQLBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
+	return self.getTemplateStmt(context, self.db, self.statements.PreparedSelectTemplate, namespace, templateId)
 }
 
 // ([backend.Backend] interface)
-func (self *SQLBackend) DeleteTemplate(context contextpkg.Context, templateId string) error {
+func (self *SQLBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
 	// Will cascade delete templates_metadata, templates_deployments
-	if result, err := self.statements.PreparedDeleteTemplate.ExecContext(context, templateId); err == nil {
-		if count, err := result.RowsAffected(); err == nil {
-			if count == 0 {
-				return backend.NewNotFoundErrorf("template: %s", templateId)
-			}
-			return nil
-		} else {
-			return err
-		}
-	} else {
-		return err
-	}
+	return self.deleteWithEvent(context, self.statements.PreparedDeleteTemplate, self.templateDisassociateStmts(), backend.EventKindTemplate, namespace, templateId, namespace, templateId)
 }
 
 // ([backend.Backend] interface)
@@ -64,44 +37,75 @@ func (self *SQLBackend) ListTemplates(context contextpkg.Context, selectTemplate
 	var args SqlArgs
 	var with SqlWith
 	var where SqlWhere
+	var selection packageSelection
 
 	args.AddValue(window.Offset)
 	args.AddValue(window.Limit())
 
+	if selectTemplates.Namespace != backend.AllNamespaces {
+		where.Add(`templates.namespace = ` + args.Add(selectTemplates.Namespace))
+	}
+
 	for _, pattern := range selectTemplates.TemplateIDPatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`templates.template_id ~ ` + pattern)
+		where.Add(self.regexp(`templates.template_id`, pattern))
 	}
 
 	if len(selectTemplates.MetadataPatterns) > 0 {
 		for key, pattern := range selectTemplates.MetadataPatterns {
 			key = args.Add(key)
 			pattern = args.Add(backend.PatternRE(pattern))
-			with.Add(`SELECT template_id FROM templates_metadata WHERE (key = `+key+`) AND (value ~ `+pattern+`)`,
-				`templates`, `template_id`)
+			with.Add(`SELECT namespace, template_id FROM templates_metadata WHERE (key = `+key+`) AND (`+self.regexp(`value`, pattern)+`)`,
+				`templates`, `namespace`, `template_id`)
+		}
+	}
+
+	if selectors, err := backend.ParseSelectors(selectTemplates.MetadataSelector, selectTemplates.PackageSelector); err == nil {
+		if selectors.Metadata != nil {
+			where.Add(self.metadataSelectorSql(selectors.Metadata, &args, `templates_metadata`, `templates`, `namespace`, `template_id`))
 		}
+
+		if selectors.Package != nil {
+			selection = packageSelection{
+				Statement:  self.statements.SelectTemplatePackages,
+				IDColumn:   `template_id`,
+				Namespace:  selectTemplates.Namespace,
+				Expression: selectors.Package,
+			}
+			where.Add(selectedPackagesSql(`templates.namespace`, `templates.template_id`))
+		}
+	} else {
+		return nil, err
 	}
 
 	sql = with.Apply(sql)
 	sql = where.Apply(sql)
 	self.log.Debugf("generated SQL:\n%s", sql)
 
-	rows, err := self.db.QueryContext(context, sql, args.Args...)
+	querier, release, err := self.selectPackagesQuerier(context, selection)
 	if err != nil {
 		return nil, err
 	}
 
+	rows, err := querier.QueryContext(context, sql, args.Args...)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
 	stream := util.NewResultsStream[backend.TemplateInfo](func() {
 		self.closeRows(rows)
+		release()
 	})
 
 	go func() {
 		for rows.Next() {
-			var templateId string
+			var namespace, templateId string
 			var updated time.Time
+			var version uint64
 			var metadataJson, deploymentIdsJson []byte
-			if err := rows.Scan(&templateId, &updated, &metadataJson, &deploymentIdsJson); err == nil {
-				if templateInfo, err := self.newTemplateInfo(templateId, updated, metadataJson, deploymentIdsJson); err == nil {
+			if err := rows.Scan(&namespace, &templateId, &updated, &version, &metadataJson, &deploymentIdsJson); err == nil {
+				if templateInfo, err := self.newTemplateInfo(namespace, templateId, updated, version, metadataJson, deploymentIdsJson); err == nil {
 					stream.Send(templateInfo)
 				} else {
 					stream.Close(err)
@@ -124,36 +128,120 @@ func (self *SQLBackend) PurgeTemplates(context contextpkg.Context, selectTemplat
 	sql := self.statements.DeleteTemplates
 	var args SqlArgs
 	var where SqlWhere
+	var selection packageSelection
+
+	where.Add(`templates.namespace = ` + args.Add(selectTemplates.Namespace))
 
 	for _, pattern := range selectTemplates.TemplateIDPatterns {
 		pattern = args.Add(backend.IDPatternRE(pattern))
-		where.Add(`template_id ~ ` + pattern)
+		where.Add(self.regexp(`templates.template_id`, pattern))
 	}
 
 	if len(selectTemplates.MetadataPatterns) > 0 {
+		where.Add(`templates.namespace = templates_metadata.namespace`)
 		where.Add(`templates.template_id = templates_metadata.template_id`)
 		for key, pattern := range selectTemplates.MetadataPatterns {
 			key = args.Add(key)
 			pattern = args.Add(backend.PatternRE(pattern))
 			where.Add(`key = ` + key)
-			where.Add(`value ~ ` + pattern)
+			where.Add(self.regexp(`value`, pattern))
 		}
 	}
 
+	if selectors, err := backend.ParseSelectors(selectTemplates.MetadataSelector, selectTemplates.PackageSelector); err == nil {
+		if selectors.Metadata != nil {
+			where.Add(self.metadataSelectorSql(selectors.Metadata, &args, `templates_metadata`, `templates`, `namespace`, `template_id`))
+		}
+
+		if selectors.Package != nil {
+			selection = packageSelection{
+				Statement:  self.statements.SelectTemplatePackages,
+				IDColumn:   `template_id`,
+				Namespace:  selectTemplates.Namespace,
+				Expression: selectors.Package,
+			}
+			where.Add(selectedPackagesSql(`templates.namespace`, `templates.template_id`))
+		}
+	} else {
+		return err
+	}
+
 	sql = where.Apply(sql)
+	sql += "\nRETURNING templates.template_id"
 	self.log.Debugf("generated SQL:\n%s", sql)
 
-	_, err := self.db.ExecContext(context, sql, args.Args...)
-	return err
+	return self.purge(context, sql, args.Args, selection, backend.EventKindTemplate, selectTemplates.Namespace, scanID, self.templateDisassociateStmts()...)
 }
 
 // Utils
 
-func (self *SQLBackend) newTemplateInfo(templateId string, updated time.Time, metadataJson []byte, deploymentIdsJson []byte) (backend.TemplateInfo, error) {
+func (self *SQLBackend) setTemplate(context contextpkg.Context, template *backend.Template, importing bool) error {
+	if tx, err := self.db.BeginTx(context, nil); err == nil {
+		var package_ []byte
+		var err error
+		if package_, err = self.encodePackage(context, tx, template.Package); err != nil {
+			self.rollback(tx)
+			return err
+		}
+
+		eventType := backend.EventTypeAdded
+		if version, exists, err := self.selectVersion(context, tx, self.statements.PreparedSelectTemplateVersion, template.Namespace, template.TemplateID); err == nil {
+			if exists {
+				eventType = backend.EventTypeUpdated
+			}
+
+			if err := backend.CheckVersion(backend.EventKindTemplate, template.TemplateID, template.Version, version); err != nil {
+				self.rollback(tx)
+				return err
+			}
+		} else {
+			self.rollback(tx)
+			return err
+		}
+
+		if !importing || template.Updated.IsZero() {
+			template.Updated = time.Now().UTC()
+		}
+		if version, err := self.upsertWithVersion(context, tx, self.statements.PreparedUpsertTemplate, backend.EventKindTemplate, template.TemplateID, template.Namespace, template.TemplateID, template.Updated, package_, template.Version); err == nil {
+			template.Version = version
+
+			if err := self.updateTemplateMetadata(context, tx, template); err != nil {
+				self.rollback(tx)
+				return err
+			}
+
+			if revision, err := backend.NewTemplateRevision(context, template); err == nil {
+				if err := self.insertRevision(context, tx, revision); err != nil {
+					self.rollback(tx)
+					return err
+				}
+			} else {
+				self.rollback(tx)
+				return err
+			}
+
+			if err := self.insertEvents(context, tx, backend.NewEvent(eventType, backend.EventKindTemplate, template.Namespace, template.TemplateID)); err != nil {
+				self.rollback(tx)
+				return err
+			}
+
+			return tx.Commit()
+		} else {
+			self.rollback(tx)
+			return err
+		}
+	} else {
+		return err
+	}
+}
+
+func (self *SQLBackend) newTemplateInfo(namespace string, templateId string, updated time.Time, version uint64, metadataJson []byte, deploymentIdsJson []byte) (backend.TemplateInfo, error) {
 	templateInfo := backend.TemplateInfo{
+		Namespace:  namespace,
 		TemplateID: templateId,
 		Metadata:   make(map[string]string),
 		Updated:    updated,
+		Version:    version,
 	}
 
 	if err := jsonUnmarshallStringMapEntries(metadataJson, templateInfo.Metadata); err != nil {
@@ -167,10 +255,10 @@ func (self *SQLBackend) newTemplateInfo(templateId string, updated time.Time, me
 	return templateInfo, nil
 }
 
-func (self *SQLBackend) newTemplate(templateId string, updated time.Time, metadataJson []byte, deploymentIdsJson []byte, package_ []byte) (*backend.Template, error) {
-	if templateInfo, err := self.newTemplateInfo(templateId, updated, metadataJson, deploymentIdsJson); err == nil {
+func (self *SQLBackend) newTemplate(context contextpkg.Context, querier querier, namespace string, templateId string, updated time.Time, version uint64, metadataJson []byte, deploymentIdsJson []byte, package_ []byte) (*backend.Template, error) {
+	if templateInfo, err := self.newTemplateInfo(namespace, templateId, updated, version, metadataJson, deploymentIdsJson); err == nil {
 		template := backend.Template{TemplateInfo: templateInfo}
-		if template.Package, err = self.decodePackage(package_); err == nil {
+		if template.Package, err = self.decodePackage(context, querier, package_); err == nil {
 			return &template, nil
 		} else {
 			return nil, err
@@ -180,13 +268,14 @@ func (self *SQLBackend) newTemplate(templateId string, updated time.Time, metada
 	}
 }
 
-func (self *SQLBackend) getTemplateTx(context contextpkg.Context, tx *sql.Tx, templateId string) (*backend.Template, error) {
+func (self *SQLBackend) getTemplateTx(context contextpkg.Context, tx *sql.Tx, namespace string, templateId string) (*backend.Template, error) {
 	selectTemplate := tx.StmtContext(context, self.statements.PreparedSelectTemplate)
-	return self.getTemplateStmt(context, selectTemplate, templateId)
+	return self.getTemplateStmt(context, tx, selectTemplate, namespace, templateId)
 }
 
-func (self *SQLBackend) getTemplateStmt(context contextpkg.Context, selectTemplate *sql.Stmt, templateId string) (*backend.Template, error) {
-	rows, err := selectTemplate.QueryContext(context, templateId)
+// The querier must be the one on which the statement is prepared.
+func (self *SQLBackend) getTemplateStmt(context contextpkg.Context, querier querier, selectTemplate *sql.Stmt, namespace string, templateId string) (*backend.Template, error) {
+	rows, err := selectTemplate.QueryContext(context, namespace, templateId)
 	if err != nil {
 		return nil, err
 	}
@@ -194,29 +283,39 @@ func (self *SQLBackend) getTemplateStmt(context contextpkg.Context, selectTempla
 
 	if rows.Next() {
 		var updated time.Time
+		var version uint64
 		var package_, metadataJson, deploymentIdsJson []byte
-		if err := rows.Scan(&updated, &package_, &metadataJson, &deploymentIdsJson); err == nil {
-			return self.newTemplate(templateId, updated, metadataJson, deploymentIdsJson, package_)
+		if err := rows.Scan(&updated, &version, &package_, &metadataJson, &deploymentIdsJson); err == nil {
+			self.closeRows(rows)
+			return self.newTemplate(context, querier, namespace, templateId, updated, version, metadataJson, deploymentIdsJson, package_)
 		} else {
 			return nil, err
 		}
 	}
 
-	return nil, backend.NewNotFoundErrorf("template: %s", templateId)
+	return nil, backend.NewNotFoundErrorf("template: %s/%s", namespace, templateId)
 }
 
 func (self *SQLBackend) updateTemplateMetadata(context contextpkg.Context, tx *sql.Tx, template *backend.Template) error {
 	deleteTemplateMetadata := tx.StmtContext(context, self.statements.PreparedDeleteTemplateMetadata)
-	if _, err := deleteTemplateMetadata.ExecContext(context, template.TemplateID); err != nil {
+	if _, err := deleteTemplateMetadata.ExecContext(context, template.Namespace, template.TemplateID); err != nil {
 		return err
 	}
 
 	upsertTemplateMetadata := tx.StmtContext(context, self.statements.PreparedUpsertTemplateMetadata)
 	for key, value := range template.Metadata {
-		if _, err := upsertTemplateMetadata.ExecContext(context, template.TemplateID, key, value); err != nil {
+		if _, err := upsertTemplateMetadata.ExecContext(context, template.Namespace, template.TemplateID, key, value); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// Composite foreign keys cannot "SET NULL" just the ID column, so we remove associations explicitly.
+func (self *SQLBackend) templateDisassociateStmts() []*sql.Stmt {
+	return []*sql.Stmt{
+		self.statements.PreparedDisassociateTemplateSites,
+		self.statements.PreparedDisassociateTemplateDeployments,
+	}
+}
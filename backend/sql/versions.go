@@ -0,0 +1,34 @@
+package sql
+
+import (
+	contextpkg "context"
+	"database/sql"
+	"errors"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// Returns false if the object does not exist.
+func (self *SQLBackend) selectVersion(context contextpkg.Context, tx *sql.Tx, stmt *sql.Stmt, args ...any) (uint64, bool, error) {
+	var version uint64
+	if err := tx.StmtContext(context, stmt).QueryRowContext(context, args...).Scan(&version); err == nil {
+		return version, true, nil
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	} else {
+		return 0, false, err
+	}
+}
+
+// The upsert statement is expected to return no rows if the version check failed, which can
+// happen if the object was modified after we selected its version.
+func (self *SQLBackend) upsertWithVersion(context contextpkg.Context, tx *sql.Tx, stmt *sql.Stmt, kind string, id string, args ...any) (uint64, error) {
+	var version uint64
+	if err := tx.StmtContext(context, stmt).QueryRowContext(context, args...).Scan(&version); err == nil {
+		return version, nil
+	} else if errors.Is(err, sql.ErrNoRows) {
+		return 0, backend.NewConflictErrorf("%s: %s", kind, id)
+	} else {
+		return 0, err
+	}
+}
@@ -1,8 +1,8 @@
 package backend
 
 import (
+	"io"
 	"slices"
-	"strings"
 	"time"
 
 	"github.com/nephio-experimental/tko/util"
@@ -13,25 +13,31 @@ import (
 //
 
 type TemplateInfo struct {
+	Namespace     string
 	TemplateID    string
 	Metadata      map[string]string
 	Updated       time.Time // millisecond precision
+	Version       uint64    // incremented on every write
 	DeploymentIDs []string
 }
 
 func (self *TemplateInfo) Clone(withDeployments bool) TemplateInfo {
 	if withDeployments {
 		return TemplateInfo{
+			Namespace:     self.Namespace,
 			TemplateID:    self.TemplateID,
 			Metadata:      util.CloneStringMap(self.Metadata),
 			Updated:       self.Updated,
+			Version:       self.Version,
 			DeploymentIDs: util.CloneStringList(self.DeploymentIDs),
 		}
 	} else {
 		return TemplateInfo{
+			Namespace:  self.Namespace,
 			TemplateID: self.TemplateID,
 			Metadata:   util.CloneStringMap(self.Metadata),
 			Updated:    self.Updated,
+			Version:    self.Version,
 		}
 	}
 }
@@ -42,7 +48,7 @@ func (self *TemplateInfo) UpdateFromPackage(package_ util.Package) {
 
 func SortTemplateInfos(templateInfos []TemplateInfo) {
 	slices.SortFunc(templateInfos, func(a TemplateInfo, b TemplateInfo) int {
-		return strings.Compare(a.TemplateID, b.TemplateID)
+		return CompareNamespacedIDs(a.Namespace, a.TemplateID, b.Namespace, b.TemplateID)
 	})
 }
 
@@ -55,18 +61,31 @@ type Template struct {
 	Package util.Package
 }
 
-func NewTemplateFromBytes(templateId string, metadata map[string]string, packageFormat string, package_ []byte) (*Template, error) {
+func NewTemplate(namespace string, templateId string, metadata map[string]string, package_ util.Package) *Template {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	return &Template{
+		TemplateInfo: TemplateInfo{
+			Namespace:  namespace,
+			TemplateID: templateId,
+			Metadata:   metadata,
+		},
+		Package: package_,
+	}
+}
+
+func NewTemplateFromBytes(namespace string, templateId string, metadata map[string]string, packageFormat string, package_ []byte) (*Template, error) {
 	if package__, err := util.DecodePackage(packageFormat, package_); err == nil {
-		if metadata == nil {
-			metadata = make(map[string]string)
-		}
-		return &Template{
-			TemplateInfo: TemplateInfo{
-				TemplateID: templateId,
-				Metadata:   metadata,
-			},
-			Package: package__,
-		}, nil
+		return NewTemplate(namespace, templateId, metadata, package__), nil
+	} else {
+		return nil, err
+	}
+}
+
+func NewTemplateFromReader(namespace string, templateId string, metadata map[string]string, packageFormat string, reader io.Reader) (*Template, error) {
+	if package_, err := util.ReadPackage(packageFormat, reader); err == nil {
+		return NewTemplate(namespace, templateId, metadata, package_), nil
 	} else {
 		return nil, err
 	}
@@ -104,6 +123,9 @@ func (self *Template) RemoveDeployment(deploymentId string) bool {
 //
 
 type SelectTemplates struct {
+	Namespace          string // can be AllNamespaces for listing
 	TemplateIDPatterns []string
 	MetadataPatterns   map[string]string
+	MetadataSelector   string // see [selector.Parse]
+	PackageSelector    string // see [selector.Parse]
 }
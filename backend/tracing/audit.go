@@ -0,0 +1,25 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	context, span := telemetry.StartSpan(context, "backend.recordAuditEvent")
+	err := self.Backend.RecordAuditEvent(context, event)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	context, span := telemetry.StartSpan(context, "backend.listAuditEvents")
+	results, err := self.Backend.ListAuditEvents(context, selectAuditEvents, window)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
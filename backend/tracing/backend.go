@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	backendpkg "github.com/nephio-experimental/tko/backend"
+)
+
+var _ backendpkg.Backend = new(TracingBackend)
+
+//
+// TracingBackend
+//
+
+type TracingBackend struct {
+	Backend backendpkg.Backend
+}
+
+// Wraps an existing backend with an OpenTelemetry span for every operation. For list and
+// watch operations the span ends when the results are available.
+func NewTracingBackend(backend backendpkg.Backend) *TracingBackend {
+	return &TracingBackend{
+		Backend: backend,
+	}
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) Connect(context contextpkg.Context) error {
+	return self.Backend.Connect(context)
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) Release(context contextpkg.Context) error {
+	return self.Backend.Release(context)
+}
+
+// ([fmt.Stringer] interface)
+// ([backend.Backend] interface)
+func (self *TracingBackend) String() string {
+	return self.Backend.String()
+}
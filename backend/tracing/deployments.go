@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	validationpkg "github.com/nephio-experimental/tko/validation"
+	"github.com/tliron/kutil/util"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) CreateDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	context, span := telemetry.StartSpan(context, "backend.createDeployment", attribute.String("tko.namespace", deployment.Namespace), attribute.String("tko.deployment_id", deployment.DeploymentID))
+	err := self.Backend.CreateDeployment(context, deployment)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
+	context, span := telemetry.StartSpan(context, "backend.getDeployment", attribute.String("tko.namespace", namespace), attribute.String("tko.deployment_id", deploymentId))
+	deployment, err := self.Backend.GetDeployment(context, namespace, deploymentId)
+	telemetry.EndSpan(span, err)
+	return deployment, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
+	context, span := telemetry.StartSpan(context, "backend.deleteDeployment", attribute.String("tko.namespace", namespace), attribute.String("tko.deployment_id", deploymentId))
+	err := self.Backend.DeleteDeployment(context, namespace, deploymentId, propagation)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ListDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, window backend.Window) (util.Results[backend.DeploymentInfo], error) {
+	context, span := telemetry.StartSpan(context, "backend.listDeployments")
+	results, err := self.Backend.ListDeployments(context, selectDeployments, window)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
+	context, span := telemetry.StartSpan(context, "backend.purgeDeployments")
+	err := self.Backend.PurgeDeployments(context, selectDeployments, propagation)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
+	context, span := telemetry.StartSpan(context, "backend.startDeploymentModification", attribute.String("tko.namespace", namespace), attribute.String("tko.deployment_id", deploymentId))
+	modificationToken, deployment, err := self.Backend.StartDeploymentModification(context, namespace, deploymentId)
+	telemetry.EndSpan(span, err)
+	return modificationToken, deployment, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) EndDeploymentModification(context contextpkg.Context, modificationToken string, package_ tkoutil.Package, validation *validationpkg.Validation) (string, error) {
+	context, span := telemetry.StartSpan(context, "backend.endDeploymentModification")
+	deploymentId, err := self.Backend.EndDeploymentModification(context, modificationToken, package_, validation)
+	telemetry.EndSpan(span, err)
+	return deploymentId, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) CancelDeploymentModification(context contextpkg.Context, modificationToken string) error {
+	context, span := telemetry.StartSpan(context, "backend.cancelDeploymentModification")
+	err := self.Backend.CancelDeploymentModification(context, modificationToken)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	context, span := telemetry.StartSpan(context, "backend.modifyDeployments")
+	results, err := self.Backend.ModifyDeployments(context, selectDeployments, modifyDeployments)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
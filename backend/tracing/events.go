@@ -0,0 +1,25 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	context, span := telemetry.StartSpan(context, "backend.watch")
+	results, err := self.Backend.Watch(context, selectEvents)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	context, span := telemetry.StartSpan(context, "backend.getEventRevision")
+	revision, err := self.Backend.GetEventRevision(context)
+	telemetry.EndSpan(span, err)
+	return revision, err
+}
@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	context, span := telemetry.StartSpan(context, "backend.importTemplate", attribute.String("tko.namespace", template.Namespace), attribute.String("tko.template_id", template.TemplateID))
+	err := self.Backend.ImportTemplate(context, template)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	context, span := telemetry.StartSpan(context, "backend.importSite", attribute.String("tko.namespace", site.Namespace), attribute.String("tko.site_id", site.SiteID))
+	err := self.Backend.ImportSite(context, site)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	context, span := telemetry.StartSpan(context, "backend.importDeployment", attribute.String("tko.namespace", deployment.Namespace), attribute.String("tko.deployment_id", deployment.DeploymentID))
+	err := self.Backend.ImportDeployment(context, deployment)
+	telemetry.EndSpan(span, err)
+	return err
+}
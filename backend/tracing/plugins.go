@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	"github.com/tliron/kutil/util"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) SetPlugin(context contextpkg.Context, plugin *backend.Plugin) error {
+	context, span := telemetry.StartSpan(context, "backend.setPlugin", attribute.String("tko.plugin_type", plugin.Type), attribute.String("tko.plugin_name", plugin.Name))
+	err := self.Backend.SetPlugin(context, plugin)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) GetPlugin(context contextpkg.Context, pluginId backend.PluginID) (*backend.Plugin, error) {
+	context, span := telemetry.StartSpan(context, "backend.getPlugin", attribute.String("tko.plugin_type", pluginId.Type), attribute.String("tko.plugin_name", pluginId.Name))
+	plugin, err := self.Backend.GetPlugin(context, pluginId)
+	telemetry.EndSpan(span, err)
+	return plugin, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) DeletePlugin(context contextpkg.Context, pluginId backend.PluginID) error {
+	context, span := telemetry.StartSpan(context, "backend.deletePlugin", attribute.String("tko.plugin_type", pluginId.Type), attribute.String("tko.plugin_name", pluginId.Name))
+	err := self.Backend.DeletePlugin(context, pluginId)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ListPlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins, window backend.Window) (util.Results[backend.Plugin], error) {
+	context, span := telemetry.StartSpan(context, "backend.listPlugins")
+	results, err := self.Backend.ListPlugins(context, selectPlugins, window)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) PurgePlugins(context contextpkg.Context, selectPlugins backend.SelectPlugins) error {
+	context, span := telemetry.StartSpan(context, "backend.purgePlugins")
+	err := self.Backend.PurgePlugins(context, selectPlugins)
+	telemetry.EndSpan(span, err)
+	return err
+}
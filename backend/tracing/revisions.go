@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	"github.com/tliron/kutil/util"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	context, span := telemetry.StartSpan(context, "backend.listRevisions", attribute.String("tko.namespace", namespace), attribute.String("tko.object_id", objectId))
+	results, err := self.Backend.ListRevisions(context, type_, namespace, objectId, window)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	context, span := telemetry.StartSpan(context, "backend.getRevision", attribute.String("tko.namespace", revisionId.Namespace), attribute.String("tko.object_id", revisionId.ObjectID))
+	revision, err := self.Backend.GetRevision(context, revisionId)
+	telemetry.EndSpan(span, err)
+	return revision, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	context, span := telemetry.StartSpan(context, "backend.revertTo", attribute.String("tko.namespace", revisionId.Namespace), attribute.String("tko.object_id", revisionId.ObjectID))
+	err := self.Backend.RevertTo(context, revisionId)
+	telemetry.EndSpan(span, err)
+	return err
+}
@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	"github.com/tliron/kutil/util"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
+	context, span := telemetry.StartSpan(context, "backend.setSite", attribute.String("tko.namespace", site.Namespace), attribute.String("tko.site_id", site.SiteID))
+	err := self.Backend.SetSite(context, site)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
+	context, span := telemetry.StartSpan(context, "backend.getSite", attribute.String("tko.namespace", namespace), attribute.String("tko.site_id", siteId))
+	site, err := self.Backend.GetSite(context, namespace, siteId)
+	telemetry.EndSpan(span, err)
+	return site, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
+	context, span := telemetry.StartSpan(context, "backend.deleteSite", attribute.String("tko.namespace", namespace), attribute.String("tko.site_id", siteId))
+	err := self.Backend.DeleteSite(context, namespace, siteId)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ListSites(context contextpkg.Context, selectSites backend.SelectSites, window backend.Window) (util.Results[backend.SiteInfo], error) {
+	context, span := telemetry.StartSpan(context, "backend.listSites")
+	results, err := self.Backend.ListSites(context, selectSites, window)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) PurgeSites(context contextpkg.Context, selectSites backend.SelectSites) error {
+	context, span := telemetry.StartSpan(context, "backend.purgeSites")
+	err := self.Backend.PurgeSites(context, selectSites)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	context, span := telemetry.StartSpan(context, "backend.listDeletedDeployments", attribute.String("tko.namespace", namespace), attribute.String("tko.site_id", siteId))
+	results, err := self.Backend.ListDeletedDeployments(context, namespace, siteId)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	context, span := telemetry.StartSpan(context, "backend.acknowledgeDeletedDeployments", attribute.String("tko.namespace", namespace), attribute.String("tko.site_id", siteId), attribute.StringSlice("tko.deployment_ids", deploymentIds))
+	err := self.Backend.AcknowledgeDeletedDeployments(context, namespace, siteId, deploymentIds)
+	telemetry.EndSpan(span, err)
+	return err
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/nephio-experimental/tko/telemetry"
+	"github.com/tliron/kutil/util"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
+	context, span := telemetry.StartSpan(context, "backend.setTemplate", attribute.String("tko.namespace", template.Namespace), attribute.String("tko.template_id", template.TemplateID))
+	err := self.Backend.SetTemplate(context, template)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
+	context, span := telemetry.StartSpan(context, "backend.getTemplate", attribute.String("tko.namespace", namespace), attribute.String("tko.template_id", templateId))
+	template, err := self.Backend.GetTemplate(context, namespace, templateId)
+	telemetry.EndSpan(span, err)
+	return template, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
+	context, span := telemetry.StartSpan(context, "backend.deleteTemplate", attribute.String("tko.namespace", namespace), attribute.String("tko.template_id", templateId))
+	err := self.Backend.DeleteTemplate(context, namespace, templateId)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) ListTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, window backend.Window) (util.Results[backend.TemplateInfo], error) {
+	context, span := telemetry.StartSpan(context, "backend.listTemplates")
+	results, err := self.Backend.ListTemplates(context, selectTemplates, window)
+	telemetry.EndSpan(span, err)
+	return results, err
+}
+
+// ([backend.Backend] interface)
+func (self *TracingBackend) PurgeTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates) error {
+	context, span := telemetry.StartSpan(context, "backend.purgeTemplates")
+	err := self.Backend.PurgeTemplates(context, selectTemplates)
+	telemetry.EndSpan(span, err)
+	return err
+}
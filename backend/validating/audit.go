@@ -0,0 +1,39 @@
+package validating
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) RecordAuditEvent(context contextpkg.Context, event *backend.AuditEvent) error {
+	if event.Operation == "" {
+		return backend.NewBadArgumentError("operation is empty")
+	}
+	if event.Type == "" {
+		return backend.NewBadArgumentError("type is empty")
+	}
+	if event.Outcome == "" {
+		return backend.NewBadArgumentError("outcome is empty")
+	}
+
+	return self.Backend.RecordAuditEvent(context, event)
+}
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) ListAuditEvents(context contextpkg.Context, selectAuditEvents backend.SelectAuditEvents, window backend.Window) (util.Results[backend.AuditEvent], error) {
+	var err error
+	if selectAuditEvents.Namespace, err = ValidateSelectNamespace(selectAuditEvents.Namespace); err != nil {
+		return nil, err
+	}
+	if !selectAuditEvents.Since.IsZero() && !selectAuditEvents.Until.IsZero() && !selectAuditEvents.Since.Before(selectAuditEvents.Until) {
+		return nil, backend.NewBadArgumentError("since must be before until")
+	}
+	if err := ValidateWindow(&window); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListAuditEvents(context, selectAuditEvents, window)
+}
@@ -4,6 +4,7 @@ import (
 	contextpkg "context"
 	"errors"
 	"regexp"
+	"sync"
 
 	backendpkg "github.com/nephio-experimental/tko/backend"
 	"github.com/tliron/kutil/util"
@@ -13,13 +14,39 @@ var (
 	ParallelBufferSize = 1000
 	ParallelWorkers    = 10
 
-	validIdRe = regexp.MustCompile(`^[0-9A-Za-z_.\-\/:]+$`)
+	validIdRe        = regexp.MustCompile(`^[0-9A-Za-z_.\-\/:]+$`)
+	validNamespaceRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
 )
 
 func IsValidID(id string) bool {
 	return validIdRe.MatchString(id)
 }
 
+// Namespaces are DNS labels, as in Kubernetes.
+func IsValidNamespace(namespace string) bool {
+	return (len(namespace) <= 63) && validNamespaceRe.MatchString(namespace)
+}
+
+// Empty defaults to the default namespace.
+func ValidateNamespace(namespace string) (string, error) {
+	namespace = backendpkg.NormalizeNamespace(namespace)
+	if namespace == backendpkg.AllNamespaces {
+		return "", backendpkg.NewBadArgumentError("all namespaces cannot be used here")
+	}
+	if !IsValidNamespace(namespace) {
+		return "", backendpkg.NewBadArgumentErrorf("invalid namespace: %s", namespace)
+	}
+	return namespace, nil
+}
+
+// Like [ValidateNamespace] but also allows all namespaces.
+func ValidateSelectNamespace(namespace string) (string, error) {
+	if namespace == backendpkg.AllNamespaces {
+		return namespace, nil
+	}
+	return ValidateNamespace(namespace)
+}
+
 func ValidateWindow(window *backendpkg.Window) error {
 	if window.MaxCount > int(backendpkg.MaxMaxCount) {
 		return backendpkg.NewBadArgumentErrorf("maxCount is too large: %d > %d", window.MaxCount, backendpkg.MaxMaxCount)
@@ -28,6 +55,54 @@ func ValidateWindow(window *backendpkg.Window) error {
 	return nil
 }
 
+func ValidateSelectors(metadataSelector string, packageSelector string) error {
+	_, err := backendpkg.ParseSelectors(metadataSelector, packageSelector)
+	return err
+}
+
+// Empty defaults to orphan.
+func ValidatePropagation(propagation string) (string, error) {
+	if propagation == "" {
+		return backendpkg.PropagationOrphan, nil
+	}
+	if !backendpkg.IsValidPropagation(propagation) {
+		return "", backendpkg.NewBadArgumentErrorf("propagation must be %s: %s", backendpkg.PropagationsDescription, propagation)
+	}
+	return propagation, nil
+}
+
+// Returns the validated namespace.
+func ValidateRevisionObject(type_ string, namespace string, objectId string) (string, error) {
+	if !backendpkg.IsValidRevisionType(type_) {
+		return "", backendpkg.NewBadArgumentErrorf("revision type must be %s: %s", backendpkg.RevisionTypesDescription, type_)
+	}
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return "", err
+	}
+	if objectId == "" {
+		return "", backendpkg.NewBadArgumentError("objectId is empty")
+	}
+	if !IsValidID(objectId) {
+		return "", backendpkg.NewBadArgumentError("invalid objectId")
+	}
+
+	return namespace, nil
+}
+
+// Normalizes the namespace.
+func ValidateRevisionID(revisionId *backendpkg.RevisionID) error {
+	var err error
+	if revisionId.Namespace, err = ValidateRevisionObject(revisionId.Type, revisionId.Namespace, revisionId.ObjectID); err != nil {
+		return err
+	}
+	if revisionId.Revision == 0 {
+		return backendpkg.NewBadArgumentError("revision is empty")
+	}
+
+	return nil
+}
+
 func ParallelDelete[R any, T any](context contextpkg.Context, results util.Results[R], getTask func(result R) T, delete_ func(task T) error) error {
 	deleter := util.NewParallelExecutor[T](ParallelBufferSize, func(task T) error {
 		err := delete_(task)
@@ -50,3 +125,39 @@ func ParallelDelete[R any, T any](context contextpkg.Context, results util.Resul
 
 	return errors.Join(deleter.Wait()...)
 }
+
+func ParallelModify[R any, T any](context contextpkg.Context, results util.Results[R], getTask func(result R) T, modify func(task T) (backendpkg.DeploymentModificationResult, error)) ([]backendpkg.DeploymentModificationResult, error) {
+	var modificationResults []backendpkg.DeploymentModificationResult
+	var lock sync.Mutex
+
+	modifier := util.NewParallelExecutor[T](ParallelBufferSize, func(task T) error {
+		if modificationResult, err := modify(task); err == nil {
+			lock.Lock()
+			modificationResults = append(modificationResults, modificationResult)
+			lock.Unlock()
+			return nil
+		} else if backendpkg.IsNotFoundError(err) {
+			// Swallow not-found errors
+			return nil
+		} else {
+			return err
+		}
+	})
+
+	modifier.Start(ParallelWorkers)
+
+	if err := util.IterateResults(results, func(result R) error {
+		modifier.Queue(getTask(result))
+		return nil
+	}); err != nil {
+		modifier.Close()
+		return nil, err
+	}
+
+	if err := errors.Join(modifier.Wait()...); err != nil {
+		return nil, err
+	}
+
+	backendpkg.SortDeploymentModificationResults(modificationResults)
+	return modificationResults, nil
+}
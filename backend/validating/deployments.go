@@ -11,6 +11,11 @@ import (
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) CreateDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	var err error
+	if deployment.Namespace, err = ValidateNamespace(deployment.Namespace); err != nil {
+		return err
+	}
+
 	if (deployment.TemplateID != "") && !IsValidID(deployment.TemplateID) {
 		return backend.NewBadArgumentError("invalid templateId")
 	}
@@ -24,7 +29,7 @@ func (self *ValidatingBackend) CreateDeployment(context contextpkg.Context, depl
 	clone.UpdateFromPackage(true)
 	completeValidation := clone.Prepared
 
-	if err := self.Validation.ValidatePackage(deployment.Package, completeValidation); err != nil {
+	if err := self.Validation.ValidatePackage(context, deployment.Package, completeValidation); err != nil {
 		return backend.WrapBadArgumentError(err)
 	}
 
@@ -32,25 +37,44 @@ func (self *ValidatingBackend) CreateDeployment(context contextpkg.Context, depl
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) GetDeployment(context contextpkg.Context, deploymentId string) (*backend.Deployment, error) {
+func (self *ValidatingBackend) GetDeployment(context contextpkg.Context, namespace string, deploymentId string) (*backend.Deployment, error) {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return nil, err
+	}
 	if deploymentId == "" {
 		return nil, backend.NewBadArgumentError("deploymentId is empty")
 	}
 
-	return self.Backend.GetDeployment(context, deploymentId)
+	return self.Backend.GetDeployment(context, namespace, deploymentId)
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) DeleteDeployment(context contextpkg.Context, deploymentId string) error {
+func (self *ValidatingBackend) DeleteDeployment(context contextpkg.Context, namespace string, deploymentId string, propagation string) error {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return err
+	}
 	if deploymentId == "" {
 		return backend.NewBadArgumentError("deploymentId is empty")
 	}
 
-	return self.Backend.DeleteDeployment(context, deploymentId)
+	if propagation, err = ValidatePropagation(propagation); err != nil {
+		return err
+	}
+
+	return self.Backend.DeleteDeployment(context, namespace, deploymentId, propagation)
 }
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) ListDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, window backend.Window) (util.Results[backend.DeploymentInfo], error) {
+	var err error
+	if selectDeployments.Namespace, err = ValidateSelectNamespace(selectDeployments.Namespace); err != nil {
+		return nil, err
+	}
+	if err := ValidateSelectors(selectDeployments.MetadataSelector, selectDeployments.PackageSelector); err != nil {
+		return nil, err
+	}
 	if err := ValidateWindow(&window); err != nil {
 		return nil, err
 	}
@@ -59,8 +83,19 @@ func (self *ValidatingBackend) ListDeployments(context contextpkg.Context, selec
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments) error {
-	if err := self.Backend.PurgeDeployments(context, selectDeployments); err == nil {
+func (self *ValidatingBackend) PurgeDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, propagation string) error {
+	var err error
+	if selectDeployments.Namespace, err = ValidateNamespace(selectDeployments.Namespace); err != nil {
+		return err
+	}
+	if err := ValidateSelectors(selectDeployments.MetadataSelector, selectDeployments.PackageSelector); err != nil {
+		return err
+	}
+	if propagation, err = ValidatePropagation(propagation); err != nil {
+		return err
+	}
+
+	if err := self.Backend.PurgeDeployments(context, selectDeployments, propagation); err == nil {
 		return nil
 	} else if backend.IsNotImplementedError(err) {
 		if results, err := self.Backend.ListDeployments(context, selectDeployments, backend.Window{MaxCount: -1}); err == nil {
@@ -69,7 +104,7 @@ func (self *ValidatingBackend) PurgeDeployments(context contextpkg.Context, sele
 					return deploymentInfo.DeploymentID
 				},
 				func(deploymentId string) error {
-					return self.Backend.DeleteDeployment(context, deploymentId)
+					return self.Backend.DeleteDeployment(context, selectDeployments.Namespace, deploymentId, propagation)
 				},
 			)
 		} else {
@@ -81,12 +116,16 @@ func (self *ValidatingBackend) PurgeDeployments(context contextpkg.Context, sele
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) StartDeploymentModification(context contextpkg.Context, deploymentId string) (string, *backend.Deployment, error) {
+func (self *ValidatingBackend) StartDeploymentModification(context contextpkg.Context, namespace string, deploymentId string) (string, *backend.Deployment, error) {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return "", nil, err
+	}
 	if deploymentId == "" {
 		return "", nil, backend.NewBadArgumentError("deploymentId is empty")
 	}
 
-	return self.Backend.StartDeploymentModification(context, deploymentId)
+	return self.Backend.StartDeploymentModification(context, namespace, deploymentId)
 }
 
 // ([backend.Backend] interface)
@@ -96,7 +135,7 @@ func (self *ValidatingBackend) EndDeploymentModification(context contextpkg.Cont
 	}
 
 	// Partial validation before calling the wrapped backend
-	if err := self.Validation.ValidatePackage(package_, false); err != nil {
+	if err := self.Validation.ValidatePackage(context, package_, false); err != nil {
 		return "", backend.WrapBadArgumentError(err)
 	}
 
@@ -116,3 +155,94 @@ func (self *ValidatingBackend) CancelDeploymentModification(context contextpkg.C
 
 	return self.Backend.CancelDeploymentModification(context, modificationToken)
 }
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) ModifyDeployments(context contextpkg.Context, selectDeployments backend.SelectDeployments, modifyDeployments backend.ModifyDeployments) ([]backend.DeploymentModificationResult, error) {
+	var err error
+	if selectDeployments.Namespace, err = ValidateNamespace(selectDeployments.Namespace); err != nil {
+		return nil, err
+	}
+	if err := ValidateSelectors(selectDeployments.MetadataSelector, selectDeployments.PackageSelector); err != nil {
+		return nil, err
+	}
+	if modifyDeployments.IsEmpty() {
+		return nil, backend.NewBadArgumentError("no modification")
+	}
+
+	for key := range modifyDeployments.SetMetadata {
+		if key == "" {
+			return nil, backend.NewBadArgumentError("metadata key is empty")
+		}
+	}
+
+	if modifyDeployments.Validation == nil {
+		modifyDeployments.Validation = self.Validation
+	}
+
+	if results, err := self.Backend.ModifyDeployments(context, selectDeployments, modifyDeployments); err == nil {
+		return results, nil
+	} else if backend.IsNotImplementedError(err) && !modifyDeployments.ChangesMetadata() {
+		// Fallback: modify one deployment at a time (not a single transaction)
+		if deploymentInfos, err := self.Backend.ListDeployments(context, selectDeployments, backend.Window{MaxCount: -1}); err == nil {
+			return ParallelModify(context, deploymentInfos,
+				func(deploymentInfo backend.DeploymentInfo) string {
+					return deploymentInfo.DeploymentID
+				},
+				func(deploymentId string) (backend.DeploymentModificationResult, error) {
+					return self.modifyDeployment(context, selectDeployments.Namespace, deploymentId, modifyDeployments)
+				},
+			)
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
+
+// Utils
+
+func (self *ValidatingBackend) modifyDeployment(context contextpkg.Context, namespace string, deploymentId string, modifyDeployments backend.ModifyDeployments) (backend.DeploymentModificationResult, error) {
+	result := backend.DeploymentModificationResult{DeploymentID: deploymentId}
+
+	if modificationToken, deployment, err := self.Backend.StartDeploymentModification(context, namespace, deploymentId); err == nil {
+		result.Version = deployment.Version
+
+		approved := deployment.Approved
+
+		// Validate only once: completely if becoming approved, otherwise when ending the modification
+		validation := modifyDeployments.Validation
+
+		var modified bool
+		if modified, err = modifyDeployments.ApplyToPackage(deployment.Package); err != nil {
+			result.NotModifiedReason = err.Error()
+		} else if modified && !approved && *modifyDeployments.Approved {
+			if err = modifyDeployments.ValidateApproved(context, deployment.Package); err == nil {
+				validation = nil
+			} else {
+				result.NotModifiedReason = err.Error()
+				modified = false
+			}
+		}
+
+		if !modified {
+			return result, self.Backend.CancelDeploymentModification(context, modificationToken)
+		}
+
+		if _, err := self.Backend.EndDeploymentModification(context, modificationToken, deployment.Package, validation); err == nil {
+			result.Modified = true
+			result.Version = deployment.Version + 1
+			return result, nil
+		} else if backend.IsBadArgumentError(err) || backend.IsNotFoundError(err) || backend.IsTimeoutError(err) {
+			result.NotModifiedReason = err.Error()
+			return result, nil
+		} else {
+			return result, err
+		}
+	} else if backend.IsBusyError(err) {
+		result.NotModifiedReason = "busy"
+		return result, nil
+	} else {
+		return result, err
+	}
+}
@@ -0,0 +1,29 @@
+package validating
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) Watch(context contextpkg.Context, selectEvents backend.SelectEvents) (util.Results[backend.Event], error) {
+	var err error
+	if selectEvents.Namespace, err = ValidateSelectNamespace(selectEvents.Namespace); err != nil {
+		return nil, err
+	}
+
+	for _, kind := range selectEvents.Kinds {
+		if !backend.IsValidEventKind(kind) {
+			return nil, backend.NewBadArgumentErrorf("event kind must be %s: %s", backend.EventKindsDescription, kind)
+		}
+	}
+
+	return self.Backend.Watch(context, selectEvents)
+}
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) GetEventRevision(context contextpkg.Context) (uint64, error) {
+	return self.Backend.GetEventRevision(context)
+}
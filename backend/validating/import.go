@@ -0,0 +1,85 @@
+package validating
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+)
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) ImportTemplate(context contextpkg.Context, template *backend.Template) error {
+	var err error
+	if template.Namespace, err = ValidateNamespace(template.Namespace); err != nil {
+		return err
+	}
+	if template.TemplateID == "" {
+		return backend.NewBadArgumentError("templateId is empty")
+	}
+	if !IsValidID(template.TemplateID) {
+		return backend.NewBadArgumentError("invalid templateId")
+	}
+
+	if err := self.Validation.ValidatePackage(context, template.Package, false); err != nil {
+		return backend.WrapBadArgumentError(err)
+	}
+
+	return self.Backend.ImportTemplate(context, template)
+}
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) ImportSite(context contextpkg.Context, site *backend.Site) error {
+	var err error
+	if site.Namespace, err = ValidateNamespace(site.Namespace); err != nil {
+		return err
+	}
+	if site.SiteID == "" {
+		return backend.NewBadArgumentError("siteId is empty")
+	}
+	if !IsValidID(site.SiteID) {
+		return backend.NewBadArgumentError("invalid siteId")
+	}
+
+	if (site.TemplateID != "") && !IsValidID(site.TemplateID) {
+		return backend.NewBadArgumentError("invalid templateId")
+	}
+
+	if err := self.Validation.ValidatePackage(context, site.Package, true); err != nil {
+		return backend.WrapBadArgumentError(err)
+	}
+
+	return self.Backend.ImportSite(context, site)
+}
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) ImportDeployment(context contextpkg.Context, deployment *backend.Deployment) error {
+	var err error
+	if deployment.Namespace, err = ValidateNamespace(deployment.Namespace); err != nil {
+		return err
+	}
+	if deployment.DeploymentID == "" {
+		return backend.NewBadArgumentError("deploymentId is empty")
+	}
+
+	if (deployment.TemplateID != "") && !IsValidID(deployment.TemplateID) {
+		return backend.NewBadArgumentError("invalid templateId")
+	}
+
+	if (deployment.SiteID != "") && !IsValidID(deployment.SiteID) {
+		return backend.NewBadArgumentError("invalid siteId")
+	}
+
+	if deployment.ParentDeploymentID == deployment.DeploymentID {
+		return backend.NewBadArgumentError("deployment cannot be its own parent")
+	}
+
+	// Prepared deployments must be completely valid
+	clone := deployment.Clone(true)
+	clone.UpdateFromPackage(true)
+	completeValidation := clone.Prepared
+
+	if err := self.Validation.ValidatePackage(context, deployment.Package, completeValidation); err != nil {
+		return backend.WrapBadArgumentError(err)
+	}
+
+	return self.Backend.ImportDeployment(context, deployment)
+}
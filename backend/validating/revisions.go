@@ -0,0 +1,44 @@
+package validating
+
+import (
+	contextpkg "context"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/tliron/kutil/util"
+)
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) ListRevisions(context contextpkg.Context, type_ string, namespace string, objectId string, window backend.Window) (util.Results[backend.RevisionInfo], error) {
+	var err error
+	if namespace, err = ValidateRevisionObject(type_, namespace, objectId); err != nil {
+		return nil, err
+	}
+	if err := ValidateWindow(&window); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.ListRevisions(context, type_, namespace, objectId, window)
+}
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) GetRevision(context contextpkg.Context, revisionId backend.RevisionID) (*backend.Revision, error) {
+	if err := ValidateRevisionID(&revisionId); err != nil {
+		return nil, err
+	}
+
+	return self.Backend.GetRevision(context, revisionId)
+}
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) RevertTo(context contextpkg.Context, revisionId backend.RevisionID) error {
+	if err := ValidateRevisionID(&revisionId); err != nil {
+		return err
+	}
+
+	// We revert here rather than in the wrapped backend so that the writes are validated
+	if revision, err := self.Backend.GetRevision(context, revisionId); err == nil {
+		return backend.RevertToRevision(context, self, revision)
+	} else {
+		return err
+	}
+}
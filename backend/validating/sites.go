@@ -9,6 +9,10 @@ import (
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) SetSite(context contextpkg.Context, site *backend.Site) error {
+	var err error
+	if site.Namespace, err = ValidateNamespace(site.Namespace); err != nil {
+		return err
+	}
 	if site.SiteID == "" {
 		return backend.NewBadArgumentError("siteId is empty")
 	}
@@ -16,7 +20,7 @@ func (self *ValidatingBackend) SetSite(context contextpkg.Context, site *backend
 		return backend.NewBadArgumentError("invalid siteId")
 	}
 
-	if err := self.Validation.ValidatePackage(site.Package, true); err != nil {
+	if err := self.Validation.ValidatePackage(context, site.Package, true); err != nil {
 		return backend.WrapBadArgumentError(err)
 	}
 
@@ -24,7 +28,11 @@ func (self *ValidatingBackend) SetSite(context contextpkg.Context, site *backend
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) GetSite(context contextpkg.Context, siteId string) (*backend.Site, error) {
+func (self *ValidatingBackend) GetSite(context contextpkg.Context, namespace string, siteId string) (*backend.Site, error) {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return nil, err
+	}
 	if siteId == "" {
 		return nil, backend.NewBadArgumentError("siteId is empty")
 	}
@@ -32,11 +40,15 @@ func (self *ValidatingBackend) GetSite(context contextpkg.Context, siteId string
 		return nil, backend.NewBadArgumentError("invalid siteId")
 	}
 
-	return self.Backend.GetSite(context, siteId)
+	return self.Backend.GetSite(context, namespace, siteId)
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) DeleteSite(context contextpkg.Context, siteId string) error {
+func (self *ValidatingBackend) DeleteSite(context contextpkg.Context, namespace string, siteId string) error {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return err
+	}
 	if siteId == "" {
 		return backend.NewBadArgumentError("siteId is empty")
 	}
@@ -44,11 +56,18 @@ func (self *ValidatingBackend) DeleteSite(context contextpkg.Context, siteId str
 		return backend.NewBadArgumentError("invalid siteId")
 	}
 
-	return self.Backend.DeleteSite(context, siteId)
+	return self.Backend.DeleteSite(context, namespace, siteId)
 }
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) ListSites(context contextpkg.Context, selectSites backend.SelectSites, window backend.Window) (util.Results[backend.SiteInfo], error) {
+	var err error
+	if selectSites.Namespace, err = ValidateSelectNamespace(selectSites.Namespace); err != nil {
+		return nil, err
+	}
+	if err := ValidateSelectors(selectSites.MetadataSelector, selectSites.PackageSelector); err != nil {
+		return nil, err
+	}
 	if err := ValidateWindow(&window); err != nil {
 		return nil, err
 	}
@@ -58,6 +77,14 @@ func (self *ValidatingBackend) ListSites(context contextpkg.Context, selectSites
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) PurgeSites(context contextpkg.Context, selectSites backend.SelectSites) error {
+	var err error
+	if selectSites.Namespace, err = ValidateNamespace(selectSites.Namespace); err != nil {
+		return err
+	}
+	if err := ValidateSelectors(selectSites.MetadataSelector, selectSites.PackageSelector); err != nil {
+		return err
+	}
+
 	if err := self.Backend.PurgeSites(context, selectSites); err == nil {
 		return nil
 	} else if backend.IsNotImplementedError(err) {
@@ -67,7 +94,7 @@ func (self *ValidatingBackend) PurgeSites(context contextpkg.Context, selectSite
 					return siteInfo.SiteID
 				},
 				func(siteId string) error {
-					return self.Backend.DeleteSite(context, siteId)
+					return self.Backend.DeleteSite(context, selectSites.Namespace, siteId)
 				},
 			)
 		} else {
@@ -77,3 +104,40 @@ func (self *ValidatingBackend) PurgeSites(context contextpkg.Context, selectSite
 		return err
 	}
 }
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) ListDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (util.Results[backend.DeletedDeployment], error) {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return nil, err
+	}
+	if siteId == "" {
+		return nil, backend.NewBadArgumentError("siteId is empty")
+	}
+	if !IsValidID(siteId) {
+		return nil, backend.NewBadArgumentError("invalid siteId")
+	}
+
+	return self.Backend.ListDeletedDeployments(context, namespace, siteId)
+}
+
+// ([backend.Backend] interface)
+func (self *ValidatingBackend) AcknowledgeDeletedDeployments(context contextpkg.Context, namespace string, siteId string, deploymentIds []string) error {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return err
+	}
+	if siteId == "" {
+		return backend.NewBadArgumentError("siteId is empty")
+	}
+	if !IsValidID(siteId) {
+		return backend.NewBadArgumentError("invalid siteId")
+	}
+	for _, deploymentId := range deploymentIds {
+		if deploymentId == "" {
+			return backend.NewBadArgumentError("deploymentId is empty")
+		}
+	}
+
+	return self.Backend.AcknowledgeDeletedDeployments(context, namespace, siteId, deploymentIds)
+}
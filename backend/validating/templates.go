@@ -9,6 +9,10 @@ import (
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) SetTemplate(context contextpkg.Context, template *backend.Template) error {
+	var err error
+	if template.Namespace, err = ValidateNamespace(template.Namespace); err != nil {
+		return err
+	}
 	if template.TemplateID == "" {
 		return backend.NewBadArgumentError("templateId is empty")
 	}
@@ -16,7 +20,7 @@ func (self *ValidatingBackend) SetTemplate(context contextpkg.Context, template
 		return backend.NewBadArgumentError("invalid templateId")
 	}
 
-	if err := self.Validation.ValidatePackage(template.Package, false); err != nil {
+	if err := self.Validation.ValidatePackage(context, template.Package, false); err != nil {
 		return backend.WrapBadArgumentError(err)
 	}
 
@@ -24,7 +28,11 @@ func (self *ValidatingBackend) SetTemplate(context contextpkg.Context, template
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) GetTemplate(context contextpkg.Context, templateId string) (*backend.Template, error) {
+func (self *ValidatingBackend) GetTemplate(context contextpkg.Context, namespace string, templateId string) (*backend.Template, error) {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return nil, err
+	}
 	if templateId == "" {
 		return nil, backend.NewBadArgumentError("templateId is empty")
 	}
@@ -32,11 +40,15 @@ func (self *ValidatingBackend) GetTemplate(context contextpkg.Context, templateI
 		return nil, backend.NewBadArgumentError("invalid templateId")
 	}
 
-	return self.Backend.GetTemplate(context, templateId)
+	return self.Backend.GetTemplate(context, namespace, templateId)
 }
 
 // ([backend.Backend] interface)
-func (self *ValidatingBackend) DeleteTemplate(context contextpkg.Context, templateId string) error {
+func (self *ValidatingBackend) DeleteTemplate(context contextpkg.Context, namespace string, templateId string) error {
+	var err error
+	if namespace, err = ValidateNamespace(namespace); err != nil {
+		return err
+	}
 	if templateId == "" {
 		return backend.NewBadArgumentError("templateId is empty")
 	}
@@ -44,11 +56,18 @@ func (self *ValidatingBackend) DeleteTemplate(context contextpkg.Context, templa
 		return backend.NewBadArgumentError("invalid templateId")
 	}
 
-	return self.Backend.DeleteTemplate(context, templateId)
+	return self.Backend.DeleteTemplate(context, namespace, templateId)
 }
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) ListTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates, window backend.Window) (util.Results[backend.TemplateInfo], error) {
+	var err error
+	if selectTemplates.Namespace, err = ValidateSelectNamespace(selectTemplates.Namespace); err != nil {
+		return nil, err
+	}
+	if err := ValidateSelectors(selectTemplates.MetadataSelector, selectTemplates.PackageSelector); err != nil {
+		return nil, err
+	}
 	if err := ValidateWindow(&window); err != nil {
 		return nil, err
 	}
@@ -58,6 +77,14 @@ func (self *ValidatingBackend) ListTemplates(context contextpkg.Context, selectT
 
 // ([backend.Backend] interface)
 func (self *ValidatingBackend) PurgeTemplates(context contextpkg.Context, selectTemplates backend.SelectTemplates) error {
+	var err error
+	if selectTemplates.Namespace, err = ValidateNamespace(selectTemplates.Namespace); err != nil {
+		return err
+	}
+	if err := ValidateSelectors(selectTemplates.MetadataSelector, selectTemplates.PackageSelector); err != nil {
+		return err
+	}
+
 	if err := self.Backend.PurgeTemplates(context, selectTemplates); err == nil {
 		return nil
 	} else if backend.IsNotImplementedError(err) {
@@ -67,7 +94,7 @@ func (self *ValidatingBackend) PurgeTemplates(context contextpkg.Context, select
 					return templateInfo.TemplateID
 				},
 				func(templateId string) error {
-					return self.Backend.DeleteTemplate(context, templateId)
+					return self.Backend.DeleteTemplate(context, selectTemplates.Namespace, templateId)
 				},
 			)
 		} else {
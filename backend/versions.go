@@ -0,0 +1,35 @@
+package backend
+
+import (
+	contextpkg "context"
+)
+
+// Versions start at 1 for new objects. An expected version of 0 matches any current version,
+// including that of a non-existing object (0).
+//
+// Can return ConflictError.
+func CheckVersion(kind string, id string, expectedVersion uint64, currentVersion uint64) error {
+	if (expectedVersion != 0) && (expectedVersion != currentVersion) {
+		return NewConflictErrorf("%s: %s, expected version %d but current version is %d", kind, id, expectedVersion, currentVersion)
+	}
+	return nil
+}
+
+// Like [Backend.StartDeploymentModification] but also checks the version, cancelling the
+// modification if it does not match.
+//
+// Can return BadArgumentError, NotFoundError, NotDoneError, BusyError, ConflictError.
+func StartDeploymentModificationForVersion(context contextpkg.Context, backend Backend, namespace string, deploymentId string, expectedVersion uint64) (string, *Deployment, error) {
+	if modificationToken, deployment, err := backend.StartDeploymentModification(context, namespace, deploymentId); err == nil {
+		if err := CheckVersion(EventKindDeployment, deploymentId, expectedVersion, deployment.Version); err == nil {
+			return modificationToken, deployment, nil
+		} else {
+			if err := backend.CancelDeploymentModification(context, modificationToken); err != nil {
+				return "", nil, err
+			}
+			return "", nil, err
+		}
+	} else {
+		return "", nil, err
+	}
+}
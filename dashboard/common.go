@@ -61,6 +61,11 @@ func CleanTableRows(table *tview.Table, exists func(row int) bool) {
 	}
 }
 
+// Used for keying table rows.
+func ToNamespacedID(namespace string, id string) string {
+	return namespace + "/" + id
+}
+
 func PackageToYAML(package_ tkoutil.Package) (string, error) {
 	return ToYAML(ToSliceAny(package_))
 }
@@ -11,8 +11,8 @@ import (
 // ([UpdateTableFunc] signature)
 func (self *Application) UpdateDeployments(table *tview.Table) error {
 	// TODO: paging
-	if deploymentnfoResults, err := self.client.ListDeployments(client.SelectDeployments{}, 0, -1); err == nil {
-		SetTableHeader(table, "ID", "Template", "Parent", "Site", "Prepared", "Approved", "Created", "Updated")
+	if deploymentnfoResults, err := self.client.ListDeployments(client.SelectDeployments{Namespace: client.AllNamespaces}, 0, -1); err == nil {
+		SetTableHeader(table, "Namespace", "ID", "Template", "Parent", "Site", "Prepared", "Approved", "Created", "Updated")
 
 		var deploymentIds []string
 		util.IterateResults(deploymentnfoResults, func(deploymentInfo client.DeploymentInfo) error {
@@ -33,26 +33,29 @@ func (self *Application) UpdateDeployments(table *tview.Table) error {
 }
 
 func (self *Application) SetDeploymentRow(table *tview.Table, row int, deploymentInfo *client.DeploymentInfo) {
-	table.SetCell(row, 0, tview.NewTableCell(deploymentInfo.DeploymentID).SetReference(&DeploymentDetails{deploymentInfo.DeploymentID, self}))
+	namespace := deploymentInfo.Namespace
+	deploymentDetails := &DeploymentDetails{namespace, deploymentInfo.DeploymentID, self}
+	table.SetCell(row, 0, tview.NewTableCell(namespace).SetReference(deploymentDetails))
+	table.SetCell(row, 1, tview.NewTableCell(deploymentInfo.DeploymentID).SetReference(deploymentDetails))
 	if deploymentInfo.TemplateID != "" {
-		table.SetCell(row, 1, tview.NewTableCell(deploymentInfo.TemplateID).SetReference(&TemplateDetails{deploymentInfo.TemplateID, self}))
+		table.SetCell(row, 2, tview.NewTableCell(deploymentInfo.TemplateID).SetReference(&TemplateDetails{namespace, deploymentInfo.TemplateID, self}))
 	} else {
-		table.SetCellSimple(row, 1, "")
+		table.SetCellSimple(row, 2, "")
 	}
 	if deploymentInfo.ParentDeploymentID != "" {
-		table.SetCell(row, 2, tview.NewTableCell(deploymentInfo.ParentDeploymentID).SetReference(&DeploymentDetails{deploymentInfo.ParentDeploymentID, self}))
+		table.SetCell(row, 3, tview.NewTableCell(deploymentInfo.ParentDeploymentID).SetReference(&DeploymentDetails{namespace, deploymentInfo.ParentDeploymentID, self}))
 	} else {
-		table.SetCellSimple(row, 2, "")
+		table.SetCellSimple(row, 3, "")
 	}
 	if deploymentInfo.SiteID != "" {
-		table.SetCell(row, 3, tview.NewTableCell(deploymentInfo.SiteID).SetReference(&SiteDetails{deploymentInfo.SiteID, self}))
+		table.SetCell(row, 4, tview.NewTableCell(deploymentInfo.SiteID).SetReference(&SiteDetails{namespace, deploymentInfo.SiteID, self}))
 	} else {
-		table.SetCellSimple(row, 3, "")
+		table.SetCellSimple(row, 4, "")
 	}
-	table.SetCell(row, 4, NewBoolTableCell(deploymentInfo.Prepared))
-	table.SetCell(row, 5, NewBoolTableCell(deploymentInfo.Approved))
-	table.SetCellSimple(row, 6, self.timestamp(deploymentInfo.Created))
-	table.SetCellSimple(row, 7, self.timestamp(deploymentInfo.Updated))
+	table.SetCell(row, 5, NewBoolTableCell(deploymentInfo.Prepared))
+	table.SetCell(row, 6, NewBoolTableCell(deploymentInfo.Approved))
+	table.SetCellSimple(row, 7, self.timestamp(deploymentInfo.Created))
+	table.SetCellSimple(row, 8, self.timestamp(deploymentInfo.Updated))
 }
 
 func GetDeploymentRow(table *tview.Table, row int) string {
@@ -74,6 +77,7 @@ func FindDeploymentRow(table *tview.Table, deploymentId string) int {
 //
 
 type DeploymentDetails struct {
+	namespace    string
 	deploymentId string
 	application  *Application
 }
@@ -85,7 +89,7 @@ func (self *DeploymentDetails) GetTitle() string {
 
 // ([Details] interface)
 func (self *DeploymentDetails) GetText() (string, error) {
-	if deployment, ok, err := self.application.client.GetDeployment(self.deploymentId); err == nil {
+	if deployment, ok, err := self.application.client.GetDeployment(self.namespace, self.deploymentId); err == nil {
 		if ok {
 			return PackageToYAML(deployment.Package)
 		} else {
@@ -12,15 +12,15 @@ import (
 // ([UpdateTableFunc] signature)
 func (self *Application) UpdateSites(table *tview.Table) error {
 	// TODO: paging
-	if siteInfoResults, err := self.client.ListSites(client.SelectSites{}, 0, -1); err == nil {
+	if siteInfoResults, err := self.client.ListSites(client.SelectSites{Namespace: client.AllNamespaces}, 0, -1); err == nil {
 		table.Clear()
 
-		SetTableHeader(table, "ID", "Template", "Deployments", "Updated")
+		SetTableHeader(table, "Namespace", "ID", "Template", "Deployments", "Updated")
 
 		var siteIds []string
 		util.IterateResults(siteInfoResults, func(siteInfo client.SiteInfo) error {
-			siteIds = append(siteIds, siteInfo.SiteID)
-			row := FindSiteRow(table, siteInfo.SiteID)
+			siteIds = append(siteIds, ToNamespacedID(siteInfo.Namespace, siteInfo.SiteID))
+			row := FindSiteRow(table, siteInfo.Namespace, siteInfo.SiteID)
 			self.SetSiteRow(table, row, &siteInfo)
 			return nil
 		})
@@ -36,24 +36,28 @@ func (self *Application) UpdateSites(table *tview.Table) error {
 }
 
 func (self *Application) SetSiteRow(table *tview.Table, row int, siteInfo *client.SiteInfo) {
-	table.SetCell(row, 0, tview.NewTableCell(siteInfo.SiteID).SetReference(&SiteDetails{siteInfo.SiteID, self}))
+	siteDetails := &SiteDetails{siteInfo.Namespace, siteInfo.SiteID, self}
+	table.SetCell(row, 0, tview.NewTableCell(siteInfo.Namespace).SetReference(siteDetails))
+	table.SetCell(row, 1, tview.NewTableCell(siteInfo.SiteID).SetReference(siteDetails))
 	if siteInfo.TemplateID != "" {
-		table.SetCell(row, 1, tview.NewTableCell(siteInfo.TemplateID).SetReference(&TemplateDetails{siteInfo.TemplateID, self}))
+		table.SetCell(row, 2, tview.NewTableCell(siteInfo.TemplateID).SetReference(&TemplateDetails{siteInfo.Namespace, siteInfo.TemplateID, self}))
 	} else {
-		table.SetCellSimple(row, 1, "")
+		table.SetCellSimple(row, 2, "")
 	}
-	table.SetCellSimple(row, 2, strconv.Itoa(len(siteInfo.DeploymentIDs)))
-	table.SetCellSimple(row, 3, self.timestamp(siteInfo.Updated))
+	table.SetCellSimple(row, 3, strconv.Itoa(len(siteInfo.DeploymentIDs)))
+	table.SetCellSimple(row, 4, self.timestamp(siteInfo.Updated))
 }
 
 func GetSiteRow(table *tview.Table, row int) string {
-	return table.GetCell(row, 0).GetReference().(*SiteDetails).siteId
+	siteDetails := table.GetCell(row, 0).GetReference().(*SiteDetails)
+	return ToNamespacedID(siteDetails.namespace, siteDetails.siteId)
 }
 
-func FindSiteRow(table *tview.Table, siteId string) int {
+func FindSiteRow(table *tview.Table, namespace string, siteId string) int {
+	namespacedId := ToNamespacedID(namespace, siteId)
 	rowCount := table.GetRowCount()
 	for row := 1; row < rowCount; row++ {
-		if siteId == GetSiteRow(table, row) {
+		if namespacedId == GetSiteRow(table, row) {
 			return row
 		}
 	}
@@ -65,18 +69,19 @@ func FindSiteRow(table *tview.Table, siteId string) int {
 //
 
 type SiteDetails struct {
+	namespace   string
 	siteId      string
 	application *Application
 }
 
 // ([Details] interface)
 func (self *SiteDetails) GetTitle() string {
-	return "Site: " + self.siteId
+	return "Site: " + ToNamespacedID(self.namespace, self.siteId)
 }
 
 // ([Details] interface)
 func (self *SiteDetails) GetText() (string, error) {
-	if site, ok, err := self.application.client.GetSite(self.siteId); err == nil {
+	if site, ok, err := self.application.client.GetSite(self.namespace, self.siteId); err == nil {
 		if ok {
 			return PackageToYAML(site.Package)
 		} else {
@@ -12,13 +12,13 @@ import (
 // ([UpdateTableFunc] signature)
 func (self *Application) UpdateTemplates(table *tview.Table) error {
 	// TODO: paging
-	if templateInfoResults, err := self.client.ListTemplates(client.SelectTemplates{}, 0, -1); err == nil {
-		SetTableHeader(table, "ID", "Deployments", "Updated")
+	if templateInfoResults, err := self.client.ListTemplates(client.SelectTemplates{Namespace: client.AllNamespaces}, 0, -1); err == nil {
+		SetTableHeader(table, "Namespace", "ID", "Deployments", "Updated")
 
 		var templateIds []string
 		util.IterateResults(templateInfoResults, func(templateInfo client.TemplateInfo) error {
-			templateIds = append(templateIds, templateInfo.TemplateID)
-			row := FindTemplateRow(table, templateInfo.TemplateID)
+			templateIds = append(templateIds, ToNamespacedID(templateInfo.Namespace, templateInfo.TemplateID))
+			row := FindTemplateRow(table, templateInfo.Namespace, templateInfo.TemplateID)
 			self.SetTemplateRow(table, row, &templateInfo)
 			return nil
 		})
@@ -34,19 +34,23 @@ func (self *Application) UpdateTemplates(table *tview.Table) error {
 }
 
 func (self *Application) SetTemplateRow(table *tview.Table, row int, templateInfo *client.TemplateInfo) {
-	table.SetCell(row, 0, tview.NewTableCell(templateInfo.TemplateID).SetReference(&TemplateDetails{templateInfo.TemplateID, self}))
-	table.SetCellSimple(row, 1, strconv.Itoa(len(templateInfo.DeploymentIDs)))
-	table.SetCellSimple(row, 2, self.timestamp(templateInfo.Updated))
+	templateDetails := &TemplateDetails{templateInfo.Namespace, templateInfo.TemplateID, self}
+	table.SetCell(row, 0, tview.NewTableCell(templateInfo.Namespace).SetReference(templateDetails))
+	table.SetCell(row, 1, tview.NewTableCell(templateInfo.TemplateID).SetReference(templateDetails))
+	table.SetCellSimple(row, 2, strconv.Itoa(len(templateInfo.DeploymentIDs)))
+	table.SetCellSimple(row, 3, self.timestamp(templateInfo.Updated))
 }
 
 func GetTemplateRow(table *tview.Table, row int) string {
-	return table.GetCell(row, 0).GetReference().(*TemplateDetails).templateId
+	templateDetails := table.GetCell(row, 0).GetReference().(*TemplateDetails)
+	return ToNamespacedID(templateDetails.namespace, templateDetails.templateId)
 }
 
-func FindTemplateRow(table *tview.Table, templateId string) int {
+func FindTemplateRow(table *tview.Table, namespace string, templateId string) int {
+	namespacedId := ToNamespacedID(namespace, templateId)
 	rowCount := table.GetRowCount()
 	for row := 1; row < rowCount; row++ {
-		if templateId == GetTemplateRow(table, row) {
+		if namespacedId == GetTemplateRow(table, row) {
 			return row
 		}
 	}
@@ -58,18 +62,19 @@ func FindTemplateRow(table *tview.Table, templateId string) int {
 //
 
 type TemplateDetails struct {
+	namespace   string
 	templateId  string
 	application *Application
 }
 
 // ([Details] interface)
 func (self *TemplateDetails) GetTitle() string {
-	return "Template: " + self.templateId
+	return "Template: " + ToNamespacedID(self.namespace, self.templateId)
 }
 
 // ([Details] interface)
 func (self *TemplateDetails) GetText() (string, error) {
-	if template, ok, err := self.application.client.GetTemplate(self.templateId); err == nil {
+	if template, ok, err := self.application.client.GetTemplate(self.namespace, self.templateId); err == nil {
 		if ok {
 			return PackageToYAML(template.Package)
 		} else {
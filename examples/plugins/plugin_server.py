@@ -0,0 +1,16 @@
+#!/usr/bin/env python3
+
+# Allow relative imports
+import sys, pathlib
+sys.path.append(str(pathlib.Path(__file__).parents[2] / 'sdk' / 'python'))
+
+import tko, validate_free5gc_smf, prepare_free5gc_smf
+
+
+# Serves the example plugins for the "grpc" executor, e.g.:
+#   tko plugin register validate free5gc/smf localhost:50060 --executor=grpc --trigger=free5gc.plugin.nephio.org,v1alpha1,SMF
+#   tko plugin register prepare free5gc/smf localhost:50060 --executor=grpc --trigger=free5gc.plugin.nephio.org,v1alpha1,SMF
+if __name__ == '__main__':
+  tko.serve_plugins(
+    validators={'free5gc/smf': validate_free5gc_smf.validate},
+    preparers={'free5gc/smf': prepare_free5gc_smf.prepare})
@@ -29,6 +29,16 @@ def schedule():
     for chart in helm.iter_charts(deployment):
       helm.install(chart, deployment, kube_context=kube_context)
 
+  for deployment_id, deployment in tko.get_deleted_deployments():
+    for chart in helm.iter_charts(deployment):
+      helm.uninstall(chart, kube_context=kube_context)
+
+    cluster_package, namespaced_package = tko.meta_schedule(deployment)
+    kubectl.delete(namespaced_package, kube_context=kube_context)
+    kubectl.delete(cluster_package, kube_context=kube_context)
+
+    tko.acknowledge_deleted_deployment(deployment_id)
+
 
 if __name__ == '__main__':
   tko.schedule(schedule)
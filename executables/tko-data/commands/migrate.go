@@ -0,0 +1,81 @@
+package commands
+
+import (
+	contextpkg "context"
+	"fmt"
+
+	"github.com/nephio-experimental/tko/backend/sql"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/spf13/cobra"
+	"github.com/tliron/commonlog"
+	cobrautil "github.com/tliron/kutil/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+var dryRun bool
+
+func init() {
+	rootCommand.AddCommand(migrateCommand)
+
+	migrateCommand.Flags().StringVarP(&backendName, "backend", "b", sql.PostgreSQLName, "backend implementation (\"postgresql\" or \"sqlite\")")
+	migrateCommand.Flags().StringVar(&backendConnection, "backend-connection", "", "backend connection (defaults to \""+defaultPostgreSQLConnection+"\" for postgresql and \""+sql.SQLiteDefaultDataSource+"\" for sqlite)")
+	migrateCommand.Flags().Float64Var(&backendConnectTimeout, "backend-connection-timeout", 30.0, "backend connection timeout in seconds")
+	migrateCommand.Flags().BoolVar(&dryRun, "dry-run", false, "validate pending schema migrations without applying them")
+
+	cobrautil.SetFlagsFromEnvironment("TKO_", migrateCommand)
+}
+
+var migrateCommand = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending SQL schema migrations",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		Migrate()
+	},
+}
+
+func Migrate() {
+	var sqlBackend *sql.SQLBackend
+	switch backendName {
+	case sql.PostgreSQLName:
+		if backendConnection == "" {
+			backendConnection = defaultPostgreSQLConnection
+		}
+		sqlBackend = sql.NewSQLBackend("pgx", backendConnection, "cbor", maxModificationDuration, commonlog.GetLogger("backend.sql"))
+
+	case sql.SQLiteName:
+		if backendConnection == "" {
+			backendConnection = sql.SQLiteDefaultDataSource
+		}
+		sqlBackend = sql.NewSQLBackend("sqlite", sql.SQLiteDataSource(backendConnection), "cbor", maxModificationDuration, commonlog.GetLogger("backend.sql"))
+
+	default:
+		util.Failf("backend does not support schema migrations: %s", backendName)
+	}
+
+	util.OnExitError(func() error {
+		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), BackendReleaseTimeout)
+		defer cancel()
+		return sqlBackend.Release(context)
+	})
+
+	context, cancel := contextpkg.WithTimeout(contextpkg.Background(), tkoutil.SecondsToDuration(backendConnectTimeout))
+	defer cancel()
+
+	version, migrations, err := sqlBackend.Migrate(context, dryRun)
+	util.FailOnError(err)
+
+	fmt.Printf("schema version: %d\n", version)
+	if len(migrations) == 0 {
+		fmt.Println("no pending migrations")
+		return
+	}
+
+	verb := "applied"
+	if dryRun {
+		verb = "would apply"
+	}
+	for _, migration := range migrations {
+		fmt.Printf("%s migration %d: %s\n", verb, migration.Version, migration.Description)
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"time"
 
 	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	healthpkg "github.com/nephio-experimental/tko/health"
+	"github.com/nephio-experimental/tko/metrics"
+	pluginspkg "github.com/nephio-experimental/tko/plugins"
 	schedulingpkg "github.com/nephio-experimental/tko/scheduling"
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/spf13/cobra"
 	"github.com/tliron/commonlog"
@@ -13,22 +17,42 @@ import (
 )
 
 var (
-	interval float64
-
-	grpcIpStackString string
-	grpcIpStack       util.IPStack
-	grpcAddress       string
-	grpcPort          uint
-	grpcFormat        string
-	grpcTimeout       float64
+	interval       float64
+	resyncInterval float64
+
+	grpcIpStackString  string
+	grpcIpStack        util.IPStack
+	grpcAddress        string
+	grpcPort           uint
+	grpcFormat         string
+	grpcTimeout        float64
+	grpcToken          string
+	grpcTls            bool
+	grpcTlsCa          string
+	grpcTlsCertificate string
+	grpcTlsKey         string
 
 	logIpStackString string
 	logIpStack       util.IPStack
 	logAddress       string
 	logPort          uint
 
+	metrics_             bool
+	metricsIpStackString string
+	metricsIpStack       util.IPStack
+	metricsAddress       string
+	metricsPort          uint
+
+	otlpEndpoint string
+	otlpInsecure bool
+
+	heartbeatTimeout float64
+
 	schedulerTimeout float64
 
+	wasmMounts       []string
+	grpcTokenTargets []string
+
 	ResetSchedulingPluginCacheFrequency = 10 * time.Second
 )
 
@@ -36,15 +60,30 @@ func init() {
 	rootCommand.AddCommand(startCommand)
 
 	startCommand.Flags().Float64Var(&interval, "interval", 3.0, "polling interval in seconds")
+	startCommand.Flags().Float64Var(&resyncInterval, "resync-interval", 60.0, "polling interval in seconds while watching for changes")
 	startCommand.Flags().StringVar(&grpcIpStackString, "grpc-ip-stack", "dual", "IP stack for TKO Data gRPC (\"dual\", \"ipv6\", or \"ipv4\")")
 	startCommand.Flags().StringVar(&grpcAddress, "grpc-address", "", "IP address for TKO Data gRPC")
 	startCommand.Flags().UintVar(&grpcPort, "grpc-port", 50050, "TCP port for TKO Data gRPC")
 	startCommand.Flags().StringVar(&grpcFormat, "grpc-format", "cbor", "preferred format for encoding KRM for TKO Data gRPC (\"yaml\" or \"cbor\")")
 	startCommand.Flags().Float64Var(&grpcTimeout, "grpc-timeout", 10.0, "gRPC timeout in seconds")
+	startCommand.Flags().StringVar(&grpcToken, "grpc-token", "", "bearer token for TKO Data gRPC")
+	startCommand.Flags().BoolVar(&grpcTls, "grpc-tls", false, "use TLS for TKO Data gRPC")
+	startCommand.Flags().StringVar(&grpcTlsCa, "grpc-tls-ca", "", "CA certificate file (PEM) for verifying TKO Data gRPC (implies TLS; defaults to system CAs)")
+	startCommand.Flags().StringVar(&grpcTlsCertificate, "grpc-tls-certificate", "", "client certificate file (PEM) for TKO Data gRPC mTLS (implies TLS)")
+	startCommand.Flags().StringVar(&grpcTlsKey, "grpc-tls-key", "", "client key file (PEM) for TKO Data gRPC mTLS (implies TLS)")
 	startCommand.Flags().StringVar(&logAddress, "log-address", "", "bind IP address for log server")
 	startCommand.Flags().StringVar(&logIpStackString, "log-ip-stack", "dual", "IP stack for log server (\"dual\", \"ipv6\", or \"ipv4\")")
 	startCommand.Flags().UintVar(&logPort, "log-port", 50055, "bind TCP port for log server")
+	startCommand.Flags().BoolVar(&metrics_, "metrics", true, "start Prometheus metrics and health server")
+	startCommand.Flags().StringVar(&metricsIpStackString, "metrics-ip-stack", "dual", "bind IP stack for metrics and health server (\"dual\", \"ipv6\", or \"ipv4\")")
+	startCommand.Flags().StringVar(&metricsAddress, "metrics-address", "", "bind IP address for metrics and health server")
+	startCommand.Flags().UintVar(&metricsPort, "metrics-port", 50057, "bind TCP port for metrics and health server")
+	startCommand.Flags().StringVar(&otlpEndpoint, "otlp-endpoint", "", "OTLP gRPC endpoint for exporting traces, e.g. \"localhost:4317\" (traces are not exported if empty)")
+	startCommand.Flags().BoolVar(&otlpInsecure, "otlp-insecure", true, "do not use TLS for the OTLP endpoint")
+	startCommand.Flags().Float64Var(&heartbeatTimeout, "heartbeat-timeout", 600.0, "fail the liveness check if the controller loop has not completed within this many seconds")
 	startCommand.Flags().Float64Var(&schedulerTimeout, "scheduler-timeout", 300.0, "scheduler timeout in seconds")
+	startCommand.Flags().StringArrayVar(&wasmMounts, "wasm-mount", nil, "host directory that WASM plugins may mount read-only, as \"name=path\" (can be repeated)")
+	startCommand.Flags().StringArrayVar(&grpcTokenTargets, "grpc-plugin-token-target", nil, "gRPC plugin server target allowed to receive this service's bearer token over TLS (can be repeated)")
 
 	cobrautil.SetFlagsFromEnvironment("TKO_", startCommand)
 }
@@ -59,13 +98,35 @@ var startCommand = &cobra.Command{
 		logIpStack = util.IPStack(logIpStackString)
 		util.FailOnError(logIpStack.Validate("log-ip-stack"))
 
+		metricsIpStack = util.IPStack(metricsIpStackString)
+		util.FailOnError(metricsIpStack.Validate("metrics-ip-stack"))
+
 		Start()
 	},
 }
 
 func Start() {
+	// Tracing
+	shutdownTracing, err := telemetry.StartTracing(toolName, otlpEndpoint, otlpInsecure, commonlog.GetLogger("telemetry"))
+	util.FailOnError(err)
+	util.OnExit(shutdownTracing)
+
 	// Client
 	client := clientpkg.NewClient(grpcIpStack, grpcAddress, int(grpcPort), grpcFormat, tkoutil.SecondsToDuration(grpcTimeout), commonlog.GetLogger("client"))
+	client.Author = toolName
+	client.Token = grpcToken
+	client.TLS, err = tkoutil.NewClientTLSConfig(grpcTls, grpcTlsCa, grpcTlsCertificate, grpcTlsKey)
+	util.FailOnError(err)
+
+	// WASM mounts
+	for _, wasmMount := range wasmMounts {
+		util.FailOnError(pluginspkg.AllowWASMMount(wasmMount))
+	}
+
+	// gRPC plugin token forwarding
+	for _, grpcTokenTarget := range grpcTokenTargets {
+		pluginspkg.AllowGRPCTokenTarget(grpcTokenTarget)
+	}
 
 	// Scheduling
 	scheduling := schedulingpkg.NewScheduling(client, tkoutil.SecondsToDuration(schedulerTimeout), commonlog.GetLogger("scheduling"), logIpStack, logAddress, int(logPort))
@@ -74,11 +135,26 @@ func Start() {
 	util.OnExit(schedulingTicker.Stop)
 
 	// Controller
-	controller := schedulingpkg.NewController(scheduling, tkoutil.SecondsToDuration(interval), commonlog.GetLogger("controller"))
+	controller := schedulingpkg.NewController(scheduling, tkoutil.SecondsToDuration(interval), tkoutil.SecondsToDuration(resyncInterval), commonlog.GetLogger("controller"))
 
 	controller.Start()
 	util.OnExit(controller.Stop)
 
+	// Health
+	health := healthpkg.NewHealth(commonlog.GetLogger("health"))
+	health.AddLivenessCheck("controller", healthpkg.HeartbeatCheck(controller.Heartbeat, tkoutil.SecondsToDuration(heartbeatTimeout)))
+	health.AddReadinessCheck("data", healthpkg.ClientCheck(client))
+	health.Start()
+	util.OnExit(health.Stop)
+
+	// Metrics and health
+	if metrics_ {
+		metricsServer := metrics.NewServer(metricsIpStack, metricsAddress, int(metricsPort), commonlog.GetLogger("metrics"))
+		health.Handle(metricsServer.Mux)
+		util.FailOnError(metricsServer.Start())
+		util.OnExit(metricsServer.Stop)
+	}
+
 	// Block forever
 	select {}
 }
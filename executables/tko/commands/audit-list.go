@@ -0,0 +1,46 @@
+package commands
+
+import (
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+var (
+	auditSince  string
+	auditUntil  string
+	auditActors []string
+)
+
+func init() {
+	auditCommand.AddCommand(auditListCommand)
+
+	auditListCommand.Flags().UintVar(&offset, "offset", 0, "fetch results starting at this offset")
+	auditListCommand.Flags().UintVar(&maxCount, "max-count", backend.DefaultMaxCount, "maximum number of results to fetch")
+	auditListCommand.Flags().StringVar(&auditSince, "since", "", "filter by time at or after this (RFC 3339 timestamp or duration before now)")
+	auditListCommand.Flags().StringVar(&auditUntil, "until", "", "filter by time before this (RFC 3339 timestamp or duration before now)")
+	auditListCommand.Flags().StringArrayVar(&auditActors, "actor", nil, "filter by actor")
+}
+
+var auditListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "List audit events",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ListAuditEvents(namespace, offset, maxCount, auditSince, auditUntil, auditActors)
+	},
+}
+
+func ListAuditEvents(namespace string, offset uint, maxCount uint, since string, until string, actors []string) {
+	auditEvents, err := NewClient().ListAuditEvents(client.SelectAuditEvents{
+		Namespace: namespace,
+		Since:     ParseTime(since),
+		Until:     ParseTime(until),
+		Actors:    actors,
+	}, offset, int(maxCount))
+	FailOnGRPCError(err)
+	auditEvents_, err := util.GatherResults(auditEvents)
+	util.FailOnError(err)
+	Print(auditEvents_)
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+func init() {
+	rootCommand.AddCommand(auditCommand)
+}
+
+var auditCommand = &cobra.Command{
+	Use:   "audit",
+	Short: "Work with the audit log",
+}
+
+// Accepts either an RFC 3339 timestamp or a duration before now, e.g. "12h". Empty is
+// the zero time.
+func ParseTime(value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-duration)
+	}
+	if time_, err := time.Parse(time.RFC3339, value); err == nil {
+		return time_
+	}
+	util.Failf("time must be an RFC 3339 timestamp or a duration: %s", value)
+	return time.Time{}
+}
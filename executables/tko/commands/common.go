@@ -3,6 +3,7 @@ package commands
 import (
 	"io"
 	"os"
+	userpkg "os/user"
 	"strings"
 	"time"
 
@@ -30,14 +31,28 @@ var (
 	templateMetadata   map[string]string
 	siteMetadata       map[string]string
 	deploymentMetadata map[string]string
+	metadataSelector   string
+	packageSelector    string
 	parentDeploymentId string
 	executor           string
 	offset             uint
 	maxCount           uint
+	expectedVersion    uint64
+	cascade            string
 )
 
 func NewClient() *clientpkg.Client {
 	client := clientpkg.NewClient(grpcIpStack, grpcAddress, int(grpcPort), grpcFormat, tkoutil.SecondsToDuration(grpcTimeout), clientLog)
+	client.Author = author
+	if client.Author == "" {
+		if user, err := userpkg.Current(); err == nil {
+			client.Author = user.Username
+		}
+	}
+	client.Token = grpcToken
+	var err error
+	client.TLS, err = tkoutil.NewClientTLSConfig(grpcTls, grpcTlsCa, grpcTlsCertificate, grpcTlsKey)
+	util.FailOnError(err)
 	return client
 }
 
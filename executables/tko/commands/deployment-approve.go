@@ -6,7 +6,6 @@ import (
 
 	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
 	"github.com/nephio-experimental/tko/backend"
-	"github.com/nephio-experimental/tko/backend/validating"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/spf13/cobra"
 	"github.com/tliron/kutil/util"
@@ -17,6 +16,8 @@ func init() {
 
 	deploymentApproveCommand.Flags().StringVar(&parentDeploymentId, "parent", "", "filter by parent deployment ID")
 	deploymentApproveCommand.Flags().StringToStringVar(&deploymentMetadata, "metadata", nil, "filter by metadata")
+	deploymentApproveCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	deploymentApproveCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
 	deploymentApproveCommand.Flags().StringArrayVar(&templateIdPatterns, "template-id", nil, "filter by template ID pattern")
 	deploymentApproveCommand.Flags().StringToStringVar(&templateMetadata, "template-metadata", nil, "filter by template metadata")
 	deploymentApproveCommand.Flags().StringArrayVar(&siteIdPatterns, "site-id", nil, "filter by site ID pattern")
@@ -33,41 +34,22 @@ var deploymentApproveCommand = &cobra.Command{
 			deploymentId = args[0]
 		}
 
-		ApproveDeployment(deploymentId, parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata)
+		ApproveDeployment(namespace, deploymentId, true, parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata, metadataSelector, packageSelector)
 	},
 }
 
-func ApproveDeployment(deploymentId string, parentDemploymentId string, templateIdPatterns []string, templateMetadataPatterns map[string]string, siteIdPatterns []string, siteMetadataPatterns map[string]string, metadataPatterns map[string]string) {
-	var deploymentInfos util.Results[clientpkg.DeploymentInfo]
+func ApproveDeployment(namespace string, deploymentId string, approve bool, parentDemploymentId string, templateIdPatterns []string, templateMetadataPatterns map[string]string, siteIdPatterns []string, siteMetadataPatterns map[string]string, metadataPatterns map[string]string, metadataSelector string, packageSelector string) {
+	verb := "approved"
+	if !approve {
+		verb = "unapproved"
+	}
 
 	client := NewClient()
 
-	// TODO: it would be more efficient to add an ApproveDeployments API to the backend
-
 	if deploymentId != "" {
-		deploymentInfos = util.NewResult(clientpkg.DeploymentInfo{DeploymentID: deploymentId})
-	} else {
-		var parentDemploymentId_ *string
-		if parentDemploymentId != "" {
-			parentDemploymentId_ = &parentDemploymentId
-		}
-
-		deploymentInfos = client.ListAllDeployments(clientpkg.SelectDeployments{
-			ParentDeploymentID:       parentDemploymentId_,
-			TemplateIDPatterns:       templateIdPatterns,
-			TemplateMetadataPatterns: templateMetadataPatterns,
-			SiteIDPatterns:           siteIdPatterns,
-			SiteMetadataPatterns:     siteMetadataPatterns,
-			MetadataPatterns:         metadataPatterns,
-			Prepared:                 &trueBool,  // must be prepared
-			Approved:                 &falseBool, // avoid approving if already approved
-		})
-	}
-
-	approver := util.NewParallelExecutor(validating.ParallelBufferSize, func(deploymentId string) error {
-		if approved, err := client.ModifyDeployment(deploymentId, func(package_ tkoutil.Package) (bool, tkoutil.Package, error) {
+		if modified, err := client.ModifyDeployment(namespace, deploymentId, func(package_ tkoutil.Package) (bool, tkoutil.Package, error) {
 			if deployment, ok := tkoutil.DeploymentResourceIdentifier.GetResource(package_); ok {
-				if tkoutil.SetApprovedAnnotation(deployment, true) {
+				if tkoutil.SetApprovedAnnotation(deployment, approve) {
 					return true, package_, nil
 				} else {
 					return false, nil, nil
@@ -76,33 +58,66 @@ func ApproveDeployment(deploymentId string, parentDemploymentId string, template
 				return false, nil, errors.New("malformed Deployment")
 			}
 		}); err == nil {
-			if approved {
-				Print(fmt.Sprintf("approved: %s", deploymentId))
+			if modified {
+				Print(fmt.Sprintf("%s: %s", verb, deploymentId))
 			} else {
-				Print(fmt.Sprintf("already approved: %s", deploymentId))
-			}
-			return nil
-		} else {
-			// Swallow not-found errors
-			if backend.IsNotFoundError(err) {
-				return nil
+				Print(fmt.Sprintf("already %s: %s", verb, deploymentId))
 			}
-			return err
+		} else if !backend.IsNotFoundError(err) {
+			FailOnGRPCError(err)
 		}
-	})
+		return
+	}
 
-	approver.Start(validating.ParallelWorkers)
+	var parentDemploymentId_ *string
+	if parentDemploymentId != "" {
+		parentDemploymentId_ = &parentDemploymentId
+	}
 
-	empty := true
-	util.FailOnError(util.IterateResults(deploymentInfos, func(deploymentInfo clientpkg.DeploymentInfo) error {
-		empty = false
-		approver.Queue(deploymentInfo.DeploymentID)
-		return nil
-	}))
+	// Only prepared deployments can be approved (but any can be unapproved), and we avoid
+	// modifying those already as requested
+	var prepared *bool
+	if approve {
+		prepared = &trueBool
+	}
+	notApprove := !approve
+	ModifyDeployments(client, clientpkg.SelectDeployments{
+		Namespace:                namespace,
+		ParentDeploymentID:       parentDemploymentId_,
+		TemplateIDPatterns:       templateIdPatterns,
+		TemplateMetadataPatterns: templateMetadataPatterns,
+		SiteIDPatterns:           siteIdPatterns,
+		SiteMetadataPatterns:     siteMetadataPatterns,
+		MetadataPatterns:         metadataPatterns,
+		MetadataSelector:         metadataSelector,
+		PackageSelector:          packageSelector,
+		Prepared:                 prepared,
+		Approved:                 &notApprove,
+	}, &approve, nil, nil, verb, "already "+verb)
+}
 
-	util.FailOnError(errors.Join(approver.Wait()...))
+func ModifyDeployments(client *clientpkg.Client, selectDeployments clientpkg.SelectDeployments, approved *bool, setMetadata map[string]string, deleteMetadata []string, verb string, unchangedVerb string) {
+	results, err := client.ModifyDeployments(selectDeployments, approved, setMetadata, deleteMetadata)
+	FailOnGRPCError(err)
+
+	if len(results) == 0 {
+		Print(fmt.Sprintf("no deployments %s", verb))
+		return
+	}
+
+	var failed uint
+	for _, result := range results {
+		if result.NotModifiedReason != "" {
+			Print(fmt.Sprintf("not %s: %s: %s", verb, result.DeploymentID, result.NotModifiedReason))
+			failed++
+		} else if result.Modified {
+			Print(fmt.Sprintf("%s: %s", verb, result.DeploymentID))
+		} else {
+			Print(fmt.Sprintf("%s: %s", unchangedVerb, result.DeploymentID))
+		}
+	}
 
-	if empty {
-		Print("no deployments to approve")
+	if failed > 0 {
+		util.Failf("%d of %d deployments not %s", failed, len(results), verb)
 	}
 }
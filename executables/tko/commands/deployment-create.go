@@ -34,11 +34,11 @@ var deploymentCreateCommand = &cobra.Command{
 		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), readPackageTimeout)
 		util.OnExit(cancel)
 
-		CreateDeployment(context, parentDeploymentId, args[0], siteId, deploymentMetadata, prepared, approved, url, stdin)
+		CreateDeployment(context, namespace, parentDeploymentId, args[0], siteId, deploymentMetadata, prepared, approved, url, stdin)
 	},
 }
 
-func CreateDeployment(context contextpkg.Context, parentDeploymentId string, templateId string, siteId string, mergeMetadata map[string]string, prepared bool, approved bool, url string, stdin bool) {
+func CreateDeployment(context contextpkg.Context, namespace string, parentDeploymentId string, templateId string, siteId string, mergeMetadata map[string]string, prepared bool, approved bool, url string, stdin bool) {
 	var mergePackage tkoutil.Package
 	if stdin || (url != "") {
 		var err error
@@ -46,7 +46,7 @@ func CreateDeployment(context contextpkg.Context, parentDeploymentId string, tem
 		util.FailOnError(err)
 	}
 
-	ok, reason, deploymentId, err := NewClient().CreateDeployment(parentDeploymentId, templateId, siteId, mergeMetadata, prepared, approved, mergePackage)
+	ok, reason, deploymentId, err := NewClient().CreateDeployment(namespace, parentDeploymentId, templateId, siteId, mergeMetadata, prepared, approved, mergePackage)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("created deployment: %s", deploymentId)
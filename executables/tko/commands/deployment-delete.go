@@ -7,6 +7,9 @@ import (
 
 func init() {
 	deploymentCommand.AddCommand(deploymentDeleteCommand)
+
+	deploymentDeleteCommand.Flags().StringVar(&cascade, "cascade", "orphan", "child deployments propagation (\"orphan\", \"background\", or \"foreground\")")
+	deploymentDeleteCommand.Flags().Lookup("cascade").NoOptDefVal = "background"
 }
 
 var deploymentDeleteCommand = &cobra.Command{
@@ -14,12 +17,12 @@ var deploymentDeleteCommand = &cobra.Command{
 	Short: "Delete deployment",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		DeleteDeployment(args[0])
+		DeleteDeployment(namespace, args[0], cascade)
 	},
 }
 
-func DeleteDeployment(deploymentId string) {
-	ok, reason, err := NewClient().DeleteDeployment(deploymentId)
+func DeleteDeployment(namespace string, deploymentId string, cascade string) {
+	ok, reason, err := NewClient().DeleteDeployment(namespace, deploymentId, cascade)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("deleted deployment: %s", deploymentId)
@@ -5,8 +5,12 @@ import (
 	"github.com/tliron/kutil/util"
 )
 
+var changesOnly bool
+
 func init() {
 	deploymentCommand.AddCommand(deploymentGetCommand)
+
+	deploymentGetCommand.Flags().BoolVar(&changesOnly, "changes-only", false, "only get resources that differ from the template")
 }
 
 var deploymentGetCommand = &cobra.Command{
@@ -14,12 +18,18 @@ var deploymentGetCommand = &cobra.Command{
 	Short: "Get deployment package",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		GetDeployment(args[0])
+		GetDeployment(namespace, args[0], changesOnly)
 	},
 }
 
-func GetDeployment(deploymentId string) {
-	deployment, ok, err := NewClient().GetDeployment(deploymentId)
+func GetDeployment(namespace string, deploymentId string, changesOnly bool) {
+	client := NewClient()
+	getDeployment := client.GetDeployment
+	if changesOnly {
+		getDeployment = client.GetDeploymentChanges
+	}
+
+	deployment, ok, err := getDeployment(namespace, deploymentId)
 	FailOnGRPCError(err)
 	if ok {
 		PrintPackage(deployment.Package)
@@ -19,6 +19,8 @@ func init() {
 	deploymentListCommand.Flags().UintVar(&maxCount, "max-count", backend.DefaultMaxCount, "maximum number of results to fetch")
 	deploymentListCommand.Flags().StringVar(&parentDeploymentId, "parent", "", "filter by parent deployment ID")
 	deploymentListCommand.Flags().StringToStringVar(&deploymentMetadata, "metadata", nil, "filter by metadata")
+	deploymentListCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	deploymentListCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
 	deploymentListCommand.Flags().StringArrayVar(&templateIdPatterns, "template-id", nil, "filter by template ID pattern")
 	deploymentListCommand.Flags().StringToStringVar(&templateMetadata, "template-metadata", nil, "filter by template metadata")
 	deploymentListCommand.Flags().StringArrayVar(&siteIdPatterns, "site-id", nil, "filter by site ID pattern")
@@ -32,14 +34,14 @@ var deploymentListCommand = &cobra.Command{
 	Short: "List deployments",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		ListDeployments(offset, maxCount, parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata, preparedFilter, approvedFilter)
+		ListDeployments(namespace, offset, maxCount, parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata, metadataSelector, packageSelector, preparedFilter, approvedFilter)
 	},
 }
 
 var trueBool = true
 var falseBool = false
 
-func ListDeployments(offset uint, maxCount uint, parentDemploymentId string, templateIdPatterns []string, templateMetadataPatterns map[string]string, siteIdPatterns []string, siteMetadataPatterns map[string]string, metadataPatterns map[string]string, preparedFilter string, approvedFilter string) {
+func ListDeployments(namespace string, offset uint, maxCount uint, parentDemploymentId string, templateIdPatterns []string, templateMetadataPatterns map[string]string, siteIdPatterns []string, siteMetadataPatterns map[string]string, metadataPatterns map[string]string, metadataSelector string, packageSelector string, preparedFilter string, approvedFilter string) {
 	var prepared *bool
 	switch preparedFilter {
 	case "true":
@@ -62,12 +64,15 @@ func ListDeployments(offset uint, maxCount uint, parentDemploymentId string, tem
 	}
 
 	deploymentInfos, err := NewClient().ListDeployments(client.SelectDeployments{
+		Namespace:                namespace,
 		ParentDeploymentID:       parentDemploymentId_,
 		TemplateIDPatterns:       templateIdPatterns,
 		TemplateMetadataPatterns: templateMetadataPatterns,
 		SiteIDPatterns:           siteIdPatterns,
 		SiteMetadataPatterns:     siteMetadataPatterns,
 		MetadataPatterns:         metadataPatterns,
+		MetadataSelector:         metadataSelector,
+		PackageSelector:          packageSelector,
 		Prepared:                 prepared,
 		Approved:                 approved,
 	}, offset, int(maxCount))
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+var (
+	setMetadata    map[string]string
+	deleteMetadata []string
+)
+
+func init() {
+	deploymentCommand.AddCommand(deploymentMetadataCommand)
+
+	deploymentMetadataCommand.Flags().StringToStringVar(&setMetadata, "set", nil, "set metadata")
+	deploymentMetadataCommand.Flags().StringArrayVar(&deleteMetadata, "delete", nil, "delete metadata key")
+	deploymentMetadataCommand.Flags().StringVar(&parentDeploymentId, "parent", "", "filter by parent deployment ID")
+	deploymentMetadataCommand.Flags().StringToStringVar(&deploymentMetadata, "metadata", nil, "filter by metadata")
+	deploymentMetadataCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	deploymentMetadataCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
+	deploymentMetadataCommand.Flags().StringArrayVar(&templateIdPatterns, "template-id", nil, "filter by template ID pattern")
+	deploymentMetadataCommand.Flags().StringToStringVar(&templateMetadata, "template-metadata", nil, "filter by template metadata")
+	deploymentMetadataCommand.Flags().StringArrayVar(&siteIdPatterns, "site-id", nil, "filter by site ID pattern")
+	deploymentMetadataCommand.Flags().StringToStringVar(&siteMetadata, "site-metadata", nil, "filter by site metadata")
+	deploymentMetadataCommand.Flags().StringVar(&preparedFilter, "prepared", "", "filter by prepared state (\"true\", \"false\", or empty)")
+	deploymentMetadataCommand.Flags().StringVar(&approvedFilter, "approved", "", "filter by approved state (\"true\", \"false\", or empty)")
+}
+
+var deploymentMetadataCommand = &cobra.Command{
+	Use:   "metadata",
+	Short: "Set or delete metadata of deployments",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		SetDeploymentsMetadata(namespace, setMetadata, deleteMetadata, parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata, metadataSelector, packageSelector, preparedFilter, approvedFilter)
+	},
+}
+
+func SetDeploymentsMetadata(namespace string, setMetadata map[string]string, deleteMetadata []string, parentDemploymentId string, templateIdPatterns []string, templateMetadataPatterns map[string]string, siteIdPatterns []string, siteMetadataPatterns map[string]string, metadataPatterns map[string]string, metadataSelector string, packageSelector string, preparedFilter string, approvedFilter string) {
+	if (len(setMetadata) == 0) && (len(deleteMetadata) == 0) {
+		util.Fail("must provide \"--set\" and/or \"--delete\"")
+	}
+
+	var prepared *bool
+	switch preparedFilter {
+	case "true":
+		prepared = &trueBool
+	case "false":
+		prepared = &falseBool
+	}
+
+	var approved *bool
+	switch approvedFilter {
+	case "true":
+		approved = &trueBool
+	case "false":
+		approved = &falseBool
+	}
+
+	var parentDemploymentId_ *string
+	if parentDemploymentId != "" {
+		parentDemploymentId_ = &parentDemploymentId
+	}
+
+	ModifyDeployments(NewClient(), clientpkg.SelectDeployments{
+		Namespace:                namespace,
+		ParentDeploymentID:       parentDemploymentId_,
+		TemplateIDPatterns:       templateIdPatterns,
+		TemplateMetadataPatterns: templateMetadataPatterns,
+		SiteIDPatterns:           siteIdPatterns,
+		SiteMetadataPatterns:     siteMetadataPatterns,
+		MetadataPatterns:         metadataPatterns,
+		MetadataSelector:         metadataSelector,
+		PackageSelector:          packageSelector,
+		Prepared:                 prepared,
+		Approved:                 approved,
+	}, nil, setMetadata, deleteMetadata, "modified", "unchanged")
+}
@@ -13,6 +13,7 @@ func init() {
 	deploymentModCommand.AddCommand(deploymentModStartCommand)
 
 	deploymentModStartCommand.Flags().StringVarP(&url, "url", "u", "", "URL for package YAML manifests output (can be a local directory or file)")
+	deploymentModStartCommand.Flags().Uint64Var(&expectedVersion, "expected-version", 0, "fail if the current version is different (0 to ignore)")
 }
 
 var deploymentModStartCommand = &cobra.Command{
@@ -20,12 +21,12 @@ var deploymentModStartCommand = &cobra.Command{
 	Short: "Start modification of a deployment",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		StartDeploymentModification(args[0], url)
+		StartDeploymentModification(namespace, args[0], url, expectedVersion)
 	},
 }
 
-func StartDeploymentModification(deploymentId string, url string) {
-	ok, reason, modificationToken, package_, err := NewClient().StartDeploymentModification(deploymentId)
+func StartDeploymentModification(namespace string, deploymentId string, url string, expectedVersion uint64) {
+	ok, reason, modificationToken, package_, err := NewClient().StartDeploymentModification(namespace, deploymentId, expectedVersion)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("started modification: %s", deploymentId)
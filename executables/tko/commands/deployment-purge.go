@@ -11,12 +11,16 @@ func init() {
 
 	deploymentPurgeCommand.Flags().StringVar(&parentDeploymentId, "parent", "", "filter by parent deployment ID")
 	deploymentPurgeCommand.Flags().StringToStringVar(&deploymentMetadata, "metadata", nil, "filter by metadata")
+	deploymentPurgeCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	deploymentPurgeCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
 	deploymentPurgeCommand.Flags().StringArrayVar(&templateIdPatterns, "template-id", nil, "filter by template ID pattern")
 	deploymentPurgeCommand.Flags().StringToStringVar(&templateMetadata, "template-metadata", nil, "filter by template metadata")
 	deploymentPurgeCommand.Flags().StringArrayVar(&siteIdPatterns, "site-id", nil, "filter by site ID pattern")
 	deploymentPurgeCommand.Flags().StringToStringVar(&siteMetadata, "site-metadata", nil, "filter by site metadata")
 	deploymentPurgeCommand.Flags().StringVar(&preparedFilter, "prepared", "", "filter by prepared state (\"true\", \"false\", or empty)")
 	deploymentPurgeCommand.Flags().StringVar(&approvedFilter, "approved", "", "filter by approved state (\"true\", \"false\", or empty)")
+	deploymentPurgeCommand.Flags().StringVar(&cascade, "cascade", "orphan", "child deployments propagation (\"orphan\", \"background\", or \"foreground\")")
+	deploymentPurgeCommand.Flags().Lookup("cascade").NoOptDefVal = "background"
 }
 
 var deploymentPurgeCommand = &cobra.Command{
@@ -24,11 +28,11 @@ var deploymentPurgeCommand = &cobra.Command{
 	Short: "purge deployments",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		PurgeDeployments(parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata, preparedFilter, approvedFilter)
+		PurgeDeployments(namespace, parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata, metadataSelector, packageSelector, preparedFilter, approvedFilter, cascade)
 	},
 }
 
-func PurgeDeployments(parentDemploymentId string, templateIdPatterns []string, templateMetadataPatterns map[string]string, siteIdPatterns []string, siteMetadataPatterns map[string]string, metadataPatterns map[string]string, preparedFilter string, approvedFilter string) {
+func PurgeDeployments(namespace string, parentDemploymentId string, templateIdPatterns []string, templateMetadataPatterns map[string]string, siteIdPatterns []string, siteMetadataPatterns map[string]string, metadataPatterns map[string]string, metadataSelector string, packageSelector string, preparedFilter string, approvedFilter string, cascade string) {
 	var prepared *bool
 	switch preparedFilter {
 	case "true":
@@ -51,15 +55,18 @@ func PurgeDeployments(parentDemploymentId string, templateIdPatterns []string, t
 	}
 
 	ok, reason, err := NewClient().PurgeDeployments(client.SelectDeployments{
+		Namespace:                namespace,
 		ParentDeploymentID:       parentDemploymentId_,
 		TemplateIDPatterns:       templateIdPatterns,
 		TemplateMetadataPatterns: templateMetadataPatterns,
 		SiteIDPatterns:           siteIdPatterns,
 		SiteMetadataPatterns:     siteMetadataPatterns,
 		MetadataPatterns:         metadataPatterns,
+		MetadataSelector:         metadataSelector,
+		PackageSelector:          packageSelector,
 		Prepared:                 prepared,
 		Approved:                 approved,
-	})
+	}, cascade)
 	FailOnGRPCError(err)
 	if !ok {
 		util.Fail(reason)
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	deploymentCommand.AddCommand(deploymentUnapproveCommand)
+
+	deploymentUnapproveCommand.Flags().StringVar(&parentDeploymentId, "parent", "", "filter by parent deployment ID")
+	deploymentUnapproveCommand.Flags().StringToStringVar(&deploymentMetadata, "metadata", nil, "filter by metadata")
+	deploymentUnapproveCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	deploymentUnapproveCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
+	deploymentUnapproveCommand.Flags().StringArrayVar(&templateIdPatterns, "template-id", nil, "filter by template ID pattern")
+	deploymentUnapproveCommand.Flags().StringToStringVar(&templateMetadata, "template-metadata", nil, "filter by template metadata")
+	deploymentUnapproveCommand.Flags().StringArrayVar(&siteIdPatterns, "site-id", nil, "filter by site ID pattern")
+	deploymentUnapproveCommand.Flags().StringToStringVar(&siteMetadata, "site-metadata", nil, "filter by site metadata")
+}
+
+var deploymentUnapproveCommand = &cobra.Command{
+	Use:   "unapprove [[DEPLOYMENT ID]]",
+	Short: "Unapprove deployments",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var deploymentId string
+		if len(args) == 1 {
+			deploymentId = args[0]
+		}
+
+		ApproveDeployment(namespace, deploymentId, false, parentDeploymentId, templateIdPatterns, templateMetadata, siteIdPatterns, siteMetadata, deploymentMetadata, metadataSelector, packageSelector)
+	},
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"os"
+
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+var archiveFormat string
+
+func init() {
+	rootCommand.AddCommand(exportCommand)
+
+	exportCommand.Flags().StringVar(&archiveFormat, "archive-format", client.ArchiveFormatTar, "archive format ("+client.ArchiveFormatsDescription+")")
+}
+
+var exportCommand = &cobra.Command{
+	Use:   "export [FILE]",
+	Short: "Export templates, sites, plugins, and deployments to an archive",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		Export(namespace, args[0], archiveFormat)
+	},
+}
+
+func Export(namespace string, path string, archiveFormat string) {
+	if !client.IsValidArchiveFormat(archiveFormat) {
+		util.Failf("archive format must be %s: %s", client.ArchiveFormatsDescription, archiveFormat)
+	}
+
+	archiveEntries, err := NewClient().ExportArchive(namespace)
+	FailOnGRPCError(err)
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	util.FailOnError(err)
+	util.OnExitError(file.Close)
+
+	err = client.WriteArchive(file, archiveFormat, archiveEntries)
+	FailOnGRPCError(err)
+	log.Noticef("exported: %s", path)
+}
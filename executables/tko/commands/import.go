@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"os"
+
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+var importMode string
+
+func init() {
+	rootCommand.AddCommand(importCommand)
+
+	importCommand.Flags().StringVar(&archiveFormat, "archive-format", client.ArchiveFormatTar, "archive format ("+client.ArchiveFormatsDescription+")")
+	importCommand.Flags().StringVar(&importMode, "mode", "merge", "import mode (\"merge\" or \"replace\")")
+}
+
+var importCommand = &cobra.Command{
+	Use:   "import [FILE]",
+	Short: "Import templates, sites, plugins, and deployments from an archive",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		Import(args[0], archiveFormat, importMode)
+	},
+}
+
+func Import(path string, archiveFormat string, mode string) {
+	file, err := os.Open(path)
+	util.FailOnError(err)
+	util.OnExitError(file.Close)
+
+	archiveEntries, err := client.ReadArchive(file, archiveFormat)
+	util.FailOnError(err)
+
+	result, err := NewClient().ImportArchive(mode, archiveEntries)
+	FailOnGRPCError(err)
+	if result.Imported {
+		log.Noticef("imported: %s", path)
+		Print(result)
+	} else {
+		Print(result)
+		util.Fail(result.NotImportedReason)
+	}
+}
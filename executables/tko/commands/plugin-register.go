@@ -19,6 +19,7 @@ func init() {
 	pluginRegisterCommand.Flags().StringVarP(&executor, "executor", "e", "command", "plugin executor")
 	pluginRegisterCommand.Flags().StringToStringVarP(&properties, "property", "r", nil, "executor property")
 	pluginRegisterCommand.Flags().StringArrayVarP(&triggers, "trigger", "g", nil, "plugin trigger (\"group,version,kind\")")
+	pluginRegisterCommand.Flags().Uint64Var(&expectedVersion, "expected-version", 0, "fail if the current version is different (0 to ignore)")
 }
 
 var pluginRegisterCommand = &cobra.Command{
@@ -33,17 +34,17 @@ var pluginRegisterCommand = &cobra.Command{
 			}
 		}
 
-		RegisterPlugin(args[0], args[1], executor, args[2:], properties, triggers_)
+		RegisterPlugin(args[0], args[1], executor, args[2:], properties, triggers_, expectedVersion)
 	},
 }
 
-func RegisterPlugin(type_ string, name string, executor string, arguments []string, properties map[string]string, triggers []tkoutil.GVK) {
+func RegisterPlugin(type_ string, name string, executor string, arguments []string, properties map[string]string, triggers []tkoutil.GVK, expectedVersion uint64) {
 	if !plugins.IsValidPluginType(type_, false) {
 		util.Failf("plugin type must be %s: %s", plugins.PluginTypesDescription, type_)
 	}
 
 	pluginId := client.NewPluginID(type_, name)
-	ok, reason, err := NewClient().RegisterPlugin(pluginId, executor, arguments, properties, triggers)
+	ok, reason, err := NewClient().RegisterPlugin(pluginId, executor, arguments, properties, triggers, expectedVersion)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("registered plugin: %s", pluginId)
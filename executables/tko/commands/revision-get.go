@@ -0,0 +1,32 @@
+package commands
+
+import (
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+func init() {
+	revisionCommand.AddCommand(revisionGetCommand)
+}
+
+var revisionGetCommand = &cobra.Command{
+	Use:   "get [TYPE] [ID] [REVISION]",
+	Short: "Get revision package",
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		GetRevision(args[0], namespace, args[1], ParseRevision(args[2]))
+	},
+}
+
+func GetRevision(type_ string, namespace string, objectId string, revision uint64) {
+	ValidateRevisionType(type_)
+
+	revision_, ok, err := NewClient().GetRevision(client.NewRevisionID(type_, namespace, objectId, revision))
+	FailOnGRPCError(err)
+	if ok {
+		PrintPackage(revision_.Package)
+	} else {
+		util.Fail("not found")
+	}
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+func init() {
+	revisionCommand.AddCommand(revisionListCommand)
+
+	revisionListCommand.Flags().UintVar(&offset, "offset", 0, "fetch results starting at this offset")
+	revisionListCommand.Flags().UintVar(&maxCount, "max-count", backend.DefaultMaxCount, "maximum number of results to fetch")
+}
+
+var revisionListCommand = &cobra.Command{
+	Use:   "list [TYPE] [ID]",
+	Short: "List revisions",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ListRevisions(offset, maxCount, args[0], namespace, args[1])
+	},
+}
+
+func ListRevisions(offset uint, maxCount uint, type_ string, namespace string, objectId string) {
+	ValidateRevisionType(type_)
+
+	revisionInfos, err := NewClient().ListRevisions(type_, namespace, objectId, offset, int(maxCount))
+	FailOnGRPCError(err)
+	revisionInfos_, err := util.GatherResults(revisionInfos)
+	util.FailOnError(err)
+	Print(revisionInfos_)
+}
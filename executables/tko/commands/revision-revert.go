@@ -0,0 +1,33 @@
+package commands
+
+import (
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+func init() {
+	revisionCommand.AddCommand(revisionRevertCommand)
+}
+
+var revisionRevertCommand = &cobra.Command{
+	Use:   "revert [TYPE] [ID] [REVISION]",
+	Short: "Revert to revision",
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		RevertTo(args[0], namespace, args[1], ParseRevision(args[2]))
+	},
+}
+
+func RevertTo(type_ string, namespace string, objectId string, revision uint64) {
+	ValidateRevisionType(type_)
+
+	revisionId := client.NewRevisionID(type_, namespace, objectId, revision)
+	ok, reason, err := NewClient().RevertTo(revisionId)
+	FailOnGRPCError(err)
+	if ok {
+		log.Noticef("reverted to revision: %s", revisionId)
+	} else {
+		util.Fail(reason)
+	}
+}
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"strconv"
+
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+func init() {
+	rootCommand.AddCommand(revisionCommand)
+}
+
+var revisionCommand = &cobra.Command{
+	Use:   "revision",
+	Short: "Work with revisions of templates, sites, and deployments",
+}
+
+func ValidateRevisionType(type_ string) {
+	if !backend.IsValidRevisionType(type_) {
+		util.Failf("revision type must be %s: %s", backend.RevisionTypesDescription, type_)
+	}
+}
+
+func ParseRevision(revision string) uint64 {
+	revision_, err := strconv.ParseUint(revision, 10, 64)
+	if (err != nil) || (revision_ == 0) {
+		util.Failf("invalid revision: %s", revision)
+	}
+	return revision_
+}
@@ -17,12 +17,19 @@ var (
 	strict   bool
 	pretty   bool
 
-	grpcIpStackString string
-	grpcIpStack       util.IPStack
-	grpcAddress       string
-	grpcPort          uint
-	grpcFormat        string
-	grpcTimeout       float64
+	grpcIpStackString  string
+	grpcIpStack        util.IPStack
+	grpcAddress        string
+	grpcPort           uint
+	grpcFormat         string
+	grpcTimeout        float64
+	grpcToken          string
+	grpcTls            bool
+	grpcTlsCa          string
+	grpcTlsCertificate string
+	grpcTlsKey         string
+	author             string
+	namespace          string
 )
 
 func init() {
@@ -40,6 +47,13 @@ func init() {
 	rootCommand.PersistentFlags().UintVar(&grpcPort, "grpc-port", 50050, "HTTP/2 port for TKO Data gRPC")
 	rootCommand.PersistentFlags().StringVar(&grpcFormat, "grpc-format", "cbor", "preferred format for encoding KRM over gRPC (\"yaml\" or \"cbor\")")
 	rootCommand.PersistentFlags().Float64Var(&grpcTimeout, "grpc-timeout", 10.0, "gRPC timeout in seconds")
+	rootCommand.PersistentFlags().StringVar(&grpcToken, "grpc-token", "", "bearer token for TKO Data gRPC")
+	rootCommand.PersistentFlags().BoolVar(&grpcTls, "grpc-tls", false, "use TLS for TKO Data gRPC")
+	rootCommand.PersistentFlags().StringVar(&grpcTlsCa, "grpc-tls-ca", "", "CA certificate file (PEM) for verifying TKO Data gRPC (implies TLS; defaults to system CAs)")
+	rootCommand.PersistentFlags().StringVar(&grpcTlsCertificate, "grpc-tls-certificate", "", "client certificate file (PEM) for TKO Data gRPC mTLS (implies TLS)")
+	rootCommand.PersistentFlags().StringVar(&grpcTlsKey, "grpc-tls-key", "", "client key file (PEM) for TKO Data gRPC mTLS (implies TLS)")
+	rootCommand.PersistentFlags().StringVar(&author, "author", "", "author recorded in revisions (defaults to current user)")
+	rootCommand.PersistentFlags().StringVar(&namespace, "namespace", "", "namespace (defaults to \"default\"; \"*\" for all namespaces when listing, watching, or exporting)")
 
 	cobrautil.SetFlagsFromEnvironment("TKO_", rootCommand)
 }
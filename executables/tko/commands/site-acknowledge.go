@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+func init() {
+	siteCommand.AddCommand(siteAcknowledgeCommand)
+}
+
+var siteAcknowledgeCommand = &cobra.Command{
+	Use:   "acknowledge [SITE ID] [DEPLOYMENT ID]...",
+	Short: "Acknowledge the teardown of site's deleted deployments",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		AcknowledgeDeletedDeployments(namespace, args[0], args[1:])
+	},
+}
+
+func AcknowledgeDeletedDeployments(namespace string, siteId string, deploymentIds []string) {
+	ok, reason, err := NewClient().AcknowledgeDeletedDeployments(namespace, siteId, deploymentIds)
+	FailOnGRPCError(err)
+	if ok {
+		log.Noticef("acknowledged deleted deployments for site %s: %s", siteId, strings.Join(deploymentIds, ", "))
+	} else {
+		util.Fail(reason)
+	}
+}
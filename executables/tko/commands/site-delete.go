@@ -14,12 +14,12 @@ var siteDeleteCommand = &cobra.Command{
 	Short: "Delete site",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		DeleteSite(args[0])
+		DeleteSite(namespace, args[0])
 	},
 }
 
-func DeleteSite(siteId string) {
-	ok, reason, err := NewClient().DeleteSite(siteId)
+func DeleteSite(namespace string, siteId string) {
+	ok, reason, err := NewClient().DeleteSite(namespace, siteId)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("deleted site: %s", siteId)
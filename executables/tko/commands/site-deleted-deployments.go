@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+func init() {
+	siteCommand.AddCommand(siteDeletedDeploymentsCommand)
+}
+
+var siteDeletedDeploymentsCommand = &cobra.Command{
+	Use:   "deleted-deployments [SITE ID]",
+	Short: "List site's deleted deployments that have not yet been acknowledged",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ListDeletedDeployments(namespace, args[0])
+	},
+}
+
+func ListDeletedDeployments(namespace string, siteId string) {
+	deletedDeployments, err := NewClient().ListDeletedDeployments(namespace, siteId)
+	FailOnGRPCError(err)
+	deletedDeployments_, err := util.GatherResults(deletedDeployments)
+	util.FailOnError(err)
+	Print(deletedDeployments_)
+}
@@ -14,12 +14,12 @@ var siteGetCommand = &cobra.Command{
 	Short: "Get site package",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		GetSite(args[0])
+		GetSite(namespace, args[0])
 	},
 }
 
-func GetSite(siteId string) {
-	site, ok, err := NewClient().GetSite(siteId)
+func GetSite(namespace string, siteId string) {
+	site, ok, err := NewClient().GetSite(namespace, siteId)
 	FailOnGRPCError(err)
 	if ok {
 		PrintPackage(site.Package)
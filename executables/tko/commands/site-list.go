@@ -15,6 +15,8 @@ func init() {
 	siteListCommand.Flags().StringArrayVar(&siteIdPatterns, "id", nil, "filter by site ID pattern")
 	siteListCommand.Flags().StringArrayVar(&templateIdPatterns, "template-id", nil, "filter by template ID pattern")
 	siteListCommand.Flags().StringToStringVarP(&siteMetadata, "metadata", "m", nil, "filter by metadata")
+	siteListCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	siteListCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
 }
 
 var siteListCommand = &cobra.Command{
@@ -22,15 +24,18 @@ var siteListCommand = &cobra.Command{
 	Short: "List sites",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		ListSites(offset, maxCount, siteIdPatterns, templateIdPatterns, siteMetadata)
+		ListSites(namespace, offset, maxCount, siteIdPatterns, templateIdPatterns, siteMetadata, metadataSelector, packageSelector)
 	},
 }
 
-func ListSites(offset uint, maxCount uint, siteIdPatterns []string, templateIdPatterns []string, siteMetadataPatterns map[string]string) {
+func ListSites(namespace string, offset uint, maxCount uint, siteIdPatterns []string, templateIdPatterns []string, siteMetadataPatterns map[string]string, metadataSelector string, packageSelector string) {
 	siteIds, err := NewClient().ListSites(client.SelectSites{
+		Namespace:          namespace,
 		SiteIDPatterns:     siteIdPatterns,
 		TemplateIDPatterns: templateIdPatterns,
 		MetadataPatterns:   siteMetadataPatterns,
+		MetadataSelector:   metadataSelector,
+		PackageSelector:    packageSelector,
 	}, offset, int(maxCount))
 	FailOnGRPCError(err)
 	siteIds_, err := util.GatherResults(siteIds)
@@ -12,6 +12,8 @@ func init() {
 	sitePurgeCommand.Flags().StringArrayVar(&siteIdPatterns, "id", nil, "filter by site ID pattern")
 	sitePurgeCommand.Flags().StringArrayVar(&templateIdPatterns, "template-id", nil, "filter by template ID pattern")
 	sitePurgeCommand.Flags().StringToStringVarP(&siteMetadata, "metadata", "m", nil, "filter by metadata")
+	sitePurgeCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	sitePurgeCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
 }
 
 var sitePurgeCommand = &cobra.Command{
@@ -19,15 +21,18 @@ var sitePurgeCommand = &cobra.Command{
 	Short: "Purge sites",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		PurgeSites(siteIdPatterns, templateIdPatterns, siteMetadata)
+		PurgeSites(namespace, siteIdPatterns, templateIdPatterns, siteMetadata, metadataSelector, packageSelector)
 	},
 }
 
-func PurgeSites(siteIdPatterns []string, templateIdPatterns []string, siteMetadataPatterns map[string]string) {
+func PurgeSites(namespace string, siteIdPatterns []string, templateIdPatterns []string, siteMetadataPatterns map[string]string, metadataSelector string, packageSelector string) {
 	ok, reason, err := NewClient().PurgeSites(client.SelectSites{
+		Namespace:          namespace,
 		SiteIDPatterns:     siteIdPatterns,
 		TemplateIDPatterns: templateIdPatterns,
 		MetadataPatterns:   siteMetadataPatterns,
+		MetadataSelector:   metadataSelector,
+		PackageSelector:    packageSelector,
 	})
 	FailOnGRPCError(err)
 	if !ok {
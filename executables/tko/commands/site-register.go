@@ -14,6 +14,7 @@ func init() {
 	siteRegisterCommand.Flags().StringToStringVarP(&siteMetadata, "metadata", "m", nil, "mergeable metadata")
 	siteRegisterCommand.Flags().StringVarP(&url, "url", "u", "", "URL for mergeable package YAML manifests (can be a local directory or file)")
 	siteRegisterCommand.Flags().BoolVarP(&stdin, "stdin", "i", false, "use mergeable package YAML manifests from stdin")
+	siteRegisterCommand.Flags().Uint64Var(&expectedVersion, "expected-version", 0, "fail if the current version is different (0 to ignore)")
 }
 
 var siteRegisterCommand = &cobra.Command{
@@ -25,14 +26,14 @@ var siteRegisterCommand = &cobra.Command{
 		util.OnExit(cancel)
 
 		if len(args) == 2 {
-			RegisterSite(context, args[0], args[1], siteMetadata, url, stdin)
+			RegisterSite(context, namespace, args[0], args[1], siteMetadata, url, stdin, expectedVersion)
 		} else {
-			RegisterSite(context, args[0], "", siteMetadata, url, stdin)
+			RegisterSite(context, namespace, args[0], "", siteMetadata, url, stdin, expectedVersion)
 		}
 	},
 }
 
-func RegisterSite(context contextpkg.Context, siteId string, templateId string, metadata map[string]string, url string, stdin bool) {
+func RegisterSite(context contextpkg.Context, namespace string, siteId string, templateId string, metadata map[string]string, url string, stdin bool, expectedVersion uint64) {
 	var package_ tkoutil.Package
 	if stdin || (url != "") {
 		var err error
@@ -40,7 +41,7 @@ func RegisterSite(context contextpkg.Context, siteId string, templateId string,
 		util.FailOnError(err)
 	}
 
-	ok, reason, err := NewClient().RegisterSite(siteId, templateId, metadata, package_)
+	ok, reason, err := NewClient().RegisterSite(namespace, siteId, templateId, metadata, package_, expectedVersion)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("registered site: %s", siteId)
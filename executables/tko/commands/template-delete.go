@@ -14,12 +14,12 @@ var templateDeleteCommand = &cobra.Command{
 	Short: "Delete template",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		DeleteTemplate(args[0])
+		DeleteTemplate(namespace, args[0])
 	},
 }
 
-func DeleteTemplate(templateId string) {
-	ok, reason, err := NewClient().DeleteTemplate(templateId)
+func DeleteTemplate(namespace string, templateId string) {
+	ok, reason, err := NewClient().DeleteTemplate(namespace, templateId)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("deleted template: %s", templateId)
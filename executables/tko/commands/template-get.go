@@ -14,12 +14,12 @@ var templateGetCommand = &cobra.Command{
 	Short: "Get template package",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		GetTemplate(args[0])
+		GetTemplate(namespace, args[0])
 	},
 }
 
-func GetTemplate(templateId string) {
-	template, ok, err := NewClient().GetTemplate(templateId)
+func GetTemplate(namespace string, templateId string) {
+	template, ok, err := NewClient().GetTemplate(namespace, templateId)
 	FailOnGRPCError(err)
 	if ok {
 		PrintPackage(template.Package)
@@ -14,6 +14,8 @@ func init() {
 	templateListCommand.Flags().UintVar(&maxCount, "max-count", backend.DefaultMaxCount, "maximum number of results to fetch")
 	templateListCommand.Flags().StringArrayVar(&templateIdPatterns, "id", nil, "filter by template ID pattern")
 	templateListCommand.Flags().StringToStringVarP(&templateMetadata, "metadata", "m", nil, "filter by metadata")
+	templateListCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	templateListCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
 }
 
 var templateListCommand = &cobra.Command{
@@ -21,14 +23,17 @@ var templateListCommand = &cobra.Command{
 	Short: "List templates",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		ListTemplates(offset, maxCount, templateIdPatterns, templateMetadata)
+		ListTemplates(namespace, offset, maxCount, templateIdPatterns, templateMetadata, metadataSelector, packageSelector)
 	},
 }
 
-func ListTemplates(offset uint, maxCount uint, templateIdPatterns []string, templateMetadataPatterns map[string]string) {
+func ListTemplates(namespace string, offset uint, maxCount uint, templateIdPatterns []string, templateMetadataPatterns map[string]string, metadataSelector string, packageSelector string) {
 	templateInfos, err := NewClient().ListTemplates(client.SelectTemplates{
+		Namespace:          namespace,
 		TemplateIDPatterns: templateIdPatterns,
 		MetadataPatterns:   templateMetadataPatterns,
+		MetadataSelector:   metadataSelector,
+		PackageSelector:    packageSelector,
 	}, offset, int(maxCount))
 	FailOnGRPCError(err)
 	templateInfos_, err := util.GatherResults(templateInfos)
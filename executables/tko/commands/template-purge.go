@@ -11,6 +11,8 @@ func init() {
 
 	templatePurgeCommand.Flags().StringArrayVar(&templateIdPatterns, "id", nil, "filter by template ID pattern")
 	templatePurgeCommand.Flags().StringToStringVarP(&templateMetadata, "metadata", "m", nil, "filter by metadata")
+	templatePurgeCommand.Flags().StringVar(&metadataSelector, "metadata-selector", "", "filter by metadata selector expression")
+	templatePurgeCommand.Flags().StringVar(&packageSelector, "package-selector", "", "filter by package selector expression")
 }
 
 var templatePurgeCommand = &cobra.Command{
@@ -18,14 +20,17 @@ var templatePurgeCommand = &cobra.Command{
 	Short: "Purge templates",
 	Args:  cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		PurgeTemplates(templateIdPatterns, templateMetadata)
+		PurgeTemplates(namespace, templateIdPatterns, templateMetadata, metadataSelector, packageSelector)
 	},
 }
 
-func PurgeTemplates(templateIdPatterns []string, templateMetadataPatterns map[string]string) {
+func PurgeTemplates(namespace string, templateIdPatterns []string, templateMetadataPatterns map[string]string, metadataSelector string, packageSelector string) {
 	ok, reason, err := NewClient().PurgeTemplates(client.SelectTemplates{
+		Namespace:          namespace,
 		TemplateIDPatterns: templateIdPatterns,
 		MetadataPatterns:   templateMetadataPatterns,
+		MetadataSelector:   metadataSelector,
+		PackageSelector:    packageSelector,
 	})
 	FailOnGRPCError(err)
 	if !ok {
@@ -13,6 +13,7 @@ func init() {
 	templateRegisterCommand.Flags().StringToStringVarP(&templateMetadata, "metadata", "m", nil, "metadata")
 	templateRegisterCommand.Flags().StringVarP(&url, "url", "u", "", "URL for package YAML manifests (can be a local directory or file)")
 	templateRegisterCommand.Flags().BoolVarP(&stdin, "stdin", "i", false, "read package YAML manifests from stdin")
+	templateRegisterCommand.Flags().Uint64Var(&expectedVersion, "expected-version", 0, "fail if the current version is different (0 to ignore)")
 }
 
 var templateRegisterCommand = &cobra.Command{
@@ -23,15 +24,15 @@ var templateRegisterCommand = &cobra.Command{
 		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), readPackageTimeout)
 		util.OnExit(cancel)
 
-		RegisterTemplate(context, args[0], templateMetadata, url, stdin)
+		RegisterTemplate(context, namespace, args[0], templateMetadata, url, stdin, expectedVersion)
 	},
 }
 
-func RegisterTemplate(context contextpkg.Context, templateId string, metadata map[string]string, url string, stdin bool) {
+func RegisterTemplate(context contextpkg.Context, namespace string, templateId string, metadata map[string]string, url string, stdin bool, expectedVersion uint64) {
 	package_, err := readPackage(context, url, stdin)
 	util.FailOnError(err)
 
-	ok, reason, err := NewClient().RegisterTemplate(templateId, metadata, package_)
+	ok, reason, err := NewClient().RegisterTemplate(namespace, templateId, metadata, package_, expectedVersion)
 	FailOnGRPCError(err)
 	if ok {
 		log.Noticef("registered template: %s", templateId)
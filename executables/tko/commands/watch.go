@@ -0,0 +1,51 @@
+package commands
+
+import (
+	contextpkg "context"
+
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/backend"
+	"github.com/spf13/cobra"
+	"github.com/tliron/kutil/util"
+)
+
+var (
+	watchKinds    []string
+	sinceRevision uint64
+)
+
+func init() {
+	rootCommand.AddCommand(watchCommand)
+
+	watchCommand.Flags().StringArrayVarP(&watchKinds, "kind", "k", nil, "filter by event kind ("+backend.EventKindsDescription+")")
+	watchCommand.Flags().Uint64VarP(&sinceRevision, "since", "s", 0, "replay events after this revision")
+}
+
+var watchCommand = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch events",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		Watch(namespace, watchKinds, sinceRevision)
+	},
+}
+
+func Watch(namespace string, kinds []string, sinceRevision uint64) {
+	for _, kind := range kinds {
+		if !backend.IsValidEventKind(kind) {
+			util.Failf("kind must be %s: %s", backend.EventKindsDescription, kind)
+		}
+	}
+
+	events, err := NewClient().Watch(contextpkg.Background(), client.SelectEvents{
+		Namespace:     namespace,
+		Kinds:         kinds,
+		SinceRevision: sinceRevision,
+	})
+	FailOnGRPCError(err)
+	err = util.IterateResults(events, func(event client.Event) error {
+		Print(event)
+		return nil
+	})
+	FailOnGRPCError(err)
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	contextpkg "context"
+	"fmt"
+	"time"
+
+	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	backendpkg "github.com/nephio-experimental/tko/backend"
+)
+
+// Fails if the heartbeat is older than maxAge, e.g. if a controller loop is stuck or has
+// stopped. A zero heartbeat (before the first beat) is considered fresh.
+func HeartbeatCheck(heartbeat func() time.Time, maxAge time.Duration) Check {
+	start := time.Now()
+	return func(context contextpkg.Context) error {
+		last := heartbeat()
+		if last.IsZero() {
+			last = start
+		}
+
+		if age := time.Since(last); age > maxAge {
+			return fmt.Errorf("no heartbeat for %s", age.Round(time.Second))
+		}
+
+		return nil
+	}
+}
+
+// Fails if the backend cannot be reached. Backends that do not support events are only checked
+// for having connected.
+func BackendCheck(backend backendpkg.Backend) Check {
+	return func(context contextpkg.Context) error {
+		if _, err := backend.GetEventRevision(context); (err == nil) || backendpkg.IsNotImplementedError(err) {
+			return nil
+		} else {
+			return err
+		}
+	}
+}
+
+// Fails if TKO Data cannot be reached via the client.
+func ClientCheck(client *clientpkg.Client) Check {
+	return func(context contextpkg.Context) error {
+		_, err := client.WithContext(context).About()
+		return err
+	}
+}
@@ -0,0 +1,191 @@
+package health
+
+import (
+	contextpkg "context"
+	"sort"
+	"sync"
+	"time"
+
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/tliron/commonlog"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var (
+	DefaultInterval = 5 * time.Second
+	DefaultTimeout  = 3 * time.Second
+)
+
+// Returns nil if healthy.
+type Check func(context contextpkg.Context) error
+
+//
+// Health
+//
+
+// Runs the checks periodically and caches the results, so that probes are cheap and do
+// not load the checked dependencies.
+//
+// Liveness checks should only fail if restarting would help. Readiness includes liveness.
+type Health struct {
+	Interval time.Duration
+	Timeout  time.Duration // for each check
+	Log      commonlog.Logger
+
+	checks       []check
+	grpcServer   *grpchealth.Server
+	grpcServices []string
+	results      []Result
+	live         bool
+	ready        bool
+	lock         sync.RWMutex
+	ticker       *tkoutil.Ticker
+}
+
+func NewHealth(log commonlog.Logger) *Health {
+	grpcServer := grpchealth.NewServer()
+	grpcServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	return &Health{
+		Interval:   DefaultInterval,
+		Timeout:    DefaultTimeout,
+		Log:        log,
+		grpcServer: grpcServer,
+	}
+}
+
+// Should be called before [Health.Start].
+func (self *Health) AddLivenessCheck(name string, check_ Check) {
+	self.checks = append(self.checks, check{name, check_, true})
+}
+
+// Should be called before [Health.Start].
+func (self *Health) AddReadinessCheck(name string, check_ Check) {
+	self.checks = append(self.checks, check{name, check_, false})
+}
+
+// The standard gRPC health service. Its overall status ("" service) and the status of the
+// added services follow readiness.
+func (self *Health) GRPCServer() grpc_health_v1.HealthServer {
+	return self.grpcServer
+}
+
+// Should be called before [Health.Start].
+func (self *Health) AddGRPCService(service string) {
+	self.grpcServices = append(self.grpcServices, service)
+	self.grpcServer.SetServingStatus(service, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+}
+
+// Runs the checks once and then periodically.
+func (self *Health) Start() {
+	self.Update()
+	self.ticker = tkoutil.NewTicker(self.Interval, self.Update)
+	self.ticker.Start()
+}
+
+func (self *Health) Stop() {
+	if self.ticker != nil {
+		self.ticker.Stop()
+	}
+	self.grpcServer.Shutdown()
+}
+
+func (self *Health) IsLive() bool {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+	return self.live
+}
+
+func (self *Health) IsReady() bool {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+	return self.ready
+}
+
+// Results of the last run of the checks, sorted by name.
+func (self *Health) Results() []Result {
+	self.lock.RLock()
+	defer self.lock.RUnlock()
+	return self.results
+}
+
+// Runs all the checks (concurrently).
+func (self *Health) Update() {
+	results := make([]Result, len(self.checks))
+
+	var waitGroup sync.WaitGroup
+	for index, check := range self.checks {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+			defer cancel()
+			results[index] = Result{Name: check.name, Liveness: check.liveness, Error: check.check(context)}
+		}()
+	}
+	waitGroup.Wait()
+
+	sort.Slice(results, func(i int, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	live := true
+	ready := true
+	for _, result := range results {
+		if result.Error != nil {
+			ready = false
+			if result.Liveness {
+				live = false
+			}
+		}
+	}
+
+	self.lock.Lock()
+	wasReady := self.ready
+	self.results = results
+	self.live = live
+	self.ready = ready
+	self.lock.Unlock()
+
+	if ready != wasReady {
+		if ready {
+			self.Log.Notice("ready")
+		} else {
+			for _, result := range results {
+				if result.Error != nil {
+					self.Log.Warningf("not ready: %s: %s", result.Name, result.Error.Error())
+				}
+			}
+		}
+	}
+
+	servingStatus := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if ready {
+		servingStatus = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	self.grpcServer.SetServingStatus("", servingStatus)
+	for _, service := range self.grpcServices {
+		self.grpcServer.SetServingStatus(service, servingStatus)
+	}
+}
+
+//
+// Result
+//
+
+type Result struct {
+	Name     string
+	Liveness bool
+	Error    error // nil if healthy
+}
+
+//
+// check
+//
+
+type check struct {
+	name     string
+	check    Check
+	liveness bool
+}
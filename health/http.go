@@ -0,0 +1,54 @@
+package health
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Serves "/healthz" and "/readyz". Responds with 200 if healthy, otherwise with 503. The body
+// lists the results of the checks in the style of Kubernetes.
+func (self *Health) Handle(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
+		self.writeResults(writer, true)
+	})
+
+	mux.HandleFunc("/readyz", func(writer http.ResponseWriter, request *http.Request) {
+		self.writeResults(writer, false)
+	})
+}
+
+func (self *Health) writeResults(writer http.ResponseWriter, liveness bool) {
+	self.lock.RLock()
+	results := self.results
+	ok := self.ready
+	if liveness {
+		ok = self.live
+	}
+	self.lock.RUnlock()
+
+	writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	writer.Header().Set("Cache-Control", "no-store")
+	if ok {
+		writer.WriteHeader(http.StatusOK)
+	} else {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	for _, result := range results {
+		if liveness && !result.Liveness {
+			continue
+		}
+
+		if result.Error == nil {
+			fmt.Fprintf(writer, "[+]%s ok\n", result.Name)
+		} else {
+			fmt.Fprintf(writer, "[-]%s failed: %s\n", result.Name, result.Error.Error())
+		}
+	}
+
+	if ok {
+		fmt.Fprintln(writer, "ok")
+	} else {
+		fmt.Fprintln(writer, "failed")
+	}
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	backendOperationDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "backend",
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of backend operations by operation and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "outcome"})
+
+	backendBusyErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "backend",
+		Name:      "busy_errors_total",
+		Help:      "Number of backend operations that failed because a deployment was being modified.",
+	}, []string{"operation"})
+)
+
+// Busy errors are counted separately in addition to being failures.
+func ObserveBackendOperation(operation string, start time.Time, err error, busy bool) {
+	backendOperationDuration.WithLabelValues(operation, outcome(err)).Observe(since(start))
+	if busy {
+		backendBusyErrors.WithLabelValues(operation).Inc()
+	}
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	controllerLoopDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "controller",
+		Name:      "loop_duration_seconds",
+		Help:      "Duration of controller loops by controller and outcome.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+	}, []string{"controller", "outcome"})
+
+	controllerLastLoop = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "controller",
+		Name:      "last_loop_timestamp_seconds",
+		Help:      "Unix time at which the last controller loop ended, for detecting stuck controllers.",
+	}, []string{"controller"})
+
+	pluginExecutions = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "plugin",
+		Name:      "executions_total",
+		Help:      "Number of plugin executions by plugin type, name, and outcome.",
+	}, []string{"type", "name", "outcome"})
+
+	pluginExecutionDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "plugin",
+		Name:      "execution_duration_seconds",
+		Help:      "Duration of plugin executions by plugin type and name.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+	}, []string{"type", "name"})
+)
+
+// Controller is e.g. "preparation" or "scheduling".
+func ObserveControllerLoop(controller string, start time.Time, err error) {
+	controllerLoopDuration.WithLabelValues(controller, outcome(err)).Observe(since(start))
+	controllerLastLoop.WithLabelValues(controller).SetToCurrentTime()
+}
+
+// Type is the plugin type, e.g. "validate", "prepare", or "schedule".
+func ObservePluginExecution(type_ string, name string, start time.Time, err error) {
+	pluginExecutions.WithLabelValues(type_, name, outcome(err)).Inc()
+	pluginExecutionDuration.WithLabelValues(type_, name).Observe(since(start))
+}
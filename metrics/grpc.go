@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	contextpkg "context"
+	"path"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of gRPC requests by method (for streams until the stream ends).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+// ([grpc.UnaryServerInterceptor] signature)
+func GRPCUnaryInterceptor(context contextpkg.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	response, err := handler(context, request)
+	observeGRPCRequest(info.FullMethod, start, err)
+	return response, err
+}
+
+// ([grpc.StreamServerInterceptor] signature)
+func GRPCStreamInterceptor(server any, serverStream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(server, serverStream)
+	observeGRPCRequest(info.FullMethod, start, err)
+	return err
+}
+
+func observeGRPCRequest(fullMethod string, start time.Time, err error) {
+	// Full method is "/package.Service/method"
+	method := path.Base(fullMethod)
+	grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	grpcRequestDuration.WithLabelValues(method).Observe(since(start))
+}
@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	httpRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by route and status code.",
+	}, []string{"route", "code"})
+
+	httpRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route"})
+
+	kubernetesRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "kubernetes",
+		Name:      "requests_total",
+		Help:      "Number of Kubernetes API requests by verb, resource, and status code.",
+	}, []string{"verb", "resource", "code"})
+
+	kubernetesRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "kubernetes",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of Kubernetes API requests by verb and resource (for watches until the watch ends).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"verb", "resource"})
+)
+
+// Wraps an HTTP handler with request metrics. The route should be the pattern with which the
+// handler is registered, not the request path, in order to keep the number of labels bounded.
+func HTTPHandler(route string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		start := time.Now()
+		statusWriter := NewStatusResponseWriter(writer)
+		handler.ServeHTTP(statusWriter, request)
+		httpRequests.WithLabelValues(route, strconv.Itoa(statusWriter.Status)).Inc()
+		httpRequestDuration.WithLabelValues(route).Observe(since(start))
+	})
+}
+
+func ObserveKubernetesRequest(verb string, resource string, status int, start time.Time) {
+	kubernetesRequests.WithLabelValues(verb, resource, strconv.Itoa(status)).Inc()
+	kubernetesRequestDuration.WithLabelValues(verb, resource).Observe(since(start))
+}
+
+//
+// StatusResponseWriter
+//
+
+// Records the status code written to the wrapped [http.ResponseWriter].
+type StatusResponseWriter struct {
+	http.ResponseWriter
+	Status int
+}
+
+func NewStatusResponseWriter(writer http.ResponseWriter) *StatusResponseWriter {
+	return &StatusResponseWriter{ResponseWriter: writer, Status: http.StatusOK}
+}
+
+// ([http.ResponseWriter] interface)
+func (self *StatusResponseWriter) WriteHeader(status int) {
+	self.Status = status
+	self.ResponseWriter.WriteHeader(status)
+}
+
+// ([http.Flusher] interface)
+func (self *StatusResponseWriter) Flush() {
+	if flusher, ok := self.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// ([http.Hijacker] interface)
+func (self *StatusResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hijacker, ok := self.ResponseWriter.(http.Hijacker); ok {
+		return hijacker.Hijack()
+	} else {
+		return nil, nil, errors.New("response writer cannot be hijacked")
+	}
+}
+
+// Allows [http.ResponseController] to access the wrapped writer.
+func (self *StatusResponseWriter) Unwrap() http.ResponseWriter {
+	return self.ResponseWriter
+}
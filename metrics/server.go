@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	contextpkg "context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/tliron/commonlog"
+	"github.com/tliron/kutil/util"
+)
+
+//
+// Server
+//
+
+// Serves the registry at "/metrics", for services that do not have their own web server.
+// Other handlers, e.g. for health checks, can be added to Mux before starting.
+type Server struct {
+	IPStack util.IPStack
+	Address string
+	Port    int
+	Mux     *http.ServeMux
+	Log     commonlog.Logger
+
+	httpServers []*http.Server
+}
+
+func NewServer(ipStack util.IPStack, address string, port int, log commonlog.Logger) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	return &Server{
+		IPStack: ipStack,
+		Address: address,
+		Port:    port,
+		Mux:     mux,
+		Log:     log,
+	}
+}
+
+func (self *Server) Start() error {
+	return self.IPStack.StartServers(self.Address, self.start)
+}
+
+func (self *Server) Stop() {
+	context, cancel := contextpkg.WithTimeout(contextpkg.Background(), 5*time.Second)
+	defer cancel()
+
+	for index, httpServer := range self.httpServers {
+		self.Log.Notice("stopping metrics server",
+			"index", index)
+		if err := httpServer.Shutdown(context); err != nil {
+			self.Log.Critical(err.Error())
+		}
+	}
+}
+
+// ([util.IPStackStartServerFunc] signature)
+func (self *Server) start(level2protocol string, address string) error {
+	addressPort := util.JoinIPAddressPort(address, self.Port)
+	if listener, err := net.Listen(level2protocol, addressPort); err == nil {
+		index := len(self.httpServers)
+		self.Log.Notice("starting metrics server",
+			"index", index,
+			"level2protocol", level2protocol,
+			"addressPort", listener.Addr().String())
+
+		httpServer := http.Server{Handler: self.Mux}
+		self.httpServers = append(self.httpServers, &httpServer)
+
+		go func() {
+			if err := httpServer.Serve(listener); err != nil {
+				if err == http.ErrServerClosed {
+					self.Log.Notice("stopped metrics server",
+						"index", index)
+				} else {
+					self.Log.Error(err.Error())
+				}
+			}
+		}()
+
+		return nil
+	} else {
+		return err
+	}
+}
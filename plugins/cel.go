@@ -0,0 +1,175 @@
+package plugins
+
+import (
+	contextpkg "context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"github.com/nephio-experimental/tko/util"
+	"github.com/tliron/commonlog"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	CEL = "cel"
+
+	CELCostLimit = "_cel.costLimit" // optional (defaults to 1,000,000)
+
+	CELSeverityError   = "error"
+	CELSeverityWarning = "warning"
+
+	DefaultCELCostLimit = 1_000_000
+)
+
+//
+// CELExecutor
+//
+
+// Evaluates validation rules written in CEL in-process. Each argument is a rule, either a bare
+// expression or a YAML map with "expression", "message", "severity" ("error" or "warning"), and
+// "complete" (true if the rule should only be evaluated for complete validation) keys.
+//
+// Expressions must evaluate to a boolean and can refer to "self" (the target resource),
+// "resources" (all resources in the package; note that "package" is a reserved word in CEL), and
+// "complete" (whether this is a complete validation).
+type CELExecutor struct {
+	Rules []*CELRule
+}
+
+func NewCELExecutor(arguments []string, properties map[string]string) (*CELExecutor, error) {
+	if len(arguments) < 1 {
+		return nil, errors.New("CEL executor must have at least one argument")
+	}
+
+	costLimit := uint64(DefaultCELCostLimit)
+	if costLimit_, ok := properties[CELCostLimit]; ok {
+		var err error
+		if costLimit, err = strconv.ParseUint(costLimit_, 10, 64); err != nil {
+			return nil, fmt.Errorf("CEL executor \"%s\" property is not a number: %s", CELCostLimit, costLimit_)
+		}
+	}
+
+	environment, err := cel.NewEnv(
+		cel.Variable("self", cel.DynType),
+		cel.Variable("resources", cel.ListType(cel.DynType)),
+		cel.Variable("complete", cel.BoolType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var self CELExecutor
+	for _, argument := range arguments {
+		if rule, err := NewCELRule(environment, argument, costLimit); err == nil {
+			self.Rules = append(self.Rules, rule)
+		} else {
+			return nil, err
+		}
+	}
+
+	return &self, nil
+}
+
+// Warnings are logged rather than returned as errors. In partial validation evaluation errors
+// (e.g. missing keys) are ignored, because the resources may not yet be complete.
+func (self *CELExecutor) Validate(context contextpkg.Context, resource util.Resource, package_ util.Package, complete bool, log commonlog.Logger) []error {
+	resources := make([]any, len(package_))
+	for index, resource_ := range package_ {
+		resources[index] = resource_
+	}
+
+	variables := map[string]any{
+		"self":      resource,
+		"resources": resources,
+		"complete":  complete,
+	}
+
+	var errs []error
+	for _, rule := range self.Rules {
+		if rule.Complete && !complete {
+			continue
+		}
+
+		if valid, err := rule.Evaluate(context, variables); err == nil {
+			if !valid {
+				if rule.Severity == CELSeverityWarning {
+					log.Warning(rule.Message)
+				} else {
+					errs = append(errs, errors.New(rule.Message))
+				}
+			}
+		} else if complete {
+			errs = append(errs, fmt.Errorf("CEL rule %q: %w", rule.Expression, err))
+		}
+	}
+
+	return errs
+}
+
+//
+// CELRule
+//
+
+type CELRule struct {
+	Expression string `yaml:"expression"`
+	Message    string `yaml:"message"`  // optional
+	Severity   string `yaml:"severity"` // optional (defaults to "error")
+	Complete   bool   `yaml:"complete"` // optional
+
+	program cel.Program
+}
+
+func NewCELRule(environment *cel.Env, argument string, costLimit uint64) (*CELRule, error) {
+	var self CELRule
+
+	// Anything that isn't a YAML map with an "expression" key is a bare expression
+	if err := yaml.Unmarshal([]byte(argument), &self); (err != nil) || (self.Expression == "") {
+		self = CELRule{Expression: argument}
+	}
+
+	self.Expression = strings.TrimSpace(self.Expression)
+
+	switch self.Severity {
+	case "":
+		self.Severity = CELSeverityError
+	case CELSeverityError, CELSeverityWarning:
+	default:
+		return nil, fmt.Errorf("CEL rule severity must be \"%s\" or \"%s\": %s", CELSeverityError, CELSeverityWarning, self.Severity)
+	}
+
+	if self.Message == "" {
+		self.Message = "failed rule: " + self.Expression
+	}
+
+	if ast, issues := environment.Compile(self.Expression); issues.Err() == nil {
+		if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("CEL rule %q must evaluate to a boolean, not %s", self.Expression, ast.OutputType())
+		}
+
+		var err error
+		if self.program, err = environment.Program(ast, cel.CostLimit(costLimit), cel.InterruptCheckFrequency(100)); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, fmt.Errorf("CEL rule %q: %w", self.Expression, issues.Err())
+	}
+
+	return &self, nil
+}
+
+func (self *CELRule) Evaluate(context contextpkg.Context, variables map[string]any) (bool, error) {
+	if value, _, err := self.program.ContextEval(context, variables); err == nil {
+		if valid, ok := value.Value().(bool); ok {
+			return valid, nil
+		} else {
+			return false, fmt.Errorf("CEL rule %q did not evaluate to a boolean: %v", self.Expression, value)
+		}
+	} else {
+		return false, err
+	}
+}
@@ -0,0 +1,329 @@
+package plugins
+
+import (
+	contextpkg "context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+
+	api "github.com/nephio-experimental/tko/api/grpc"
+	"github.com/nephio-experimental/tko/telemetry"
+	"github.com/tliron/commonlog"
+	"github.com/tliron/kutil/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	GRPC = "grpc"
+
+	GRPCTLS = "_grpc.tls" // optional ("true" to use TLS)
+
+	DefaultGRPCPackageFormat = "yaml"
+)
+
+// Plugin server targets that may receive the controller's own bearer token in the plugin data,
+// so that they can call the Data service with its credentials. These are configured by the
+// operator, not by plugin registrants. The token is otherwise never forwarded.
+var grpcTokenTargets = make(map[string]struct{})
+var grpcTokenTargetsLock sync.Mutex
+
+// Allows the controller's bearer token to be forwarded to a plugin server target. The plugin
+// must also use TLS.
+func AllowGRPCTokenTarget(target string) {
+	grpcTokenTargetsLock.Lock()
+	defer grpcTokenTargetsLock.Unlock()
+	grpcTokenTargets[target] = struct{}{}
+}
+
+func isGRPCTokenTarget(target string) bool {
+	grpcTokenTargetsLock.Lock()
+	defer grpcTokenTargetsLock.Unlock()
+	_, ok := grpcTokenTargets[target]
+	return ok
+}
+
+//
+// GRPCExecutor
+//
+
+// Executes plugins on a long-running plugin server that implements the "tko.plugin.Plugin"
+// gRPC service. The first argument is the server's gRPC target (e.g. "my-plugins:50060"). The
+// remaining arguments and the non-internal properties are sent with each request.
+type GRPCExecutor struct {
+	Type       string
+	Name       string
+	Target     string
+	Arguments  []string
+	Properties map[string]string
+	TLS        bool
+}
+
+func NewGRPCExecutor(type_ string, name string, arguments []string, properties map[string]string) (*GRPCExecutor, error) {
+	if len(arguments) < 1 {
+		return nil, errors.New("gRPC executor must have at least one argument")
+	}
+
+	properties_ := make(map[string]string)
+	for key, value := range properties {
+		if !strings.HasPrefix(key, "_") {
+			properties_[key] = value
+		}
+	}
+
+	return &GRPCExecutor{
+		Type:       type_,
+		Name:       name,
+		Target:     arguments[0],
+		Arguments:  arguments[1:],
+		Properties: properties_,
+		TLS:        properties[GRPCTLS] == "true",
+	}, nil
+}
+
+// Also verifies that the plugin server supports the plugin type and name.
+func (self *GRPCExecutor) Client(context contextpkg.Context) (api.PluginClient, *api.PluginCapabilities, error) {
+	if connection, err := self.connection(); err == nil {
+		if capabilities, err := connection.getCapabilities(context); err == nil {
+			if !slices.Contains(capabilities.Types, self.Type) {
+				return nil, nil, fmt.Errorf("plugin server %s does not support %q plugins", self.Target, self.Type)
+			}
+
+			if (len(capabilities.Names) > 0) && !slices.Contains(capabilities.Names, self.Name) {
+				return nil, nil, fmt.Errorf("plugin server %s does not support %s plugin %q", self.Target, self.Type, self.Name)
+			}
+
+			return connection.client, capabilities, nil
+		} else {
+			return nil, nil, err
+		}
+	} else {
+		return nil, nil, err
+	}
+}
+
+func (self *GRPCExecutor) Validate(context contextpkg.Context, request *api.ValidateRequest, log commonlog.Logger) (*api.ValidateResult, error) {
+	if client, _, err := self.Client(context); err == nil {
+		if err := self.filterData(request.Data); err != nil {
+			return nil, err
+		}
+		request.Name = self.Name
+		request.Arguments = self.Arguments
+		request.Properties = self.Properties
+		if stream, err := client.Validate(context, request); err == nil {
+			result, err := receivePluginResult(stream, (*api.ValidateResponse).GetLog, (*api.ValidateResponse).GetResult, log)
+			return result, self.resetCapabilitiesOnError(err)
+		} else {
+			return nil, self.resetCapabilitiesOnError(err)
+		}
+	} else {
+		return nil, err
+	}
+}
+
+func (self *GRPCExecutor) Prepare(context contextpkg.Context, request *api.PrepareRequest, log commonlog.Logger) (*api.PrepareResult, error) {
+	if client, _, err := self.Client(context); err == nil {
+		if err := self.filterData(request.Data); err != nil {
+			return nil, err
+		}
+		request.Name = self.Name
+		request.Arguments = self.Arguments
+		request.Properties = self.Properties
+		if stream, err := client.Prepare(context, request); err == nil {
+			result, err := receivePluginResult(stream, (*api.PrepareResponse).GetLog, (*api.PrepareResponse).GetResult, log)
+			return result, self.resetCapabilitiesOnError(err)
+		} else {
+			return nil, self.resetCapabilitiesOnError(err)
+		}
+	} else {
+		return nil, err
+	}
+}
+
+func (self *GRPCExecutor) Schedule(context contextpkg.Context, request *api.ScheduleRequest, log commonlog.Logger) (*api.ScheduleResult, error) {
+	if client, _, err := self.Client(context); err == nil {
+		if err := self.filterData(request.Data); err != nil {
+			return nil, err
+		}
+		request.Name = self.Name
+		request.Arguments = self.Arguments
+		request.Properties = self.Properties
+		if stream, err := client.Schedule(context, request); err == nil {
+			result, err := receivePluginResult(stream, (*api.ScheduleResponse).GetLog, (*api.ScheduleResponse).GetResult, log)
+			return result, self.resetCapabilitiesOnError(err)
+		} else {
+			return nil, self.resetCapabilitiesOnError(err)
+		}
+	} else {
+		return nil, err
+	}
+}
+
+// The package format preferred by the plugin server.
+func (self *GRPCExecutor) PackageFormat(context contextpkg.Context) (string, error) {
+	if _, capabilities, err := self.Client(context); err == nil {
+		if capabilities.PackageFormat != "" {
+			return capabilities.PackageFormat, nil
+		} else {
+			return DefaultGRPCPackageFormat, nil
+		}
+	} else {
+		return "", err
+	}
+}
+
+// Removes the token unless the operator allowed forwarding it to this target. Forwarding it
+// requires TLS.
+func (self *GRPCExecutor) filterData(data *api.PluginData) error {
+	if (data == nil) || (data.Token == "") {
+		return nil
+	}
+
+	if !isGRPCTokenTarget(self.Target) {
+		data.Token = ""
+		return nil
+	}
+
+	if !self.TLS {
+		return fmt.Errorf("plugin server %s must use TLS in order to receive the bearer token", self.Target)
+	}
+
+	return nil
+}
+
+// The plugin server may have been replaced (e.g. upgraded) behind the same target, in which case
+// its capabilities must be retrieved again.
+func (self *GRPCExecutor) resetCapabilitiesOnError(err error) error {
+	switch status.Code(err) {
+	case codes.Unimplemented, codes.Unavailable:
+		if connection, err := self.connection(); err == nil {
+			connection.resetCapabilities()
+		}
+	}
+	return err
+}
+
+// Connections are shared by all executors with the same target.
+func (self *GRPCExecutor) connection() (*grpcConnection, error) {
+	key := self.Target
+	if self.TLS {
+		key = "tls:" + key
+	}
+
+	grpcConnectionsLock.Lock()
+	defer grpcConnectionsLock.Unlock()
+
+	if connection, ok := grpcConnections[key]; ok {
+		return connection, nil
+	}
+
+	var transportCredentials credentials.TransportCredentials
+	if self.TLS {
+		transportCredentials = credentials.NewTLS(new(tls.Config))
+	} else {
+		transportCredentials = insecure.NewCredentials()
+	}
+
+	if clientConn, err := grpc.NewClient(self.Target, grpc.WithTransportCredentials(transportCredentials), telemetry.GRPCDialOption()); err == nil {
+		log.Infof("connected to plugin server: %s", self.Target)
+		connection := grpcConnection{client: api.NewPluginClient(clientConn)}
+		grpcConnections[key] = &connection
+		util.OnExit(func() {
+			clientConn.Close()
+		})
+		return &connection, nil
+	} else {
+		return nil, err
+	}
+}
+
+//
+// grpcConnection
+//
+
+type grpcConnection struct {
+	client api.PluginClient
+
+	capabilities     *api.PluginCapabilities
+	capabilitiesLock sync.Mutex
+}
+
+var grpcConnections = make(map[string]*grpcConnection)
+var grpcConnectionsLock sync.Mutex
+
+// Forgets the cached capabilities of all plugin servers. Should be called whenever the plugin
+// cache is reset, so that upgraded plugin servers are noticed.
+func ResetGRPCCapabilities() {
+	grpcConnectionsLock.Lock()
+	defer grpcConnectionsLock.Unlock()
+
+	for _, connection := range grpcConnections {
+		connection.resetCapabilities()
+	}
+}
+
+// Capabilities are retrieved once and then cached (but not if retrieval fails) until reset.
+func (self *grpcConnection) getCapabilities(context contextpkg.Context) (*api.PluginCapabilities, error) {
+	self.capabilitiesLock.Lock()
+	defer self.capabilitiesLock.Unlock()
+
+	if self.capabilities == nil {
+		if capabilities, err := self.client.Capabilities(context, new(emptypb.Empty)); err == nil {
+			self.capabilities = capabilities
+		} else {
+			return nil, err
+		}
+	}
+
+	return self.capabilities, nil
+}
+
+func (self *grpcConnection) resetCapabilities() {
+	self.capabilitiesLock.Lock()
+	defer self.capabilitiesLock.Unlock()
+	self.capabilities = nil
+}
+
+// Utils
+
+// The plugin server streams any number of logs followed by the result.
+func receivePluginResult[Response any, Result any](stream grpc.ServerStreamingClient[Response], getLog func(*Response) *api.PluginLog, getResult func(*Response) *Result, log commonlog.Logger) (*Result, error) {
+	for {
+		if response, err := stream.Recv(); err == nil {
+			if pluginLog := getLog(response); pluginLog != nil {
+				log.Log(PluginLogLevel(pluginLog.Level), 0, pluginLog.Message)
+			} else if result := getResult(response); result != nil {
+				return result, nil
+			}
+		} else if err == io.EOF {
+			return nil, errors.New("plugin server did not return a result")
+		} else {
+			return nil, err
+		}
+	}
+}
+
+func PluginLogLevel(level string) commonlog.Level {
+	switch level {
+	case "critical":
+		return commonlog.Critical
+	case "error":
+		return commonlog.Error
+	case "warning":
+		return commonlog.Warning
+	case "notice":
+		return commonlog.Notice
+	case "debug":
+		return commonlog.Debug
+	default:
+		return commonlog.Info
+	}
+}
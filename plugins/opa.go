@@ -0,0 +1,15 @@
+package plugins
+
+// The OPA executor is implemented in the "plugins/opa" package, because it can retrieve policies
+// with a TKO client.
+
+const (
+	OPA = "opa"
+
+	OPAQuery             = "_opa.query"             // optional (defaults to "data.tko.deny")
+	OPATemplate          = "_opa.template"          // optional (if set the arguments are ConfigMap names in the template)
+	OPATemplateNamespace = "_opa.templateNamespace" // optional (defaults to the default namespace)
+
+	OPAModuleSuffix = ".rego" // for keys in the ConfigMap data
+	DefaultOPAQuery = "data.tko.deny"
+)
@@ -0,0 +1,213 @@
+package opa
+
+import (
+	contextpkg "context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	pluginspkg "github.com/nephio-experimental/tko/plugins"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/tliron/go-ard"
+)
+
+//
+// Executor
+//
+
+// Evaluates Rego policies in-process. Each argument is either a Rego module or, if a template is
+// configured, the name of a ConfigMap in the template's package whose ".rego" data keys hold
+// modules.
+//
+// The query (by default "data.tko.deny") should evaluate to a collection of deny messages, each
+// either a string or an object with a "msg" key, or to a boolean (true means denied). The input is an object with "resource" (the
+// target resource), "resources" (all resources in the package), and "complete" keys.
+type Executor struct {
+	Arguments         []string
+	Query             string
+	TemplateID        string
+	TemplateNamespace string
+
+	query *rego.PreparedEvalQuery
+	lock  sync.Mutex
+}
+
+// Inline modules are compiled immediately.
+func NewExecutor(arguments []string, properties map[string]string) (*Executor, error) {
+	if len(arguments) < 1 {
+		return nil, errors.New("OPA executor must have at least one argument")
+	}
+
+	self := Executor{
+		Arguments:         arguments,
+		Query:             properties[pluginspkg.OPAQuery],
+		TemplateID:        properties[pluginspkg.OPATemplate],
+		TemplateNamespace: properties[pluginspkg.OPATemplateNamespace],
+	}
+
+	if self.Query == "" {
+		self.Query = pluginspkg.DefaultOPAQuery
+	}
+
+	if self.TemplateID == "" {
+		if _, err := self.compile(contextpkg.Background(), nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return &self, nil
+}
+
+// The client is used only to retrieve modules from the template.
+func (self *Executor) Validate(context contextpkg.Context, client *client.Client, resource tkoutil.Resource, package_ tkoutil.Package, complete bool) []error {
+	query, err := self.compile(context, client)
+	if err != nil {
+		return []error{err}
+	}
+
+	resources := make(ard.List, len(package_))
+	for index, resource_ := range package_ {
+		resources[index] = ard.CopyMapsToStringMaps(resource_)
+	}
+
+	input := ard.StringMap{
+		"resource":  ard.CopyMapsToStringMaps(resource),
+		"resources": resources,
+		"complete":  complete,
+	}
+
+	results, err := query.Eval(context, rego.EvalInput(input))
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, result := range results {
+		for _, expression := range result.Expressions {
+			errs = append(errs, denyErrors(expression.Value)...)
+		}
+	}
+
+	return errs
+}
+
+// The query is compiled once. If compilation fails it will be retried on the next call.
+func (self *Executor) compile(context contextpkg.Context, client *client.Client) (*rego.PreparedEvalQuery, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.query != nil {
+		return self.query, nil
+	}
+
+	modules, err := self.getModules(context, client)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []func(*rego.Rego){rego.Query(self.Query)}
+	for _, name := range sortedKeys(modules) {
+		options = append(options, rego.Module(name, modules[name]))
+	}
+
+	if query, err := rego.New(options...).PrepareForEval(context); err == nil {
+		self.query = &query
+		return self.query, nil
+	} else {
+		return nil, err
+	}
+}
+
+// Module names are used in compilation error messages.
+func (self *Executor) getModules(context contextpkg.Context, client *client.Client) (map[string]string, error) {
+	modules := make(map[string]string)
+
+	if self.TemplateID == "" {
+		for index, module := range self.Arguments {
+			modules[fmt.Sprintf("argument%d%s", index+1, pluginspkg.OPAModuleSuffix)] = module
+		}
+		return modules, nil
+	}
+
+	if client == nil {
+		return nil, errors.New("TKO client is not available")
+	}
+
+	if template, ok, err := client.WithContext(context).GetTemplate(self.TemplateNamespace, self.TemplateID); err == nil {
+		if !ok {
+			return nil, fmt.Errorf("template not found: %s", self.TemplateID)
+		}
+
+		configMapGvk := tkoutil.NewGVK2("v1", "ConfigMap")
+		for _, configMapName := range self.Arguments {
+			if configMap, ok := configMapGvk.NewResourceIdentifier(configMapName).GetResource(template.Package); ok {
+				if data, ok := ard.With(configMap).ConvertSimilar().Get("data").StringMap(); ok {
+					for key, module := range data {
+						if module_, ok := module.(string); ok && strings.HasSuffix(key, pluginspkg.OPAModuleSuffix) {
+							modules[configMapName+"/"+key] = module_
+						}
+					}
+				}
+			} else {
+				return nil, fmt.Errorf("template %q does not have a ConfigMap %q", self.TemplateID, configMapName)
+			}
+		}
+
+		if len(modules) == 0 {
+			return nil, fmt.Errorf("template %q ConfigMaps do not have \"%s\" data", self.TemplateID, pluginspkg.OPAModuleSuffix)
+		}
+
+		return modules, nil
+	} else {
+		return nil, err
+	}
+}
+
+// Utils
+
+// Deny messages are strings or objects with a "msg" key (as used by Gatekeeper and Conftest).
+func denyErrors(value any) []error {
+	switch value_ := value.(type) {
+	case nil:
+		return nil
+
+	case string:
+		return []error{errors.New(value_)}
+
+	case []any:
+		var errs []error
+		for _, element := range value_ {
+			errs = append(errs, denyErrors(element)...)
+		}
+		return errs
+
+	case map[string]any:
+		if message, ok := value_["msg"]; ok {
+			return []error{fmt.Errorf("%v", message)}
+		}
+		return []error{fmt.Errorf("%v", value_)}
+
+	case bool:
+		// A boolean deny rule (e.g. "deny if { ... }")
+		if value_ {
+			return []error{errors.New("denied by policy")}
+		}
+		return nil
+
+	default:
+		return []error{fmt.Errorf("%v", value_)}
+	}
+}
+
+func sortedKeys(map_ map[string]string) []string {
+	keys := make([]string, 0, len(map_))
+	for key := range map_ {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
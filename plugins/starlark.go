@@ -0,0 +1,15 @@
+package plugins
+
+// The Starlark executor is implemented in the "plugins/starlark" package, because its API includes a
+// TKO client.
+
+const (
+	Starlark = "starlark"
+
+	StarlarkTemplate          = "_starlark.template"          // optional (if set the first argument is a ConfigMap name in the template)
+	StarlarkTemplateNamespace = "_starlark.templateNamespace" // optional (defaults to the default namespace)
+	StarlarkMaxSteps          = "_starlark.maxSteps"          // optional (defaults to 10,000,000)
+
+	StarlarkScriptKey       = "script.star" // in the ConfigMap data
+	DefaultStarlarkMaxSteps = 10_000_000
+)
@@ -0,0 +1,225 @@
+package starlark
+
+import (
+	contextpkg "context"
+	"errors"
+	"fmt"
+
+	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/tliron/kutil/util"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// The read-only "tko.client" module. Results are converted to dicts with the same keys as the
+// client's YAML output.
+func newClientModule(context contextpkg.Context, client *clientpkg.Client) *starlarkstruct.Module {
+	if client != nil {
+		client = client.WithContext(context)
+	}
+
+	return &starlarkstruct.Module{
+		Name: "client",
+		Members: starlark.StringDict{
+			"about": newClientBuiltin("about", client, func(client *clientpkg.Client, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs); err != nil {
+					return nil, err
+				}
+				if about, err := client.About(); err == nil {
+					return ToStarlarkViaYAML(about)
+				} else {
+					return nil, err
+				}
+			}),
+
+			"get_template":   newClientGetBuiltin("get_template", client, (*clientpkg.Client).GetTemplate),
+			"get_site":       newClientGetBuiltin("get_site", client, (*clientpkg.Client).GetSite),
+			"get_deployment": newClientGetBuiltin("get_deployment", client, (*clientpkg.Client).GetDeployment),
+
+			"list_templates": newClientBuiltin("list_templates", client, func(client *clientpkg.Client, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var namespace, metadataSelector, packageSelector string
+				var templateIdPatterns *starlark.List
+				var metadataPatterns *starlark.Dict
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+					"namespace?", &namespace,
+					"template_id_patterns?", &templateIdPatterns,
+					"metadata_patterns?", &metadataPatterns,
+					"metadata_selector?", &metadataSelector,
+					"package_selector?", &packageSelector); err != nil {
+					return nil, err
+				}
+
+				selectTemplates := clientpkg.SelectTemplates{
+					Namespace:        namespace,
+					MetadataSelector: metadataSelector,
+					PackageSelector:  packageSelector,
+				}
+				var err error
+				if selectTemplates.TemplateIDPatterns, err = toStrings(templateIdPatterns); err != nil {
+					return nil, err
+				}
+				if selectTemplates.MetadataPatterns, err = toStringMap(metadataPatterns); err != nil {
+					return nil, err
+				}
+
+				return gatherResults(client.ListAllTemplates(selectTemplates))
+			}),
+
+			"list_sites": newClientBuiltin("list_sites", client, func(client *clientpkg.Client, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var namespace, metadataSelector, packageSelector string
+				var siteIdPatterns, templateIdPatterns *starlark.List
+				var metadataPatterns *starlark.Dict
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+					"namespace?", &namespace,
+					"site_id_patterns?", &siteIdPatterns,
+					"template_id_patterns?", &templateIdPatterns,
+					"metadata_patterns?", &metadataPatterns,
+					"metadata_selector?", &metadataSelector,
+					"package_selector?", &packageSelector); err != nil {
+					return nil, err
+				}
+
+				selectSites := clientpkg.SelectSites{
+					Namespace:        namespace,
+					MetadataSelector: metadataSelector,
+					PackageSelector:  packageSelector,
+				}
+				var err error
+				if selectSites.SiteIDPatterns, err = toStrings(siteIdPatterns); err != nil {
+					return nil, err
+				}
+				if selectSites.TemplateIDPatterns, err = toStrings(templateIdPatterns); err != nil {
+					return nil, err
+				}
+				if selectSites.MetadataPatterns, err = toStringMap(metadataPatterns); err != nil {
+					return nil, err
+				}
+
+				return gatherResults(client.ListAllSites(selectSites))
+			}),
+
+			"list_deployments": newClientBuiltin("list_deployments", client, func(client *clientpkg.Client, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var namespace, metadataSelector, packageSelector string
+				var templateIdPatterns, siteIdPatterns *starlark.List
+				var metadataPatterns *starlark.Dict
+				var prepared, approved starlark.Value = starlark.None, starlark.None
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs,
+					"namespace?", &namespace,
+					"template_id_patterns?", &templateIdPatterns,
+					"site_id_patterns?", &siteIdPatterns,
+					"metadata_patterns?", &metadataPatterns,
+					"metadata_selector?", &metadataSelector,
+					"package_selector?", &packageSelector,
+					"prepared?", &prepared,
+					"approved?", &approved); err != nil {
+					return nil, err
+				}
+
+				selectDeployments := clientpkg.SelectDeployments{
+					Namespace:        namespace,
+					MetadataSelector: metadataSelector,
+					PackageSelector:  packageSelector,
+					Prepared:         toBoolPointer(prepared),
+					Approved:         toBoolPointer(approved),
+				}
+				var err error
+				if selectDeployments.TemplateIDPatterns, err = toStrings(templateIdPatterns); err != nil {
+					return nil, err
+				}
+				if selectDeployments.SiteIDPatterns, err = toStrings(siteIdPatterns); err != nil {
+					return nil, err
+				}
+				if selectDeployments.MetadataPatterns, err = toStringMap(metadataPatterns); err != nil {
+					return nil, err
+				}
+
+				return gatherResults(client.ListAllDeployments(selectDeployments))
+			}),
+		},
+	}
+}
+
+// Utils
+
+type clientBuiltinFunc func(client *clientpkg.Client, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error)
+
+func newClientBuiltin(name string, client *clientpkg.Client, function clientBuiltinFunc) *starlark.Builtin {
+	return starlark.NewBuiltin(name, func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if client == nil {
+			return nil, errors.New("TKO client is not available")
+		}
+		return function(client, builtin, args, kwargs)
+	})
+}
+
+// Returns None if not found.
+func newClientGetBuiltin[E any](name string, client *clientpkg.Client, get func(client *clientpkg.Client, namespace string, id string) (E, bool, error)) *starlark.Builtin {
+	return newClientBuiltin(name, client, func(client *clientpkg.Client, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var id, namespace string
+		if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "id", &id, "namespace?", &namespace); err != nil {
+			return nil, err
+		}
+		if entity, ok, err := get(client, namespace, id); err == nil {
+			if ok {
+				return ToStarlarkViaYAML(entity)
+			} else {
+				return starlark.None, nil
+			}
+		} else {
+			return nil, err
+		}
+	})
+}
+
+func gatherResults[E any](results util.Results[E]) (starlark.Value, error) {
+	if entities, err := util.GatherResults(results); err == nil {
+		return ToStarlarkViaYAML(entities)
+	} else {
+		return nil, err
+	}
+}
+
+func toStrings(list *starlark.List) ([]string, error) {
+	if list == nil {
+		return nil, nil
+	}
+
+	length := list.Len()
+	strings := make([]string, length)
+	for index := 0; index < length; index++ {
+		var ok bool
+		if strings[index], ok = starlark.AsString(list.Index(index)); !ok {
+			return nil, fmt.Errorf("not a string: %s", list.Index(index).String())
+		}
+	}
+	return strings, nil
+}
+
+func toStringMap(dict *starlark.Dict) (map[string]string, error) {
+	if dict == nil {
+		return nil, nil
+	}
+
+	map_ := make(map[string]string)
+	for _, item := range dict.Items() {
+		if key, ok := starlark.AsString(item[0]); ok {
+			if value, ok := starlark.AsString(item[1]); ok {
+				map_[key] = value
+			} else {
+				return nil, fmt.Errorf("not a string: %s", item[1].String())
+			}
+		} else {
+			return nil, fmt.Errorf("not a string: %s", item[0].String())
+		}
+	}
+	return map_, nil
+}
+
+// None is nil.
+func toBoolPointer(value starlark.Value) *bool {
+	if value == starlark.None {
+		return nil
+	}
+	bool_ := bool(value.Truth())
+	return &bool_
+}
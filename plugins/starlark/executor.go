@@ -0,0 +1,270 @@
+package starlark
+
+import (
+	contextpkg "context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	client "github.com/nephio-experimental/tko/api/grpc-client"
+	pluginspkg "github.com/nephio-experimental/tko/plugins"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"github.com/tliron/commonlog"
+	"github.com/tliron/go-ard"
+	"go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+var fileOptions = syntax.FileOptions{
+	Set:             true,
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+//
+// Executor
+//
+
+// Executes plugins written in Starlark in-process. The first argument is either the script itself
+// or, if a template is configured, the name of a ConfigMap in the template's package that holds
+// the script. The remaining arguments and the non-internal properties are available to the
+// script.
+type Executor struct {
+	Name              string
+	Script            string
+	TemplateID        string
+	TemplateNamespace string
+	Arguments         []string
+	Properties        map[string]string
+	MaxSteps          uint64
+
+	source  string
+	program *starlark.Program
+	lock    sync.Mutex
+}
+
+func NewExecutor(name string, arguments []string, properties map[string]string) (*Executor, error) {
+	if len(arguments) < 1 {
+		return nil, errors.New("Starlark executor must have at least one argument")
+	}
+
+	self := Executor{
+		Name:              name,
+		Script:            arguments[0], // or ConfigMap name
+		TemplateID:        properties[pluginspkg.StarlarkTemplate],
+		TemplateNamespace: properties[pluginspkg.StarlarkTemplateNamespace],
+		Arguments:         arguments[1:],
+		Properties:        make(map[string]string),
+		MaxSteps:          pluginspkg.DefaultStarlarkMaxSteps,
+	}
+
+	for key, value := range properties {
+		if !strings.HasPrefix(key, "_") {
+			self.Properties[key] = value
+		}
+	}
+
+	if maxSteps, ok := properties[pluginspkg.StarlarkMaxSteps]; ok {
+		if maxSteps_, err := strconv.ParseUint(maxSteps, 10, 64); (err == nil) && (maxSteps_ > 0) {
+			self.MaxSteps = maxSteps_
+		} else {
+			return nil, fmt.Errorf("Starlark executor \"%s\" property is not a positive number: %s", pluginspkg.StarlarkMaxSteps, maxSteps)
+		}
+	}
+
+	return &self, nil
+}
+
+// Calls the script's "validate(complete)" function. The function can fail or return a list of
+// error messages.
+func (self *Executor) Validate(context contextpkg.Context, environment *Environment) []error {
+	value, _, err := self.call(context, environment, "validate", starlark.Tuple{starlark.Bool(environment.Complete)})
+	if err != nil {
+		return []error{err}
+	}
+
+	switch value_ := value.(type) {
+	case starlark.NoneType:
+		return nil
+
+	case starlark.String:
+		return []error{errors.New(string(value_))}
+
+	case starlark.Iterable:
+		var errs []error
+		iterator := value_.Iterate()
+		defer iterator.Done()
+		var element starlark.Value
+		for iterator.Next(&element) {
+			if message, ok := starlark.AsString(element); ok {
+				errs = append(errs, errors.New(message))
+			} else {
+				errs = append(errs, errors.New(element.String()))
+			}
+		}
+		return errs
+
+	default:
+		return []error{fmt.Errorf("Starlark \"validate\" function returned unsupported value: %s", value.Type())}
+	}
+}
+
+// Calls the script's "prepare()" function, which returns whether the target resource was
+// prepared. Changes the script makes to the package are returned even if it was not.
+func (self *Executor) Prepare(context contextpkg.Context, environment *Environment) (bool, tkoutil.Package, error) {
+	value, resources, err := self.call(context, environment, "prepare", nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if package_, err := resources.ToPackage(); err == nil {
+		return bool(value.Truth()), package_, nil
+	} else {
+		return false, nil, err
+	}
+}
+
+// Calls the script's "schedule()" function.
+func (self *Executor) Schedule(context contextpkg.Context, environment *Environment) error {
+	_, _, err := self.call(context, environment, "schedule", nil)
+	return err
+}
+
+func (self *Executor) call(context contextpkg.Context, environment *Environment, function string, arguments starlark.Tuple) (starlark.Value, *Resources, error) {
+	program, err := self.compile(context, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	thread := starlark.Thread{
+		Name: self.Name,
+		Print: func(thread *starlark.Thread, message string) {
+			environment.Log.Info(message)
+		},
+	}
+	thread.SetMaxExecutionSteps(self.MaxSteps)
+
+	stop := contextpkg.AfterFunc(context, func() {
+		thread.Cancel(context.Err().Error())
+	})
+	defer stop()
+
+	module, resources, err := newModule(context, self, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	globals, err := program.Init(&thread, starlark.StringDict{
+		"tko":    module,
+		"json":   json.Module,
+		"struct": starlark.NewBuiltin("struct", starlarkstruct.Make),
+	})
+	if err != nil {
+		return nil, nil, scriptError(err)
+	}
+
+	if function_, ok := globals[function]; ok {
+		if value, err := starlark.Call(&thread, function_, arguments, nil); err == nil {
+			return value, resources, nil
+		} else {
+			return nil, nil, scriptError(err)
+		}
+	} else {
+		return nil, nil, fmt.Errorf("Starlark script does not define a %q function", function)
+	}
+}
+
+// The compiled program is cached until the script changes.
+func (self *Executor) compile(context contextpkg.Context, environment *Environment) (*starlark.Program, error) {
+	source, err := self.getSource(context, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if (self.program != nil) && (self.source == source) {
+		return self.program, nil
+	}
+
+	if _, program, err := starlark.SourceProgramOptions(&fileOptions, self.Name+".star", source, isPredeclared); err == nil {
+		self.source = source
+		self.program = program
+		return program, nil
+	} else {
+		return nil, err
+	}
+}
+
+// The script is either inline or retrieved from a ConfigMap in the template.
+func (self *Executor) getSource(context contextpkg.Context, environment *Environment) (string, error) {
+	if self.TemplateID == "" {
+		return self.Script, nil
+	}
+
+	if template, ok, err := environment.Client.WithContext(context).GetTemplate(self.TemplateNamespace, self.TemplateID); err == nil {
+		if ok {
+			if configMap, ok := tkoutil.NewGVK2("v1", "ConfigMap").NewResourceIdentifier(self.Script).GetResource(template.Package); ok {
+				if script, ok := ard.With(configMap).ConvertSimilar().Get("data", pluginspkg.StarlarkScriptKey).String(); ok {
+					return script, nil
+				}
+			}
+			return "", fmt.Errorf("template %q does not have a ConfigMap %q with \"%s\" data", self.TemplateID, self.Script, pluginspkg.StarlarkScriptKey)
+		} else {
+			return "", fmt.Errorf("template not found: %s", self.TemplateID)
+		}
+	} else {
+		return "", err
+	}
+}
+
+//
+// Environment
+//
+
+// Not all fields are relevant to all plugin types.
+type Environment struct {
+	Client                   *client.Client
+	Log                      commonlog.Logger
+	Package                  tkoutil.Package
+	TargetResourceIdentifier tkoutil.ResourceIdentifier
+	Namespace                string
+
+	// Validation
+	Complete bool
+
+	// Preparation
+	DeploymentID string
+
+	// Scheduling
+	SiteID                       string
+	Deployments                  map[string]tkoutil.Package
+	DeletedDeployments           map[string]tkoutil.Package
+	AcknowledgeDeletedDeployment func(deploymentId string)
+}
+
+// Utils
+
+func isPredeclared(name string) bool {
+	switch name {
+	case "tko", "json", "struct":
+		return true
+	default:
+		return false
+	}
+}
+
+// Includes the Starlark backtrace.
+func scriptError(err error) error {
+	var evalError *starlark.EvalError
+	if errors.As(err, &evalError) {
+		return errors.New(evalError.Backtrace())
+	}
+	return err
+}
@@ -0,0 +1,260 @@
+package starlark
+
+import (
+	contextpkg "context"
+
+	pluginspkg "github.com/nephio-experimental/tko/plugins"
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// The "tko" module predeclared for scripts. Its "package" member is the package being validated,
+// prepared, or scheduled, and changes to it are visible to the executor.
+func newModule(context contextpkg.Context, executor *Executor, environment *Environment) (*starlarkstruct.Module, *Resources, error) {
+	package_, err := ToStarlark(environment.Package)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources := Resources{Package: package_.(*starlark.List)}
+
+	arguments, err := ToStarlark(executor.Arguments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	properties, err := ToStarlark(executor.Properties)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployments, err := toStarlarkPackages(environment.Deployments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deletedDeployments, err := toStarlarkPackages(environment.DeletedDeployments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetResourceIdentifier := environment.TargetResourceIdentifier
+	target := starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"api_version": starlark.String(targetResourceIdentifier.GVK.APIVersion()),
+		"group":       starlark.String(targetResourceIdentifier.GVK.Group),
+		"version":     starlark.String(targetResourceIdentifier.GVK.Version),
+		"kind":        starlark.String(targetResourceIdentifier.GVK.Kind),
+		"name":        starlark.String(targetResourceIdentifier.Name),
+	})
+
+	module := starlarkstruct.Module{
+		Name: "tko",
+		Members: starlark.StringDict{
+			"package":             resources.Package,
+			"target":              target,
+			"namespace":           starlark.String(environment.Namespace),
+			"complete":            starlark.Bool(environment.Complete),
+			"deployment_id":       starlark.String(environment.DeploymentID),
+			"site_id":             starlark.String(environment.SiteID),
+			"deployments":         deployments,
+			"deleted_deployments": deletedDeployments,
+			"arguments":           arguments,
+			"properties":          properties,
+			"client":              newClientModule(context, environment.Client),
+
+			"acknowledge_deleted_deployment": starlark.NewBuiltin("acknowledge_deleted_deployment", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var deploymentId string
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "deployment_id", &deploymentId); err != nil {
+					return nil, err
+				}
+				if environment.AcknowledgeDeletedDeployment != nil {
+					environment.AcknowledgeDeletedDeployment(deploymentId)
+				}
+				return starlark.None, nil
+			}),
+
+			"log": starlark.NewBuiltin("log", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var message string
+				level := "info"
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "message", &message, "level?", &level); err != nil {
+					return nil, err
+				}
+				environment.Log.Log(pluginspkg.PluginLogLevel(level), 0, message)
+				return starlark.None, nil
+			}),
+
+			// Resources
+
+			"get_resource": starlark.NewBuiltin("get_resource", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var apiVersion, kind, name string
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "api_version", &apiVersion, "kind", &kind, "name", &name); err != nil {
+					return nil, err
+				}
+				if resource, _ := resources.Get(apiVersion, kind, name); resource != nil {
+					return resource, nil
+				}
+				return starlark.None, nil
+			}),
+
+			"get_resources": starlark.NewBuiltin("get_resources", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var apiVersion, kind string
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "api_version?", &apiVersion, "kind?", &kind); err != nil {
+					return nil, err
+				}
+				return resources.Select(apiVersion, kind), nil
+			}),
+
+			"get_target_resource": starlark.NewBuiltin("get_target_resource", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs); err != nil {
+					return nil, err
+				}
+				if resource, _ := resources.Get(targetResourceIdentifier.GVK.APIVersion(), targetResourceIdentifier.GVK.Kind, targetResourceIdentifier.Name); resource != nil {
+					return resource, nil
+				}
+				return starlark.None, nil
+			}),
+
+			"set_resource": starlark.NewBuiltin("set_resource", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var resource *starlark.Dict
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "resource", &resource); err != nil {
+					return nil, err
+				}
+				return starlark.None, resources.Set(resource)
+			}),
+
+			"delete_resource": starlark.NewBuiltin("delete_resource", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var apiVersion, kind, name string
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "api_version", &apiVersion, "kind", &kind, "name", &name); err != nil {
+					return nil, err
+				}
+				deleted, err := resources.Delete(apiVersion, kind, name)
+				return starlark.Bool(deleted), err
+			}),
+
+			// Annotations
+
+			"get_annotation": starlark.NewBuiltin("get_annotation", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var resource *starlark.Dict
+				var name string
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "resource", &resource, "name", &name); err != nil {
+					return nil, err
+				}
+				if value, ok := getKeys(resource, []string{"metadata", "annotations", name}); ok {
+					return value, nil
+				}
+				return starlark.None, nil
+			}),
+
+			// A None value deletes the annotation.
+			"set_annotation": starlark.NewBuiltin("set_annotation", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var resource *starlark.Dict
+				var name string
+				var value starlark.Value
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "resource", &resource, "name", &name, "value", &value); err != nil {
+					return nil, err
+				}
+				return starlark.None, setAnnotation(resource, name, value)
+			}),
+
+			"is_prepared":  newIsAnnotationBuiltin("is_prepared", tkoutil.PreparedAnnotation),
+			"set_prepared": newSetAnnotationBuiltin("set_prepared", tkoutil.PreparedAnnotation, "prepared"),
+			"is_approved":  newIsAnnotationBuiltin("is_approved", tkoutil.ApprovedAnnotation),
+			"set_approved": newSetAnnotationBuiltin("set_approved", tkoutil.ApprovedAnnotation, "approved"),
+
+			// Paths
+
+			"get_path": starlark.NewBuiltin("get_path", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var value starlark.Value
+				var path string
+				separator := "."
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "value", &value, "path", &path, "separator?", &separator); err != nil {
+					return nil, err
+				}
+				if value_, ok := GetPath(value, path, separator); ok {
+					return value_, nil
+				}
+				return starlark.None, nil
+			}),
+
+			"set_path": starlark.NewBuiltin("set_path", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var value, newValue starlark.Value
+				var path string
+				separator := "."
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "value", &value, "path", &path, "new_value", &newValue, "separator?", &separator); err != nil {
+					return nil, err
+				}
+				return starlark.None, SetPath(value, path, separator, newValue)
+			}),
+
+			"delete_path": starlark.NewBuiltin("delete_path", func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+				var value starlark.Value
+				var path string
+				separator := "."
+				if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "value", &value, "path", &path, "separator?", &separator); err != nil {
+					return nil, err
+				}
+				deleted, err := DeletePath(value, path, separator)
+				return starlark.Bool(deleted), err
+			}),
+		},
+	}
+
+	return &module, &resources, nil
+}
+
+// Utils
+
+func newIsAnnotationBuiltin(name string, annotation string) *starlark.Builtin {
+	return starlark.NewBuiltin(name, func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var resource *starlark.Dict
+		if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "resource", &resource); err != nil {
+			return nil, err
+		}
+		if value, ok := getKeys(resource, []string{"metadata", "annotations", annotation}); ok {
+			if value_, ok := starlark.AsString(value); ok {
+				return starlark.Bool(value_ == tkoutil.AnnotationTrue), nil
+			}
+		}
+		return starlark.False, nil
+	})
+}
+
+// As in [tkoutil.SetPreparedAnnotation], false deletes the annotation.
+func newSetAnnotationBuiltin(name string, annotation string, argumentName string) *starlark.Builtin {
+	return starlark.NewBuiltin(name, func(thread *starlark.Thread, builtin *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var resource *starlark.Dict
+		value := true
+		if err := starlark.UnpackArgs(builtin.Name(), args, kwargs, "resource", &resource, argumentName+"?", &value); err != nil {
+			return nil, err
+		}
+		if value {
+			return starlark.None, setAnnotation(resource, annotation, starlark.String(tkoutil.AnnotationTrue))
+		} else {
+			return starlark.None, setAnnotation(resource, annotation, starlark.None)
+		}
+	})
+}
+
+func setAnnotation(resource *starlark.Dict, name string, value starlark.Value) error {
+	keys := []string{"metadata", "annotations", name}
+	if value == starlark.None {
+		_, err := deleteKeys(resource, keys)
+		return err
+	} else {
+		return setKeys(resource, keys, value)
+	}
+}
+
+func toStarlarkPackages(packages map[string]tkoutil.Package) (*starlark.Dict, error) {
+	dict := starlark.NewDict(len(packages))
+	for id, package_ := range packages {
+		if package__, err := ToStarlark(package_); err == nil {
+			if err := dict.SetKey(starlark.String(id), package__); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, err
+		}
+	}
+	return dict, nil
+}
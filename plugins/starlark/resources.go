@@ -0,0 +1,180 @@
+package starlark
+
+import (
+	"fmt"
+	"strings"
+
+	tkoutil "github.com/nephio-experimental/tko/util"
+	"go.starlark.net/starlark"
+)
+
+//
+// Resources
+//
+
+// Operates on the package, which is a Starlark list of resource dicts.
+type Resources struct {
+	Package *starlark.List
+}
+
+func (self *Resources) Get(apiVersion string, kind string, name string) (*starlark.Dict, int) {
+	for index := 0; index < self.Package.Len(); index++ {
+		if resource, ok := self.Package.Index(index).(*starlark.Dict); ok {
+			if isResource(resource, apiVersion, kind, name) {
+				return resource, index
+			}
+		}
+	}
+	return nil, -1
+}
+
+func (self *Resources) Select(apiVersion string, kind string) *starlark.List {
+	var resources []starlark.Value
+	for index := 0; index < self.Package.Len(); index++ {
+		if resource, ok := self.Package.Index(index).(*starlark.Dict); ok {
+			if ((apiVersion == "") || (getString(resource, "apiVersion") == apiVersion)) && ((kind == "") || (getString(resource, "kind") == kind)) {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	return starlark.NewList(resources)
+}
+
+// Replaces the resource with the same identity or appends it.
+func (self *Resources) Set(resource *starlark.Dict) error {
+	if _, index := self.Get(getString(resource, "apiVersion"), getString(resource, "kind"), getName(resource)); index != -1 {
+		return self.Package.SetIndex(index, resource)
+	} else {
+		return self.Package.Append(resource)
+	}
+}
+
+func (self *Resources) Delete(apiVersion string, kind string, name string) (bool, error) {
+	if _, index := self.Get(apiVersion, kind, name); index != -1 {
+		var elements []starlark.Value
+		for index_ := 0; index_ < self.Package.Len(); index_++ {
+			if index_ != index {
+				elements = append(elements, self.Package.Index(index_))
+			}
+		}
+		if err := self.Package.Clear(); err != nil {
+			return false, err
+		}
+		for _, element := range elements {
+			if err := self.Package.Append(element); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (self *Resources) ToPackage() (tkoutil.Package, error) {
+	length := self.Package.Len()
+	package_ := make(tkoutil.Package, length)
+	for index := 0; index < length; index++ {
+		if resource, err := FromStarlark(self.Package.Index(index)); err == nil {
+			var ok bool
+			if package_[index], ok = resource.(tkoutil.Resource); !ok {
+				return nil, fmt.Errorf("package element is not a dict: %s", self.Package.Index(index).String())
+			}
+		} else {
+			return nil, err
+		}
+	}
+	return package_, nil
+}
+
+// Paths
+
+// Path elements are dict keys separated by the separator, as in [ard.Node.GetPath].
+func GetPath(value starlark.Value, path string, separator string) (starlark.Value, bool) {
+	return getKeys(value, strings.Split(path, separator))
+}
+
+// Missing dicts along the path are created, as in [ard.Node.ForceGetPath].
+func SetPath(value starlark.Value, path string, separator string, newValue starlark.Value) error {
+	return setKeys(value, strings.Split(path, separator), newValue)
+}
+
+func DeletePath(value starlark.Value, path string, separator string) (bool, error) {
+	return deleteKeys(value, strings.Split(path, separator))
+}
+
+// Utils
+
+func isResource(resource *starlark.Dict, apiVersion string, kind string, name string) bool {
+	return (getString(resource, "apiVersion") == apiVersion) && (getString(resource, "kind") == kind) && (getName(resource) == name)
+}
+
+func getName(resource *starlark.Dict) string {
+	if name, ok := getKeys(resource, []string{"metadata", "name"}); ok {
+		if name_, ok := starlark.AsString(name); ok {
+			return name_
+		}
+	}
+	return ""
+}
+
+func getString(dict *starlark.Dict, key string) string {
+	if value, found, _ := dict.Get(starlark.String(key)); found {
+		if value_, ok := starlark.AsString(value); ok {
+			return value_
+		}
+	}
+	return ""
+}
+
+func getKeys(value starlark.Value, keys []string) (starlark.Value, bool) {
+	for _, key := range keys {
+		if dict, ok := value.(*starlark.Dict); ok {
+			var found bool
+			if value, found, _ = dict.Get(starlark.String(key)); !found {
+				return nil, false
+			}
+		} else {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func setKeys(value starlark.Value, keys []string, newValue starlark.Value) error {
+	last := len(keys) - 1
+	for index, key := range keys {
+		if dict, ok := value.(*starlark.Dict); ok {
+			if index == last {
+				return dict.SetKey(starlark.String(key), newValue)
+			}
+
+			if value_, found, _ := dict.Get(starlark.String(key)); found {
+				value = value_
+			} else {
+				value = starlark.NewDict(1)
+				if err := dict.SetKey(starlark.String(key), value); err != nil {
+					return err
+				}
+			}
+		} else {
+			return fmt.Errorf("not a dict at %q in path: %s", key, strings.Join(keys, "/"))
+		}
+	}
+	return nil
+}
+
+func deleteKeys(value starlark.Value, keys []string) (bool, error) {
+	last := len(keys) - 1
+	if last > 0 {
+		var ok bool
+		if value, ok = getKeys(value, keys[:last]); !ok {
+			return false, nil
+		}
+	}
+
+	if dict, ok := value.(*starlark.Dict); ok {
+		_, found, err := dict.Delete(starlark.String(keys[last]))
+		return found, err
+	}
+	return false, nil
+}
@@ -0,0 +1,189 @@
+package starlark
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tliron/go-ard"
+	"go.starlark.net/starlark"
+	"gopkg.in/yaml.v3"
+)
+
+// Converts ARD values to Starlark values. Maps and lists are converted to mutable dicts and lists.
+func ToStarlark(value any) (starlark.Value, error) {
+	switch value_ := value.(type) {
+	case nil:
+		return starlark.None, nil
+	case starlark.Value:
+		return value_, nil
+	case bool:
+		return starlark.Bool(value_), nil
+	case int:
+		return starlark.MakeInt(value_), nil
+	case int8:
+		return starlark.MakeInt64(int64(value_)), nil
+	case int16:
+		return starlark.MakeInt64(int64(value_)), nil
+	case int32:
+		return starlark.MakeInt64(int64(value_)), nil
+	case int64:
+		return starlark.MakeInt64(value_), nil
+	case uint:
+		return starlark.MakeUint(value_), nil
+	case uint8:
+		return starlark.MakeUint64(uint64(value_)), nil
+	case uint16:
+		return starlark.MakeUint64(uint64(value_)), nil
+	case uint32:
+		return starlark.MakeUint64(uint64(value_)), nil
+	case uint64:
+		return starlark.MakeUint64(value_), nil
+	case float32:
+		return starlark.Float(value_), nil
+	case float64:
+		return starlark.Float(value_), nil
+	case string:
+		return starlark.String(value_), nil
+	case []byte:
+		return starlark.Bytes(value_), nil
+	case time.Time:
+		return starlark.String(value_.Format(time.RFC3339Nano)), nil
+
+	case ard.List:
+		list := make([]starlark.Value, len(value_))
+		for index, element := range value_ {
+			var err error
+			if list[index], err = ToStarlark(element); err != nil {
+				return nil, err
+			}
+		}
+		return starlark.NewList(list), nil
+
+	case []string:
+		list := make([]starlark.Value, len(value_))
+		for index, element := range value_ {
+			list[index] = starlark.String(element)
+		}
+		return starlark.NewList(list), nil
+
+	case ard.Map:
+		dict := starlark.NewDict(len(value_))
+		for key, element := range value_ {
+			if key_, err := ToStarlark(key); err == nil {
+				if element_, err := ToStarlark(element); err == nil {
+					if err := dict.SetKey(key_, element_); err != nil {
+						return nil, err
+					}
+				} else {
+					return nil, err
+				}
+			} else {
+				return nil, err
+			}
+		}
+		return dict, nil
+
+	case ard.StringMap:
+		dict := starlark.NewDict(len(value_))
+		for key, element := range value_ {
+			if element_, err := ToStarlark(element); err == nil {
+				if err := dict.SetKey(starlark.String(key), element_); err != nil {
+					return nil, err
+				}
+			} else {
+				return nil, err
+			}
+		}
+		return dict, nil
+
+	case map[string]string:
+		dict := starlark.NewDict(len(value_))
+		for key, element := range value_ {
+			if err := dict.SetKey(starlark.String(key), starlark.String(element)); err != nil {
+				return nil, err
+			}
+		}
+		return dict, nil
+
+	case []ard.Map:
+		list := make([]starlark.Value, len(value_))
+		for index, element := range value_ {
+			var err error
+			if list[index], err = ToStarlark(element); err != nil {
+				return nil, err
+			}
+		}
+		return starlark.NewList(list), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported value type: %T", value)
+	}
+}
+
+// Converts Starlark values to ARD values. Dicts are converted to [ard.Map].
+func FromStarlark(value starlark.Value) (any, error) {
+	switch value_ := value.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(value_), nil
+	case starlark.Int:
+		if int64_, ok := value_.Int64(); ok {
+			return int64_, nil
+		} else if uint64_, ok := value_.Uint64(); ok {
+			return uint64_, nil
+		} else {
+			return nil, fmt.Errorf("integer is too large: %s", value_.String())
+		}
+	case starlark.Float:
+		return float64(value_), nil
+	case starlark.String:
+		return string(value_), nil
+	case starlark.Bytes:
+		return []byte(value_), nil
+
+	case starlark.Indexable: // includes lists and tuples
+		length := value_.Len()
+		list := make(ard.List, length)
+		for index := 0; index < length; index++ {
+			var err error
+			if list[index], err = FromStarlark(value_.Index(index)); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+
+	case *starlark.Dict:
+		map_ := make(ard.Map)
+		for _, item := range value_.Items() {
+			if key, err := FromStarlark(item[0]); err == nil {
+				if element, err := FromStarlark(item[1]); err == nil {
+					map_[key] = element
+				} else {
+					return nil, err
+				}
+			} else {
+				return nil, err
+			}
+		}
+		return map_, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported Starlark type: %s", value.Type())
+	}
+}
+
+// Converts any YAML-serializable value (e.g. client structs) to a Starlark value using the field
+// names of its YAML tags.
+func ToStarlarkViaYAML(value any) (starlark.Value, error) {
+	if bytes, err := yaml.Marshal(value); err == nil {
+		var value_ any
+		if err := yaml.Unmarshal(bytes, &value_); err == nil {
+			return ToStarlark(value_)
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
+}
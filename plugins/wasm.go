@@ -0,0 +1,292 @@
+package plugins
+
+import (
+	"bufio"
+	"bytes"
+	contextpkg "context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+	"github.com/tliron/commonlog"
+	"github.com/tliron/exturl"
+	"github.com/tliron/kutil/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	WASM = "wasm"
+
+	WASMMemoryLimit = "_wasm.memoryLimit" // optional, in MiB (defaults to 64)
+	WASMTimeLimit   = "_wasm.timeLimit"   // optional, in seconds (defaults to 60)
+	WASMMount       = "_wasm.mount"       // optional name of an allowed mount (see [AllowWASMMount])
+
+	DefaultWASMMemoryLimit = 64 // MiB
+	DefaultWASMTimeLimit   = 60 * time.Second
+	WASMOutputLimit        = 16 * 1024 * 1024 // bytes, for each of stdout and stderr
+
+	wasmPageSize = 64 * 1024
+)
+
+var wasmCompilationCache = wazero.NewCompilationCache()
+
+// Host directories that plugins may mount, by name. These are configured by the operator,
+// not by plugin registrants.
+var wasmMounts = make(map[string]string)
+var wasmMountsLock sync.Mutex
+
+// Allows plugins to mount a host directory read-only by name. The argument is in the form
+// "name=path".
+func AllowWASMMount(mount string) error {
+	if name, path, ok := strings.Cut(mount, "="); ok && (name != "") && (path != "") {
+		wasmMountsLock.Lock()
+		defer wasmMountsLock.Unlock()
+		wasmMounts[name] = path
+		return nil
+	} else {
+		return fmt.Errorf("WASM mount is not in the form \"name=path\": %s", mount)
+	}
+}
+
+func getWASMMount(name string) (string, bool) {
+	wasmMountsLock.Lock()
+	defer wasmMountsLock.Unlock()
+	path, ok := wasmMounts[name]
+	return path, ok
+}
+
+//
+// WASMExecutor
+//
+
+// Executes plugins compiled to WASI modules in-process. The first argument is the URL or path of
+// the module. The remaining arguments are provided to the module as its arguments, and the
+// non-internal properties as its environment variables. Modules have no filesystem access (unless
+// they name a mount allowed by the operator) and no network access.
+type WASMExecutor struct {
+	URL         string
+	Arguments   []string
+	Environment map[string]string
+	MemoryLimit uint32 // MiB
+	TimeLimit   time.Duration
+	Mount       string // host directory
+}
+
+func NewWASMExecutor(arguments []string, properties map[string]string) (*WASMExecutor, error) {
+	if len(arguments) < 1 {
+		return nil, errors.New("WASM executor must have at least one argument")
+	}
+
+	self := WASMExecutor{
+		URL:         arguments[0],
+		Arguments:   arguments[1:],
+		Environment: make(map[string]string),
+		MemoryLimit: DefaultWASMMemoryLimit,
+		TimeLimit:   DefaultWASMTimeLimit,
+	}
+
+	if mount, ok := properties[WASMMount]; ok {
+		if self.Mount, ok = getWASMMount(mount); !ok {
+			return nil, fmt.Errorf("WASM executor \"%s\" property is not an allowed mount: %s", WASMMount, mount)
+		}
+	}
+
+	for key, value := range properties {
+		if !strings.HasPrefix(key, "_") {
+			self.Environment[key] = value
+		}
+	}
+
+	if memoryLimit, ok := properties[WASMMemoryLimit]; ok {
+		// WASM 32-bit memory is limited to 4 GiB
+		if memoryLimit_, err := strconv.ParseUint(memoryLimit, 10, 32); (err == nil) && (memoryLimit_ > 0) && (memoryLimit_ <= 4096) {
+			self.MemoryLimit = uint32(memoryLimit_)
+		} else {
+			return nil, fmt.Errorf("WASM executor \"%s\" property is not a number of MiB between 1 and 4096: %s", WASMMemoryLimit, memoryLimit)
+		}
+	}
+
+	if timeLimit, ok := properties[WASMTimeLimit]; ok {
+		if timeLimit_, err := strconv.ParseFloat(timeLimit, 64); err == nil {
+			self.TimeLimit = time.Duration(timeLimit_ * float64(time.Second))
+		} else {
+			return nil, fmt.Errorf("WASM executor \"%s\" property is not a number of seconds: %s", WASMTimeLimit, timeLimit)
+		}
+	}
+
+	return &self, nil
+}
+
+// Input is written to the module's stdin as YAML and output is read from its stdout as YAML. Lines
+// written to stderr are logged.
+func (self *WASMExecutor) Execute(context contextpkg.Context, input any, output any, log commonlog.Logger) error {
+	runtime, module, err := self.compile(context)
+	if err != nil {
+		return err
+	}
+
+	inputBytes, err := yaml.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	stdout := newLimitedBuffer(WASMOutputLimit)
+	stderr := newLimitedBuffer(WASMOutputLimit)
+	config := wazero.NewModuleConfig().
+		WithName("").
+		WithArgs(append([]string{self.URL}, self.Arguments...)...).
+		WithStdin(bytes.NewReader(inputBytes)).
+		WithStdout(stdout).
+		WithStderr(stderr).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithRandSource(rand.Reader)
+
+	for key, value := range self.Environment {
+		config = config.WithEnv(key, value)
+	}
+
+	if self.Mount != "" {
+		config = config.WithFSConfig(wazero.NewFSConfig().WithReadOnlyDirMount(self.Mount, "/"))
+	}
+
+	context, cancel := contextpkg.WithTimeout(context, self.TimeLimit)
+	defer cancel()
+
+	log.Infof("execute WASM: %s", self.URL)
+
+	instance, err := runtime.InstantiateModule(context, module, config)
+	if instance != nil {
+		instance.Close(context)
+	}
+
+	if err != nil {
+		var exitError *sys.ExitError
+		if errors.As(err, &exitError) && (exitError.ExitCode() == 0) {
+			// Success
+		} else if context.Err() == contextpkg.DeadlineExceeded {
+			return fmt.Errorf("WASM module exceeded time limit of %s: %s", self.TimeLimit, self.URL)
+		} else {
+			return errorWithStderr(err, stderr.String())
+		}
+	}
+
+	if stdout.exceeded || stderr.exceeded {
+		return fmt.Errorf("WASM module exceeded output limit of %d bytes: %s", WASMOutputLimit, self.URL)
+	}
+
+	scanner := bufio.NewScanner(&stderr.Buffer)
+	for scanner.Scan() {
+		log.Info(scanner.Text())
+	}
+
+	return yaml.Unmarshal(stdout.Bytes(), output)
+}
+
+// The module is read and compiled once per URL and memory limit, and then shared by all
+// executors. Each execution is a new instance. Note that this means that changes to the module
+// at the URL are not picked up until restart; register the plugin with a new URL instead.
+func (self *WASMExecutor) compile(context contextpkg.Context) (wazero.Runtime, wazero.CompiledModule, error) {
+	key := wasmModuleKey{self.URL, self.MemoryLimit}
+
+	wasmModulesLock.Lock()
+	defer wasmModulesLock.Unlock()
+
+	if module, ok := wasmModules[key]; ok {
+		return module.runtime, module.module, nil
+	}
+
+	wasm, err := readWASM(context, self.URL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runtime := wazero.NewRuntimeWithConfig(context, wazero.NewRuntimeConfig().
+		WithCompilationCache(wasmCompilationCache).
+		WithMemoryLimitPages(self.MemoryLimit*(1024*1024/wasmPageSize)).
+		WithCloseOnContextDone(true))
+
+	if _, err := wasi_snapshot_preview1.Instantiate(context, runtime); err != nil {
+		runtime.Close(context)
+		return nil, nil, err
+	}
+
+	if module, err := runtime.CompileModule(context, wasm); err == nil {
+		log.Infof("compiled WASM module: %s", self.URL)
+		wasmModules[key] = wasmModule{runtime, module}
+		util.OnExit(func() {
+			runtime.Close(contextpkg.Background())
+		})
+		return runtime, module, nil
+	} else {
+		runtime.Close(context)
+		return nil, nil, err
+	}
+}
+
+type wasmModuleKey struct {
+	url         string
+	memoryLimit uint32
+}
+
+type wasmModule struct {
+	runtime wazero.Runtime
+	module  wazero.CompiledModule
+}
+
+var wasmModules = make(map[wasmModuleKey]wasmModule)
+var wasmModulesLock sync.Mutex
+
+// Utils
+
+func readWASM(context contextpkg.Context, url string) ([]byte, error) {
+	urlContext := exturl.NewContext()
+	defer urlContext.Release()
+
+	base, err := urlContext.NewWorkingDirFileURL()
+	if err != nil {
+		return nil, err
+	}
+
+	if url_, err := urlContext.NewValidAnyOrFileURL(context, url, []exturl.URL{base}); err == nil {
+		return exturl.ReadBytes(context, url_)
+	} else {
+		return nil, err
+	}
+}
+
+//
+// limitedBuffer
+//
+
+// Discards writes beyond the limit and remembers that it did so.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit    int
+	exceeded bool
+}
+
+func newLimitedBuffer(limit int) *limitedBuffer {
+	return &limitedBuffer{limit: limit}
+}
+
+// ([io.Writer] interface)
+func (self *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := self.limit - self.Len(); len(p) > remaining {
+		self.exceeded = true
+		var n int
+		if remaining > 0 {
+			n, _ = self.Buffer.Write(p[:remaining])
+		}
+		return n, io.ErrShortWrite
+	}
+	return self.Buffer.Write(p)
+}
@@ -12,15 +12,17 @@ import (
 type Context struct {
 	Preparation             *Preparation
 	Log                     commonlog.Logger
+	Namespace               string // of the deployment
 	DeploymentID            string
 	DeploymentPackage       util.Package
 	TargetResourceIdentifer util.ResourceIdentifier
 }
 
-func (self *Preparation) NewContext(deploymentId string, deploymentPackage util.Package, targetResourceIdentifer util.ResourceIdentifier, log commonlog.Logger) *Context {
+func (self *Preparation) NewContext(namespace string, deploymentId string, deploymentPackage util.Package, targetResourceIdentifer util.ResourceIdentifier, log commonlog.Logger) *Context {
 	return &Context{
 		Preparation:             self,
 		Log:                     log,
+		Namespace:               namespace,
 		DeploymentID:            deploymentId,
 		DeploymentPackage:       deploymentPackage,
 		TargetResourceIdentifer: targetResourceIdentifer,
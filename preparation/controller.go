@@ -1,10 +1,15 @@
 package preparation
 
 import (
+	contextpkg "context"
+	"errors"
 	"time"
 
-	"github.com/nephio-experimental/tko/util"
+	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/metrics"
+	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
+	"github.com/tliron/kutil/util"
 )
 
 //
@@ -12,25 +17,74 @@ import (
 //
 
 type Controller struct {
-	*util.Controller
+	*tkoutil.Controller
 
 	Preparation *Preparation
 
 	log commonlog.Logger
 }
 
-func NewController(preparation *Preparation, interval time.Duration, log commonlog.Logger) *Controller {
+// While watching, the full scan happens every resyncInterval instead of every interval.
+func NewController(preparation *Preparation, interval time.Duration, resyncInterval time.Duration, log commonlog.Logger) *Controller {
 	self := Controller{
 		Preparation: preparation,
 		log:         log,
 	}
-	self.Controller = util.NewController(self.run, interval, log)
+	self.Controller = tkoutil.NewController(self.run, interval, log)
+	self.Controller.RunQueued = self.runQueued
+	self.Controller.ResyncInterval = resyncInterval
+	self.Controller.Watch(self.watch)
 	return &self
 }
 
 func (self *Controller) run() error {
-	if err := self.Preparation.PrepareDeployments(); err != nil {
+	start := time.Now()
+	err := self.Preparation.PrepareDeployments()
+	metrics.ObserveControllerLoop("preparation", start, err)
+	if err != nil {
 		self.log.Error(err.Error())
 	}
 	return nil
 }
+
+// Prepares only the deployments that have changed.
+func (self *Controller) runQueued(keys []tkoutil.ControllerKey) error {
+	start := time.Now()
+	var errs []error
+	for _, key := range keys {
+		if err := self.Preparation.PrepareDeploymentID(key.Namespace, key.ID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	err := errors.Join(errs...)
+	metrics.ObserveControllerLoop("preparation", start, err)
+	if err != nil {
+		self.log.Error(err.Error())
+	}
+	return nil
+}
+
+// Queues new or modified deployments. A full scan is done whenever watching starts, in order
+// to catch up on events that may have been missed.
+func (self *Controller) watch(context contextpkg.Context) error {
+	if events, err := self.Preparation.Client.Watch(context, clientpkg.SelectEvents{Kinds: []string{"deployment"}, Namespace: clientpkg.AllNamespaces}); err == nil {
+		self.SetWatching(true)
+		self.Resync()
+		if err := util.IterateResults(events, func(event clientpkg.Event) error {
+			if event.Type != "deleted" {
+				self.Enqueue(tkoutil.ControllerKey{Kind: event.Kind, Namespace: event.Namespace, ID: event.ID})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	} else if clientpkg.IsNotImplementedError(err) {
+		// Fall back to interval only
+		self.log.Notice("backend does not support watching")
+		<-context.Done()
+	} else {
+		return err
+	}
+
+	return nil
+}
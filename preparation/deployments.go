@@ -1,43 +1,49 @@
 package preparation
 
 import (
+	contextpkg "context"
 	"errors"
 
 	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var falseBool = false
 
 func (self *Preparation) PrepareDeployments() error {
 	//self.Log.Notice("preparing deployments")
-	deploymentInfos := self.Client.ListAllDeployments(client.SelectDeployments{Prepared: &falseBool})
+	deploymentInfos := self.Client.ListAllDeployments(client.SelectDeployments{Namespace: client.AllNamespaces, Prepared: &falseBool})
 	return util.IterateResults(deploymentInfos, self.PrepareDeployment)
 }
 
+// The preparation is traced as a root span that includes all its client requests and plugins.
 func (self *Preparation) PrepareDeployment(deploymentInfo client.DeploymentInfo) error {
 	if deploymentInfo.Prepared {
 		return nil
 	}
 
-	log := commonlog.NewKeyValueLogger(self.Log,
-		"deployment", deploymentInfo.DeploymentID)
+	context, span := telemetry.StartSpan(contextpkg.Background(), "prepareDeployment",
+		attribute.String("tko.namespace", deploymentInfo.Namespace),
+		attribute.String("tko.deployment_id", deploymentInfo.DeploymentID),
+		attribute.String("tko.template_id", deploymentInfo.TemplateID))
+	err := self.prepareDeploymentInfo(context, deploymentInfo)
+	telemetry.EndSpan(span, err)
+	return err
+}
 
-	log.Notice("preparing deployment",
-		"template", deploymentInfo.TemplateID)
-	if deployment, ok, err := self.Client.GetDeployment(deploymentInfo.DeploymentID); err == nil {
-		if ok {
-			_, err := self.prepareDeployment(deploymentInfo.DeploymentID, deployment.Package, log)
-			return err
-		} else {
-			log.Info("deployment disappeared")
-			return nil
-		}
-	} else {
-		return err
-	}
+// Like [Preparation.PrepareDeployment] but fetches the deployment first. Does nothing if the
+// deployment does not exist.
+func (self *Preparation) PrepareDeploymentID(namespace string, deploymentId string) error {
+	context, span := telemetry.StartSpan(contextpkg.Background(), "prepareDeployment",
+		attribute.String("tko.namespace", namespace),
+		attribute.String("tko.deployment_id", deploymentId))
+	err := self.prepareDeploymentID(context, namespace, deploymentId)
+	telemetry.EndSpan(span, err)
+	return err
 }
 
 func (self *Preparation) IsDeploymentFullyPrepared(package_ tkoutil.Package) bool {
@@ -59,7 +65,45 @@ func (self *Preparation) IsDeploymentFullyPrepared(package_ tkoutil.Package) boo
 	return prepared
 }
 
-func (self *Preparation) prepareDeployment(deploymentId string, deploymentPackage tkoutil.Package, log commonlog.Logger) (bool, error) {
+func (self *Preparation) prepareDeploymentInfo(context contextpkg.Context, deploymentInfo client.DeploymentInfo) error {
+	log := commonlog.NewKeyValueLogger(self.Log,
+		"namespace", deploymentInfo.Namespace,
+		"deployment", deploymentInfo.DeploymentID)
+
+	log.Notice("preparing deployment",
+		"template", deploymentInfo.TemplateID)
+	if deployment, ok, err := self.Client.WithContext(context).GetDeployment(deploymentInfo.Namespace, deploymentInfo.DeploymentID); err == nil {
+		if ok {
+			_, err := self.prepareDeployment(context, deploymentInfo.Namespace, deploymentInfo.DeploymentID, deployment.Package, log)
+			return err
+		} else {
+			log.Info("deployment disappeared")
+			return nil
+		}
+	} else {
+		return err
+	}
+}
+
+func (self *Preparation) prepareDeploymentID(context contextpkg.Context, namespace string, deploymentId string) error {
+	if deployment, ok, err := self.Client.WithContext(context).GetDeployment(namespace, deploymentId); err == nil {
+		if ok && !deployment.Prepared {
+			log := commonlog.NewKeyValueLogger(self.Log,
+				"namespace", namespace,
+				"deployment", deploymentId)
+
+			log.Notice("preparing deployment",
+				"template", deployment.TemplateID)
+			_, err := self.prepareDeployment(context, namespace, deploymentId, deployment.Package, log)
+			return err
+		}
+		return nil
+	} else {
+		return err
+	}
+}
+
+func (self *Preparation) prepareDeployment(context contextpkg.Context, namespace string, deploymentId string, deploymentPackage tkoutil.Package, log commonlog.Logger) (bool, error) {
 	deploymentModified := false
 
 	// Are we already fully prepared?
@@ -73,7 +117,7 @@ func (self *Preparation) prepareDeployment(deploymentId string, deploymentPackag
 	preparableResources := self.GetPreparableResources(deploymentPackage, log)
 	for {
 		if resourceIdentifier, ok := preparableResources.Pop(); ok {
-			if self.prepareResource(deploymentId, resourceIdentifier, log) {
+			if self.prepareResource(context, namespace, deploymentId, resourceIdentifier, log) {
 				deploymentModified = true
 			}
 		} else {
@@ -82,7 +126,7 @@ func (self *Preparation) prepareDeployment(deploymentId string, deploymentPackag
 	}
 
 	// If we're fully prepared then update annotations
-	if packageModified, err := self.finalizeDeploymentPreparation(deploymentId, log); err == nil {
+	if packageModified, err := self.finalizeDeploymentPreparation(context, namespace, deploymentId, log); err == nil {
 		if packageModified {
 			deploymentModified = true
 		}
@@ -93,8 +137,8 @@ func (self *Preparation) prepareDeployment(deploymentId string, deploymentPackag
 	return deploymentModified, nil
 }
 
-func (self *Preparation) finalizeDeploymentPreparation(deploymentId string, log commonlog.Logger) (bool, error) {
-	return self.Client.ModifyDeployment(deploymentId, func(package_ tkoutil.Package) (bool, tkoutil.Package, error) {
+func (self *Preparation) finalizeDeploymentPreparation(context contextpkg.Context, namespace string, deploymentId string, log commonlog.Logger) (bool, error) {
+	return self.Client.WithContext(context).ModifyDeployment(namespace, deploymentId, func(package_ tkoutil.Package) (bool, tkoutil.Package, error) {
 		if self.IsDeploymentFullyPrepared(package_) {
 			log.Info("fully prepared")
 
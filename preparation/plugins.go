@@ -5,9 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	api "github.com/nephio-experimental/tko/api/grpc"
 	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/metrics"
 	pluginspkg "github.com/nephio-experimental/tko/plugins"
+	starlarkpkg "github.com/nephio-experimental/tko/plugins/starlark"
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/go-ard"
 	"github.com/tliron/kutil/util"
@@ -17,8 +22,10 @@ const FIFOPrefix = "tko-preparation-"
 
 type PluginInput struct {
 	GRPC                    PluginInputGRPC            `yaml:"grpc"`
+	Trace                   map[string]string          `yaml:"trace,omitempty"`
 	LogFile                 string                     `yaml:"logFile"`
 	LogAddressPort          string                     `yaml:"logAddressPort"`
+	Namespace               string                     `yaml:"namespace"`
 	DeploymentID            string                     `yaml:"deploymentId"`
 	DeploymentPackage       tkoutil.Package            `yaml:"deploymentPackage"`
 	TargetResourceIdentifer tkoutil.ResourceIdentifier `yaml:"targetResourceIdentifier"`
@@ -28,6 +35,7 @@ type PluginInputGRPC struct {
 	Level2Protocol string `yaml:"level2protocol"`
 	Address        string `yaml:"address"`
 	Port           int    `yaml:"port"`
+	Token          string `yaml:"token,omitempty"`
 }
 
 type PluginOutput struct {
@@ -36,27 +44,61 @@ type PluginOutput struct {
 	Error    string          `yaml:"error,omitempty"`
 }
 
-func (self *Context) ToPluginInput(logFile string, logAddressPort string) PluginInput {
+func (self *Context) ToPluginInput(context contextpkg.Context, logFile string, logAddressPort string) PluginInput {
 	return PluginInput{
 		GRPC: PluginInputGRPC{
 			Level2Protocol: self.Preparation.Client.GRPCLevel2Protocol,
 			Address:        self.Preparation.Client.GRPCAddress,
 			Port:           self.Preparation.Client.GRPCPort,
+			Token:          self.Preparation.Client.Token,
 		},
+		Trace:                   telemetry.InjectTrace(context),
 		LogFile:                 logFile,
 		LogAddressPort:          logAddressPort,
+		Namespace:               self.Namespace,
 		DeploymentID:            self.DeploymentID,
 		DeploymentPackage:       self.DeploymentPackage,
 		TargetResourceIdentifer: self.TargetResourceIdentifer,
 	}
 }
 
+func (self *Context) ToPluginData() *api.PluginData {
+	return &api.PluginData{
+		Level2Protocol: self.Preparation.Client.GRPCLevel2Protocol,
+		Address:        self.Preparation.Client.GRPCAddress,
+		Port:           int32(self.Preparation.Client.GRPCPort),
+		Token:          self.Preparation.Client.Token,
+	}
+}
+
+// Executions are counted in the plugin metrics and traced.
 func NewPluginPreparer(plugin client.Plugin, logIpStack util.IPStack, logAddress string, logPort int) (PrepareFunc, error) {
+	if prepare, err := newPluginPreparer(plugin, logIpStack, logAddress, logPort); err == nil {
+		return func(context contextpkg.Context, preparationContext *Context) (bool, []ard.Map, error) {
+			context, span := telemetry.StartPluginSpan(context, plugin.Type, plugin.Name, plugin.Executor)
+			start := time.Now()
+			prepared, package_, err := prepare(context, preparationContext)
+			metrics.ObservePluginExecution(plugin.Type, plugin.Name, start, err)
+			telemetry.EndSpan(span, err)
+			return prepared, package_, err
+		}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func newPluginPreparer(plugin client.Plugin, logIpStack util.IPStack, logAddress string, logPort int) (PrepareFunc, error) {
 	switch plugin.Executor {
 	case pluginspkg.Command:
 		return NewCommandPluginPreparer(plugin, logIpStack, logAddress, logPort)
 	case pluginspkg.Kpt:
 		return NewKptPluginPreparer(plugin)
+	case pluginspkg.GRPC:
+		return NewGRPCPluginPreparer(plugin)
+	case pluginspkg.WASM:
+		return NewWASMPluginPreparer(plugin)
+	case pluginspkg.Starlark:
+		return NewStarlarkPluginPreparer(plugin)
 	default:
 		return nil, fmt.Errorf("unsupported plugin executor: %s", plugin.Executor)
 	}
@@ -77,7 +119,7 @@ func NewCommandPluginPreparer(plugin client.Plugin, logIpStack util.IPStack, log
 		var output PluginOutput
 
 		if logFile, logAddressPort, err := executor.GetLog(FIFOPrefix, logIpStack, logAddress, logPort, preparationContext.Preparation.Log); err == nil {
-			input = preparationContext.ToPluginInput(logFile, logAddressPort)
+			input = preparationContext.ToPluginInput(context, logFile, logAddressPort)
 		} else {
 			return false, nil, err
 		}
@@ -114,3 +156,99 @@ func NewKptPluginPreparer(plugin client.Plugin) (PrepareFunc, error) {
 		}
 	}, nil
 }
+
+func NewGRPCPluginPreparer(plugin client.Plugin) (PrepareFunc, error) {
+	executor, err := pluginspkg.NewGRPCExecutor(plugin.Type, plugin.Name, plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, preparationContext *Context) (bool, []ard.Map, error) {
+		preparationContext.Log.Info("prepare via gRPC plugin",
+			"resource", preparationContext.TargetResourceIdentifer,
+			"target", executor.Target)
+
+		packageFormat, err := executor.PackageFormat(context)
+		if err != nil {
+			return false, nil, err
+		}
+
+		deploymentPackage, err := tkoutil.EncodePackage(packageFormat, preparationContext.DeploymentPackage)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if result, err := executor.Prepare(context, &api.PrepareRequest{
+			Data:                     preparationContext.ToPluginData(),
+			Namespace:                preparationContext.Namespace,
+			DeploymentId:             preparationContext.DeploymentID,
+			PackageFormat:            packageFormat,
+			DeploymentPackage:        deploymentPackage,
+			TargetResourceIdentifier: tkoutil.PluginResourceIdentifierToAPI(preparationContext.TargetResourceIdentifer),
+		}, preparationContext.Log); err == nil {
+			if result.Error != "" {
+				return false, nil, errors.New(result.Error)
+			}
+
+			if !result.Prepared && (len(result.Package) == 0) {
+				// Unchanged
+				return false, preparationContext.DeploymentPackage, nil
+			}
+
+			if package_, err := tkoutil.DecodePackage(result.PackageFormat, result.Package); err == nil {
+				return result.Prepared, package_, nil
+			} else {
+				return false, nil, err
+			}
+		} else {
+			return false, nil, err
+		}
+	}, nil
+}
+
+func NewWASMPluginPreparer(plugin client.Plugin) (PrepareFunc, error) {
+	executor, err := pluginspkg.NewWASMExecutor(plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, preparationContext *Context) (bool, []ard.Map, error) {
+		preparationContext.Log.Info("prepare via WASM plugin",
+			"resource", preparationContext.TargetResourceIdentifer,
+			"arguments", strings.Join(plugin.Arguments, " "))
+
+		input := preparationContext.ToPluginInput(context, "", "")
+		var output PluginOutput
+
+		if err := executor.Execute(context, input, &output, preparationContext.Log); err == nil {
+			if output.Error == "" {
+				return output.Prepared, output.Package, nil
+			} else {
+				return false, nil, errors.New(output.Error)
+			}
+		} else {
+			return false, nil, err
+		}
+	}, nil
+}
+
+func NewStarlarkPluginPreparer(plugin client.Plugin) (PrepareFunc, error) {
+	executor, err := starlarkpkg.NewExecutor(plugin.Name, plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, preparationContext *Context) (bool, []ard.Map, error) {
+		preparationContext.Log.Info("prepare via Starlark plugin",
+			"resource", preparationContext.TargetResourceIdentifer)
+
+		return executor.Prepare(context, &starlarkpkg.Environment{
+			Client:                   preparationContext.Preparation.Client,
+			Log:                      preparationContext.Log,
+			Package:                  preparationContext.DeploymentPackage,
+			TargetResourceIdentifier: preparationContext.TargetResourceIdentifer,
+			Namespace:                preparationContext.Namespace,
+			DeploymentID:             preparationContext.DeploymentID,
+		})
+	}, nil
+}
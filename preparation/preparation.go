@@ -5,6 +5,7 @@ import (
 	"time"
 
 	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/plugins"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
 )
@@ -41,4 +42,5 @@ func NewPreparation(client *clientpkg.Client, timeout time.Duration, defaultAuto
 
 func (self *Preparation) ResetPluginCache() {
 	self.preparers = sync.Map{}
+	plugins.ResetGRPCCapabilities()
 }
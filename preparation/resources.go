@@ -3,8 +3,10 @@ package preparation
 import (
 	contextpkg "context"
 
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (self *Preparation) GetPreparableResources(package_ tkoutil.Package, log commonlog.Logger) *tkoutil.ResourceIdentifiers {
@@ -58,8 +60,12 @@ func (self *Preparation) IsResourcePreparable(resourceIdentifier tkoutil.Resourc
 	return false, nil
 }
 
-func (self *Preparation) prepareResource(deploymentId string, resourceIdentifier tkoutil.ResourceIdentifier, log commonlog.Logger) bool {
-	if modified, err := self.Client.ModifyDeployment(deploymentId, func(package_ tkoutil.Package) (bool, tkoutil.Package, error) {
+func (self *Preparation) prepareResource(context contextpkg.Context, namespace string, deploymentId string, resourceIdentifier tkoutil.ResourceIdentifier, log commonlog.Logger) bool {
+	context, span := telemetry.StartSpan(context, "prepareResource",
+		attribute.String("tko.resource", resourceIdentifier.String()))
+	defer span.End()
+
+	if modified, err := self.Client.WithContext(context).ModifyDeployment(namespace, deploymentId, func(package_ tkoutil.Package) (bool, tkoutil.Package, error) {
 		var resourceModified bool
 		if resource, ok := resourceIdentifier.GetResource(package_); ok {
 			log = commonlog.NewKeyValueLogger(log,
@@ -71,14 +77,14 @@ func (self *Preparation) prepareResource(deploymentId string, resourceIdentifier
 					for _, prepare := range preparers {
 						log.Info("preparing resource")
 
-						preparationContext := self.NewContext(deploymentId, package_, resourceIdentifier, log)
+						preparationContext := self.NewContext(namespace, deploymentId, package_, resourceIdentifier, log)
 						var preparerModified bool
 						var err error
 
-						context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
+						prepareContext, cancel := contextpkg.WithTimeout(context, self.Timeout)
 						defer cancel()
 
-						if preparerModified, package_, err = prepare(context, preparationContext); err == nil {
+						if preparerModified, package_, err = prepare(prepareContext, preparationContext); err == nil {
 							if preparerModified {
 								resourceModified = true
 							}
@@ -30,7 +30,7 @@ func PreparePlacement(context contextpkg.Context, preparationContext *preparatio
 		for _, template := range templates {
 			template_ := ard.With(template).ConvertSimilar()
 			if templateName, ok := template_.Get("template").String(); ok {
-				if templateId, ok := GetTemplateID(preparationContext, templateName); ok {
+				if templateId, ok := GetTemplateID(context, preparationContext, templateName); ok {
 					merge, _ := template_.Get("merge").List()
 					_, mergePackage, err := preparationContext.GetMergePackage(merge)
 					if err != nil {
@@ -39,7 +39,7 @@ func PreparePlacement(context contextpkg.Context, preparationContext *preparatio
 
 					siteNames, _ := template_.Get("sites").StringList()
 					for _, siteName := range siteNames {
-						if siteIds, ok := GetSiteIDs(preparationContext, siteName); ok {
+						if siteIds, ok := GetSiteIDs(context, preparationContext, siteName); ok {
 							for _, siteId := range siteIds {
 								deployments = append(deployments, Deployment{templateId, mergePackage, siteId, nil})
 							}
@@ -94,7 +94,7 @@ func PreparePlacement(context contextpkg.Context, preparationContext *preparatio
 
 		if prepared {
 			for _, deployment := range deployments {
-				if ok, reason, deploymentId, err := preparationContext.Preparation.Client.CreateDeployment(preparationContext.DeploymentID, deployment.TemplateID, deployment.SiteID, nil, false, false, deployment.MergePackage); err == nil {
+				if ok, reason, deploymentId, err := preparationContext.Preparation.Client.WithContext(context).CreateDeployment(preparationContext.Namespace, preparationContext.DeploymentID, deployment.TemplateID, deployment.SiteID, nil, false, false, deployment.MergePackage); err == nil {
 					if ok {
 						preparationContext.Log.Infof("created deployment %s (%s) for site %s", deploymentId, deployment.TemplateID, deployment.SiteID)
 						/*AppendStatusDeploymentID(placement, deploymentId)
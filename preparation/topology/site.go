@@ -40,7 +40,7 @@ func PrepareSite(context contextpkg.Context, preparationContext *preparation.Con
 				metadataPatterns[key] = util.ToString(value)
 			}
 
-			if siteInfos, err := preparationContext.Preparation.Client.ListSites(clientpkg.SelectSites{MetadataPatterns: metadataPatterns}, 0, 1); err == nil {
+			if siteInfos, err := preparationContext.Preparation.Client.WithContext(context).ListSites(clientpkg.SelectSites{Namespace: preparationContext.Namespace, MetadataPatterns: metadataPatterns}, 0, 1); err == nil {
 				// First one we find
 				if siteInfo, err := siteInfos.Next(); err == nil {
 					siteInfos.Release()
@@ -65,7 +65,7 @@ func PrepareSite(context contextpkg.Context, preparationContext *preparation.Con
 				}
 
 				siteId := "provisioned/" + backend.NewID()
-				if ok, reason, err := preparationContext.Preparation.Client.RegisterSite(siteId, templateId, map[string]string{"type": "provisioned"}, mergePackage); err == nil {
+				if ok, reason, err := preparationContext.Preparation.Client.WithContext(context).RegisterSite(preparationContext.Namespace, siteId, templateId, map[string]string{"type": "provisioned"}, mergePackage, 0); err == nil {
 					if ok {
 						preparationContext.Log.Infof("provisioned new site %s for %s", siteId, preparationContext.TargetResourceIdentifer.Name)
 						SetStatusSiteID(site, siteId)
@@ -1,6 +1,8 @@
 package topology
 
 import (
+	contextpkg "context"
+
 	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
 	"github.com/nephio-experimental/tko/preparation"
 	tkoutil "github.com/nephio-experimental/tko/util"
@@ -11,7 +13,7 @@ import (
 var SitesGVK = tkoutil.NewGVK("topology.nephio.org", "v1alpha1", "Sites")
 
 // TODO: cache result
-func GetSiteIDs(preparationContext *preparation.Context, name string) ([]string, bool) {
+func GetSiteIDs(context contextpkg.Context, preparationContext *preparation.Context, name string) ([]string, bool) {
 	if site, ok := SitesGVK.NewResourceIdentifier(name).GetResource(preparationContext.DeploymentPackage); ok {
 		spec := ard.With(site).Get("spec").ConvertSimilar()
 
@@ -21,7 +23,7 @@ func GetSiteIDs(preparationContext *preparation.Context, name string) ([]string,
 				metadataPatterns[key] = util.ToString(value)
 			}
 
-			siteInfos := preparationContext.Preparation.Client.ListAllSites(clientpkg.SelectSites{MetadataPatterns: metadataPatterns})
+			siteInfos := preparationContext.Preparation.Client.WithContext(context).ListAllSites(clientpkg.SelectSites{Namespace: preparationContext.Namespace, MetadataPatterns: metadataPatterns})
 			var siteIds []string
 			if err := util.IterateResults(siteInfos, func(siteInfo clientpkg.SiteInfo) error {
 				siteIds = append(siteIds, siteInfo.SiteID)
@@ -1,6 +1,8 @@
 package topology
 
 import (
+	contextpkg "context"
+
 	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
 	"github.com/nephio-experimental/tko/preparation"
 	tkoutil "github.com/nephio-experimental/tko/util"
@@ -11,7 +13,7 @@ import (
 var TemplateGVK = tkoutil.NewGVK("topology.nephio.org", "v1alpha1", "Template")
 
 // TODO: cache result
-func GetTemplateID(preparationContext *preparation.Context, name string) (string, bool) {
+func GetTemplateID(context contextpkg.Context, preparationContext *preparation.Context, name string) (string, bool) {
 	if template, ok := TemplateGVK.NewResourceIdentifier(name).GetResource(preparationContext.DeploymentPackage); ok {
 		spec := ard.With(template).Get("spec").ConvertSimilar()
 
@@ -25,7 +27,7 @@ func GetTemplateID(preparationContext *preparation.Context, name string) (string
 				metadataPatterns[key] = util.ToString(value)
 			}
 
-			if templateInfos, err := preparationContext.Preparation.Client.ListTemplates(clientpkg.SelectTemplates{MetadataPatterns: metadataPatterns}, 0, 1); err == nil {
+			if templateInfos, err := preparationContext.Preparation.Client.WithContext(context).ListTemplates(clientpkg.SelectTemplates{Namespace: preparationContext.Namespace, MetadataPatterns: metadataPatterns}, 0, 1); err == nil {
 				defer templateInfos.Release()
 
 				// First one we find
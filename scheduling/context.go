@@ -12,23 +12,37 @@ import (
 type Context struct {
 	Scheduling              *Scheduling
 	Log                     commonlog.Logger
+	Namespace               string // of the site and its deployments
 	SiteID                  string
 	SitePackage             util.Package
 	TargetResourceIdentifer util.ResourceIdentifier
 	Deployments             map[string]util.Package
+	DeletedDeployments      map[string]util.Package // last packages, to be torn down
+
+	acknowledgedDeletedDeploymentIDs []string
 }
 
-func (self *Scheduling) NewContext(siteId string, sitePackage util.Package, targetResourceIdentifer util.ResourceIdentifier, deployments map[string]util.Package, log commonlog.Logger) *Context {
+func (self *Scheduling) NewContext(namespace string, siteId string, sitePackage util.Package, targetResourceIdentifer util.ResourceIdentifier, deployments map[string]util.Package, deletedDeployments map[string]util.Package, log commonlog.Logger) *Context {
 	return &Context{
 		Scheduling:              self,
 		Log:                     log,
+		Namespace:               namespace,
 		SiteID:                  siteId,
 		SitePackage:             sitePackage,
 		TargetResourceIdentifer: targetResourceIdentifer,
 		Deployments:             deployments,
+		DeletedDeployments:      deletedDeployments,
 	}
 }
 
 func (self *Context) GetResource() (util.Resource, bool) {
 	return self.TargetResourceIdentifer.GetResource(self.SitePackage)
 }
+
+// Schedulers should call this after tearing down a deleted deployment so that its tombstone
+// will be removed.
+func (self *Context) AcknowledgeDeletedDeployment(deploymentId string) {
+	if _, ok := self.DeletedDeployments[deploymentId]; ok {
+		self.acknowledgedDeletedDeploymentIDs, _ = util.AddToStringSet(self.acknowledgedDeletedDeploymentIDs, deploymentId)
+	}
+}
@@ -1,10 +1,15 @@
 package scheduling
 
 import (
+	contextpkg "context"
+	"errors"
 	"time"
 
-	"github.com/nephio-experimental/tko/util"
+	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/metrics"
+	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
+	"github.com/tliron/kutil/util"
 )
 
 //
@@ -12,25 +17,95 @@ import (
 //
 
 type Controller struct {
-	*util.Controller
+	*tkoutil.Controller
 
 	Scheduling *Scheduling
 
 	log commonlog.Logger
 }
 
-func NewController(scheduling *Scheduling, interval time.Duration, log commonlog.Logger) *Controller {
+// While watching, the full scan happens every resyncInterval instead of every interval.
+func NewController(scheduling *Scheduling, interval time.Duration, resyncInterval time.Duration, log commonlog.Logger) *Controller {
 	self := Controller{
 		Scheduling: scheduling,
 		log:        log,
 	}
-	self.Controller = util.NewController(self.run, interval, log)
+	self.Controller = tkoutil.NewController(self.run, interval, log)
+	self.Controller.RunQueued = self.runQueued
+	self.Controller.ResyncInterval = resyncInterval
+	self.Controller.Watch(self.watch)
 	return &self
 }
 
 func (self *Controller) run() error {
-	if err := self.Scheduling.ScheduleSites(); err != nil {
+	start := time.Now()
+	err := self.Scheduling.ScheduleSites()
+	metrics.ObserveControllerLoop("scheduling", start, err)
+	if err != nil {
 		self.log.Error(err.Error())
 	}
 	return nil
 }
+
+// Schedules only the sites that have changed or whose deployments have changed. Because a
+// deleted deployment no longer tells us its site, this falls back to a full run for them.
+func (self *Controller) runQueued(keys []tkoutil.ControllerKey) error {
+	start := time.Now()
+
+	sites := make(map[tkoutil.ControllerKey]struct{})
+	var errs []error
+	for _, key := range keys {
+		switch key.Kind {
+		case "site":
+			sites[key] = struct{}{}
+
+		case "deployment":
+			if deployment, ok, err := self.Scheduling.Client.GetDeployment(key.Namespace, key.ID); err == nil {
+				if !ok {
+					return self.run()
+				}
+				if deployment.SiteID != "" {
+					sites[tkoutil.ControllerKey{Kind: "site", Namespace: key.Namespace, ID: deployment.SiteID}] = struct{}{}
+				}
+			} else {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	for site := range sites {
+		if err := self.Scheduling.ScheduleSiteID(site.Namespace, site.ID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := errors.Join(errs...)
+	metrics.ObserveControllerLoop("scheduling", start, err)
+	if err != nil {
+		self.log.Error(err.Error())
+	}
+	return nil
+}
+
+// Queues changes to deployments and sites. A full scan is done whenever watching starts, in
+// order to catch up on events that may have been missed.
+func (self *Controller) watch(context contextpkg.Context) error {
+	if events, err := self.Scheduling.Client.Watch(context, clientpkg.SelectEvents{Kinds: []string{"deployment", "site"}, Namespace: clientpkg.AllNamespaces}); err == nil {
+		self.SetWatching(true)
+		self.Resync()
+		if err := util.IterateResults(events, func(event clientpkg.Event) error {
+			self.Enqueue(tkoutil.ControllerKey{Kind: event.Kind, Namespace: event.Namespace, ID: event.ID})
+			return nil
+		}); err != nil {
+			return err
+		}
+	} else if clientpkg.IsNotImplementedError(err) {
+		// Fall back to interval only
+		self.log.Notice("backend does not support watching")
+		<-context.Done()
+	} else {
+		return err
+	}
+
+	return nil
+}
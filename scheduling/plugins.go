@@ -5,9 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	api "github.com/nephio-experimental/tko/api/grpc"
 	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/metrics"
 	pluginspkg "github.com/nephio-experimental/tko/plugins"
+	starlarkpkg "github.com/nephio-experimental/tko/plugins/starlark"
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/kutil/util"
 )
@@ -16,46 +21,86 @@ const FIFOPrefix = "tko-scheduling-"
 
 type PluginInput struct {
 	GRPC                    PluginInputGRPC            `yaml:"grpc"`
+	Trace                   map[string]string          `yaml:"trace,omitempty"`
 	LogFile                 string                     `yaml:"logFile"`
 	LogAddressPort          string                     `yaml:"logAddressPort"`
+	Namespace               string                     `yaml:"namespace"`
 	SiteID                  string                     `yaml:"siteId"`
 	SitePackage             tkoutil.Package            `yaml:"sitePackage"`
 	TargetResourceIdentifer tkoutil.ResourceIdentifier `yaml:"targetResourceIdentifier"`
 	Deployments             map[string]tkoutil.Package `yaml:"deployments"`
+	DeletedDeployments      map[string]tkoutil.Package `yaml:"deletedDeployments"`
 }
 
 type PluginInputGRPC struct {
 	Level2Protocol string `yaml:"level2protocol"`
 	Address        string `yaml:"address"`
 	Port           int    `yaml:"port"`
+	Token          string `yaml:"token,omitempty"`
 }
 
 type PluginOutput struct {
-	Error string `yaml:"error,omitempty"`
+	AcknowledgedDeletedDeployments []string `yaml:"acknowledgedDeletedDeployments,omitempty"`
+	Error                          string   `yaml:"error,omitempty"`
 }
 
-func (self *Context) ToPluginInput(logFile string, logAddressPort string) PluginInput {
+func (self *Context) ToPluginInput(context contextpkg.Context, logFile string, logAddressPort string) PluginInput {
 	return PluginInput{
 		GRPC: PluginInputGRPC{
 			Level2Protocol: self.Scheduling.Client.GRPCLevel2Protocol,
 			Address:        self.Scheduling.Client.GRPCAddress,
 			Port:           self.Scheduling.Client.GRPCPort,
+			Token:          self.Scheduling.Client.Token,
 		},
+		Trace:                   telemetry.InjectTrace(context),
 		LogFile:                 logFile,
 		LogAddressPort:          logAddressPort,
+		Namespace:               self.Namespace,
 		SiteID:                  self.SiteID,
 		SitePackage:             self.SitePackage,
 		TargetResourceIdentifer: self.TargetResourceIdentifer,
 		Deployments:             self.Deployments,
+		DeletedDeployments:      self.DeletedDeployments,
 	}
 }
 
+func (self *Context) ToPluginData() *api.PluginData {
+	return &api.PluginData{
+		Level2Protocol: self.Scheduling.Client.GRPCLevel2Protocol,
+		Address:        self.Scheduling.Client.GRPCAddress,
+		Port:           int32(self.Scheduling.Client.GRPCPort),
+		Token:          self.Scheduling.Client.Token,
+	}
+}
+
+// Executions are counted in the plugin metrics and traced.
 func NewPluginScheduler(plugin client.Plugin, logIpStack util.IPStack, logAddress string, logPort int) (ScheduleFunc, error) {
+	if schedule, err := newPluginScheduler(plugin, logIpStack, logAddress, logPort); err == nil {
+		return func(context contextpkg.Context, schedulingContext *Context) error {
+			context, span := telemetry.StartPluginSpan(context, plugin.Type, plugin.Name, plugin.Executor)
+			start := time.Now()
+			err := schedule(context, schedulingContext)
+			metrics.ObservePluginExecution(plugin.Type, plugin.Name, start, err)
+			telemetry.EndSpan(span, err)
+			return err
+		}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func newPluginScheduler(plugin client.Plugin, logIpStack util.IPStack, logAddress string, logPort int) (ScheduleFunc, error) {
 	switch plugin.Executor {
 	case pluginspkg.Command:
 		return NewCommandPluginScheduler(plugin, logIpStack, logAddress, logPort)
 	case pluginspkg.Ansible:
 		return NewAnsiblePluginScheduler(plugin)
+	case pluginspkg.GRPC:
+		return NewGRPCPluginScheduler(plugin)
+	case pluginspkg.WASM:
+		return NewWASMPluginScheduler(plugin)
+	case pluginspkg.Starlark:
+		return NewStarlarkPluginScheduler(plugin)
 	default:
 		return nil, fmt.Errorf("unsupported plugin executor: %s", plugin.Executor)
 	}
@@ -76,12 +121,16 @@ func NewCommandPluginScheduler(plugin client.Plugin, logIpStack util.IPStack, lo
 		var output PluginOutput
 
 		if logFile, logAddressPort, err := executor.GetLog(FIFOPrefix, logIpStack, logAddress, logPort, schedulingContext.Scheduling.Log); err == nil {
-			input = schedulingContext.ToPluginInput(logFile, logAddressPort)
+			input = schedulingContext.ToPluginInput(context, logFile, logAddressPort)
 		} else {
 			return err
 		}
 
 		if err := executor.Execute(context, input, &output); err == nil {
+			for _, deploymentId := range output.AcknowledgedDeletedDeployments {
+				schedulingContext.AcknowledgeDeletedDeployment(deploymentId)
+			}
+
 			if output.Error == "" {
 				return nil
 			} else {
@@ -104,8 +153,129 @@ func NewAnsiblePluginScheduler(plugin client.Plugin) (ScheduleFunc, error) {
 			"resource", schedulingContext.TargetResourceIdentifer,
 			"arguments", strings.Join(plugin.Arguments, " "))
 
-		input := schedulingContext.ToPluginInput("", "")
+		input := schedulingContext.ToPluginInput(context, "", "")
 
 		return executor.Execute(context, input)
 	}, nil
 }
+
+func NewGRPCPluginScheduler(plugin client.Plugin) (ScheduleFunc, error) {
+	executor, err := pluginspkg.NewGRPCExecutor(plugin.Type, plugin.Name, plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, schedulingContext *Context) error {
+		schedulingContext.Log.Info("schedule via gRPC plugin",
+			"resource", schedulingContext.TargetResourceIdentifer,
+			"target", executor.Target)
+
+		packageFormat, err := executor.PackageFormat(context)
+		if err != nil {
+			return err
+		}
+
+		sitePackage, err := tkoutil.EncodePackage(packageFormat, schedulingContext.SitePackage)
+		if err != nil {
+			return err
+		}
+
+		deployments, err := encodePackages(packageFormat, schedulingContext.Deployments)
+		if err != nil {
+			return err
+		}
+
+		deletedDeployments, err := encodePackages(packageFormat, schedulingContext.DeletedDeployments)
+		if err != nil {
+			return err
+		}
+
+		if result, err := executor.Schedule(context, &api.ScheduleRequest{
+			Data:                     schedulingContext.ToPluginData(),
+			Namespace:                schedulingContext.Namespace,
+			SiteId:                   schedulingContext.SiteID,
+			PackageFormat:            packageFormat,
+			SitePackage:              sitePackage,
+			TargetResourceIdentifier: tkoutil.PluginResourceIdentifierToAPI(schedulingContext.TargetResourceIdentifer),
+			Deployments:              deployments,
+			DeletedDeployments:       deletedDeployments,
+		}, schedulingContext.Log); err == nil {
+			for _, deploymentId := range result.AcknowledgedDeletedDeployments {
+				schedulingContext.AcknowledgeDeletedDeployment(deploymentId)
+			}
+
+			if result.Error == "" {
+				return nil
+			} else {
+				return errors.New(result.Error)
+			}
+		} else {
+			return err
+		}
+	}, nil
+}
+
+func encodePackages(format string, packages map[string]tkoutil.Package) (map[string][]byte, error) {
+	encodedPackages := make(map[string][]byte)
+	for id, package_ := range packages {
+		var err error
+		if encodedPackages[id], err = tkoutil.EncodePackage(format, package_); err != nil {
+			return nil, err
+		}
+	}
+	return encodedPackages, nil
+}
+
+func NewWASMPluginScheduler(plugin client.Plugin) (ScheduleFunc, error) {
+	executor, err := pluginspkg.NewWASMExecutor(plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, schedulingContext *Context) error {
+		schedulingContext.Log.Info("schedule via WASM plugin",
+			"resource", schedulingContext.TargetResourceIdentifer,
+			"arguments", strings.Join(plugin.Arguments, " "))
+
+		input := schedulingContext.ToPluginInput(context, "", "")
+		var output PluginOutput
+
+		if err := executor.Execute(context, input, &output, schedulingContext.Log); err == nil {
+			for _, deploymentId := range output.AcknowledgedDeletedDeployments {
+				schedulingContext.AcknowledgeDeletedDeployment(deploymentId)
+			}
+
+			if output.Error == "" {
+				return nil
+			} else {
+				return errors.New(output.Error)
+			}
+		} else {
+			return err
+		}
+	}, nil
+}
+
+func NewStarlarkPluginScheduler(plugin client.Plugin) (ScheduleFunc, error) {
+	executor, err := starlarkpkg.NewExecutor(plugin.Name, plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, schedulingContext *Context) error {
+		schedulingContext.Log.Info("schedule via Starlark plugin",
+			"resource", schedulingContext.TargetResourceIdentifer)
+
+		return executor.Schedule(context, &starlarkpkg.Environment{
+			Client:                       schedulingContext.Scheduling.Client,
+			Log:                          schedulingContext.Log,
+			Package:                      schedulingContext.SitePackage,
+			TargetResourceIdentifier:     schedulingContext.TargetResourceIdentifer,
+			Namespace:                    schedulingContext.Namespace,
+			SiteID:                       schedulingContext.SiteID,
+			Deployments:                  schedulingContext.Deployments,
+			DeletedDeployments:           schedulingContext.DeletedDeployments,
+			AcknowledgeDeletedDeployment: schedulingContext.AcknowledgeDeletedDeployment,
+		})
+	}, nil
+}
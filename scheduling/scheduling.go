@@ -5,6 +5,7 @@ import (
 	"time"
 
 	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/plugins"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
 )
@@ -41,4 +42,5 @@ func NewScheduling(client *clientpkg.Client, timeout time.Duration, log commonlo
 
 func (self *Scheduling) ResetPluginCache() {
 	self.schedulers = sync.Map{}
+	plugins.ResetGRPCCapabilities()
 }
@@ -4,25 +4,50 @@ import (
 	contextpkg "context"
 
 	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (self *Scheduling) ScheduleSites() error {
 	//self.Log.Notice("scheduling sites")
-	siteInfos := self.Client.ListAllSites(client.SelectSites{})
+	siteInfos := self.Client.ListAllSites(client.SelectSites{Namespace: client.AllNamespaces})
 	return util.IterateResults(siteInfos, self.ScheduleSite)
 }
 
+// The scheduling is traced as a root span that includes all its client requests and plugins.
 func (self *Scheduling) ScheduleSite(siteInfo client.SiteInfo) error {
+	context, span := telemetry.StartSpan(contextpkg.Background(), "scheduleSite",
+		attribute.String("tko.namespace", siteInfo.Namespace),
+		attribute.String("tko.site_id", siteInfo.SiteID))
+	err := self.scheduleSiteInfo(context, siteInfo)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// Like [Scheduling.ScheduleSite] but for a site ID.
+func (self *Scheduling) ScheduleSiteID(namespace string, siteId string) error {
+	return self.ScheduleSite(client.SiteInfo{Namespace: namespace, SiteID: siteId})
+}
+
+// The deployment IDs are taken from the fetched site rather than from the site info.
+func (self *Scheduling) scheduleSiteInfo(context contextpkg.Context, siteInfo client.SiteInfo) error {
+	client_ := self.Client.WithContext(context)
+
 	log := commonlog.NewKeyValueLogger(self.Log,
+		"namespace", siteInfo.Namespace,
 		"site", siteInfo.SiteID)
 	log.Notice("scheduling site")
 
-	if site, ok, err := self.Client.GetSite(siteInfo.SiteID); err == nil {
+	if site, ok, err := client_.GetSite(siteInfo.Namespace, siteInfo.SiteID); err == nil {
 		if ok {
-			self.scheduleSite(siteInfo.SiteID, site.Package, siteInfo.DeploymentIDs, log)
+			if deletedDeployments, err := self.getDeletedDeployments(context, siteInfo.Namespace, siteInfo.SiteID); err == nil {
+				self.scheduleSite(context, siteInfo.Namespace, siteInfo.SiteID, site.Package, site.DeploymentIDs, deletedDeployments, log)
+			} else {
+				return err
+			}
 		} else {
 			log.Info("site disappeared")
 		}
@@ -32,14 +57,18 @@ func (self *Scheduling) ScheduleSite(siteInfo client.SiteInfo) error {
 	}
 }
 
-func (self *Scheduling) scheduleSite(siteId string, sitePackage tkoutil.Package, deploymentIds []string, log commonlog.Logger) {
+func (self *Scheduling) scheduleSite(context contextpkg.Context, namespace string, siteId string, sitePackage tkoutil.Package, deploymentIds []string, deletedDeployments map[string]tkoutil.Package, log commonlog.Logger) {
+	client_ := self.Client.WithContext(context)
+
+	var acknowledgedDeletedDeploymentIds []string
+
 	for _, resource := range sitePackage {
 		if resourceIdentifier, ok := tkoutil.NewResourceIdentifierForResource(resource); ok {
 			if schedulers, err := self.GetSchedulers(resourceIdentifier.GVK); err == nil {
 				if len(schedulers) > 0 {
 					deployments := make(map[string]tkoutil.Package)
 					for _, deploymentId := range deploymentIds {
-						if deployment, ok, err := self.Client.GetDeployment(deploymentId); err == nil {
+						if deployment, ok, err := client_.GetDeployment(namespace, deploymentId); err == nil {
 							if ok {
 								if deployment.Prepared && deployment.Approved {
 									deployments[deploymentId] = deployment.Package
@@ -48,19 +77,55 @@ func (self *Scheduling) scheduleSite(siteId string, sitePackage tkoutil.Package,
 						}
 					}
 
-					schedulingContext := self.NewContext(siteId, sitePackage, resourceIdentifier, deployments, log)
+					schedulingContext := self.NewContext(namespace, siteId, sitePackage, resourceIdentifier, deployments, deletedDeployments, log)
 
 					for _, schedule := range schedulers {
-						context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
-						if err := schedule(context, schedulingContext); err != nil {
+						scheduleContext, cancel := contextpkg.WithTimeout(context, self.Timeout)
+						if err := schedule(scheduleContext, schedulingContext); err != nil {
 							log.Error(err.Error())
 						}
 						cancel()
 					}
+
+					for _, deploymentId := range schedulingContext.acknowledgedDeletedDeploymentIDs {
+						acknowledgedDeletedDeploymentIds, _ = tkoutil.AddToStringSet(acknowledgedDeletedDeploymentIds, deploymentId)
+					}
 				}
 			} else {
 				log.Error(err.Error())
 			}
 		}
 	}
+
+	if len(acknowledgedDeletedDeploymentIds) > 0 {
+		log.Info("acknowledging deleted deployments",
+			"deploymentIds", acknowledgedDeletedDeploymentIds)
+		if ok, reason, err := client_.AcknowledgeDeletedDeployments(namespace, siteId, acknowledgedDeletedDeploymentIds); err == nil {
+			if !ok {
+				log.Error(reason)
+			}
+		} else {
+			log.Error(err.Error())
+		}
+	}
+}
+
+// Utils
+
+func (self *Scheduling) getDeletedDeployments(context contextpkg.Context, namespace string, siteId string) (map[string]tkoutil.Package, error) {
+	deletedDeployments := make(map[string]tkoutil.Package)
+	if deletedDeploymentResults, err := self.Client.WithContext(context).ListDeletedDeployments(namespace, siteId); err == nil {
+		if err := util.IterateResults(deletedDeploymentResults, func(deletedDeployment client.DeletedDeployment) error {
+			deletedDeployments[deletedDeployment.DeploymentID] = deletedDeployment.Package
+			return nil
+		}); err == nil {
+			return deletedDeployments, nil
+		} else if client.IsNotImplementedError(err) {
+			return deletedDeployments, nil
+		} else {
+			return nil, err
+		}
+	} else {
+		return nil, err
+	}
 }
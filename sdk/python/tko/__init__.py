@@ -5,3 +5,4 @@ from tko.expressions import *
 from tko.scheduling import *
 from tko.client import *
 from tko.encoding import *
+from tko.plugin_server import *
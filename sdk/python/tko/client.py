@@ -1,4 +1,4 @@
-import tko.plugin, tko.encoding, tko.tko_pb2_grpc, tko.tko_pb2, grpc
+import collections, tko.plugin, tko.encoding, tko.tko_pb2_grpc, tko.tko_pb2, grpc
 
 
 MAX_INT32 = 1 << (32-1) - 1
@@ -8,11 +8,24 @@ DEFAULT_PACKAGE_FORMAT = 'yaml'
 
 
 class Client:
-  def __init__(self, host=None):
-    self.host = host if host is not None else tko.plugin.get_grpc_host()
+  # Within a plugin the host, token, and trace default to those provided in the plugin input.
+  def __init__(self, host=None, token=None, trace=None):
+    if host is None:
+      host = tko.plugin.get_grpc_host()
+      if token is None:
+        token = tko.plugin.get_grpc_token()
+      if trace is None:
+        trace = tko.plugin.get_trace()
+    self.host = host
+    self.token = token
+    self.trace = trace
 
   def __enter__(self):
     self.channel = grpc.insecure_channel(self.host)
+    if self.token:
+      self.channel = grpc.intercept_channel(self.channel, TokenInterceptor(self.token))
+    if self.trace:
+      self.channel = grpc.intercept_channel(self.channel, MetadataInterceptor(self.trace))
     self.stub = tko.tko_pb2_grpc.DataStub(self.channel)
     return self
 
@@ -23,91 +36,99 @@ class Client:
 
   # Templates
 
-  def register_template(self, template_id, metadata=None, package_format=DEFAULT_PACKAGE_FORMAT, package=None):
+  def register_template(self, template_id, metadata=None, package_format=DEFAULT_PACKAGE_FORMAT, package=None, namespace=None):
     if package is not None:
       package = tko.encoding.encode_package(package, format=package_format)
-    r = self.stub.registerTemplate(tko.tko_pb2.Template(templateId=template_id, metadata=metadata, packageFormat=package_format, package=package))
+    r = self.stub.registerTemplate(tko.tko_pb2.Template(namespace=namespace, templateId=template_id, metadata=metadata, packageFormat=package_format, package=package))
     if not r.registered:
       raise Exception(r.notRegisteredReason)
 
-  def get_template(self, template_id):
-    return self.stub.getTemplate(tko.tko_pb2.GetTemplate(templateId=template_id))
+  def get_template(self, template_id, namespace=None):
+    return self.stub.getTemplate(tko.tko_pb2.GetTemplate(namespace=namespace, templateId=template_id))
 
-  def delete_template(self, template_id):
-    r = self.stub.deleteTemplate(tko.tko_pb2.TemplateID(templateId=template_id))
+  def delete_template(self, template_id, namespace=None):
+    r = self.stub.deleteTemplate(tko.tko_pb2.TemplateID(namespace=namespace, templateId=template_id))
     if not r.deleted:
       raise Exception(r.notDeletedReason)
 
-  def list_templates(self, template_id_patterns=None, metadata_patterns=None, offset=0, max_count=DEFAULT_MAX_COUNT):
-    select_templates = tko.tko_pb2.SelectTemplates(templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns)
+  def list_templates(self, template_id_patterns=None, metadata_patterns=None, offset=0, max_count=DEFAULT_MAX_COUNT, namespace=None):
+    select_templates = tko.tko_pb2.SelectTemplates(namespace=namespace, templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns)
     window = tko.tko_pb2.Window(offset=offset, maxCount=max_count)
     return self.stub.listTemplates(tko.tko_pb2.ListTemplates(select=select_templates, window=window))
 
-  def purge_templates(self, template_id_patterns=None, metadata_patterns=None):
-    r = self.stub.purgeTemplates(tko.tko_pb2.SelectTemplates(templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns))
+  def purge_templates(self, template_id_patterns=None, metadata_patterns=None, namespace=None):
+    r = self.stub.purgeTemplates(tko.tko_pb2.SelectTemplates(namespace=namespace, templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns))
     if not r.deleted:
       raise Exception(r.notDeletedReason)
 
   # Sites
 
-  def register_site(self, site_id, template_id=None, metadata=None, package_format=DEFAULT_PACKAGE_FORMAT, package=None):
+  def register_site(self, site_id, template_id=None, metadata=None, package_format=DEFAULT_PACKAGE_FORMAT, package=None, namespace=None):
     if package is not None:
       package = tko.encoding.encode_package(package, format=package_format)
-    r = self.stub.registerSite(tko.tko_pb2.Site(siteId=site_id, templateId=template_id, metadata=metadata, packageFormat=package_format, package=package))
+    r = self.stub.registerSite(tko.tko_pb2.Site(namespace=namespace, siteId=site_id, templateId=template_id, metadata=metadata, packageFormat=package_format, package=package))
     if not r.registered:
       raise Exception(r.notRegisteredReason)
 
-  def get_site(self, site_id):
-    return self.stub.getSite(tko.tko_pb2.GetSite(siteId=site_id))
+  def get_site(self, site_id, namespace=None):
+    return self.stub.getSite(tko.tko_pb2.GetSite(namespace=namespace, siteId=site_id))
 
-  def delete_site(self, site_id):
-    r = self.stub.deleteSite(tko.tko_pb2.SiteID(siteId=site_id))
+  def delete_site(self, site_id, namespace=None):
+    r = self.stub.deleteSite(tko.tko_pb2.SiteID(namespace=namespace, siteId=site_id))
     if not r.deleted:
       raise Exception(r.notDeletedReason)
 
-  def list_sites(self, site_id_patterns=None, template_id_patterns=None, metadata_patterns=None, offset=0, max_count=DEFAULT_MAX_COUNT):
-    select_sites = tko.tko_pb2.SelectSites(siteIdPatterns=site_id_patterns, templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns)
+  def list_sites(self, site_id_patterns=None, template_id_patterns=None, metadata_patterns=None, offset=0, max_count=DEFAULT_MAX_COUNT, namespace=None):
+    select_sites = tko.tko_pb2.SelectSites(namespace=namespace, siteIdPatterns=site_id_patterns, templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns)
     window = tko.tko_pb2.Window(offset=offset, maxCount=max_count)
     return self.stub.listSites(tko.tko_pb2.ListSites(select=select_sites, window=window))
 
-  def purge_sites(self, site_id_patterns=None, template_id_patterns=None, metadata_patterns=None):
-    r = self.stub.purgeSites(tko.tko_pb2.SelectSites(siteIdPatterns=site_id_patterns, templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns))
+  def purge_sites(self, site_id_patterns=None, template_id_patterns=None, metadata_patterns=None, namespace=None):
+    r = self.stub.purgeSites(tko.tko_pb2.SelectSites(namespace=namespace, siteIdPatterns=site_id_patterns, templateIdPatterns=template_id_patterns, metadataPatterns=metadata_patterns))
+    if not r.deleted:
+      raise Exception(r.notDeletedReason)
+
+  def list_deleted_deployments(self, site_id, namespace=None):
+    return self.stub.listDeletedDeployments(tko.tko_pb2.GetSite(namespace=namespace, siteId=site_id))
+
+  def acknowledge_deleted_deployments(self, site_id, deployment_ids, namespace=None):
+    r = self.stub.acknowledgeDeletedDeployments(tko.tko_pb2.AcknowledgeDeletedDeployments(namespace=namespace, siteId=site_id, deploymentIds=deployment_ids))
     if not r.deleted:
       raise Exception(r.notDeletedReason)
 
   # Deployments
 
-  def create_deployment(self, template_id, parent_deployment_id=None, site_id=None, merge_metadata=None, prepared=False, approved=False, merge_package_format=DEFAULT_PACKAGE_FORMAT, merge_package=None):
+  def create_deployment(self, template_id, parent_deployment_id=None, site_id=None, merge_metadata=None, prepared=False, approved=False, merge_package_format=DEFAULT_PACKAGE_FORMAT, merge_package=None, namespace=None):
     if merge_package is not None:
       merge_package = tko.encoding.encode_package(merge_package, format=merge_package_format)
-    r = self.stub.createDeployment(tko.tko_pb2.CreateDeployment(templateId=template_id, parentDeploymentId=parent_deployment_id, siteId=site_id, mergeMetadata=merge_metadata, prepared=prepared, approved=approved, mergePackageFormat=merge_package_format, mergePackage=merge_package))
+    r = self.stub.createDeployment(tko.tko_pb2.CreateDeployment(namespace=namespace, templateId=template_id, parentDeploymentId=parent_deployment_id, siteId=site_id, mergeMetadata=merge_metadata, prepared=prepared, approved=approved, mergePackageFormat=merge_package_format, mergePackage=merge_package))
     if r.created:
       return r.deploymentId
     else:
       raise Exception(r.notCreatedReason)
 
-  def get_deployment(self, deployment_id):
-    return self.stub.getDeployment(tko.tko_pb2.GetDeployment(deploymentId=deployment_id))
+  def get_deployment(self, deployment_id, namespace=None, changes_only=False):
+    return self.stub.getDeployment(tko.tko_pb2.GetDeployment(namespace=namespace, deploymentId=deployment_id, changesOnly=changes_only))
 
-  def delete_deployment(self, deployment_id):
-    r = self.stub.deleteDeployment(tko.tko_pb2.DeploymentID(deploymentId=deployment_id))
+  def delete_deployment(self, deployment_id, namespace=None):
+    r = self.stub.deleteDeployment(tko.tko_pb2.DeploymentID(namespace=namespace, deploymentId=deployment_id))
     if not r.deleted:
       raise Exception(r.notDeletedReason)
 
   # "prepared" and "approved" can be True, False, or None (the default), which means either value.
-  def list_deployments(self, parent_deployment_id=None, template_id_patterns=None, template_metadata_patterns=None, site_id_patterns=None, site_metadata_patterns=None, metadata_patterns=None, prepared=None, approved=None, offset=0, max_count=DEFAULT_MAX_COUNT):
-    select_deployments = tko.tko_pb2.SelectDeployments(parentDeploymentId=parent_deployment_id, templateIdPatterns=template_id_patterns, templateMetadataPatterns=template_metadata_patterns, siteIdPatterns=site_id_patterns, siteMetadataPatterns=site_metadata_patterns, metadataPatterns=metadata_patterns, prepared=prepared, approved=approved)
+  def list_deployments(self, parent_deployment_id=None, template_id_patterns=None, template_metadata_patterns=None, site_id_patterns=None, site_metadata_patterns=None, metadata_patterns=None, prepared=None, approved=None, offset=0, max_count=DEFAULT_MAX_COUNT, namespace=None):
+    select_deployments = tko.tko_pb2.SelectDeployments(namespace=namespace, parentDeploymentId=parent_deployment_id, templateIdPatterns=template_id_patterns, templateMetadataPatterns=template_metadata_patterns, siteIdPatterns=site_id_patterns, siteMetadataPatterns=site_metadata_patterns, metadataPatterns=metadata_patterns, prepared=prepared, approved=approved)
     window = tko.tko_pb2.Window(offset=offset, maxCount=max_count)
     return self.stub.listDeployments(tko.tko_pb2.ListDeployments(select=select_deployments, window=window))
 
   # "prepared" and "approved" can be True, False, or None (the default), which means either value.
-  def purge_deployments(self, parent_deployment_id=None, template_id_patterns=None, template_metadata_patterns=None, site_id_patterns=None, site_metadata_patterns=None, metadata_patterns=None, prepared=None, approved=None):
-    r = self.stub.purgeDeployments(tko.tko_pb2.SelectDeployments(parentDeploymentId=parent_deployment_id, templateIdPatterns=template_id_patterns, templateMetadataPatterns=template_metadata_patterns, siteIdPatterns=site_id_patterns, siteMetadataPatterns=site_metadata_patterns, metadataPatterns=metadata_patterns, prepared=prepared, approved=approved))
+  def purge_deployments(self, parent_deployment_id=None, template_id_patterns=None, template_metadata_patterns=None, site_id_patterns=None, site_metadata_patterns=None, metadata_patterns=None, prepared=None, approved=None, namespace=None):
+    r = self.stub.purgeDeployments(tko.tko_pb2.SelectDeployments(namespace=namespace, parentDeploymentId=parent_deployment_id, templateIdPatterns=template_id_patterns, templateMetadataPatterns=template_metadata_patterns, siteIdPatterns=site_id_patterns, siteMetadataPatterns=site_metadata_patterns, metadataPatterns=metadata_patterns, prepared=prepared, approved=approved))
     if not r.deleted:
       raise Exception(r.notDeletedReason)
 
-  def start_deployment_modification(self, deployment_id):
-    r = self.stub.startDeploymentModification(tko.tko_pb2.StartDeploymentModification(deploymentId=deployment_id))
+  def start_deployment_modification(self, deployment_id, namespace=None):
+    r = self.stub.startDeploymentModification(tko.tko_pb2.StartDeploymentModification(namespace=namespace, deploymentId=deployment_id))
     if r.started:
       return r.modificationToken, r.get_package()
     else:
@@ -154,12 +175,38 @@ class Client:
       raise Exception(r.notDeletedReason)
 
 
+class ClientCallDetails(collections.namedtuple('ClientCallDetails', ('method', 'timeout', 'metadata', 'credentials', 'wait_for_ready', 'compression')), grpc.ClientCallDetails):
+  pass
+
+
+class MetadataInterceptor(grpc.UnaryUnaryClientInterceptor, grpc.UnaryStreamClientInterceptor):
+  def __init__(self, metadata):
+    self.metadata = list(metadata.items())
+
+  def intercept_unary_unary(self, continuation, client_call_details, request):
+    return continuation(self.with_metadata(client_call_details), request)
+
+  def intercept_unary_stream(self, continuation, client_call_details, request):
+    return continuation(self.with_metadata(client_call_details), request)
+
+  def with_metadata(self, client_call_details):
+    metadata = list(client_call_details.metadata or ())
+    metadata.extend(self.metadata)
+    return ClientCallDetails(client_call_details.method, client_call_details.timeout, metadata, client_call_details.credentials, client_call_details.wait_for_ready, client_call_details.compression)
+
+
+class TokenInterceptor(MetadataInterceptor):
+  def __init__(self, token):
+    super().__init__({'authorization': f'Bearer {token}'})
+
+
 def decode_package(self):
   return tko.encoding.decode_package(self.package, self.packageFormat)
 
 
 def listed_site_to_ard(self):
   return {
+      'namespace': self.namespace,
       'site_id': self.siteId,
       'template_id': self.templateId,
       'metadata': dict(self.metadata),
@@ -176,6 +223,7 @@ def site_to_ard(self):
 
 def listed_deployment_to_ard(self):
   return {
+      'namespace': self.namespace,
       'deployment_id': self.deploymentId,
       'parent_deployment_id': self.parentDeploymentId,
       'template_id': self.templateId,
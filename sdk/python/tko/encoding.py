@@ -52,7 +52,7 @@ def decode_package(package, format):
     return cbor2.loads(package)
 
   elif format == 'yaml':
-    return yaml.load(package)
+    return list(yaml.load_all(package))
 
   else:
     raise Exception(f'unsupported package format: {format}')
@@ -5,6 +5,7 @@ input = None
 output = {'package': [], 'error': ''}
 log_file = None
 log_socket = None
+log_queue = None # used by the plugin server
 log_socket_timeout = 5 # seconds
 
 
@@ -13,6 +14,11 @@ def get_output_package():
   return tko.package.Package(output.get('package', []))
 
 
+def get_namespace():
+  global input
+  return input.get('namespace', '') or 'default'
+
+
 def get_target_resource_identifier():
   global input
   target_resource_identifier = input.get('targetResourceIdentifier', {})
@@ -35,6 +41,18 @@ def get_deployments():
     yield tko.package.Package(deployment)
 
 
+def get_deleted_deployments():
+  global input
+  deleted_deployments = input.get('deletedDeployments', {})
+  for deployment_id, deployment in deleted_deployments.items():
+    yield deployment_id, tko.package.Package(deployment)
+
+
+def acknowledge_deleted_deployment(deployment_id):
+  global output
+  output.setdefault('acknowledgedDeletedDeployments', []).append(deployment_id)
+
+
 def get_grpc_host():
   global input
   grpc_ = input.get('grpc', {})
@@ -47,6 +65,29 @@ def get_grpc_host():
     return f'{address}:{port}' # ipv4
 
 
+def get_grpc_token():
+  global input
+  return input.get('grpc', {}).get('token', None)
+
+
+# Only provided by the plugin server (for command plugins use sys.argv)
+def get_arguments():
+  global input
+  return input.get('arguments', None) or []
+
+
+# Only provided by the plugin server
+def get_properties():
+  global input
+  return input.get('properties', None) or {}
+
+
+# W3C trace context (e.g. "traceparent") to be sent as gRPC metadata in order to continue the trace
+def get_trace():
+  global input
+  return input.get('trace', None) or {}
+
+
 def execute(*args, env=None, input=None):
   env_ = ''.join(f'{k}={v} ' for k, v in env.items()) if env else ''
   args_ = " ".join(args)
@@ -66,9 +107,12 @@ def execute(*args, env=None, input=None):
   return complete.stdout.decode()
 
 
-def log(message):
-  global log_file, log_socket
-  if log_file:
+# The level is only used by the plugin server
+def log(message, level='info'):
+  global log_file, log_socket, log_queue
+  if log_queue is not None:
+    log_queue.put((level, str(message)))
+  elif log_file:
     log_file.write(str(message)+'\n')
   elif log_socket:
     log_socket.sendall((str(message)+'\n').encode())
@@ -0,0 +1,84 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: tko/plugin.proto
+# Protobuf Python Version: 5.27.2
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    27,
+    2,
+    '',
+    'tko/plugin.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10tko/plugin.proto\x12\ntko.plugin\x1a\x1bgoogle/protobuf/empty.proto\"I\n\x12PluginCapabilities\x12\r\n\x05types\x18\x01 \x03(\t\x12\r\n\x05names\x18\x02 \x03(\t\x12\x15\n\rpackageFormat\x18\x03 \x01(\t\"R\n\nPluginData\x12\x16\n\x0elevel2protocol\x18\x01 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\x05\x12\r\n\x05token\x18\x04 \x01(\t\"V\n\x18PluginResourceIdentifier\x12\r\n\x05group\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04kind\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\"+\n\tPluginLog\x12\r\n\x05level\x18\x01 \x01(\t\x12\x0f\n\x07message\x18\x02 \x01(\t\"\xce\x02\n\x0fValidateRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\targuments\x18\x02 \x03(\t\x12?\n\nproperties\x18\x03 \x03(\x0b\x32+.tko.plugin.ValidateRequest.PropertiesEntry\x12$\n\x04\x64\x61ta\x18\x04 \x01(\x0b\x32\x16.tko.plugin.PluginData\x12\x15\n\rpackageFormat\x18\x05 \x01(\t\x12\x0f\n\x07package\x18\x06 \x01(\x0c\x12\x46\n\x18targetResourceIdentifier\x18\x07 \x01(\x0b\x32$.tko.plugin.PluginResourceIdentifier\x12\x10\n\x08\x63omplete\x18\x08 \x01(\x08\x1a\x31\n\x0fPropertiesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x1f\n\x0eValidateResult\x12\r\n\x05\x65rror\x18\x01 \x01(\t\"r\n\x10ValidateResponse\x12$\n\x03log\x18\x01 \x01(\x0b\x32\x15.tko.plugin.PluginLogH\x00\x12,\n\x06result\x18\x02 \x01(\x0b\x32\x1a.tko.plugin.ValidateResultH\x00\x42\n\n\x08response\"\xed\x02\n\x0ePrepareRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\targuments\x18\x02 \x03(\t\x12>\n\nproperties\x18\x03 \x03(\x0b\x32*.tko.plugin.PrepareRequest.PropertiesEntry\x12$\n\x04\x64\x61ta\x18\x04 \x01(\x0b\x32\x16.tko.plugin.PluginData\x12\x11\n\tnamespace\x18\x05 \x01(\t\x12\x14\n\x0c\x64\x65ploymentId\x18\x06 \x01(\t\x12\x15\n\rpackageFormat\x18\x07 \x01(\t\x12\x19\n\x11\x64\x65ploymentPackage\x18\x08 \x01(\x0c\x12\x46\n\x18targetResourceIdentifier\x18\t \x01(\x0b\x32$.tko.plugin.PluginResourceIdentifier\x1a\x31\n\x0fPropertiesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"X\n\rPrepareResult\x12\x10\n\x08prepared\x18\x01 \x01(\x08\x12\x15\n\rpackageFormat\x18\x02 \x01(\t\x12\x0f\n\x07package\x18\x03 \x01(\x0c\x12\r\n\x05\x65rror\x18\x04 \x01(\t\"p\n\x0fPrepareResponse\x12$\n\x03log\x18\x01 \x01(\x0b\x32\x15.tko.plugin.PluginLogH\x00\x12+\n\x06result\x18\x02 \x01(\x0b\x32\x19.tko.plugin.PrepareResultH\x00\x42\n\n\x08response\"\xe6\x04\n\x0fScheduleRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\targuments\x18\x02 \x03(\t\x12?\n\nproperties\x18\x03 \x03(\x0b\x32+.tko.plugin.ScheduleRequest.PropertiesEntry\x12$\n\x04\x64\x61ta\x18\x04 \x01(\x0b\x32\x16.tko.plugin.PluginData\x12\x11\n\tnamespace\x18\x05 \x01(\t\x12\x0e\n\x06siteId\x18\x06 \x01(\t\x12\x15\n\rpackageFormat\x18\x07 \x01(\t\x12\x13\n\x0bsitePackage\x18\x08 \x01(\x0c\x12\x46\n\x18targetResourceIdentifier\x18\t \x01(\x0b\x32$.tko.plugin.PluginResourceIdentifier\x12\x41\n\x0b\x64\x65ployments\x18\n \x03(\x0b\x32,.tko.plugin.ScheduleRequest.DeploymentsEntry\x12O\n\x12\x64\x65letedDeployments\x18\x0b \x03(\x0b\x32\x33.tko.plugin.ScheduleRequest.DeletedDeploymentsEntry\x1a\x31\n\x0fPropertiesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a\x32\n\x10\x44\x65ploymentsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c:\x02\x38\x01\x1a\x39\n\x17\x44\x65letedDeploymentsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x0c:\x02\x38\x01\"G\n\x0eScheduleResult\x12&\n\x1e\x61\x63knowledgedDeletedDeployments\x18\x01 \x03(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"r\n\x10ScheduleResponse\x12$\n\x03log\x18\x01 \x01(\x0b\x32\x15.tko.plugin.PluginLogH\x00\x12,\n\x06result\x18\x02 \x01(\x0b\x32\x1a.tko.plugin.ScheduleResultH\x00\x42\n\n\x08response2\xa8\x02\n\x06Plugin\x12\x46\n\x0c\x63\x61pabilities\x12\x16.google.protobuf.Empty\x1a\x1e.tko.plugin.PluginCapabilities\x12G\n\x08validate\x12\x1b.tko.plugin.ValidateRequest\x1a\x1c.tko.plugin.ValidateResponse0\x01\x12\x44\n\x07prepare\x12\x1a.tko.plugin.PrepareRequest\x1a\x1b.tko.plugin.PrepareResponse0\x01\x12G\n\x08schedule\x12\x1b.tko.plugin.ScheduleRequest\x1a\x1c.tko.plugin.ScheduleResponse0\x01\x42-Z+github.com/nephio-experimental/tko/api/grpcb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'tko.plugin_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'Z+github.com/nephio-experimental/tko/api/grpc'
+  _globals['_VALIDATEREQUEST_PROPERTIESENTRY']._loaded_options = None
+  _globals['_VALIDATEREQUEST_PROPERTIESENTRY']._serialized_options = b'8\001'
+  _globals['_PREPAREREQUEST_PROPERTIESENTRY']._loaded_options = None
+  _globals['_PREPAREREQUEST_PROPERTIESENTRY']._serialized_options = b'8\001'
+  _globals['_SCHEDULEREQUEST_PROPERTIESENTRY']._loaded_options = None
+  _globals['_SCHEDULEREQUEST_PROPERTIESENTRY']._serialized_options = b'8\001'
+  _globals['_SCHEDULEREQUEST_DEPLOYMENTSENTRY']._loaded_options = None
+  _globals['_SCHEDULEREQUEST_DEPLOYMENTSENTRY']._serialized_options = b'8\001'
+  _globals['_SCHEDULEREQUEST_DELETEDDEPLOYMENTSENTRY']._loaded_options = None
+  _globals['_SCHEDULEREQUEST_DELETEDDEPLOYMENTSENTRY']._serialized_options = b'8\001'
+  _globals['_PLUGINCAPABILITIES']._serialized_start=61
+  _globals['_PLUGINCAPABILITIES']._serialized_end=134
+  _globals['_PLUGINDATA']._serialized_start=136
+  _globals['_PLUGINDATA']._serialized_end=218
+  _globals['_PLUGINRESOURCEIDENTIFIER']._serialized_start=220
+  _globals['_PLUGINRESOURCEIDENTIFIER']._serialized_end=306
+  _globals['_PLUGINLOG']._serialized_start=308
+  _globals['_PLUGINLOG']._serialized_end=351
+  _globals['_VALIDATEREQUEST']._serialized_start=354
+  _globals['_VALIDATEREQUEST']._serialized_end=688
+  _globals['_VALIDATEREQUEST_PROPERTIESENTRY']._serialized_start=639
+  _globals['_VALIDATEREQUEST_PROPERTIESENTRY']._serialized_end=688
+  _globals['_VALIDATERESULT']._serialized_start=690
+  _globals['_VALIDATERESULT']._serialized_end=721
+  _globals['_VALIDATERESPONSE']._serialized_start=723
+  _globals['_VALIDATERESPONSE']._serialized_end=837
+  _globals['_PREPAREREQUEST']._serialized_start=840
+  _globals['_PREPAREREQUEST']._serialized_end=1205
+  _globals['_PREPAREREQUEST_PROPERTIESENTRY']._serialized_start=639
+  _globals['_PREPAREREQUEST_PROPERTIESENTRY']._serialized_end=688
+  _globals['_PREPARERESULT']._serialized_start=1207
+  _globals['_PREPARERESULT']._serialized_end=1295
+  _globals['_PREPARERESPONSE']._serialized_start=1297
+  _globals['_PREPARERESPONSE']._serialized_end=1409
+  _globals['_SCHEDULEREQUEST']._serialized_start=1412
+  _globals['_SCHEDULEREQUEST']._serialized_end=2026
+  _globals['_SCHEDULEREQUEST_PROPERTIESENTRY']._serialized_start=639
+  _globals['_SCHEDULEREQUEST_PROPERTIESENTRY']._serialized_end=688
+  _globals['_SCHEDULEREQUEST_DEPLOYMENTSENTRY']._serialized_start=1917
+  _globals['_SCHEDULEREQUEST_DEPLOYMENTSENTRY']._serialized_end=1967
+  _globals['_SCHEDULEREQUEST_DELETEDDEPLOYMENTSENTRY']._serialized_start=1969
+  _globals['_SCHEDULEREQUEST_DELETEDDEPLOYMENTSENTRY']._serialized_end=2026
+  _globals['_SCHEDULERESULT']._serialized_start=2028
+  _globals['_SCHEDULERESULT']._serialized_end=2099
+  _globals['_SCHEDULERESPONSE']._serialized_start=2101
+  _globals['_SCHEDULERESPONSE']._serialized_end=2215
+  _globals['_PLUGIN']._serialized_start=2218
+  _globals['_PLUGIN']._serialized_end=2514
+# @@protoc_insertion_point(module_scope)
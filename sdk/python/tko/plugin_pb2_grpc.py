@@ -0,0 +1,227 @@
+# Generated by the gRPC Python protocol compiler plugin. DO NOT EDIT!
+"""Client and server classes corresponding to protobuf-defined services."""
+import grpc
+import warnings
+
+from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
+from tko import plugin_pb2 as tko_dot_plugin__pb2
+
+GRPC_GENERATED_VERSION = '1.66.1'
+GRPC_VERSION = grpc.__version__
+_version_not_supported = False
+
+try:
+    from grpc._utilities import first_version_is_lower
+    _version_not_supported = first_version_is_lower(GRPC_VERSION, GRPC_GENERATED_VERSION)
+except ImportError:
+    _version_not_supported = True
+
+if _version_not_supported:
+    raise RuntimeError(
+        f'The grpc package installed is at version {GRPC_VERSION},'
+        + f' but the generated code in tko/plugin_pb2_grpc.py depends on'
+        + f' grpcio>={GRPC_GENERATED_VERSION}.'
+        + f' Please upgrade your grpc module to grpcio>={GRPC_GENERATED_VERSION}'
+        + f' or downgrade your generated code using grpcio-tools<={GRPC_VERSION}.'
+    )
+
+
+class PluginStub(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.capabilities = channel.unary_unary(
+                '/tko.plugin.Plugin/capabilities',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=tko_dot_plugin__pb2.PluginCapabilities.FromString,
+                _registered_method=True)
+        self.validate = channel.unary_stream(
+                '/tko.plugin.Plugin/validate',
+                request_serializer=tko_dot_plugin__pb2.ValidateRequest.SerializeToString,
+                response_deserializer=tko_dot_plugin__pb2.ValidateResponse.FromString,
+                _registered_method=True)
+        self.prepare = channel.unary_stream(
+                '/tko.plugin.Plugin/prepare',
+                request_serializer=tko_dot_plugin__pb2.PrepareRequest.SerializeToString,
+                response_deserializer=tko_dot_plugin__pb2.PrepareResponse.FromString,
+                _registered_method=True)
+        self.schedule = channel.unary_stream(
+                '/tko.plugin.Plugin/schedule',
+                request_serializer=tko_dot_plugin__pb2.ScheduleRequest.SerializeToString,
+                response_deserializer=tko_dot_plugin__pb2.ScheduleResponse.FromString,
+                _registered_method=True)
+
+
+class PluginServicer(object):
+    """Missing associated documentation comment in .proto file."""
+
+    def capabilities(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def validate(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def prepare(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def schedule(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_PluginServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'capabilities': grpc.unary_unary_rpc_method_handler(
+                    servicer.capabilities,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=tko_dot_plugin__pb2.PluginCapabilities.SerializeToString,
+            ),
+            'validate': grpc.unary_stream_rpc_method_handler(
+                    servicer.validate,
+                    request_deserializer=tko_dot_plugin__pb2.ValidateRequest.FromString,
+                    response_serializer=tko_dot_plugin__pb2.ValidateResponse.SerializeToString,
+            ),
+            'prepare': grpc.unary_stream_rpc_method_handler(
+                    servicer.prepare,
+                    request_deserializer=tko_dot_plugin__pb2.PrepareRequest.FromString,
+                    response_serializer=tko_dot_plugin__pb2.PrepareResponse.SerializeToString,
+            ),
+            'schedule': grpc.unary_stream_rpc_method_handler(
+                    servicer.schedule,
+                    request_deserializer=tko_dot_plugin__pb2.ScheduleRequest.FromString,
+                    response_serializer=tko_dot_plugin__pb2.ScheduleResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'tko.plugin.Plugin', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+    server.add_registered_method_handlers('tko.plugin.Plugin', rpc_method_handlers)
+
+
+ # This class is part of an EXPERIMENTAL API.
+class Plugin(object):
+    """Missing associated documentation comment in .proto file."""
+
+    @staticmethod
+    def capabilities(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tko.plugin.Plugin/capabilities',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            tko_dot_plugin__pb2.PluginCapabilities.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def validate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.plugin.Plugin/validate',
+            tko_dot_plugin__pb2.ValidateRequest.SerializeToString,
+            tko_dot_plugin__pb2.ValidateResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def prepare(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.plugin.Plugin/prepare',
+            tko_dot_plugin__pb2.PrepareRequest.SerializeToString,
+            tko_dot_plugin__pb2.PrepareResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def schedule(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.plugin.Plugin/schedule',
+            tko_dot_plugin__pb2.ScheduleRequest.SerializeToString,
+            tko_dot_plugin__pb2.ScheduleResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
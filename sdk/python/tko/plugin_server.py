@@ -0,0 +1,156 @@
+import threading, queue, traceback, copy, concurrent.futures, grpc, tko.plugin, tko.encoding, tko.plugin_pb2, tko.plugin_pb2_grpc
+
+
+DEFAULT_PLUGIN_SERVER_ADDRESS = '[::]:50060'
+DEFAULT_PLUGIN_SERVER_PACKAGE_FORMAT = 'yaml'
+DEFAULT_PLUGIN_SERVER_MAX_WORKERS = 10
+TRACE_METADATA_KEYS = ('traceparent', 'tracestate', 'baggage')
+
+
+# Serves plugins for the "grpc" executor. Each argument is a dict of plugin names to the same
+# functions that are used with tko.validate(), tko.prepare(), and tko.schedule(), so that the
+# plugin code does not have to change. Note that because the plugin API uses global state, plugin
+# functions are executed one at a time.
+def serve_plugins(validators=None, preparers=None, schedulers=None, address=DEFAULT_PLUGIN_SERVER_ADDRESS, package_format=DEFAULT_PLUGIN_SERVER_PACKAGE_FORMAT, max_workers=DEFAULT_PLUGIN_SERVER_MAX_WORKERS):
+  server = grpc.server(concurrent.futures.ThreadPoolExecutor(max_workers=max_workers))
+  tko.plugin_pb2_grpc.add_PluginServicer_to_server(PluginServicer(validators, preparers, schedulers, package_format), server)
+  server.add_insecure_port(address)
+  server.start()
+  server.wait_for_termination()
+
+
+class PluginServicer(tko.plugin_pb2_grpc.PluginServicer):
+  def __init__(self, validators=None, preparers=None, schedulers=None, package_format=DEFAULT_PLUGIN_SERVER_PACKAGE_FORMAT):
+    self.validators = validators or {}
+    self.preparers = preparers or {}
+    self.schedulers = schedulers or {}
+    self.package_format = package_format
+    self.lock = threading.Lock()
+
+  def capabilities(self, request, context):
+    types = []
+    names = set()
+    for type_, plugins in (('validate', self.validators), ('prepare', self.preparers), ('schedule', self.schedulers)):
+      if plugins:
+        types.append(type_)
+        names.update(plugins.keys())
+    return tko.plugin_pb2.PluginCapabilities(types=types, names=sorted(names), packageFormat=self.package_format)
+
+  def validate(self, request, context):
+    f = get_plugin(self.validators, request.name, context)
+    input = new_input(request, context)
+    input['package'] = tko.encoding.decode_package(request.package, request.packageFormat)
+    input['complete'] = request.complete
+
+    def validate():
+      tko.plugin.output['package'] = input['package']
+      f(request.complete)
+
+    def to_result(output):
+      return tko.plugin_pb2.ValidateResult(error=output.get('error', ''))
+
+    yield from self.execute(input, validate, tko.plugin_pb2.ValidateResponse, to_result)
+
+  def prepare(self, request, context):
+    f = get_plugin(self.preparers, request.name, context)
+    input = new_input(request, context)
+    input['namespace'] = request.namespace
+    input['deploymentId'] = request.deploymentId
+    input['deploymentPackage'] = tko.encoding.decode_package(request.deploymentPackage, request.packageFormat)
+
+    def prepare():
+      tko.plugin.output['prepared'] = False
+      tko.plugin.output['package'] = copy.deepcopy(input['deploymentPackage'])
+      if f():
+        tko.plugin.output['prepared'] = True
+
+    def to_result(output):
+      error = output.get('error', '')
+      if error:
+        return tko.plugin_pb2.PrepareResult(error=error)
+      package = tko.encoding.encode_package(output.get('package', []), self.package_format)
+      return tko.plugin_pb2.PrepareResult(prepared=output.get('prepared', False), packageFormat=self.package_format, package=package)
+
+    yield from self.execute(input, prepare, tko.plugin_pb2.PrepareResponse, to_result)
+
+  def schedule(self, request, context):
+    f = get_plugin(self.schedulers, request.name, context)
+    input = new_input(request, context)
+    input['namespace'] = request.namespace
+    input['siteId'] = request.siteId
+    input['sitePackage'] = tko.encoding.decode_package(request.sitePackage, request.packageFormat)
+    input['deployments'] = {id: tko.encoding.decode_package(package, request.packageFormat) for id, package in request.deployments.items()}
+    input['deletedDeployments'] = {id: tko.encoding.decode_package(package, request.packageFormat) for id, package in request.deletedDeployments.items()}
+
+    def schedule():
+      tko.plugin.output['package'] = input['sitePackage']
+      f()
+
+    def to_result(output):
+      return tko.plugin_pb2.ScheduleResult(acknowledgedDeletedDeployments=output.get('acknowledgedDeletedDeployments', []), error=output.get('error', ''))
+
+    yield from self.execute(input, schedule, tko.plugin_pb2.ScheduleResponse, to_result)
+
+  # Runs the plugin function in a thread while streaming its logs, followed by the result
+  def execute(self, input, f, response_type, to_result):
+    log_queue = queue.Queue()
+    done = object()
+    outputs = []
+
+    def run():
+      with self.lock:
+        tko.plugin.input = input
+        tko.plugin.output = {'package': [], 'error': ''}
+        tko.plugin.log_queue = log_queue
+        try:
+          f()
+        except:
+          tko.plugin.output['error'] = traceback.format_exc()
+        finally:
+          tko.plugin.log_queue = None
+          outputs.append(tko.plugin.output)
+          log_queue.put(done)
+
+    threading.Thread(target=run, daemon=True).start()
+
+    while True:
+      item = log_queue.get()
+      if item is done:
+        break
+      level, message = item
+      yield response_type(log=tko.plugin_pb2.PluginLog(level=level, message=message))
+
+    yield response_type(result=to_result(outputs[0]))
+
+
+def get_plugin(plugins, name, context):
+  f = plugins.get(name)
+  if f is None:
+    context.abort(grpc.StatusCode.NOT_FOUND, f'plugin not found: {name}')
+  return f
+
+
+# Same structure as the input of command plugins
+def new_input(request, context):
+  trace = {}
+  for key, value in context.invocation_metadata():
+    if key in TRACE_METADATA_KEYS:
+      trace[key] = value
+
+  return {
+    'grpc': {
+      'level2protocol': request.data.level2protocol,
+      'address': request.data.address,
+      'port': request.data.port,
+      'token': request.data.token or None,
+    },
+    'trace': trace,
+    'arguments': list(request.arguments),
+    'properties': dict(request.properties),
+    'targetResourceIdentifier': {
+      'group': request.targetResourceIdentifier.group,
+      'version': request.targetResourceIdentifier.version,
+      'kind': request.targetResourceIdentifier.kind,
+      'name': request.targetResourceIdentifier.name,
+    },
+  }
@@ -26,7 +26,7 @@ from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rtko/tko.proto\x12\x03tko\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9b\x01\n\rAboutResponse\x12\x14\n\x0cinstanceName\x18\x01 \x01(\t\x12\x1b\n\x13instanceDescription\x18\x02 \x01(\t\x12\x12\n\ntkoVersion\x18\x03 \x01(\t\x12\x0f\n\x07\x62\x61\x63kend\x18\x04 \x01(\t\x12\x14\n\x0c\x61\x64\x64ressPorts\x18\x05 \x03(\t\x12\x1c\n\x14\x64\x65\x66\x61ultPackageFormat\x18\x06 \x01(\t\"C\n\x10RegisterResponse\x12\x12\n\nregistered\x18\x01 \x01(\x08\x12\x1b\n\x13notRegisteredReason\x18\x02 \x01(\t\";\n\x0e\x44\x65leteResponse\x12\x0f\n\x07\x64\x65leted\x18\x01 \x01(\x08\x12\x18\n\x10notDeletedReason\x18\x02 \x01(\t\"*\n\x06Window\x12\x0e\n\x06offset\x18\x01 \x01(\r\x12\x10\n\x08maxCount\x18\x02 \x01(\x05\" \n\nTemplateID\x12\x12\n\ntemplateId\x18\x01 \x01(\t\"\xea\x01\n\x08Template\x12\x12\n\ntemplateId\x18\x01 \x01(\t\x12-\n\x08metadata\x18\x02 \x03(\x0b\x32\x1b.tko.Template.MetadataEntry\x12+\n\x07updated\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rpackageFormat\x18\x04 \x01(\t\x12\x0f\n\x07package\x18\x05 \x01(\x0c\x12\x15\n\rdeploymentIds\x18\x06 \x03(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xce\x01\n\x0eListedTemplate\x12\x12\n\ntemplateId\x18\x01 \x01(\t\x12\x33\n\x08metadata\x18\x02 \x03(\x0b\x32!.tko.ListedTemplate.MetadataEntry\x12+\n\x07updated\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rdeploymentIds\x18\x04 \x03(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"A\n\x0bGetTemplate\x12\x12\n\ntemplateId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\"\xac\x01\n\x0fSelectTemplates\x12\x1a\n\x12templateIdPatterns\x18\x03 \x03(\t\x12\x44\n\x10metadataPatterns\x18\x04 \x03(\x0b\x32*.tko.SelectTemplates.MetadataPatternsEntry\x1a\x37\n\x15MetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"R\n\rListTemplates\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12$\n\x06select\x18\x02 \x01(\x0b\x32\x14.tko.SelectTemplates\"\x18\n\x06SiteID\x12\x0e\n\x06siteId\x18\x01 \x01(\t\"\xf2\x01\n\x04Site\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x12\n\ntemplateId\x18\x02 \x01(\t\x12)\n\x08metadata\x18\x03 \x03(\x0b\x32\x17.tko.Site.MetadataEntry\x12+\n\x07updated\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rpackageFormat\x18\x05 \x01(\t\x12\x0f\n\x07package\x18\x06 \x01(\x0c\x12\x15\n\rdeploymentIds\x18\x07 \x03(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xd6\x01\n\nListedSite\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x12\n\ntemplateId\x18\x02 \x01(\t\x12/\n\x08metadata\x18\x03 \x03(\x0b\x32\x1d.tko.ListedSite.MetadataEntry\x12+\n\x07updated\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rdeploymentIds\x18\x05 \x03(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"9\n\x07GetSite\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\"\xbc\x01\n\x0bSelectSites\x12\x16\n\x0esiteIdPatterns\x18\x03 \x03(\t\x12\x1a\n\x12templateIdPatterns\x18\x04 \x03(\t\x12@\n\x10metadataPatterns\x18\x05 \x03(\x0b\x32&.tko.SelectSites.MetadataPatternsEntry\x1a\x37\n\x15MetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"J\n\tListSites\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12 \n\x06select\x18\x02 \x01(\x0b\x32\x10.tko.SelectSites\"$\n\x0c\x44\x65ploymentID\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\"\xea\x02\n\nDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1a\n\x12parentDeploymentId\x18\x02 \x01(\t\x12\x12\n\ntemplateId\x18\x03 \x01(\t\x12\x0e\n\x06siteId\x18\x04 \x01(\t\x12/\n\x08metadata\x18\x05 \x03(\x0b\x32\x1d.tko.Deployment.MetadataEntry\x12+\n\x07\x63reated\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07updated\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08prepared\x18\x08 \x01(\x08\x12\x10\n\x08\x61pproved\x18\t \x01(\x08\x12\x15\n\rpackageFormat\x18\n \x01(\t\x12\x0f\n\x07package\x18\x0b \x01(\x0c\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xce\x02\n\x10ListedDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1a\n\x12parentDeploymentId\x18\x02 \x01(\t\x12\x12\n\ntemplateId\x18\x03 \x01(\t\x12\x0e\n\x06siteId\x18\x04 \x01(\t\x12\x35\n\x08metadata\x18\x05 \x03(\x0b\x32#.tko.ListedDeployment.MetadataEntry\x12+\n\x07\x63reated\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07updated\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08prepared\x18\x08 \x01(\x08\x12\x10\n\x08\x61pproved\x18\t \x01(\x08\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x9f\x02\n\x10\x43reateDeployment\x12\x1a\n\x12parentDeploymentId\x18\x01 \x01(\t\x12\x12\n\ntemplateId\x18\x02 \x01(\t\x12\x0e\n\x06siteId\x18\x03 \x01(\t\x12?\n\rmergeMetadata\x18\x04 \x03(\x0b\x32(.tko.CreateDeployment.MergeMetadataEntry\x12\x10\n\x08prepared\x18\x05 \x01(\x08\x12\x10\n\x08\x61pproved\x18\x06 \x01(\x08\x12\x1a\n\x12mergePackageFormat\x18\x07 \x01(\t\x12\x14\n\x0cmergePackage\x18\x08 \x01(\x0c\x1a\x34\n\x12MergeMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"[\n\x18\x43reateDeploymentResponse\x12\x0f\n\x07\x63reated\x18\x01 \x01(\x08\x12\x18\n\x10notCreatedReason\x18\x02 \x01(\t\x12\x14\n\x0c\x64\x65ploymentId\x18\x03 \x01(\t\"E\n\rGetDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\"\xee\x04\n\x11SelectDeployments\x12\x1f\n\x12parentDeploymentId\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x46\n\x10metadataPatterns\x18\x04 \x03(\x0b\x32,.tko.SelectDeployments.MetadataPatternsEntry\x12\x1a\n\x12templateIdPatterns\x18\x05 \x03(\t\x12V\n\x18templateMetadataPatterns\x18\x06 \x03(\x0b\x32\x34.tko.SelectDeployments.TemplateMetadataPatternsEntry\x12\x16\n\x0esiteIdPatterns\x18\x07 \x03(\t\x12N\n\x14siteMetadataPatterns\x18\x08 \x03(\x0b\x32\x30.tko.SelectDeployments.SiteMetadataPatternsEntry\x12\x15\n\x08prepared\x18\t \x01(\x08H\x01\x88\x01\x01\x12\x15\n\x08\x61pproved\x18\n \x01(\x08H\x02\x88\x01\x01\x1a\x37\n\x15MetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a?\n\x1dTemplateMetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a;\n\x19SiteMetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x42\x15\n\x13_parentDeploymentIdB\x0b\n\t_preparedB\x0b\n\t_approved\"V\n\x0fListDeployments\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12&\n\x06select\x18\x02 \x01(\x0b\x32\x16.tko.SelectDeployments\"S\n\x1bStartDeploymentModification\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\"\x93\x01\n#StartDeploymentModificationResponse\x12\x0f\n\x07started\x18\x01 \x01(\x08\x12\x18\n\x10notStartedReason\x18\x02 \x01(\t\x12\x19\n\x11modificationToken\x18\x03 \x01(\t\x12\x15\n\rpackageFormat\x18\x04 \x01(\t\x12\x0f\n\x07package\x18\x05 \x01(\x0c\"^\n\x19\x45ndDeploymentModification\x12\x19\n\x11modificationToken\x18\x01 \x01(\t\x12\x15\n\rpackageFormat\x18\x02 \x01(\t\x12\x0f\n\x07package\x18\x03 \x01(\x0c\"f\n!EndDeploymentModificationResponse\x12\x10\n\x08modified\x18\x01 \x01(\x08\x12\x19\n\x11notModifiedReason\x18\x02 \x01(\t\x12\x14\n\x0c\x64\x65ploymentId\x18\x03 \x01(\t\"9\n\x1c\x43\x61ncelDeploymentModification\x12\x19\n\x11modificationToken\x18\x01 \x01(\t\"U\n$CancelDeploymentModificationResponse\x12\x11\n\tcancelled\x18\x01 \x01(\x08\x12\x1a\n\x12notCancelledReason\x18\x02 \x01(\t\"&\n\x08PluginID\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\"3\n\x03GVK\x12\r\n\x05group\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04kind\x18\x03 \x01(\t\"\xc9\x01\n\x06Plugin\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x10\n\x08\x65xecutor\x18\x03 \x01(\t\x12\x11\n\targuments\x18\x04 \x03(\t\x12/\n\nproperties\x18\x05 \x03(\x0b\x32\x1b.tko.Plugin.PropertiesEntry\x12\x1a\n\x08triggers\x18\x06 \x03(\x0b\x32\x08.tko.GVK\x1a\x31\n\x0fPropertiesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x91\x01\n\rSelectPlugins\x12\x11\n\x04type\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x14\n\x0cnamePatterns\x18\x04 \x03(\t\x12\x15\n\x08\x65xecutor\x18\x05 \x01(\tH\x01\x88\x01\x01\x12\x1e\n\x07trigger\x18\x06 \x01(\x0b\x32\x08.tko.GVKH\x02\x88\x01\x01\x42\x07\n\x05_typeB\x0b\n\t_executorB\n\n\x08_trigger\"N\n\x0bListPlugins\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12\"\n\x06select\x18\x02 \x01(\x0b\x32\x12.tko.SelectPlugins2\xba\x0b\n\x04\x44\x61ta\x12\x33\n\x05\x61\x62out\x12\x16.google.protobuf.Empty\x1a\x12.tko.AboutResponse\x12\x38\n\x10registerTemplate\x12\r.tko.Template\x1a\x15.tko.RegisterResponse\x12\x36\n\x0e\x64\x65leteTemplate\x12\x0f.tko.TemplateID\x1a\x13.tko.DeleteResponse\x12.\n\x0bgetTemplate\x12\x10.tko.GetTemplate\x1a\r.tko.Template\x12:\n\rlistTemplates\x12\x12.tko.ListTemplates\x1a\x13.tko.ListedTemplate0\x01\x12;\n\x0epurgeTemplates\x12\x14.tko.SelectTemplates\x1a\x13.tko.DeleteResponse\x12\x30\n\x0cregisterSite\x12\t.tko.Site\x1a\x15.tko.RegisterResponse\x12.\n\ndeleteSite\x12\x0b.tko.SiteID\x1a\x13.tko.DeleteResponse\x12\"\n\x07getSite\x12\x0c.tko.GetSite\x1a\t.tko.Site\x12.\n\tlistSites\x12\x0e.tko.ListSites\x1a\x0f.tko.ListedSite0\x01\x12\x33\n\npurgeSites\x12\x10.tko.SelectSites\x1a\x13.tko.DeleteResponse\x12H\n\x10\x63reateDeployment\x12\x15.tko.CreateDeployment\x1a\x1d.tko.CreateDeploymentResponse\x12:\n\x10\x64\x65leteDeployment\x12\x11.tko.DeploymentID\x1a\x13.tko.DeleteResponse\x12\x34\n\rgetDeployment\x12\x12.tko.GetDeployment\x1a\x0f.tko.Deployment\x12@\n\x0flistDeployments\x12\x14.tko.ListDeployments\x1a\x15.tko.ListedDeployment0\x01\x12?\n\x10purgeDeployments\x12\x16.tko.SelectDeployments\x1a\x13.tko.DeleteResponse\x12i\n\x1bstartDeploymentModification\x12 .tko.StartDeploymentModification\x1a(.tko.StartDeploymentModificationResponse\x12\x63\n\x19\x65ndDeploymentModification\x12\x1e.tko.EndDeploymentModification\x1a&.tko.EndDeploymentModificationResponse\x12l\n\x1c\x63\x61ncelDeploymentModification\x12!.tko.CancelDeploymentModification\x1a).tko.CancelDeploymentModificationResponse\x12\x34\n\x0eregisterPlugin\x12\x0b.tko.Plugin\x1a\x15.tko.RegisterResponse\x12\x32\n\x0c\x64\x65letePlugin\x12\r.tko.PluginID\x1a\x13.tko.DeleteResponse\x12\'\n\tgetPlugin\x12\r.tko.PluginID\x1a\x0b.tko.Plugin\x12.\n\x0blistPlugins\x12\x10.tko.ListPlugins\x1a\x0b.tko.Plugin0\x01\x12\x37\n\x0cpurgePlugins\x12\x12.tko.SelectPlugins\x1a\x13.tko.DeleteResponseB-Z+github.com/nephio-experimental/tko/api/grpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rtko/tko.proto\x12\x03tko\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9b\x01\n\rAboutResponse\x12\x14\n\x0cinstanceName\x18\x01 \x01(\t\x12\x1b\n\x13instanceDescription\x18\x02 \x01(\t\x12\x12\n\ntkoVersion\x18\x03 \x01(\t\x12\x0f\n\x07\x62\x61\x63kend\x18\x04 \x01(\t\x12\x14\n\x0c\x61\x64\x64ressPorts\x18\x05 \x03(\t\x12\x1c\n\x14\x64\x65\x66\x61ultPackageFormat\x18\x06 \x01(\t\"C\n\x10RegisterResponse\x12\x12\n\nregistered\x18\x01 \x01(\x08\x12\x1b\n\x13notRegisteredReason\x18\x02 \x01(\t\";\n\x0e\x44\x65leteResponse\x12\x0f\n\x07\x64\x65leted\x18\x01 \x01(\x08\x12\x18\n\x10notDeletedReason\x18\x02 \x01(\t\"*\n\x06Window\x12\x0e\n\x06offset\x18\x01 \x01(\r\x12\x10\n\x08maxCount\x18\x02 \x01(\x05\"3\n\nTemplateID\x12\x12\n\ntemplateId\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\"\x8e\x02\n\x08Template\x12\x12\n\ntemplateId\x18\x01 \x01(\t\x12-\n\x08metadata\x18\x02 \x03(\x0b\x32\x1b.tko.Template.MetadataEntry\x12+\n\x07updated\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rpackageFormat\x18\x04 \x01(\t\x12\x0f\n\x07package\x18\x05 \x01(\x0c\x12\x15\n\rdeploymentIds\x18\x06 \x03(\t\x12\x0f\n\x07version\x18\x07 \x01(\x04\x12\x11\n\tnamespace\x18\x08 \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"N\n\rTemplateChunk\x12!\n\x08template\x18\x01 \x01(\x0b\x32\r.tko.TemplateH\x00\x12\x11\n\x07package\x18\x02 \x01(\x0cH\x00\x42\x07\n\x05\x63hunk\"\xf2\x01\n\x0eListedTemplate\x12\x12\n\ntemplateId\x18\x01 \x01(\t\x12\x33\n\x08metadata\x18\x02 \x03(\x0b\x32!.tko.ListedTemplate.MetadataEntry\x12+\n\x07updated\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rdeploymentIds\x18\x04 \x03(\t\x12\x0f\n\x07version\x18\x05 \x01(\x04\x12\x11\n\tnamespace\x18\x06 \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"T\n\x0bGetTemplate\x12\x12\n\ntemplateId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\"\xf2\x01\n\x0fSelectTemplates\x12\x1a\n\x12templateIdPatterns\x18\x03 \x03(\t\x12\x44\n\x10metadataPatterns\x18\x04 \x03(\x0b\x32*.tko.SelectTemplates.MetadataPatternsEntry\x12\x11\n\tnamespace\x18\x05 \x01(\t\x12\x18\n\x10metadataSelector\x18\x06 \x01(\t\x12\x17\n\x0fpackageSelector\x18\x07 \x01(\t\x1a\x37\n\x15MetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"R\n\rListTemplates\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12$\n\x06select\x18\x02 \x01(\x0b\x32\x14.tko.SelectTemplates\"+\n\x06SiteID\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\"\x96\x02\n\x04Site\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x12\n\ntemplateId\x18\x02 \x01(\t\x12)\n\x08metadata\x18\x03 \x03(\x0b\x32\x17.tko.Site.MetadataEntry\x12+\n\x07updated\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rpackageFormat\x18\x05 \x01(\t\x12\x0f\n\x07package\x18\x06 \x01(\x0c\x12\x15\n\rdeploymentIds\x18\x07 \x03(\t\x12\x0f\n\x07version\x18\x08 \x01(\x04\x12\x11\n\tnamespace\x18\t \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"B\n\tSiteChunk\x12\x19\n\x04site\x18\x01 \x01(\x0b\x32\t.tko.SiteH\x00\x12\x11\n\x07package\x18\x02 \x01(\x0cH\x00\x42\x07\n\x05\x63hunk\"\xfa\x01\n\nListedSite\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x12\n\ntemplateId\x18\x02 \x01(\t\x12/\n\x08metadata\x18\x03 \x03(\x0b\x32\x1d.tko.ListedSite.MetadataEntry\x12+\n\x07updated\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rdeploymentIds\x18\x05 \x03(\t\x12\x0f\n\x07version\x18\x06 \x01(\x04\x12\x11\n\tnamespace\x18\x07 \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"L\n\x07GetSite\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\"\x82\x02\n\x0bSelectSites\x12\x16\n\x0esiteIdPatterns\x18\x03 \x03(\t\x12\x1a\n\x12templateIdPatterns\x18\x04 \x03(\t\x12@\n\x10metadataPatterns\x18\x05 \x03(\x0b\x32&.tko.SelectSites.MetadataPatternsEntry\x12\x11\n\tnamespace\x18\x06 \x01(\t\x12\x18\n\x10metadataSelector\x18\x07 \x01(\t\x12\x17\n\x0fpackageSelector\x18\x08 \x01(\t\x1a\x37\n\x15MetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa1\x01\n\x11\x44\x65letedDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x0e\n\x06siteId\x18\x02 \x01(\t\x12+\n\x07\x64\x65leted\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rpackageFormat\x18\x04 \x01(\t\x12\x0f\n\x07package\x18\x05 \x01(\x0c\x12\x11\n\tnamespace\x18\x06 \x01(\t\"Y\n\x1d\x41\x63knowledgeDeletedDeployments\x12\x0e\n\x06siteId\x18\x01 \x01(\t\x12\x15\n\rdeploymentIds\x18\x02 \x03(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\"J\n\tListSites\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12 \n\x06select\x18\x02 \x01(\x0b\x32\x10.tko.SelectSites\"L\n\x0c\x44\x65ploymentID\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x13\n\x0bpropagation\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\"\x8e\x03\n\nDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1a\n\x12parentDeploymentId\x18\x02 \x01(\t\x12\x12\n\ntemplateId\x18\x03 \x01(\t\x12\x0e\n\x06siteId\x18\x04 \x01(\t\x12/\n\x08metadata\x18\x05 \x03(\x0b\x32\x1d.tko.Deployment.MetadataEntry\x12+\n\x07\x63reated\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07updated\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08prepared\x18\x08 \x01(\x08\x12\x10\n\x08\x61pproved\x18\t \x01(\x08\x12\x15\n\rpackageFormat\x18\n \x01(\t\x12\x0f\n\x07package\x18\x0b \x01(\x0c\x12\x0f\n\x07version\x18\x0c \x01(\x04\x12\x11\n\tnamespace\x18\r \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"T\n\x0f\x44\x65ploymentChunk\x12%\n\ndeployment\x18\x01 \x01(\x0b\x32\x0f.tko.DeploymentH\x00\x12\x11\n\x07package\x18\x02 \x01(\x0cH\x00\x42\x07\n\x05\x63hunk\"\xf2\x02\n\x10ListedDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1a\n\x12parentDeploymentId\x18\x02 \x01(\t\x12\x12\n\ntemplateId\x18\x03 \x01(\t\x12\x0e\n\x06siteId\x18\x04 \x01(\t\x12\x35\n\x08metadata\x18\x05 \x03(\x0b\x32#.tko.ListedDeployment.MetadataEntry\x12+\n\x07\x63reated\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07updated\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08prepared\x18\x08 \x01(\x08\x12\x10\n\x08\x61pproved\x18\t \x01(\x08\x12\x0f\n\x07version\x18\n \x01(\x04\x12\x11\n\tnamespace\x18\x0b \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xb2\x02\n\x10\x43reateDeployment\x12\x1a\n\x12parentDeploymentId\x18\x01 \x01(\t\x12\x12\n\ntemplateId\x18\x02 \x01(\t\x12\x0e\n\x06siteId\x18\x03 \x01(\t\x12?\n\rmergeMetadata\x18\x04 \x03(\x0b\x32(.tko.CreateDeployment.MergeMetadataEntry\x12\x10\n\x08prepared\x18\x05 \x01(\x08\x12\x10\n\x08\x61pproved\x18\x06 \x01(\x08\x12\x1a\n\x12mergePackageFormat\x18\x07 \x01(\t\x12\x14\n\x0cmergePackage\x18\x08 \x01(\x0c\x12\x11\n\tnamespace\x18\t \x01(\t\x1a\x34\n\x12MergeMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"[\n\x18\x43reateDeploymentResponse\x12\x0f\n\x07\x63reated\x18\x01 \x01(\x08\x12\x18\n\x10notCreatedReason\x18\x02 \x01(\t\x12\x14\n\x0c\x64\x65ploymentId\x18\x03 \x01(\t\"m\n\rGetDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12\x13\n\x0b\x63hangesOnly\x18\x04 \x01(\x08\"\xc9\x05\n\x11SelectDeployments\x12\x1f\n\x12parentDeploymentId\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x46\n\x10metadataPatterns\x18\x04 \x03(\x0b\x32,.tko.SelectDeployments.MetadataPatternsEntry\x12\x1a\n\x12templateIdPatterns\x18\x05 \x03(\t\x12V\n\x18templateMetadataPatterns\x18\x06 \x03(\x0b\x32\x34.tko.SelectDeployments.TemplateMetadataPatternsEntry\x12\x16\n\x0esiteIdPatterns\x18\x07 \x03(\t\x12N\n\x14siteMetadataPatterns\x18\x08 \x03(\x0b\x32\x30.tko.SelectDeployments.SiteMetadataPatternsEntry\x12\x15\n\x08prepared\x18\t \x01(\x08H\x01\x88\x01\x01\x12\x15\n\x08\x61pproved\x18\n \x01(\x08H\x02\x88\x01\x01\x12\x13\n\x0bpropagation\x18\x0b \x01(\t\x12\x11\n\tnamespace\x18\x0c \x01(\t\x12\x18\n\x10metadataSelector\x18\r \x01(\t\x12\x17\n\x0fpackageSelector\x18\x0e \x01(\t\x1a\x37\n\x15MetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a?\n\x1dTemplateMetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1a;\n\x19SiteMetadataPatternsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x42\x15\n\x13_parentDeploymentIdB\x0b\n\t_preparedB\x0b\n\t_approved\"V\n\x0fListDeployments\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12&\n\x06select\x18\x02 \x01(\x0b\x32\x16.tko.SelectDeployments\"w\n\x1bStartDeploymentModification\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\x04\x12\x11\n\tnamespace\x18\x04 \x01(\t\"\x93\x01\n#StartDeploymentModificationResponse\x12\x0f\n\x07started\x18\x01 \x01(\x08\x12\x18\n\x10notStartedReason\x18\x02 \x01(\t\x12\x19\n\x11modificationToken\x18\x03 \x01(\t\x12\x15\n\rpackageFormat\x18\x04 \x01(\t\x12\x0f\n\x07package\x18\x05 \x01(\x0c\"^\n\x19\x45ndDeploymentModification\x12\x19\n\x11modificationToken\x18\x01 \x01(\t\x12\x15\n\rpackageFormat\x18\x02 \x01(\t\x12\x0f\n\x07package\x18\x03 \x01(\x0c\"t\n\x1e\x45ndDeploymentModificationChunk\x12\x36\n\x0cmodification\x18\x01 \x01(\x0b\x32\x1e.tko.EndDeploymentModificationH\x00\x12\x11\n\x07package\x18\x02 \x01(\x0cH\x00\x42\x07\n\x05\x63hunk\"f\n!EndDeploymentModificationResponse\x12\x10\n\x08modified\x18\x01 \x01(\x08\x12\x19\n\x11notModifiedReason\x18\x02 \x01(\t\x12\x14\n\x0c\x64\x65ploymentId\x18\x03 \x01(\t\"9\n\x1c\x43\x61ncelDeploymentModification\x12\x19\n\x11modificationToken\x18\x01 \x01(\t\"U\n$CancelDeploymentModificationResponse\x12\x11\n\tcancelled\x18\x01 \x01(\x08\x12\x1a\n\x12notCancelledReason\x18\x02 \x01(\t\"\xe9\x01\n\x11ModifyDeployments\x12&\n\x06select\x18\x01 \x01(\x0b\x32\x16.tko.SelectDeployments\x12\x15\n\x08\x61pproved\x18\x02 \x01(\x08H\x00\x88\x01\x01\x12<\n\x0bsetMetadata\x18\x03 \x03(\x0b\x32\'.tko.ModifyDeployments.SetMetadataEntry\x12\x16\n\x0e\x64\x65leteMetadata\x18\x04 \x03(\t\x1a\x32\n\x10SetMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x42\x0b\n\t_approved\"h\n\x12ModifiedDeployment\x12\x14\n\x0c\x64\x65ploymentId\x18\x01 \x01(\t\x12\x10\n\x08modified\x18\x02 \x01(\x08\x12\x0f\n\x07version\x18\x03 \x01(\x04\x12\x19\n\x11notModifiedReason\x18\x04 \x01(\t\"I\n\x19ModifyDeploymentsResponse\x12,\n\x0b\x64\x65ployments\x18\x01 \x03(\x0b\x32\x17.tko.ModifiedDeployment\"&\n\x08PluginID\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\"3\n\x03GVK\x12\r\n\x05group\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04kind\x18\x03 \x01(\t\"\xda\x01\n\x06Plugin\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x10\n\x08\x65xecutor\x18\x03 \x01(\t\x12\x11\n\targuments\x18\x04 \x03(\t\x12/\n\nproperties\x18\x05 \x03(\x0b\x32\x1b.tko.Plugin.PropertiesEntry\x12\x1a\n\x08triggers\x18\x06 \x03(\x0b\x32\x08.tko.GVK\x12\x0f\n\x07version\x18\x07 \x01(\x04\x1a\x31\n\x0fPropertiesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x91\x01\n\rSelectPlugins\x12\x11\n\x04type\x18\x03 \x01(\tH\x00\x88\x01\x01\x12\x14\n\x0cnamePatterns\x18\x04 \x03(\t\x12\x15\n\x08\x65xecutor\x18\x05 \x01(\tH\x01\x88\x01\x01\x12\x1e\n\x07trigger\x18\x06 \x01(\x0b\x32\x08.tko.GVKH\x02\x88\x01\x01\x42\x07\n\x05_typeB\x0b\n\t_executorB\n\n\x08_trigger\"N\n\x0bListPlugins\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12\"\n\x06select\x18\x02 \x01(\x0b\x32\x12.tko.SelectPlugins\"Q\n\nRevisionID\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x10\n\x08objectId\x18\x02 \x01(\t\x12\x10\n\x08revision\x18\x03 \x01(\x04\x12\x11\n\tnamespace\x18\x04 \x01(\t\"\xb6\x02\n\x08Revision\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x10\n\x08objectId\x18\x02 \x01(\t\x12\x10\n\x08revision\x18\x03 \x01(\x04\x12\x0e\n\x06\x61uthor\x18\x04 \x01(\t\x12+\n\x07\x63reated\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04hash\x18\x06 \x01(\t\x12\x12\n\ntemplateId\x18\x07 \x01(\t\x12-\n\x08metadata\x18\x08 \x03(\x0b\x32\x1b.tko.Revision.MetadataEntry\x12\x15\n\rpackageFormat\x18\t \x01(\t\x12\x0f\n\x07package\x18\n \x01(\x0c\x12\x11\n\tnamespace\x18\x0b \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x9a\x02\n\x0eListedRevision\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x10\n\x08objectId\x18\x02 \x01(\t\x12\x10\n\x08revision\x18\x03 \x01(\x04\x12\x0e\n\x06\x61uthor\x18\x04 \x01(\t\x12+\n\x07\x63reated\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04hash\x18\x06 \x01(\t\x12\x12\n\ntemplateId\x18\x07 \x01(\t\x12\x33\n\x08metadata\x18\x08 \x03(\x0b\x32!.tko.ListedRevision.MetadataEntry\x12\x11\n\tnamespace\x18\t \x01(\t\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"r\n\x0bGetRevision\x12\x0c\n\x04type\x18\x01 \x01(\t\x12\x10\n\x08objectId\x18\x02 \x01(\t\x12\x10\n\x08revision\x18\x03 \x01(\x04\x12\x1e\n\x16preferredPackageFormat\x18\x04 \x01(\t\x12\x11\n\tnamespace\x18\x05 \x01(\t\"_\n\rListRevisions\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x10\n\x08objectId\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\"=\n\x0eRevertResponse\x12\x10\n\x08reverted\x18\x01 \x01(\x08\x12\x19\n\x11notRevertedReason\x18\x02 \x01(\t\"\xa7\x01\n\x0fListAuditEvents\x12\x1b\n\x06window\x18\x01 \x01(\x0b\x32\x0b.tko.Window\x12\x11\n\tnamespace\x18\x02 \x01(\t\x12)\n\x05since\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12)\n\x05until\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63tors\x18\x05 \x03(\t\"\xfe\x01\n\nAuditEvent\x12\n\n\x02id\x18\x01 \x01(\x04\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05\x61\x63tor\x18\x03 \x01(\t\x12\x11\n\toperation\x18\x04 \x01(\t\x12\x0c\n\x04type\x18\x05 \x01(\t\x12\x11\n\tnamespace\x18\x06 \x01(\t\x12\x0b\n\x03ids\x18\x07 \x03(\t\x12\x10\n\x08selector\x18\x08 \x01(\t\x12\x0f\n\x07outcome\x18\t \x01(\t\x12\x0e\n\x06reason\x18\n \x01(\t\x12\x0c\n\x04\x64iff\x18\x0b \x01(\t\x12$\n\rclaimedAuthor\x18\x0c \x01(\tR\rclaimedAuthor\"B\n\rExportArchive\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\x1e\n\x16preferredPackageFormat\x18\x02 \x01(\t\"\x9c\x01\n\x0c\x41rchiveEntry\x12!\n\x08template\x18\x01 \x01(\x0b\x32\r.tko.TemplateH\x00\x12\x19\n\x04site\x18\x02 \x01(\x0b\x32\t.tko.SiteH\x00\x12\x1d\n\x06plugin\x18\x03 \x01(\x0b\x32\x0b.tko.PluginH\x00\x12%\n\ndeployment\x18\x04 \x01(\x0b\x32\x0f.tko.DeploymentH\x00\x42\x08\n\x06\x65ntity\"?\n\rImportArchive\x12\x0c\n\x04mode\x18\x01 \x01(\t\x12 \n\x05\x65ntry\x18\x02 \x01(\x0b\x32\x11.tko.ArchiveEntry\"\x8c\x01\n\x15ImportArchiveResponse\x12\x10\n\x08imported\x18\x01 \x01(\x08\x12\x19\n\x11notImportedReason\x18\x02 \x01(\t\x12\x11\n\ttemplates\x18\x03 \x01(\x04\x12\r\n\x05sites\x18\x04 \x01(\x04\x12\x0f\n\x07plugins\x18\x05 \x01(\x04\x12\x13\n\x0b\x64\x65ployments\x18\x06 \x01(\x04\"@\n\x05Watch\x12\r\n\x05kinds\x18\x01 \x03(\t\x12\x15\n\rsinceRevision\x18\x02 \x01(\x04\x12\x11\n\tnamespace\x18\x03 \x01(\t\"\x83\x01\n\x05\x45vent\x12\x10\n\x08revision\x18\x01 \x01(\x04\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04kind\x18\x03 \x01(\t\x12\n\n\x02id\x18\x04 \x01(\t\x12-\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tnamespace\x18\x06 \x01(\t2\xdc\x12\n\x04\x44\x61ta\x12\x33\n\x05\x61\x62out\x12\x16.google.protobuf.Empty\x1a\x12.tko.AboutResponse\x12\x38\n\x10registerTemplate\x12\r.tko.Template\x1a\x15.tko.RegisterResponse\x12\x46\n\x17registerTemplateChunked\x12\x12.tko.TemplateChunk\x1a\x15.tko.RegisterResponse(\x01\x12\x36\n\x0e\x64\x65leteTemplate\x12\x0f.tko.TemplateID\x1a\x13.tko.DeleteResponse\x12.\n\x0bgetTemplate\x12\x10.tko.GetTemplate\x1a\r.tko.Template\x12:\n\rlistTemplates\x12\x12.tko.ListTemplates\x1a\x13.tko.ListedTemplate0\x01\x12;\n\x0epurgeTemplates\x12\x14.tko.SelectTemplates\x1a\x13.tko.DeleteResponse\x12\x30\n\x0cregisterSite\x12\t.tko.Site\x1a\x15.tko.RegisterResponse\x12>\n\x13registerSiteChunked\x12\x0e.tko.SiteChunk\x1a\x15.tko.RegisterResponse(\x01\x12.\n\ndeleteSite\x12\x0b.tko.SiteID\x1a\x13.tko.DeleteResponse\x12\"\n\x07getSite\x12\x0c.tko.GetSite\x1a\t.tko.Site\x12.\n\tlistSites\x12\x0e.tko.ListSites\x1a\x0f.tko.ListedSite0\x01\x12\x33\n\npurgeSites\x12\x10.tko.SelectSites\x1a\x13.tko.DeleteResponse\x12@\n\x16listDeletedDeployments\x12\x0c.tko.GetSite\x1a\x16.tko.DeletedDeployment0\x01\x12X\n\x1d\x61\x63knowledgeDeletedDeployments\x12\".tko.AcknowledgeDeletedDeployments\x1a\x13.tko.DeleteResponse\x12H\n\x10\x63reateDeployment\x12\x15.tko.CreateDeployment\x1a\x1d.tko.CreateDeploymentResponse\x12:\n\x10\x64\x65leteDeployment\x12\x11.tko.DeploymentID\x1a\x13.tko.DeleteResponse\x12\x34\n\rgetDeployment\x12\x12.tko.GetDeployment\x1a\x0f.tko.Deployment\x12\x42\n\x14getDeploymentChunked\x12\x12.tko.GetDeployment\x1a\x14.tko.DeploymentChunk0\x01\x12@\n\x0flistDeployments\x12\x14.tko.ListDeployments\x1a\x15.tko.ListedDeployment0\x01\x12?\n\x10purgeDeployments\x12\x16.tko.SelectDeployments\x1a\x13.tko.DeleteResponse\x12i\n\x1bstartDeploymentModification\x12 .tko.StartDeploymentModification\x1a(.tko.StartDeploymentModificationResponse\x12\x63\n\x19\x65ndDeploymentModification\x12\x1e.tko.EndDeploymentModification\x1a&.tko.EndDeploymentModificationResponse\x12q\n endDeploymentModificationChunked\x12#.tko.EndDeploymentModificationChunk\x1a&.tko.EndDeploymentModificationResponse(\x01\x12l\n\x1c\x63\x61ncelDeploymentModification\x12!.tko.CancelDeploymentModification\x1a).tko.CancelDeploymentModificationResponse\x12K\n\x11modifyDeployments\x12\x16.tko.ModifyDeployments\x1a\x1e.tko.ModifyDeploymentsResponse\x12\x34\n\x0eregisterPlugin\x12\x0b.tko.Plugin\x1a\x15.tko.RegisterResponse\x12\x32\n\x0c\x64\x65letePlugin\x12\r.tko.PluginID\x1a\x13.tko.DeleteResponse\x12\'\n\tgetPlugin\x12\r.tko.PluginID\x1a\x0b.tko.Plugin\x12.\n\x0blistPlugins\x12\x10.tko.ListPlugins\x1a\x0b.tko.Plugin0\x01\x12\x37\n\x0cpurgePlugins\x12\x12.tko.SelectPlugins\x1a\x13.tko.DeleteResponse\x12:\n\rlistRevisions\x12\x12.tko.ListRevisions\x1a\x13.tko.ListedRevision0\x01\x12.\n\x0bgetRevision\x12\x10.tko.GetRevision\x1a\r.tko.Revision\x12\x30\n\x08revertTo\x12\x0f.tko.RevisionID\x1a\x13.tko.RevertResponse\x12:\n\x0flistAuditEvents\x12\x14.tko.ListAuditEvents\x1a\x0f.tko.AuditEvent0\x01\x12\x38\n\rexportArchive\x12\x12.tko.ExportArchive\x1a\x11.tko.ArchiveEntry0\x01\x12\x41\n\rimportArchive\x12\x12.tko.ImportArchive\x1a\x1a.tko.ImportArchiveResponse(\x01\x12!\n\x05watch\x12\n.tko.Watch\x1a\n.tko.Event0\x01\x42-Z+github.com/nephio-experimental/tko/api/grpcb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -58,8 +58,14 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_SELECTDEPLOYMENTS_TEMPLATEMETADATAPATTERNSENTRY']._serialized_options = b'8\001'
   _globals['_SELECTDEPLOYMENTS_SITEMETADATAPATTERNSENTRY']._loaded_options = None
   _globals['_SELECTDEPLOYMENTS_SITEMETADATAPATTERNSENTRY']._serialized_options = b'8\001'
+  _globals['_MODIFYDEPLOYMENTS_SETMETADATAENTRY']._loaded_options = None
+  _globals['_MODIFYDEPLOYMENTS_SETMETADATAENTRY']._serialized_options = b'8\001'
   _globals['_PLUGIN_PROPERTIESENTRY']._loaded_options = None
   _globals['_PLUGIN_PROPERTIESENTRY']._serialized_options = b'8\001'
+  _globals['_REVISION_METADATAENTRY']._loaded_options = None
+  _globals['_REVISION_METADATAENTRY']._serialized_options = b'8\001'
+  _globals['_LISTEDREVISION_METADATAENTRY']._loaded_options = None
+  _globals['_LISTEDREVISION_METADATAENTRY']._serialized_options = b'8\001'
   _globals['_ABOUTRESPONSE']._serialized_start=85
   _globals['_ABOUTRESPONSE']._serialized_end=240
   _globals['_REGISTERRESPONSE']._serialized_start=242
@@ -69,93 +75,145 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_WINDOW']._serialized_start=372
   _globals['_WINDOW']._serialized_end=414
   _globals['_TEMPLATEID']._serialized_start=416
-  _globals['_TEMPLATEID']._serialized_end=448
-  _globals['_TEMPLATE']._serialized_start=451
-  _globals['_TEMPLATE']._serialized_end=685
-  _globals['_TEMPLATE_METADATAENTRY']._serialized_start=638
-  _globals['_TEMPLATE_METADATAENTRY']._serialized_end=685
-  _globals['_LISTEDTEMPLATE']._serialized_start=688
-  _globals['_LISTEDTEMPLATE']._serialized_end=894
-  _globals['_LISTEDTEMPLATE_METADATAENTRY']._serialized_start=638
-  _globals['_LISTEDTEMPLATE_METADATAENTRY']._serialized_end=685
-  _globals['_GETTEMPLATE']._serialized_start=896
-  _globals['_GETTEMPLATE']._serialized_end=961
-  _globals['_SELECTTEMPLATES']._serialized_start=964
-  _globals['_SELECTTEMPLATES']._serialized_end=1136
-  _globals['_SELECTTEMPLATES_METADATAPATTERNSENTRY']._serialized_start=1081
-  _globals['_SELECTTEMPLATES_METADATAPATTERNSENTRY']._serialized_end=1136
-  _globals['_LISTTEMPLATES']._serialized_start=1138
-  _globals['_LISTTEMPLATES']._serialized_end=1220
-  _globals['_SITEID']._serialized_start=1222
-  _globals['_SITEID']._serialized_end=1246
-  _globals['_SITE']._serialized_start=1249
-  _globals['_SITE']._serialized_end=1491
-  _globals['_SITE_METADATAENTRY']._serialized_start=638
-  _globals['_SITE_METADATAENTRY']._serialized_end=685
-  _globals['_LISTEDSITE']._serialized_start=1494
-  _globals['_LISTEDSITE']._serialized_end=1708
-  _globals['_LISTEDSITE_METADATAENTRY']._serialized_start=638
-  _globals['_LISTEDSITE_METADATAENTRY']._serialized_end=685
-  _globals['_GETSITE']._serialized_start=1710
-  _globals['_GETSITE']._serialized_end=1767
-  _globals['_SELECTSITES']._serialized_start=1770
-  _globals['_SELECTSITES']._serialized_end=1958
-  _globals['_SELECTSITES_METADATAPATTERNSENTRY']._serialized_start=1081
-  _globals['_SELECTSITES_METADATAPATTERNSENTRY']._serialized_end=1136
-  _globals['_LISTSITES']._serialized_start=1960
-  _globals['_LISTSITES']._serialized_end=2034
-  _globals['_DEPLOYMENTID']._serialized_start=2036
-  _globals['_DEPLOYMENTID']._serialized_end=2072
-  _globals['_DEPLOYMENT']._serialized_start=2075
-  _globals['_DEPLOYMENT']._serialized_end=2437
-  _globals['_DEPLOYMENT_METADATAENTRY']._serialized_start=638
-  _globals['_DEPLOYMENT_METADATAENTRY']._serialized_end=685
-  _globals['_LISTEDDEPLOYMENT']._serialized_start=2440
-  _globals['_LISTEDDEPLOYMENT']._serialized_end=2774
-  _globals['_LISTEDDEPLOYMENT_METADATAENTRY']._serialized_start=638
-  _globals['_LISTEDDEPLOYMENT_METADATAENTRY']._serialized_end=685
-  _globals['_CREATEDEPLOYMENT']._serialized_start=2777
-  _globals['_CREATEDEPLOYMENT']._serialized_end=3064
-  _globals['_CREATEDEPLOYMENT_MERGEMETADATAENTRY']._serialized_start=3012
-  _globals['_CREATEDEPLOYMENT_MERGEMETADATAENTRY']._serialized_end=3064
-  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_start=3066
-  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_end=3157
-  _globals['_GETDEPLOYMENT']._serialized_start=3159
-  _globals['_GETDEPLOYMENT']._serialized_end=3228
-  _globals['_SELECTDEPLOYMENTS']._serialized_start=3231
-  _globals['_SELECTDEPLOYMENTS']._serialized_end=3853
-  _globals['_SELECTDEPLOYMENTS_METADATAPATTERNSENTRY']._serialized_start=1081
-  _globals['_SELECTDEPLOYMENTS_METADATAPATTERNSENTRY']._serialized_end=1136
-  _globals['_SELECTDEPLOYMENTS_TEMPLATEMETADATAPATTERNSENTRY']._serialized_start=3680
-  _globals['_SELECTDEPLOYMENTS_TEMPLATEMETADATAPATTERNSENTRY']._serialized_end=3743
-  _globals['_SELECTDEPLOYMENTS_SITEMETADATAPATTERNSENTRY']._serialized_start=3745
-  _globals['_SELECTDEPLOYMENTS_SITEMETADATAPATTERNSENTRY']._serialized_end=3804
-  _globals['_LISTDEPLOYMENTS']._serialized_start=3855
-  _globals['_LISTDEPLOYMENTS']._serialized_end=3941
-  _globals['_STARTDEPLOYMENTMODIFICATION']._serialized_start=3943
-  _globals['_STARTDEPLOYMENTMODIFICATION']._serialized_end=4026
-  _globals['_STARTDEPLOYMENTMODIFICATIONRESPONSE']._serialized_start=4029
-  _globals['_STARTDEPLOYMENTMODIFICATIONRESPONSE']._serialized_end=4176
-  _globals['_ENDDEPLOYMENTMODIFICATION']._serialized_start=4178
-  _globals['_ENDDEPLOYMENTMODIFICATION']._serialized_end=4272
-  _globals['_ENDDEPLOYMENTMODIFICATIONRESPONSE']._serialized_start=4274
-  _globals['_ENDDEPLOYMENTMODIFICATIONRESPONSE']._serialized_end=4376
-  _globals['_CANCELDEPLOYMENTMODIFICATION']._serialized_start=4378
-  _globals['_CANCELDEPLOYMENTMODIFICATION']._serialized_end=4435
-  _globals['_CANCELDEPLOYMENTMODIFICATIONRESPONSE']._serialized_start=4437
-  _globals['_CANCELDEPLOYMENTMODIFICATIONRESPONSE']._serialized_end=4522
-  _globals['_PLUGINID']._serialized_start=4524
-  _globals['_PLUGINID']._serialized_end=4562
-  _globals['_GVK']._serialized_start=4564
-  _globals['_GVK']._serialized_end=4615
-  _globals['_PLUGIN']._serialized_start=4618
-  _globals['_PLUGIN']._serialized_end=4819
-  _globals['_PLUGIN_PROPERTIESENTRY']._serialized_start=4770
-  _globals['_PLUGIN_PROPERTIESENTRY']._serialized_end=4819
-  _globals['_SELECTPLUGINS']._serialized_start=4822
-  _globals['_SELECTPLUGINS']._serialized_end=4967
-  _globals['_LISTPLUGINS']._serialized_start=4969
-  _globals['_LISTPLUGINS']._serialized_end=5047
-  _globals['_DATA']._serialized_start=5050
-  _globals['_DATA']._serialized_end=6516
+  _globals['_TEMPLATEID']._serialized_end=467
+  _globals['_TEMPLATE']._serialized_start=470
+  _globals['_TEMPLATE']._serialized_end=740
+  _globals['_TEMPLATE_METADATAENTRY']._serialized_start=693
+  _globals['_TEMPLATE_METADATAENTRY']._serialized_end=740
+  _globals['_TEMPLATECHUNK']._serialized_start=742
+  _globals['_TEMPLATECHUNK']._serialized_end=820
+  _globals['_LISTEDTEMPLATE']._serialized_start=823
+  _globals['_LISTEDTEMPLATE']._serialized_end=1065
+  _globals['_LISTEDTEMPLATE_METADATAENTRY']._serialized_start=693
+  _globals['_LISTEDTEMPLATE_METADATAENTRY']._serialized_end=740
+  _globals['_GETTEMPLATE']._serialized_start=1067
+  _globals['_GETTEMPLATE']._serialized_end=1151
+  _globals['_SELECTTEMPLATES']._serialized_start=1154
+  _globals['_SELECTTEMPLATES']._serialized_end=1396
+  _globals['_SELECTTEMPLATES_METADATAPATTERNSENTRY']._serialized_start=1341
+  _globals['_SELECTTEMPLATES_METADATAPATTERNSENTRY']._serialized_end=1396
+  _globals['_LISTTEMPLATES']._serialized_start=1398
+  _globals['_LISTTEMPLATES']._serialized_end=1480
+  _globals['_SITEID']._serialized_start=1482
+  _globals['_SITEID']._serialized_end=1525
+  _globals['_SITE']._serialized_start=1528
+  _globals['_SITE']._serialized_end=1806
+  _globals['_SITE_METADATAENTRY']._serialized_start=693
+  _globals['_SITE_METADATAENTRY']._serialized_end=740
+  _globals['_SITECHUNK']._serialized_start=1808
+  _globals['_SITECHUNK']._serialized_end=1874
+  _globals['_LISTEDSITE']._serialized_start=1877
+  _globals['_LISTEDSITE']._serialized_end=2127
+  _globals['_LISTEDSITE_METADATAENTRY']._serialized_start=693
+  _globals['_LISTEDSITE_METADATAENTRY']._serialized_end=740
+  _globals['_GETSITE']._serialized_start=2129
+  _globals['_GETSITE']._serialized_end=2205
+  _globals['_SELECTSITES']._serialized_start=2208
+  _globals['_SELECTSITES']._serialized_end=2466
+  _globals['_SELECTSITES_METADATAPATTERNSENTRY']._serialized_start=1341
+  _globals['_SELECTSITES_METADATAPATTERNSENTRY']._serialized_end=1396
+  _globals['_DELETEDDEPLOYMENT']._serialized_start=2469
+  _globals['_DELETEDDEPLOYMENT']._serialized_end=2630
+  _globals['_ACKNOWLEDGEDELETEDDEPLOYMENTS']._serialized_start=2632
+  _globals['_ACKNOWLEDGEDELETEDDEPLOYMENTS']._serialized_end=2721
+  _globals['_LISTSITES']._serialized_start=2723
+  _globals['_LISTSITES']._serialized_end=2797
+  _globals['_DEPLOYMENTID']._serialized_start=2799
+  _globals['_DEPLOYMENTID']._serialized_end=2875
+  _globals['_DEPLOYMENT']._serialized_start=2878
+  _globals['_DEPLOYMENT']._serialized_end=3276
+  _globals['_DEPLOYMENT_METADATAENTRY']._serialized_start=693
+  _globals['_DEPLOYMENT_METADATAENTRY']._serialized_end=740
+  _globals['_DEPLOYMENTCHUNK']._serialized_start=3278
+  _globals['_DEPLOYMENTCHUNK']._serialized_end=3362
+  _globals['_LISTEDDEPLOYMENT']._serialized_start=3365
+  _globals['_LISTEDDEPLOYMENT']._serialized_end=3735
+  _globals['_LISTEDDEPLOYMENT_METADATAENTRY']._serialized_start=693
+  _globals['_LISTEDDEPLOYMENT_METADATAENTRY']._serialized_end=740
+  _globals['_CREATEDEPLOYMENT']._serialized_start=3738
+  _globals['_CREATEDEPLOYMENT']._serialized_end=4044
+  _globals['_CREATEDEPLOYMENT_MERGEMETADATAENTRY']._serialized_start=3992
+  _globals['_CREATEDEPLOYMENT_MERGEMETADATAENTRY']._serialized_end=4044
+  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_start=4046
+  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_end=4137
+  _globals['_GETDEPLOYMENT']._serialized_start=4139
+  _globals['_GETDEPLOYMENT']._serialized_end=4248
+  _globals['_SELECTDEPLOYMENTS']._serialized_start=4251
+  _globals['_SELECTDEPLOYMENTS']._serialized_end=4964
+  _globals['_SELECTDEPLOYMENTS_METADATAPATTERNSENTRY']._serialized_start=1341
+  _globals['_SELECTDEPLOYMENTS_METADATAPATTERNSENTRY']._serialized_end=1396
+  _globals['_SELECTDEPLOYMENTS_TEMPLATEMETADATAPATTERNSENTRY']._serialized_start=4791
+  _globals['_SELECTDEPLOYMENTS_TEMPLATEMETADATAPATTERNSENTRY']._serialized_end=4854
+  _globals['_SELECTDEPLOYMENTS_SITEMETADATAPATTERNSENTRY']._serialized_start=4856
+  _globals['_SELECTDEPLOYMENTS_SITEMETADATAPATTERNSENTRY']._serialized_end=4915
+  _globals['_LISTDEPLOYMENTS']._serialized_start=4966
+  _globals['_LISTDEPLOYMENTS']._serialized_end=5052
+  _globals['_STARTDEPLOYMENTMODIFICATION']._serialized_start=5054
+  _globals['_STARTDEPLOYMENTMODIFICATION']._serialized_end=5173
+  _globals['_STARTDEPLOYMENTMODIFICATIONRESPONSE']._serialized_start=5176
+  _globals['_STARTDEPLOYMENTMODIFICATIONRESPONSE']._serialized_end=5323
+  _globals['_ENDDEPLOYMENTMODIFICATION']._serialized_start=5325
+  _globals['_ENDDEPLOYMENTMODIFICATION']._serialized_end=5419
+  _globals['_ENDDEPLOYMENTMODIFICATIONCHUNK']._serialized_start=5421
+  _globals['_ENDDEPLOYMENTMODIFICATIONCHUNK']._serialized_end=5537
+  _globals['_ENDDEPLOYMENTMODIFICATIONRESPONSE']._serialized_start=5539
+  _globals['_ENDDEPLOYMENTMODIFICATIONRESPONSE']._serialized_end=5641
+  _globals['_CANCELDEPLOYMENTMODIFICATION']._serialized_start=5643
+  _globals['_CANCELDEPLOYMENTMODIFICATION']._serialized_end=5700
+  _globals['_CANCELDEPLOYMENTMODIFICATIONRESPONSE']._serialized_start=5702
+  _globals['_CANCELDEPLOYMENTMODIFICATIONRESPONSE']._serialized_end=5787
+  _globals['_MODIFYDEPLOYMENTS']._serialized_start=5790
+  _globals['_MODIFYDEPLOYMENTS']._serialized_end=6023
+  _globals['_MODIFYDEPLOYMENTS_SETMETADATAENTRY']._serialized_start=5960
+  _globals['_MODIFYDEPLOYMENTS_SETMETADATAENTRY']._serialized_end=6010
+  _globals['_MODIFIEDDEPLOYMENT']._serialized_start=6025
+  _globals['_MODIFIEDDEPLOYMENT']._serialized_end=6129
+  _globals['_MODIFYDEPLOYMENTSRESPONSE']._serialized_start=6131
+  _globals['_MODIFYDEPLOYMENTSRESPONSE']._serialized_end=6204
+  _globals['_PLUGINID']._serialized_start=6206
+  _globals['_PLUGINID']._serialized_end=6244
+  _globals['_GVK']._serialized_start=6246
+  _globals['_GVK']._serialized_end=6297
+  _globals['_PLUGIN']._serialized_start=6300
+  _globals['_PLUGIN']._serialized_end=6518
+  _globals['_PLUGIN_PROPERTIESENTRY']._serialized_start=6469
+  _globals['_PLUGIN_PROPERTIESENTRY']._serialized_end=6518
+  _globals['_SELECTPLUGINS']._serialized_start=6521
+  _globals['_SELECTPLUGINS']._serialized_end=6666
+  _globals['_LISTPLUGINS']._serialized_start=6668
+  _globals['_LISTPLUGINS']._serialized_end=6746
+  _globals['_REVISIONID']._serialized_start=6748
+  _globals['_REVISIONID']._serialized_end=6829
+  _globals['_REVISION']._serialized_start=6832
+  _globals['_REVISION']._serialized_end=7142
+  _globals['_REVISION_METADATAENTRY']._serialized_start=693
+  _globals['_REVISION_METADATAENTRY']._serialized_end=740
+  _globals['_LISTEDREVISION']._serialized_start=7145
+  _globals['_LISTEDREVISION']._serialized_end=7427
+  _globals['_LISTEDREVISION_METADATAENTRY']._serialized_start=693
+  _globals['_LISTEDREVISION_METADATAENTRY']._serialized_end=740
+  _globals['_GETREVISION']._serialized_start=7429
+  _globals['_GETREVISION']._serialized_end=7543
+  _globals['_LISTREVISIONS']._serialized_start=7545
+  _globals['_LISTREVISIONS']._serialized_end=7640
+  _globals['_REVERTRESPONSE']._serialized_start=7642
+  _globals['_REVERTRESPONSE']._serialized_end=7703
+  _globals['_LISTAUDITEVENTS']._serialized_start=7706
+  _globals['_LISTAUDITEVENTS']._serialized_end=7873
+  _globals['_AUDITEVENT']._serialized_start=7876
+  _globals['_AUDITEVENT']._serialized_end=8130
+  _globals['_EXPORTARCHIVE']._serialized_start=8132
+  _globals['_EXPORTARCHIVE']._serialized_end=8198
+  _globals['_ARCHIVEENTRY']._serialized_start=8201
+  _globals['_ARCHIVEENTRY']._serialized_end=8357
+  _globals['_IMPORTARCHIVE']._serialized_start=8359
+  _globals['_IMPORTARCHIVE']._serialized_end=8422
+  _globals['_IMPORTARCHIVERESPONSE']._serialized_start=8425
+  _globals['_IMPORTARCHIVERESPONSE']._serialized_end=8565
+  _globals['_WATCH']._serialized_start=8567
+  _globals['_WATCH']._serialized_end=8631
+  _globals['_EVENT']._serialized_start=8634
+  _globals['_EVENT']._serialized_end=8765
+  _globals['_DATA']._serialized_start=8768
+  _globals['_DATA']._serialized_end=11164
 # @@protoc_insertion_point(module_scope)
@@ -45,6 +45,11 @@ class DataStub(object):
                 request_serializer=tko_dot_tko__pb2.Template.SerializeToString,
                 response_deserializer=tko_dot_tko__pb2.RegisterResponse.FromString,
                 _registered_method=True)
+        self.registerTemplateChunked = channel.stream_unary(
+                '/tko.Data/registerTemplateChunked',
+                request_serializer=tko_dot_tko__pb2.TemplateChunk.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.RegisterResponse.FromString,
+                _registered_method=True)
         self.deleteTemplate = channel.unary_unary(
                 '/tko.Data/deleteTemplate',
                 request_serializer=tko_dot_tko__pb2.TemplateID.SerializeToString,
@@ -70,6 +75,11 @@ class DataStub(object):
                 request_serializer=tko_dot_tko__pb2.Site.SerializeToString,
                 response_deserializer=tko_dot_tko__pb2.RegisterResponse.FromString,
                 _registered_method=True)
+        self.registerSiteChunked = channel.stream_unary(
+                '/tko.Data/registerSiteChunked',
+                request_serializer=tko_dot_tko__pb2.SiteChunk.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.RegisterResponse.FromString,
+                _registered_method=True)
         self.deleteSite = channel.unary_unary(
                 '/tko.Data/deleteSite',
                 request_serializer=tko_dot_tko__pb2.SiteID.SerializeToString,
@@ -90,6 +100,16 @@ class DataStub(object):
                 request_serializer=tko_dot_tko__pb2.SelectSites.SerializeToString,
                 response_deserializer=tko_dot_tko__pb2.DeleteResponse.FromString,
                 _registered_method=True)
+        self.listDeletedDeployments = channel.unary_stream(
+                '/tko.Data/listDeletedDeployments',
+                request_serializer=tko_dot_tko__pb2.GetSite.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.DeletedDeployment.FromString,
+                _registered_method=True)
+        self.acknowledgeDeletedDeployments = channel.unary_unary(
+                '/tko.Data/acknowledgeDeletedDeployments',
+                request_serializer=tko_dot_tko__pb2.AcknowledgeDeletedDeployments.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.DeleteResponse.FromString,
+                _registered_method=True)
         self.createDeployment = channel.unary_unary(
                 '/tko.Data/createDeployment',
                 request_serializer=tko_dot_tko__pb2.CreateDeployment.SerializeToString,
@@ -105,6 +125,11 @@ class DataStub(object):
                 request_serializer=tko_dot_tko__pb2.GetDeployment.SerializeToString,
                 response_deserializer=tko_dot_tko__pb2.Deployment.FromString,
                 _registered_method=True)
+        self.getDeploymentChunked = channel.unary_stream(
+                '/tko.Data/getDeploymentChunked',
+                request_serializer=tko_dot_tko__pb2.GetDeployment.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.DeploymentChunk.FromString,
+                _registered_method=True)
         self.listDeployments = channel.unary_stream(
                 '/tko.Data/listDeployments',
                 request_serializer=tko_dot_tko__pb2.ListDeployments.SerializeToString,
@@ -125,11 +150,21 @@ class DataStub(object):
                 request_serializer=tko_dot_tko__pb2.EndDeploymentModification.SerializeToString,
                 response_deserializer=tko_dot_tko__pb2.EndDeploymentModificationResponse.FromString,
                 _registered_method=True)
+        self.endDeploymentModificationChunked = channel.stream_unary(
+                '/tko.Data/endDeploymentModificationChunked',
+                request_serializer=tko_dot_tko__pb2.EndDeploymentModificationChunk.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.EndDeploymentModificationResponse.FromString,
+                _registered_method=True)
         self.cancelDeploymentModification = channel.unary_unary(
                 '/tko.Data/cancelDeploymentModification',
                 request_serializer=tko_dot_tko__pb2.CancelDeploymentModification.SerializeToString,
                 response_deserializer=tko_dot_tko__pb2.CancelDeploymentModificationResponse.FromString,
                 _registered_method=True)
+        self.modifyDeployments = channel.unary_unary(
+                '/tko.Data/modifyDeployments',
+                request_serializer=tko_dot_tko__pb2.ModifyDeployments.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.ModifyDeploymentsResponse.FromString,
+                _registered_method=True)
         self.registerPlugin = channel.unary_unary(
                 '/tko.Data/registerPlugin',
                 request_serializer=tko_dot_tko__pb2.Plugin.SerializeToString,
@@ -155,6 +190,41 @@ class DataStub(object):
                 request_serializer=tko_dot_tko__pb2.SelectPlugins.SerializeToString,
                 response_deserializer=tko_dot_tko__pb2.DeleteResponse.FromString,
                 _registered_method=True)
+        self.listRevisions = channel.unary_stream(
+                '/tko.Data/listRevisions',
+                request_serializer=tko_dot_tko__pb2.ListRevisions.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.ListedRevision.FromString,
+                _registered_method=True)
+        self.getRevision = channel.unary_unary(
+                '/tko.Data/getRevision',
+                request_serializer=tko_dot_tko__pb2.GetRevision.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.Revision.FromString,
+                _registered_method=True)
+        self.revertTo = channel.unary_unary(
+                '/tko.Data/revertTo',
+                request_serializer=tko_dot_tko__pb2.RevisionID.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.RevertResponse.FromString,
+                _registered_method=True)
+        self.listAuditEvents = channel.unary_stream(
+                '/tko.Data/listAuditEvents',
+                request_serializer=tko_dot_tko__pb2.ListAuditEvents.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.AuditEvent.FromString,
+                _registered_method=True)
+        self.exportArchive = channel.unary_stream(
+                '/tko.Data/exportArchive',
+                request_serializer=tko_dot_tko__pb2.ExportArchive.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.ArchiveEntry.FromString,
+                _registered_method=True)
+        self.importArchive = channel.stream_unary(
+                '/tko.Data/importArchive',
+                request_serializer=tko_dot_tko__pb2.ImportArchive.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.ImportArchiveResponse.FromString,
+                _registered_method=True)
+        self.watch = channel.unary_stream(
+                '/tko.Data/watch',
+                request_serializer=tko_dot_tko__pb2.Watch.SerializeToString,
+                response_deserializer=tko_dot_tko__pb2.Event.FromString,
+                _registered_method=True)
 
 
 class DataServicer(object):
@@ -172,6 +242,12 @@ class DataServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def registerTemplateChunked(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def deleteTemplate(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -202,6 +278,12 @@ class DataServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def registerSiteChunked(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def deleteSite(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -226,6 +308,18 @@ class DataServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def listDeletedDeployments(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def acknowledgeDeletedDeployments(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def createDeployment(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -244,6 +338,12 @@ class DataServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def getDeploymentChunked(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def listDeployments(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -268,12 +368,24 @@ class DataServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def endDeploymentModificationChunked(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def cancelDeploymentModification(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def modifyDeployments(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def registerPlugin(self, request, context):
         """Missing associated documentation comment in .proto file."""
         context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -304,6 +416,48 @@ class DataServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def listRevisions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def getRevision(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def revertTo(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def listAuditEvents(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def exportArchive(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def importArchive(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def watch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_DataServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -317,6 +471,11 @@ def add_DataServicer_to_server(servicer, server):
                     request_deserializer=tko_dot_tko__pb2.Template.FromString,
                     response_serializer=tko_dot_tko__pb2.RegisterResponse.SerializeToString,
             ),
+            'registerTemplateChunked': grpc.stream_unary_rpc_method_handler(
+                    servicer.registerTemplateChunked,
+                    request_deserializer=tko_dot_tko__pb2.TemplateChunk.FromString,
+                    response_serializer=tko_dot_tko__pb2.RegisterResponse.SerializeToString,
+            ),
             'deleteTemplate': grpc.unary_unary_rpc_method_handler(
                     servicer.deleteTemplate,
                     request_deserializer=tko_dot_tko__pb2.TemplateID.FromString,
@@ -342,6 +501,11 @@ def add_DataServicer_to_server(servicer, server):
                     request_deserializer=tko_dot_tko__pb2.Site.FromString,
                     response_serializer=tko_dot_tko__pb2.RegisterResponse.SerializeToString,
             ),
+            'registerSiteChunked': grpc.stream_unary_rpc_method_handler(
+                    servicer.registerSiteChunked,
+                    request_deserializer=tko_dot_tko__pb2.SiteChunk.FromString,
+                    response_serializer=tko_dot_tko__pb2.RegisterResponse.SerializeToString,
+            ),
             'deleteSite': grpc.unary_unary_rpc_method_handler(
                     servicer.deleteSite,
                     request_deserializer=tko_dot_tko__pb2.SiteID.FromString,
@@ -362,6 +526,16 @@ def add_DataServicer_to_server(servicer, server):
                     request_deserializer=tko_dot_tko__pb2.SelectSites.FromString,
                     response_serializer=tko_dot_tko__pb2.DeleteResponse.SerializeToString,
             ),
+            'listDeletedDeployments': grpc.unary_stream_rpc_method_handler(
+                    servicer.listDeletedDeployments,
+                    request_deserializer=tko_dot_tko__pb2.GetSite.FromString,
+                    response_serializer=tko_dot_tko__pb2.DeletedDeployment.SerializeToString,
+            ),
+            'acknowledgeDeletedDeployments': grpc.unary_unary_rpc_method_handler(
+                    servicer.acknowledgeDeletedDeployments,
+                    request_deserializer=tko_dot_tko__pb2.AcknowledgeDeletedDeployments.FromString,
+                    response_serializer=tko_dot_tko__pb2.DeleteResponse.SerializeToString,
+            ),
             'createDeployment': grpc.unary_unary_rpc_method_handler(
                     servicer.createDeployment,
                     request_deserializer=tko_dot_tko__pb2.CreateDeployment.FromString,
@@ -377,6 +551,11 @@ def add_DataServicer_to_server(servicer, server):
                     request_deserializer=tko_dot_tko__pb2.GetDeployment.FromString,
                     response_serializer=tko_dot_tko__pb2.Deployment.SerializeToString,
             ),
+            'getDeploymentChunked': grpc.unary_stream_rpc_method_handler(
+                    servicer.getDeploymentChunked,
+                    request_deserializer=tko_dot_tko__pb2.GetDeployment.FromString,
+                    response_serializer=tko_dot_tko__pb2.DeploymentChunk.SerializeToString,
+            ),
             'listDeployments': grpc.unary_stream_rpc_method_handler(
                     servicer.listDeployments,
                     request_deserializer=tko_dot_tko__pb2.ListDeployments.FromString,
@@ -397,11 +576,21 @@ def add_DataServicer_to_server(servicer, server):
                     request_deserializer=tko_dot_tko__pb2.EndDeploymentModification.FromString,
                     response_serializer=tko_dot_tko__pb2.EndDeploymentModificationResponse.SerializeToString,
             ),
+            'endDeploymentModificationChunked': grpc.stream_unary_rpc_method_handler(
+                    servicer.endDeploymentModificationChunked,
+                    request_deserializer=tko_dot_tko__pb2.EndDeploymentModificationChunk.FromString,
+                    response_serializer=tko_dot_tko__pb2.EndDeploymentModificationResponse.SerializeToString,
+            ),
             'cancelDeploymentModification': grpc.unary_unary_rpc_method_handler(
                     servicer.cancelDeploymentModification,
                     request_deserializer=tko_dot_tko__pb2.CancelDeploymentModification.FromString,
                     response_serializer=tko_dot_tko__pb2.CancelDeploymentModificationResponse.SerializeToString,
             ),
+            'modifyDeployments': grpc.unary_unary_rpc_method_handler(
+                    servicer.modifyDeployments,
+                    request_deserializer=tko_dot_tko__pb2.ModifyDeployments.FromString,
+                    response_serializer=tko_dot_tko__pb2.ModifyDeploymentsResponse.SerializeToString,
+            ),
             'registerPlugin': grpc.unary_unary_rpc_method_handler(
                     servicer.registerPlugin,
                     request_deserializer=tko_dot_tko__pb2.Plugin.FromString,
@@ -427,6 +616,41 @@ def add_DataServicer_to_server(servicer, server):
                     request_deserializer=tko_dot_tko__pb2.SelectPlugins.FromString,
                     response_serializer=tko_dot_tko__pb2.DeleteResponse.SerializeToString,
             ),
+            'listRevisions': grpc.unary_stream_rpc_method_handler(
+                    servicer.listRevisions,
+                    request_deserializer=tko_dot_tko__pb2.ListRevisions.FromString,
+                    response_serializer=tko_dot_tko__pb2.ListedRevision.SerializeToString,
+            ),
+            'getRevision': grpc.unary_unary_rpc_method_handler(
+                    servicer.getRevision,
+                    request_deserializer=tko_dot_tko__pb2.GetRevision.FromString,
+                    response_serializer=tko_dot_tko__pb2.Revision.SerializeToString,
+            ),
+            'revertTo': grpc.unary_unary_rpc_method_handler(
+                    servicer.revertTo,
+                    request_deserializer=tko_dot_tko__pb2.RevisionID.FromString,
+                    response_serializer=tko_dot_tko__pb2.RevertResponse.SerializeToString,
+            ),
+            'listAuditEvents': grpc.unary_stream_rpc_method_handler(
+                    servicer.listAuditEvents,
+                    request_deserializer=tko_dot_tko__pb2.ListAuditEvents.FromString,
+                    response_serializer=tko_dot_tko__pb2.AuditEvent.SerializeToString,
+            ),
+            'exportArchive': grpc.unary_stream_rpc_method_handler(
+                    servicer.exportArchive,
+                    request_deserializer=tko_dot_tko__pb2.ExportArchive.FromString,
+                    response_serializer=tko_dot_tko__pb2.ArchiveEntry.SerializeToString,
+            ),
+            'importArchive': grpc.stream_unary_rpc_method_handler(
+                    servicer.importArchive,
+                    request_deserializer=tko_dot_tko__pb2.ImportArchive.FromString,
+                    response_serializer=tko_dot_tko__pb2.ImportArchiveResponse.SerializeToString,
+            ),
+            'watch': grpc.unary_stream_rpc_method_handler(
+                    servicer.watch,
+                    request_deserializer=tko_dot_tko__pb2.Watch.FromString,
+                    response_serializer=tko_dot_tko__pb2.Event.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'tko.Data', rpc_method_handlers)
@@ -492,6 +716,33 @@ class Data(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def registerTemplateChunked(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/tko.Data/registerTemplateChunked',
+            tko_dot_tko__pb2.TemplateChunk.SerializeToString,
+            tko_dot_tko__pb2.RegisterResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def deleteTemplate(request,
             target,
@@ -627,6 +878,33 @@ class Data(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def registerSiteChunked(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/tko.Data/registerSiteChunked',
+            tko_dot_tko__pb2.SiteChunk.SerializeToString,
+            tko_dot_tko__pb2.RegisterResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def deleteSite(request,
             target,
@@ -735,6 +1013,60 @@ class Data(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def listDeletedDeployments(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.Data/listDeletedDeployments',
+            tko_dot_tko__pb2.GetSite.SerializeToString,
+            tko_dot_tko__pb2.DeletedDeployment.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def acknowledgeDeletedDeployments(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tko.Data/acknowledgeDeletedDeployments',
+            tko_dot_tko__pb2.AcknowledgeDeletedDeployments.SerializeToString,
+            tko_dot_tko__pb2.DeleteResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def createDeployment(request,
             target,
@@ -816,6 +1148,33 @@ class Data(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def getDeploymentChunked(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.Data/getDeploymentChunked',
+            tko_dot_tko__pb2.GetDeployment.SerializeToString,
+            tko_dot_tko__pb2.DeploymentChunk.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def listDeployments(request,
             target,
@@ -924,6 +1283,33 @@ class Data(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def endDeploymentModificationChunked(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/tko.Data/endDeploymentModificationChunked',
+            tko_dot_tko__pb2.EndDeploymentModificationChunk.SerializeToString,
+            tko_dot_tko__pb2.EndDeploymentModificationResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def cancelDeploymentModification(request,
             target,
@@ -951,6 +1337,33 @@ class Data(object):
             metadata,
             _registered_method=True)
 
+    @staticmethod
+    def modifyDeployments(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tko.Data/modifyDeployments',
+            tko_dot_tko__pb2.ModifyDeployments.SerializeToString,
+            tko_dot_tko__pb2.ModifyDeploymentsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
     @staticmethod
     def registerPlugin(request,
             target,
@@ -1085,3 +1498,192 @@ class Data(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def listRevisions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.Data/listRevisions',
+            tko_dot_tko__pb2.ListRevisions.SerializeToString,
+            tko_dot_tko__pb2.ListedRevision.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def getRevision(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tko.Data/getRevision',
+            tko_dot_tko__pb2.GetRevision.SerializeToString,
+            tko_dot_tko__pb2.Revision.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def revertTo(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/tko.Data/revertTo',
+            tko_dot_tko__pb2.RevisionID.SerializeToString,
+            tko_dot_tko__pb2.RevertResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def listAuditEvents(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.Data/listAuditEvents',
+            tko_dot_tko__pb2.ListAuditEvents.SerializeToString,
+            tko_dot_tko__pb2.AuditEvent.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def exportArchive(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.Data/exportArchive',
+            tko_dot_tko__pb2.ExportArchive.SerializeToString,
+            tko_dot_tko__pb2.ArchiveEntry.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def importArchive(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/tko.Data/importArchive',
+            tko_dot_tko__pb2.ImportArchive.SerializeToString,
+            tko_dot_tko__pb2.ImportArchiveResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def watch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/tko.Data/watch',
+            tko_dot_tko__pb2.Watch.SerializeToString,
+            tko_dot_tko__pb2.Event.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
@@ -40,6 +40,14 @@ def install(chart, package, kube_context=None):
   execute(*args, kube_context=kube_context)
 
 
+def uninstall(chart, kube_context=None):
+  name = chart.get('metadata', {}).get('name', '')
+  if name not in get_current_deployments(kube_context):
+    return
+
+  execute('uninstall', name, kube_context=kube_context)
+
+
 def execute(*args, kube_context=None):
   env = {'HELM_NAMESPACE': 'default'}
   if kube_context is not None:
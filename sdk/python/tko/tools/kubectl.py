@@ -7,6 +7,12 @@ def apply(package, kube_context=None):
     execute('apply', '--filename', '-', input=manifest, kube_context=kube_context)
 
 
+def delete(package, kube_context=None):
+  manifest = str(package)
+  if manifest:
+    execute('delete', '--ignore-not-found', '--filename', '-', input=manifest, kube_context=kube_context)
+
+
 def execute(*args, input=None, kube_context=None):
   if kube_context is not None:
     args = list(args)
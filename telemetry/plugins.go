@@ -0,0 +1,16 @@
+package telemetry
+
+import (
+	contextpkg "context"
+
+	"go.opentelemetry.io/otel/attribute"
+	tracepkg "go.opentelemetry.io/otel/trace"
+)
+
+// Starts a span for a plugin execution. Command plugins receive the span's trace in their input.
+func StartPluginSpan(context contextpkg.Context, type_ string, name string, executor string) (contextpkg.Context, tracepkg.Span) {
+	return StartSpan(context, "plugin."+type_,
+		attribute.String("tko.plugin_type", type_),
+		attribute.String("tko.plugin_name", name),
+		attribute.String("tko.plugin_executor", executor))
+}
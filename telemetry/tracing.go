@@ -0,0 +1,101 @@
+package telemetry
+
+import (
+	contextpkg "context"
+	"time"
+
+	"github.com/tliron/commonlog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	tracepkg "go.opentelemetry.io/otel/trace"
+)
+
+const TracerName = "github.com/nephio-experimental/tko"
+
+// Timeout for flushing pending spans on shutdown.
+var ShutdownTimeout = 5 * time.Second
+
+// Delegates to the global tracer provider, so it can be used before [StartTracing].
+var tracer = otel.Tracer(TracerName)
+
+// Configures the global tracer provider to export spans to an OTLP gRPC endpoint, e.g. a local
+// OpenTelemetry Collector at "localhost:4317". If endpoint is empty spans will not be exported,
+// but trace context will still be propagated to and from other services and plugins.
+//
+// Returns a function that flushes pending spans, which should be called on exit.
+func StartTracing(serviceName string, endpoint string, insecure bool, log commonlog.Logger) (func(), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if endpoint == "" {
+		return func() {}, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	// Note: the exporter connects lazily, so this will not fail if the collector is unreachable
+	exporter, err := otlptracegrpc.New(contextpkg.Background(), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	resource_, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := trace.NewTracerProvider(trace.WithBatcher(exporter), trace.WithResource(resource_))
+	otel.SetTracerProvider(tracerProvider)
+	log.Noticef("exporting traces to: %s", endpoint)
+
+	return func() {
+		context, cancel := contextpkg.WithTimeout(contextpkg.Background(), ShutdownTimeout)
+		defer cancel()
+		if err := tracerProvider.Shutdown(context); err != nil {
+			log.Error(err.Error())
+		}
+	}, nil
+}
+
+// Starts a child span of the span in the context, or a root span if there is none.
+func StartSpan(context contextpkg.Context, name string, attributes ...attribute.KeyValue) (contextpkg.Context, tracepkg.Span) {
+	return tracer.Start(context, name, tracepkg.WithAttributes(attributes...))
+}
+
+// Ends the span, recording the error if not nil.
+func EndSpan(span tracepkg.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Returns the context's trace as a map, e.g. for plugin input. Plugins can add the entries to
+// gRPC metadata in order to continue the trace. Returns nil if there is no trace.
+func InjectTrace(context contextpkg.Context) map[string]string {
+	carrier := make(propagation.MapCarrier)
+	otel.GetTextMapPropagator().Inject(context, carrier)
+	if len(carrier) > 0 {
+		return carrier
+	} else {
+		return nil
+	}
+}
+
+// Returns a context that continues the trace in the map, as produced by [InjectTrace].
+func ExtractTrace(context contextpkg.Context, trace map[string]string) contextpkg.Context {
+	if len(trace) > 0 {
+		return otel.GetTextMapPropagator().Extract(context, propagation.MapCarrier(trace))
+	} else {
+		return context
+	}
+}
@@ -0,0 +1,95 @@
+package util
+
+import (
+	"io"
+)
+
+// Size of package chunks when streaming over gRPC.
+var ChunkSize = 64 * 1024
+
+var (
+	_ io.Reader = new(ChunkReader)
+	_ io.Writer = new(ChunkWriter)
+)
+
+//
+// ChunkReader
+//
+
+// An [io.Reader] over chunks that are received one at a time, e.g. from a gRPC stream.
+type ChunkReader struct {
+	receive func() ([]byte, error) // returns io.EOF when done
+	chunk   []byte
+}
+
+func NewChunkReader(receive func() ([]byte, error)) *ChunkReader {
+	return &ChunkReader{receive: receive}
+}
+
+// ([io.Reader] interface)
+func (self *ChunkReader) Read(p []byte) (int, error) {
+	for len(self.chunk) == 0 {
+		var err error
+		if self.chunk, err = self.receive(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, self.chunk)
+	self.chunk = self.chunk[n:]
+	return n, nil
+}
+
+//
+// ChunkWriter
+//
+
+// An [io.Writer] that sends chunks of [ChunkSize], e.g. to a gRPC stream. Call Flush to
+// send the final chunk.
+type ChunkWriter struct {
+	send   func([]byte) error
+	buffer []byte
+}
+
+func NewChunkWriter(send func([]byte) error) *ChunkWriter {
+	return &ChunkWriter{send: send}
+}
+
+// ([io.Writer] interface)
+func (self *ChunkWriter) Write(p []byte) (int, error) {
+	written := len(p)
+	for len(p) > 0 {
+		n := min(ChunkSize-len(self.buffer), len(p))
+		self.buffer = append(self.buffer, p[:n]...)
+		p = p[n:]
+
+		if len(self.buffer) == ChunkSize {
+			if err := self.Flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (self *ChunkWriter) Flush() error {
+	if len(self.buffer) > 0 {
+		// The chunk is owned by the receiver
+		chunk := self.buffer
+		self.buffer = nil
+		return self.send(chunk)
+	}
+	return nil
+}
+
+// Sends the content in chunks of [ChunkSize].
+func SendChunks(content []byte, send func([]byte) error) error {
+	for len(content) > 0 {
+		n := min(ChunkSize, len(content))
+		if err := send(content[:n]); err != nil {
+			return err
+		}
+		content = content[n:]
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package util
 
 import (
 	contextpkg "context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/tliron/commonlog"
@@ -11,14 +13,32 @@ import (
 // Controller
 //
 
+// Run is a full run, which happens every interval. If RunQueued is set then woken runs will
+// instead process only the queued keys (see [Controller.Enqueue]). While watching (see
+// [Controller.SetWatching]) full runs happen every ResyncInterval instead, as a safety net
+// for missed events.
 type Controller struct {
-	Run      func() error
-	Interval time.Duration
-	Log      commonlog.Logger
+	Run            func() error
+	RunQueued      func(keys []ControllerKey) error
+	Interval       time.Duration
+	ResyncInterval time.Duration
+	Log            commonlog.Logger
 
-	context contextpkg.Context
-	stop    contextpkg.CancelFunc
-	stopped chan struct{}
+	context   contextpkg.Context
+	stop      contextpkg.CancelFunc
+	stopped   chan struct{}
+	wake      chan struct{}
+	heartbeat atomic.Int64 // Unix nanoseconds
+	watching  atomic.Bool
+	resync    atomic.Bool
+	queue     map[ControllerKey]struct{}
+	queueLock sync.Mutex
+}
+
+type ControllerKey struct {
+	Kind      string
+	Namespace string
+	ID        string
 }
 
 func NewController(run func() error, interval time.Duration, log commonlog.Logger) *Controller {
@@ -30,19 +50,33 @@ func NewController(run func() error, interval time.Duration, log commonlog.Logge
 		context:  context,
 		stop:     stop,
 		stopped:  make(chan struct{}),
+		wake:     make(chan struct{}, 1),
+		queue:    make(map[ControllerKey]struct{}),
 	}
 }
 
 func (self *Controller) Start() {
 	self.Log.Notice("starting controller")
 	go func() {
+		lastRun := time.Now()
 		for {
+			interval := self.Interval
+			if self.watching.Load() && (self.ResyncInterval > 0) {
+				interval = self.ResyncInterval
+			}
+
+			var err error
 			select {
-			case <-time.After(self.Interval):
-				if err := self.Run(); err != nil {
-					self.Log.Criticalf("stopped controller due to error: %s", err.Error())
-					self.stopped <- struct{}{}
-					return
+			case <-time.After(interval - time.Since(lastRun)):
+				err = self.Run()
+				lastRun = time.Now()
+
+			case <-self.wake:
+				if keys := self.dequeue(); !self.resync.Swap(false) && (self.RunQueued != nil) && (len(keys) > 0) {
+					err = self.RunQueued(keys)
+				} else {
+					err = self.Run()
+					lastRun = time.Now()
 				}
 
 			case <-self.context.Done():
@@ -50,6 +84,13 @@ func (self *Controller) Start() {
 				self.stopped <- struct{}{}
 				return
 			}
+
+			if err != nil {
+				self.Log.Criticalf("stopped controller due to error: %s", err.Error())
+				self.stopped <- struct{}{}
+				return
+			}
+			self.beat()
 		}
 	}()
 }
@@ -59,3 +100,80 @@ func (self *Controller) Stop() {
 	self.stop()
 	<-self.stopped
 }
+
+// The time at which the last run ended. Will be zero before the first run.
+func (self *Controller) Heartbeat() time.Time {
+	if heartbeat := self.heartbeat.Load(); heartbeat != 0 {
+		return time.Unix(0, heartbeat)
+	} else {
+		return time.Time{}
+	}
+}
+
+// Runs the controller as soon as possible instead of waiting for the interval.
+// Multiple wakes before the run are coalesced.
+func (self *Controller) Wake() {
+	select {
+	case self.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Queues a key for [Controller.RunQueued] and wakes the controller. Keys queued before the
+// run are coalesced.
+func (self *Controller) Enqueue(key ControllerKey) {
+	self.queueLock.Lock()
+	self.queue[key] = struct{}{}
+	self.queueLock.Unlock()
+	self.Wake()
+}
+
+// Wakes the controller for a full run, discarding queued keys.
+func (self *Controller) Resync() {
+	self.resync.Store(true)
+	self.Wake()
+}
+
+// Should be called by the watch function when it starts and stops receiving events.
+func (self *Controller) SetWatching(watching bool) {
+	self.watching.Store(watching)
+}
+
+// Calls watch repeatedly in a goroutine until the controller is stopped, waiting for the
+// interval between calls. The context is done when the controller is stopped.
+func (self *Controller) Watch(watch func(context contextpkg.Context) error) {
+	go func() {
+		for {
+			if err := watch(self.context); err != nil {
+				self.Log.Warningf("watch: %s", err.Error())
+			}
+			self.SetWatching(false)
+
+			select {
+			case <-time.After(self.Interval):
+			case <-self.context.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (self *Controller) dequeue() []ControllerKey {
+	self.queueLock.Lock()
+	defer self.queueLock.Unlock()
+
+	if len(self.queue) == 0 {
+		return nil
+	}
+
+	keys := make([]ControllerKey, 0, len(self.queue))
+	for key := range self.queue {
+		keys = append(keys, key)
+	}
+	clear(self.queue)
+	return keys
+}
+
+func (self *Controller) beat() {
+	self.heartbeat.Store(time.Now().UnixNano())
+}
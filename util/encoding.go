@@ -1,17 +1,26 @@
 package util
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/tliron/go-ard"
 	"github.com/tliron/go-transcribe"
+	"github.com/tliron/yamlkeys"
+	"gopkg.in/yaml.v3"
 )
 
 var cbor_decode cbor.DecMode
+var cbor_encodeDeterministic cbor.EncMode
 
 func init() {
 	// TODO: unsuccessful attempts to unmarhsal from Python SDK's client
@@ -24,6 +33,11 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	cbor_encodeDeterministic, err = cbor.CoreDetEncOptions().EncMode()
+	if err != nil {
+		panic(err)
+	}
 }
 
 func EncodePackage(format string, package_ Package) ([]byte, error) {
@@ -71,26 +85,192 @@ func DecodePackage(format string, content []byte) (Package, error) {
 	}
 }
 
+// Decodes the resources one at a time as they are read, so that the encoded package need not
+// be buffered.
 func ReadPackage(format string, reader io.Reader) (Package, error) {
 	switch format {
 	case "yaml":
-		if package_, err := ard.ReadAllYAML(reader); err == nil {
-			package__ := make(Package, len(package_))
-			var ok bool
-			for index, resource := range package_ {
-				if package__[index], ok = resource.(Resource); !ok {
-					return nil, fmt.Errorf("YAML resource is not a map: %+v", resource)
+		package_ := Package{}
+		decoder := yaml.NewDecoder(reader)
+		for {
+			var node yaml.Node
+			if err := decoder.Decode(&node); err == nil {
+				if resource, err := yamlkeys.DecodeNode(&node); err == nil {
+					if resource_, ok := resource.(Resource); ok {
+						package_ = append(package_, resource_)
+					} else {
+						return nil, fmt.Errorf("YAML resource is not a map: %+v", resource)
+					}
+				} else {
+					return nil, err
 				}
-				/*if _, ok := GetResourceIdentifier(resources_[index]); !ok {
-					return nil, fmt.Errorf("a resource is malformed: %s", resources_[index])
-				}*/
+			} else if err == io.EOF {
+				return package_, nil
+			} else {
+				return nil, yamlkeys.WrapWithDecodeError(err)
 			}
-			return package__, nil
+		}
+
+	case "cbor":
+		return readCBORPackage(reader)
+
+	default:
+		return nil, fmt.Errorf("format not supported: %s", format)
+	}
+}
+
+// Encodes the resources one at a time, so that the encoded package need not be buffered.
+// The result can be decoded by [DecodePackage].
+func WritePackage(format string, writer io.Writer, package_ Package) error {
+	switch format {
+	case "yaml":
+		for index, resource := range package_ {
+			if index > 0 {
+				if _, err := io.WriteString(writer, "---\n"); err != nil {
+					return err
+				}
+			}
+
+			if err := transcribe.NewTranscriber().SetWriter(writer).SetIndentSpaces(2).WriteYAML([]any{resource}); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case "cbor":
+		if _, err := writer.Write(cborArrayHead(len(package_))); err != nil {
+			return err
+		}
+
+		encoder := cbor.NewEncoder(writer)
+		for _, resource := range package_ {
+			if err := encoder.Encode(resource); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("format not supported: %s", format)
+	}
+}
+
+// Returns a hex-encoded SHA-256 digest of the deterministic CBOR encoding of the package.
+// Equal packages will always have equal hashes.
+func HashPackage(package_ Package) (string, error) {
+	if package_ == nil {
+		package_ = Package{}
+	}
+
+	if content, err := cbor_encodeDeterministic.Marshal(package_); err == nil {
+		return hash(content), nil
+	} else {
+		return "", err
+	}
+}
+
+// Returns the deterministic CBOR encoding of the resource and its hex-encoded SHA-256 digest.
+// Equal resources will always have equal encodings, so the digest can be used as a
+// content address.
+func EncodeResourceBlob(resource Resource) ([]byte, string, error) {
+	if content, err := cbor_encodeDeterministic.Marshal(resource); err == nil {
+		return content, hash(content), nil
+	} else {
+		return nil, "", err
+	}
+}
+
+func DecodeResourceBlob(content []byte) (Resource, error) {
+	var resource Resource
+	if err := cbor_decode.Unmarshal(content, &resource); err == nil {
+		return resource, nil
+	} else {
+		return nil, err
+	}
+}
+
+// See [EncodeResourceBlob].
+func HashResource(resource Resource) (string, error) {
+	if _, hash, err := EncodeResourceBlob(resource); err == nil {
+		return hash, nil
+	} else {
+		return "", err
+	}
+}
+
+func hash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// CBOR packages are arrays of resources. We decode the array head ourselves so that we can
+// decode the resources one at a time.
+func readCBORPackage(reader io.Reader) (Package, error) {
+	bufferedReader := bufio.NewReader(reader)
+
+	head, err := bufferedReader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	if head>>5 != 4 {
+		return nil, errors.New("CBOR package is not an array")
+	}
+
+	var length uint64
+	switch info := head & 0x1f; {
+	case info < 24:
+		length = uint64(info)
+
+	case info <= 27:
+		size := 1 << (info - 24) // 1, 2, 4, or 8 bytes
+		bytes_ := make([]byte, size)
+		if _, err := io.ReadFull(bufferedReader, bytes_); err != nil {
+			return nil, err
+		}
+		for _, byte_ := range bytes_ {
+			length = (length << 8) | uint64(byte_)
+		}
+
+	case info == 31:
+		// Indefinite length, so we cannot know in advance how many resources to decode
+		var package_ Package
+		if err := cbor_decode.NewDecoder(io.MultiReader(bytes.NewReader([]byte{head}), bufferedReader)).Decode(&package_); err == nil {
+			return package_, nil
 		} else {
 			return nil, err
 		}
 
 	default:
-		return nil, fmt.Errorf("format not supported: %s", format)
+		return nil, fmt.Errorf("malformed CBOR array head: %x", head)
+	}
+
+	package_ := make(Package, 0, min(length, 1024))
+	decoder := cbor_decode.NewDecoder(bufferedReader)
+	for range length {
+		var resource Resource
+		if err := decoder.Decode(&resource); err == nil {
+			package_ = append(package_, resource)
+		} else {
+			return nil, err
+		}
+	}
+
+	return package_, nil
+}
+
+func cborArrayHead(length int) []byte {
+	length_ := uint64(length)
+	switch {
+	case length_ < 24:
+		return []byte{0x80 | byte(length_)}
+	case length_ <= math.MaxUint8:
+		return []byte{0x98, byte(length_)}
+	case length_ <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16([]byte{0x99}, uint16(length_))
+	case length_ <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32([]byte{0x9a}, uint32(length_))
+	default:
+		return binary.BigEndian.AppendUint64([]byte{0x9b}, length_)
 	}
 }
@@ -1,19 +1,42 @@
 package util
 
 import (
+	"crypto/tls"
 	"strings"
 
 	api "github.com/nephio-experimental/tko/api/grpc"
 	"github.com/tliron/kutil/util"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-func DialGRPCInsecure(address string, port int) (*grpc.ClientConn, error) {
+const (
+	// gRPC metadata key for the author of writes
+	GRPCAuthorMetadataKey = "tko-author"
+
+	// gRPC metadata key for bearer tokens ("Bearer <token>")
+	GRPCAuthorizationMetadataKey = "authorization"
+)
+
+func DialGRPCInsecure(address string, port int, options ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return DialGRPC(address, port, nil, options...)
+}
+
+// If tlsConfig is nil the connection will be insecure.
+func DialGRPC(address string, port int, tlsConfig *tls.Config, options ...grpc.DialOption) (*grpc.ClientConn, error) {
 	// See: https://github.com/grpc/grpc-go/issues/3272#issuecomment-1239710027
 	address = util.JoinIPAddressPort(strings.Replace(address, "%", "%25", 1), port)
 
-	return grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	var transportCredentials credentials.TransportCredentials
+	if tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	} else {
+		transportCredentials = insecure.NewCredentials()
+	}
+
+	options = append([]grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}, options...)
+	return grpc.Dial(address, options...)
 }
 
 func TriggerFromAPI(apiTrigger *api.GVK) *GVK {
@@ -46,3 +69,12 @@ func TriggersToAPI(triggers []GVK) []*api.GVK {
 	}
 	return apiTriggers
 }
+
+func PluginResourceIdentifierToAPI(resourceIdentifier ResourceIdentifier) *api.PluginResourceIdentifier {
+	return &api.PluginResourceIdentifier{
+		Group:   resourceIdentifier.GVK.Group,
+		Version: resourceIdentifier.GVK.Version,
+		Kind:    resourceIdentifier.GVK.Kind,
+		Name:    resourceIdentifier.Name,
+	}
+}
@@ -12,8 +12,18 @@ import (
 
 type Package = []Resource
 
+// Deep copy.
 func ClonePackage(package_ Package) Package {
-	return ard.Copy(package_).(Package)
+	if package_ == nil {
+		return nil
+	}
+
+	// Note that ard.Copy would not copy the resources, because Package is not an ard.List
+	package__ := make(Package, len(package_))
+	for index, resource := range package_ {
+		package__[index] = ard.Copy(resource).(Resource)
+	}
+	return package__
 }
 
 func GetReferentPackage(objectReferences ard.List, package_ Package) (Package, error) {
@@ -36,6 +46,32 @@ func GetReferentPackage(objectReferences ard.List, package_ Package) (Package, e
 	return referentPackage, nil
 }
 
+// Returns those resources in the package that are not identical to any resource in the
+// base package. Resources that are only in the base package are ignored.
+func DiffPackage(package_ Package, base Package) (Package, error) {
+	baseHashes := make(map[string]struct{}, len(base))
+	for _, resource := range base {
+		if hash, err := HashResource(resource); err == nil {
+			baseHashes[hash] = struct{}{}
+		} else {
+			return nil, err
+		}
+	}
+
+	var diff Package
+	for _, resource := range package_ {
+		if hash, err := HashResource(resource); err == nil {
+			if _, ok := baseHashes[hash]; !ok {
+				diff = append(diff, resource)
+			}
+		} else {
+			return nil, err
+		}
+	}
+
+	return diff, nil
+}
+
 func MergePackage(package_ Package, mergePackage ...Resource) Package {
 	for _, mergeResource := range mergePackage {
 		if mergeResourceIdentifier, ok := NewResourceIdentifierForResource(mergeResource); ok {
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Returns nil if both certificate and key are empty.
+//
+// If clientCa is not empty then client certificates will be verified against it (mTLS).
+// Clients may still connect without a certificate, e.g. for health checks or in order to
+// authenticate with a bearer token instead; it is up to the servers to reject them.
+func NewServerTLSConfig(certificate string, key string, clientCa string) (*tls.Config, error) {
+	if (certificate == "") && (key == "") {
+		if clientCa != "" {
+			return nil, errors.New("client CA requires a server certificate and key")
+		}
+		return nil, nil
+	}
+
+	var tlsConfig tls.Config
+
+	if certificate_, err := tls.LoadX509KeyPair(certificate, key); err == nil {
+		tlsConfig.Certificates = []tls.Certificate{certificate_}
+	} else {
+		return nil, err
+	}
+
+	if clientCa != "" {
+		var err error
+		if tlsConfig.ClientCAs, err = loadCertPool(clientCa); err != nil {
+			return nil, err
+		}
+
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return &tlsConfig, nil
+}
+
+// Returns nil if not enabled and all arguments are empty.
+//
+// If ca is empty then the system's certificate pool will be used to verify the server. If
+// certificate and key are not empty then they will be presented to the server (mTLS).
+func NewClientTLSConfig(enabled bool, ca string, certificate string, key string) (*tls.Config, error) {
+	if !enabled && (ca == "") && (certificate == "") && (key == "") {
+		return nil, nil
+	}
+
+	var tlsConfig tls.Config
+
+	if ca != "" {
+		var err error
+		if tlsConfig.RootCAs, err = loadCertPool(ca); err != nil {
+			return nil, err
+		}
+	}
+
+	if (certificate != "") || (key != "") {
+		if certificate_, err := tls.LoadX509KeyPair(certificate, key); err == nil {
+			tlsConfig.Certificates = []tls.Certificate{certificate_}
+		} else {
+			return nil, err
+		}
+	}
+
+	return &tlsConfig, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	if pem, err := os.ReadFile(path); err == nil {
+		certPool := x509.NewCertPool()
+		if certPool.AppendCertsFromPEM(pem) {
+			return certPool, nil
+		} else {
+			return nil, fmt.Errorf("no certificates in: %s", path)
+		}
+	} else {
+		return nil, err
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/nephio-experimental/tko/util"
 )
 
-func (self *Validation) ValidatePackage(package_ util.Package, complete bool) error {
+func (self *Validation) ValidatePackage(context contextpkg.Context, package_ util.Package, complete bool) error {
 	var errs []error
 
 	for _, resource := range package_ {
@@ -18,8 +18,8 @@ func (self *Validation) ValidatePackage(package_ util.Package, complete bool) er
 					validationContext := self.NewContext(package_, resourceIdentifier, complete)
 
 					for _, validate := range validators {
-						context, cancel := contextpkg.WithTimeout(contextpkg.Background(), self.Timeout)
-						errs = append(errs, wrapErrors(resourceIdentifier, validate(context, validationContext))...)
+						validateContext, cancel := contextpkg.WithTimeout(context, self.Timeout)
+						errs = append(errs, wrapErrors(resourceIdentifier, validate(validateContext, validationContext))...)
 						cancel()
 					}
 				}
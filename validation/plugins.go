@@ -5,9 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	api "github.com/nephio-experimental/tko/api/grpc"
 	client "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/metrics"
 	pluginspkg "github.com/nephio-experimental/tko/plugins"
+	opapkg "github.com/nephio-experimental/tko/plugins/opa"
+	starlarkpkg "github.com/nephio-experimental/tko/plugins/starlark"
+	"github.com/nephio-experimental/tko/telemetry"
 	tkoutil "github.com/nephio-experimental/tko/util"
 	"github.com/tliron/kutil/util"
 )
@@ -16,6 +22,7 @@ const FIFOPrefix = "tko-validation-"
 
 type PluginInput struct {
 	GRPC                    PluginInputGRPC            `yaml:"grpc"`
+	Trace                   map[string]string          `yaml:"trace,omitempty"`
 	LogFile                 string                     `yaml:"logFile"`
 	LogAddressPort          string                     `yaml:"logAddressPort"`
 	Package                 tkoutil.Package            `yaml:"package"`
@@ -27,19 +34,22 @@ type PluginInputGRPC struct {
 	Level2Protocol string `yaml:"level2protocol"`
 	Address        string `yaml:"address"`
 	Port           int    `yaml:"port"`
+	Token          string `yaml:"token,omitempty"`
 }
 
 type PluginOutput struct {
 	Error string `yaml:"error,omitempty"`
 }
 
-func (self *Context) ToPluginInput(logFile string, logAddressPort string) PluginInput {
+func (self *Context) ToPluginInput(context contextpkg.Context, logFile string, logAddressPort string) PluginInput {
 	return PluginInput{
 		GRPC: PluginInputGRPC{
 			Level2Protocol: self.Validation.Client.GRPCLevel2Protocol,
 			Address:        self.Validation.Client.GRPCAddress,
 			Port:           self.Validation.Client.GRPCPort,
+			Token:          self.Validation.Client.Token,
 		},
+		Trace:                   telemetry.InjectTrace(context),
 		LogFile:                 logFile,
 		LogAddressPort:          logAddressPort,
 		Package:                 self.Package,
@@ -48,10 +58,46 @@ func (self *Context) ToPluginInput(logFile string, logAddressPort string) Plugin
 	}
 }
 
+func (self *Context) ToPluginData() *api.PluginData {
+	return &api.PluginData{
+		Level2Protocol: self.Validation.Client.GRPCLevel2Protocol,
+		Address:        self.Validation.Client.GRPCAddress,
+		Port:           int32(self.Validation.Client.GRPCPort),
+		Token:          self.Validation.Client.Token,
+	}
+}
+
+// Executions are counted in the plugin metrics and traced. Validation errors count as failures.
 func NewPluginValidator(plugin client.Plugin, logIpStack util.IPStack, logAddress string, logPort int) (ValidateFunc, error) {
+	if validate, err := newPluginValidator(plugin, logIpStack, logAddress, logPort); err == nil {
+		return func(context contextpkg.Context, validationContext *Context) []error {
+			context, span := telemetry.StartPluginSpan(context, plugin.Type, plugin.Name, plugin.Executor)
+			start := time.Now()
+			errs := validate(context, validationContext)
+			err := errors.Join(errs...)
+			metrics.ObservePluginExecution(plugin.Type, plugin.Name, start, err)
+			telemetry.EndSpan(span, err)
+			return errs
+		}, nil
+	} else {
+		return nil, err
+	}
+}
+
+func newPluginValidator(plugin client.Plugin, logIpStack util.IPStack, logAddress string, logPort int) (ValidateFunc, error) {
 	switch plugin.Executor {
 	case pluginspkg.Command:
 		return NewCommandPluginValidator(plugin, logIpStack, logAddress, logPort)
+	case pluginspkg.GRPC:
+		return NewGRPCPluginValidator(plugin)
+	case pluginspkg.WASM:
+		return NewWASMPluginValidator(plugin)
+	case pluginspkg.Starlark:
+		return NewStarlarkPluginValidator(plugin)
+	case pluginspkg.CEL:
+		return NewCELPluginValidator(plugin)
+	case pluginspkg.OPA:
+		return NewOPAPluginValidator(plugin)
 	default:
 		return nil, fmt.Errorf("unsupported plugin executor: %s", plugin.Executor)
 	}
@@ -72,7 +118,7 @@ func NewCommandPluginValidator(plugin client.Plugin, logIpStack util.IPStack, lo
 		var output PluginOutput
 
 		if logFile, logAddressPort, err := executor.GetLog(FIFOPrefix, logIpStack, logAddress, logPort, validationContext.Validation.Log); err == nil {
-			input = validationContext.ToPluginInput(logFile, logAddressPort)
+			input = validationContext.ToPluginInput(context, logFile, logAddressPort)
 		} else {
 			return []error{err}
 		}
@@ -88,3 +134,127 @@ func NewCommandPluginValidator(plugin client.Plugin, logIpStack util.IPStack, lo
 		}
 	}, nil
 }
+
+func NewGRPCPluginValidator(plugin client.Plugin) (ValidateFunc, error) {
+	executor, err := pluginspkg.NewGRPCExecutor(plugin.Type, plugin.Name, plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, validationContext *Context) []error {
+		validationContext.Validation.Log.Info("validate via gRPC plugin",
+			"resource", validationContext.TargetResourceIdentifer,
+			"target", executor.Target)
+
+		packageFormat, err := executor.PackageFormat(context)
+		if err != nil {
+			return []error{err}
+		}
+
+		package_, err := tkoutil.EncodePackage(packageFormat, validationContext.Package)
+		if err != nil {
+			return []error{err}
+		}
+
+		if result, err := executor.Validate(context, &api.ValidateRequest{
+			Data:                     validationContext.ToPluginData(),
+			PackageFormat:            packageFormat,
+			Package:                  package_,
+			TargetResourceIdentifier: tkoutil.PluginResourceIdentifierToAPI(validationContext.TargetResourceIdentifer),
+			Complete:                 validationContext.Complete,
+		}, validationContext.Validation.Log); err == nil {
+			if result.Error == "" {
+				return nil
+			} else {
+				return []error{errors.New(result.Error)}
+			}
+		} else {
+			return []error{err}
+		}
+	}, nil
+}
+
+func NewWASMPluginValidator(plugin client.Plugin) (ValidateFunc, error) {
+	executor, err := pluginspkg.NewWASMExecutor(plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, validationContext *Context) []error {
+		validationContext.Validation.Log.Info("validate via WASM plugin",
+			"resource", validationContext.TargetResourceIdentifer,
+			"arguments", strings.Join(plugin.Arguments, " "))
+
+		input := validationContext.ToPluginInput(context, "", "")
+		var output PluginOutput
+
+		if err := executor.Execute(context, input, &output, validationContext.Validation.Log); err == nil {
+			if output.Error == "" {
+				return nil
+			} else {
+				return []error{errors.New(output.Error)}
+			}
+		} else {
+			return []error{err}
+		}
+	}, nil
+}
+
+func NewStarlarkPluginValidator(plugin client.Plugin) (ValidateFunc, error) {
+	executor, err := starlarkpkg.NewExecutor(plugin.Name, plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, validationContext *Context) []error {
+		validationContext.Validation.Log.Info("validate via Starlark plugin",
+			"resource", validationContext.TargetResourceIdentifer)
+
+		return executor.Validate(context, &starlarkpkg.Environment{
+			Client:                   validationContext.Validation.Client,
+			Log:                      validationContext.Validation.Log,
+			Package:                  validationContext.Package,
+			TargetResourceIdentifier: validationContext.TargetResourceIdentifer,
+			Complete:                 validationContext.Complete,
+		})
+	}, nil
+}
+
+func NewCELPluginValidator(plugin client.Plugin) (ValidateFunc, error) {
+	executor, err := pluginspkg.NewCELExecutor(plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, validationContext *Context) []error {
+		validationContext.Validation.Log.Info("validate via CEL plugin",
+			"resource", validationContext.TargetResourceIdentifer,
+			"rules", len(executor.Rules))
+
+		if resource, ok := validationContext.GetResource(); ok {
+			return executor.Validate(context, resource, validationContext.Package, validationContext.Complete, validationContext.Validation.Log)
+		} else {
+			return nil
+		}
+	}, nil
+}
+
+// The compiled policy is cached with the validator.
+func NewOPAPluginValidator(plugin client.Plugin) (ValidateFunc, error) {
+	executor, err := opapkg.NewExecutor(plugin.Arguments, plugin.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(context contextpkg.Context, validationContext *Context) []error {
+		validationContext.Validation.Log.Info("validate via OPA plugin",
+			"resource", validationContext.TargetResourceIdentifer,
+			"query", executor.Query)
+
+		if resource, ok := validationContext.GetResource(); ok {
+			return executor.Validate(context, validationContext.Validation.Client, resource, validationContext.Package, validationContext.Complete)
+		} else {
+			return nil
+		}
+	}, nil
+}
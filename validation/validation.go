@@ -7,6 +7,7 @@ import (
 	"time"
 
 	clientpkg "github.com/nephio-experimental/tko/api/grpc-client"
+	"github.com/nephio-experimental/tko/plugins"
 	"github.com/tliron/commonlog"
 	"github.com/tliron/kutil/util"
 	validatorpkg "github.com/yannh/kubeconform/pkg/validator"
@@ -59,4 +60,5 @@ func NewValidation(client *clientpkg.Client, timeout time.Duration, log commonlo
 
 func (self *Validation) ResetPluginCache() {
 	self.validators = sync.Map{}
+	plugins.ResetGRPCCapabilities()
 }